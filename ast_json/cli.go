@@ -5,7 +5,9 @@ import (
 	"go/parser"
 	"go/printer"
 	"go/token"
+	"io"
 	"os"
+	"path/filepath"
 	"strings"
 )
 
@@ -14,6 +16,8 @@ type Options struct {
 	WithComments   bool
 	WithReferences bool
 	WithImports    bool
+	// Compression compresses the JSON files written, CompressionNone picks it from the extension of the output file.
+	Compression Compression
 }
 
 // SourceToJSONWithContent converts the given Go source code to JSON and writes it to the given output file.
@@ -23,6 +27,31 @@ type Options struct {
 // @param indent: indentation string
 // @param options: options for converting the file to JSON
 func SourceToJSONWithContent(input *string, path, output string, indent string, options Options) error {
+	return SourceToJSONWithMeta(input, path, output, indent, options, nil)
+}
+
+// SourceToJSONWithMeta converts the given Go source code to JSON, recording meta in the file node.
+// @param input: input file path
+// @param path: path of the file
+// @param output: output file path
+// @param indent: indentation string
+// @param options: options for converting the file to JSON
+// @param meta: description of the file, nil records none
+func SourceToJSONWithMeta(input *string, path, output string, indent string, options Options, meta *FileMetaNode) error {
+	node, err := SourceToNode(input, path, options, meta)
+	if err != nil {
+		return err
+	}
+	return writeJSON(output, indent, node, options.Compression)
+}
+
+// SourceToNode parses the given Go source code and marshals it to a file node, recording meta in it.
+// It is the first half of SourceToJSONWithMeta, WriteJSON being the second.
+// @param input: source code
+// @param path: path of the file
+// @param options: options for converting the file to JSON
+// @param meta: description of the file, nil records none
+func SourceToNode(input *string, path string, options Options, meta *FileMetaNode) (*FileNode, error) {
 	// Create a new marshaller with the given options
 	marshaller := NewMarshaller(options)
 
@@ -35,35 +64,13 @@ func SourceToJSONWithContent(input *string, path, output string, indent string,
 	// Parse the file using the marshaller
 	tree, err := parser.ParseFile(marshaller.FileSet(), path, strings.NewReader(*input), mode)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	// Marshal the file to a node
 	node := marshaller.MarshalFile(tree)
-
-	// Create the output file
-	outFile, err := os.Create(output)
-	if err != nil {
-		return err
-	}
-
-	// Create a JSON encoder with the specified indent
-	encoder := json.NewEncoder(outFile)
-	encoder.SetIndent("", indent)
-
-	// Encode the node to JSON and write it to the output file
-	err = encoder.Encode(node)
-	if err != nil {
-		return err
-	}
-
-	// Close the output file
-	err = outFile.Close()
-	if err != nil {
-		return err
-	}
-
-	return nil
+	node.Meta = meta
+	return node, nil
 }
 
 // SourceToJSON converts the given Go source code to JSON and writes it to the given output file.
@@ -90,38 +97,78 @@ func SourceToJSON(input, output string, indent string, options Options) error {
 	// Marshal the file to a node
 	node := marshaller.MarshalFile(tree)
 
-	// Create the output file
-	outFile, err := os.Create(output)
-	if err != nil {
-		return err
-	}
+	return writeJSON(output, indent, node, options.Compression)
+}
 
-	// Create a JSON encoder with the specified indent
-	encoder := json.NewEncoder(outFile)
-	encoder.SetIndent("", indent)
+// WriteJSON encodes node to output through a temporary file renamed into place,
+// so that an interrupted run never leaves a truncated output file behind.
+// Outputs named with the extension of a compression are compressed with it.
+// @param output: output file path
+// @param indent: indentation string
+// @param node: node to encode
+func WriteJSON(output string, indent string, node *FileNode) error {
+	return writeJSON(output, indent, node, CompressionNone)
+}
 
-	// Encode the node to JSON and write it to the output file
-	err = encoder.Encode(node)
+// writeJSON is WriteJSON with compression, CompressionNone picks the compression from the extension of output.
+func writeJSON(output string, indent string, node *FileNode, compression Compression) error {
+	if compression == CompressionNone {
+		compression = CompressionForFile(output)
+	}
+	return WriteFileAtomic(output, func(out io.Writer) error {
+		writer, err := NewJSONWriter(out, compression)
+		if err != nil {
+			return err
+		}
+
+		// Create a JSON encoder with the specified indent
+		encoder := json.NewEncoder(writer)
+		encoder.SetIndent("", indent)
+		err = encoder.Encode(node)
+		if closeErr := writer.Close(); err == nil {
+			err = closeErr
+		}
+		return err
+	})
+}
+
+// WriteFileAtomic writes output through a temporary file next to it, readable
+// like a created file and renamed into place once write succeeded, so that an
+// interrupted run never leaves a truncated output file behind.
+// @param output: output file path
+// @param write: function writing the content of the file
+func WriteFileAtomic(output string, write func(io.Writer) error) error {
+	outFile, err := os.CreateTemp(filepath.Dir(output), ".tmp-"+filepath.Base(output)+"-*")
 	if err != nil {
 		return err
 	}
+	err = outFile.Chmod(0644)
+	if err == nil {
+		err = write(outFile)
+	}
+	if closeErr := outFile.Close(); err == nil {
+		err = closeErr
+	}
 
-	// Close the output file
-	err = outFile.Close()
+	// Move the complete file into place
+	if err == nil {
+		err = os.Rename(outFile.Name(), output)
+	}
 	if err != nil {
+		os.Remove(outFile.Name())
 		return err
 	}
-
 	return nil
 }
 
 // JSONToSource converts the given JSON file to Go source code and writes it to the given output file.
+// Compressed input files are decompressed transparently.
 // @param input: input file path
 // @param output: output file path
 // @param options: options for converting the file to JSON
 func JSONToSource(input, output string, options Options) error {
 	// Open the input file
-	inFile, err := os.Open(input)
+	inFile, err := OpenJSON(input)
 	if err != nil {
 		return err
 	}
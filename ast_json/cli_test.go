@@ -106,6 +106,10 @@ func copyOriginalFiles() error {
 	}
 
 	dstRoot := getTestDataRoot()
+	err = os.MkdirAll(dstRoot, os.ModePerm)
+	if err != nil {
+		return err
+	}
 
 	for _, srcPath := range files {
 		filename := filepath.Base(srcPath)
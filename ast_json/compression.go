@@ -0,0 +1,161 @@
+package ast_json
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/klauspost/compress/zstd"
+)
+
+// Compression selects how the JSON files are compressed. It implements
+// flag.Value so that it can be set from the command line.
+type Compression string
+
+const (
+	CompressionNone Compression = ""
+	CompressionGzip Compression = "gzip"
+	CompressionZstd Compression = "zstd"
+)
+
+var (
+	gzipMagic = []byte{0x1f, 0x8b}
+	zstdMagic = []byte{0x28, 0xb5, 0x2f, 0xfd}
+)
+
+// String returns the name of the compression, empty without compression.
+func (c Compression) String() string {
+	return string(c)
+}
+
+// Set parses the name of a compression: none, gzip or zstd.
+// @param name: name of the compression, empty selects none
+func (c *Compression) Set(name string) error {
+	switch strings.ToLower(name) {
+	case "", "none":
+		*c = CompressionNone
+	case "gzip", "gz":
+		*c = CompressionGzip
+	case "zstd", "zst":
+		*c = CompressionZstd
+	default:
+		return fmt.Errorf("unknown compression %q, use none, gzip or zstd", name)
+	}
+	return nil
+}
+
+// Extension returns the suffix added to the name of compressed files, empty without compression.
+func (c Compression) Extension() string {
+	switch c {
+	case CompressionGzip:
+		return ".gz"
+	case CompressionZstd:
+		return ".zst"
+	}
+	return ""
+}
+
+// CompressionForFile returns the compression named by the extension of a file name.
+// @param name: file name
+func CompressionForFile(name string) Compression {
+	switch {
+	case strings.HasSuffix(name, CompressionGzip.Extension()):
+		return CompressionGzip
+	case strings.HasSuffix(name, CompressionZstd.Extension()):
+		return CompressionZstd
+	}
+	return CompressionNone
+}
+
+// NewJSONWriter returns a writer compressing what it is given to w.
+// Closing it flushes the compressed stream, it does not close w.
+// @param w: writer receiving the compressed data
+// @param compression: compression to apply
+func NewJSONWriter(w io.Writer, compression Compression) (io.WriteCloser, error) {
+	switch compression {
+	case CompressionNone:
+		return nopWriteCloser{w}, nil
+	case CompressionGzip:
+		return gzip.NewWriter(w), nil
+	case CompressionZstd:
+		// Files are written by many workers at once, each encoder keeps to one goroutine
+		return zstd.NewWriter(w, zstd.WithEncoderConcurrency(1))
+	}
+	return nil, fmt.Errorf("unknown compression %q", string(compression))
+}
+
+// NewJSONReader returns a reader of the JSON held by r, decompressing it when
+// its first bytes are the magic number of gzip or zstd.
+// @param r: reader of a JSON file, compressed or not
+func NewJSONReader(r io.Reader) (io.ReadCloser, error) {
+	buffered := bufio.NewReader(r)
+	magic, _ := buffered.Peek(len(zstdMagic))
+	switch {
+	case bytes.HasPrefix(magic, gzipMagic):
+		return gzip.NewReader(buffered)
+	case bytes.HasPrefix(magic, zstdMagic):
+		decoder, err := zstd.NewReader(buffered, zstd.WithDecoderConcurrency(1))
+		if err != nil {
+			return nil, err
+		}
+		return decoder.IOReadCloser(), nil
+	}
+	return io.NopCloser(buffered), nil
+}
+
+// OpenJSON opens a JSON file, decompressing it transparently.
+// @param path: file path
+func OpenJSON(path string) (io.ReadCloser, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	reader, err := NewJSONReader(file)
+	if err != nil {
+		file.Close()
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return readCloser{Reader: reader, closers: []io.Closer{reader, file}}, nil
+}
+
+// ReadJSONFile returns the content of a JSON file, decompressing it transparently.
+// @param path: file path
+func ReadJSONFile(path string) ([]byte, error) {
+	reader, err := OpenJSON(path)
+	if err != nil {
+		return nil, err
+	}
+	data, err := io.ReadAll(reader)
+	if closeErr := reader.Close(); err == nil {
+		err = closeErr
+	}
+	return data, err
+}
+
+type nopWriteCloser struct {
+	io.Writer
+}
+
+func (nopWriteCloser) Close() error {
+	return nil
+}
+
+// readCloser closes the decompressor before the file it reads.
+type readCloser struct {
+	io.Reader
+	closers []io.Closer
+}
+
+func (r readCloser) Close() error {
+	var err error
+	for _, closer := range r.closers {
+		if closeErr := closer.Close(); err == nil {
+			err = closeErr
+		}
+	}
+	return err
+}
@@ -0,0 +1,99 @@
+package ast_json
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestCompressedRoundTrip(t *testing.T) {
+	source, err := os.ReadFile("cli.go")
+	if err != nil {
+		t.Fatal(err)
+	}
+	options := Options{WithPositions: true, WithReferences: true, WithComments: true}
+	dir := t.TempDir()
+	plain := filepath.Join(dir, "cli.json")
+	err = SourceToJSON("cli.go", plain, "  ", options)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want, err := os.ReadFile(plain)
+	if err != nil {
+		t.Fatal(err)
+	}
+	plainGo := filepath.Join(dir, "cli.go")
+	err = JSONToSource(plain, plainGo, options)
+	if err != nil {
+		t.Fatal(err)
+	}
+	wantSource, err := os.ReadFile(plainGo)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name        string
+		output      string
+		compression Compression
+		magic       []byte
+	}{
+		{"gzip option", "cli.json", CompressionGzip, gzipMagic},
+		{"zstd option", "cli.json", CompressionZstd, zstdMagic},
+		{"gzip extension", "cli.json.gz", CompressionNone, gzipMagic},
+		{"zstd extension", "cli.json.zst", CompressionNone, zstdMagic},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			output := filepath.Join(t.TempDir(), test.output)
+			options := options
+			options.Compression = test.compression
+			input := string(source)
+			err := SourceToJSONWithContent(&input, "cli.go", output, "  ", options)
+			if err != nil {
+				t.Fatal(err)
+			}
+			data, err := os.ReadFile(output)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !bytes.HasPrefix(data, test.magic) || len(data) >= len(want) {
+				t.Errorf("%s is not compressed, %d bytes", test.output, len(data))
+			}
+
+			// Readers detect the compression from the content
+			got, err := ReadJSONFile(output)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !bytes.Equal(got, want) {
+				t.Error("decompressed JSON differs from the uncompressed one")
+			}
+			goFile := filepath.Join(t.TempDir(), "cli.go")
+			err = JSONToSource(output, goFile, options)
+			if err != nil {
+				t.Fatal(err)
+			}
+			printed, err := os.ReadFile(goFile)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !bytes.Equal(printed, wantSource) {
+				t.Error("source printed from the compressed JSON differs")
+			}
+		})
+	}
+}
+
+func TestCompressionSet(t *testing.T) {
+	var c Compression
+	for name, want := range map[string]Compression{"": CompressionNone, "none": CompressionNone, "gzip": CompressionGzip, "zst": CompressionZstd} {
+		if err := c.Set(name); err != nil || c != want {
+			t.Errorf("Set(%q) = %q, %v", name, c, err)
+		}
+	}
+	if err := c.Set("lz4"); err == nil {
+		t.Error("unknown compression accepted")
+	}
+}
@@ -0,0 +1,52 @@
+package ast_json
+
+import (
+	"encoding/json"
+	"go/token"
+)
+
+// serializedFileSet mirrors what token.FileSet.Write hands to its encoder.
+type serializedFileSet struct {
+	Base  int
+	Files []serializedFile
+}
+
+type serializedFile struct {
+	Name  string
+	Base  int
+	Size  int
+	Lines []int
+	Infos []serializedLineInfo
+}
+
+type serializedLineInfo struct {
+	Offset   int
+	Filename string
+	Line     int
+	Column   int
+}
+
+// writeFileSet returns the content of fset as token.FileSet.Write hands it to its encoder.
+func writeFileSet(fset *token.FileSet) (serializedFileSet, error) {
+	var set serializedFileSet
+	err := fset.Write(func(src any) error {
+		data, err := json.Marshal(src)
+		if err != nil {
+			return err
+		}
+		return json.Unmarshal(data, &set)
+	})
+	return set, err
+}
+
+// readFileSet restores the content of set into fset.
+func readFileSet(fset *token.FileSet, set serializedFileSet) {
+	// Read fails only when its decoder does, the set is its own JSON
+	_ = fset.Read(func(dest any) error {
+		data, err := json.Marshal(set)
+		if err != nil {
+			return err
+		}
+		return json.Unmarshal(data, dest)
+	})
+}
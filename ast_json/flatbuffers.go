@@ -0,0 +1,413 @@
+package ast_json
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"go/printer"
+	"go/token"
+	"io"
+	"os"
+
+	"GoOperatorAST/astfb"
+	flatbuffers "github.com/google/flatbuffers/go"
+)
+
+// The FlatBuffers format holds the same nodes as the JSON one, laid out by the
+// schema ast.fbs at the root of the repository. Its tables are read in place,
+// so that single declarations are decoded without the rest of the file.
+
+// NodeToFlatBuffer encodes a file node to a FlatBuffers buffer of the ast.fbs schema.
+// Nodes shared by several parents are encoded once.
+// @param node: file node to encode
+func NodeToFlatBuffer(node *FileNode) ([]byte, error) {
+	e := &flatBufferEncoder{
+		builder: flatbuffers.NewBuilder(1024),
+		tables:  map[any]flatbuffers.UOffsetT{},
+	}
+	file := e.encodeFileNode(node)
+	if e.err != nil {
+		return nil, e.err
+	}
+	astfb.FinishFileNodeBuffer(e.builder, file)
+	return e.builder.FinishedBytes(), nil
+}
+
+// FlatBufferToNode decodes a FlatBuffers buffer of the ast.fbs schema to a file node.
+// @param buf: buffer written by NodeToFlatBuffer
+func FlatBufferToNode(buf []byte) (node *FileNode, err error) {
+	decoder, err := NewFlatBufferDecoder(buf)
+	if err != nil {
+		return nil, err
+	}
+	defer recoverFlatBuffer(&err)
+	return decoder.File(), nil
+}
+
+// FlatBufferDecoder decodes the tables of a buffer to nodes on demand.
+type FlatBufferDecoder struct {
+	root     *astfb.FileNode
+	filename string
+	tables   map[flatbuffers.UOffsetT]any
+}
+
+// NewFlatBufferDecoder returns a decoder of buf, which must hold a FileNode of the ast.fbs schema.
+// @param buf: buffer written by NodeToFlatBuffer
+func NewFlatBufferDecoder(buf []byte) (decoder *FlatBufferDecoder, err error) {
+	// The root offset is followed by the 4 bytes of the file identifier
+	if len(buf) < flatbuffers.SizeUOffsetT+4 || !astfb.FileNodeBufferHasIdentifier(buf) {
+		return nil, errors.New("not a FlatBuffers AST file")
+	}
+	defer recoverFlatBuffer(&err)
+	root := astfb.GetRootAsFileNode(buf, 0)
+	return &FlatBufferDecoder{
+		root:     root,
+		filename: string(root.Filename()),
+		tables:   map[flatbuffers.UOffsetT]any{},
+	}, nil
+}
+
+// Root returns the table of the file, to read fields in place.
+func (d *FlatBufferDecoder) Root() *astfb.FileNode {
+	return d.root
+}
+
+// File decodes the whole file.
+func (d *FlatBufferDecoder) File() *FileNode {
+	table := d.root
+	node := &FileNode{
+		Node:    d.node("File", table.RefId()),
+		Meta:    d.decodeFileMetaNode(table.Meta(nil)),
+		Doc:     d.decodeCommentGroupNode(table.Doc(nil)),
+		Package: d.decodePosition(table.Package(nil)),
+		Name:    d.decodeIdentNode(table.Name(nil)),
+		Decls:   make([]IDeclNode, table.DeclsLength()),
+		Imports: decodeVector(table.ImportsLength(), func(i int) *ImportSpecNode {
+			var item astfb.ImportSpecNode
+			table.Imports(&item, i)
+			return d.decodeImportSpecNode(&item)
+		}),
+		Unresolved: decodeVector(table.UnresolvedLength(), func(i int) *IdentNode {
+			var item astfb.IdentNode
+			table.Unresolved(&item, i)
+			return d.decodeIdentNode(&item)
+		}),
+		Comments: decodeVector(table.CommentsLength(), func(i int) *CommentGroupNode {
+			var item astfb.CommentGroupNode
+			table.Comments(&item, i)
+			return d.decodeCommentGroupNode(&item)
+		}),
+		FileSet: token.NewFileSet(),
+	}
+	// The Marshaller records the list of declarations even when it is empty
+	for i := range node.Decls {
+		node.Decls[i] = d.Decl(i)
+	}
+	d.decodeFileSet(table.FileSet(nil), node.FileSet)
+	return node
+}
+
+// Decl decodes the declaration at index i of the file.
+// @param i: index of the declaration, below Root().DeclsLength()
+func (d *FlatBufferDecoder) Decl(i int) IDeclNode {
+	var item astfb.DeclItem
+	if !d.root.Decls(&item, i) {
+		return nil
+	}
+	return d.decodeDecl(item.NodeType(), item.Node)
+}
+
+// FuncDecl decodes the function or method declared with name, nil when there is none.
+// The other declarations are skipped without being decoded.
+// @param name: name of the function
+func (d *FlatBufferDecoder) FuncDecl(name string) *FuncDeclNode {
+	var item astfb.DeclItem
+	var table flatbuffers.Table
+	for i := 0; i < d.root.DeclsLength(); i++ {
+		if !d.root.Decls(&item, i) || item.NodeType() != astfb.DeclFuncDeclNode || !item.Node(&table) {
+			continue
+		}
+		var decl astfb.FuncDeclNode
+		decl.Init(table.Bytes, table.Pos)
+		ident := decl.Name(nil)
+		if ident != nil && bytes.Equal(ident.Name(), []byte(name)) {
+			return d.decodeFuncDeclNode(&decl)
+		}
+	}
+	return nil
+}
+
+// WriteFlatBuffer encodes node to output through a temporary file renamed into place.
+// @param output: output file path
+// @param node: node to encode
+func WriteFlatBuffer(output string, node *FileNode) error {
+	buf, err := NodeToFlatBuffer(node)
+	if err != nil {
+		return err
+	}
+	return WriteFileAtomic(output, func(out io.Writer) error {
+		_, err := out.Write(buf)
+		return err
+	})
+}
+
+// SourceToFlatBuffer converts the given Go source code to a FlatBuffers file.
+// @param input: input file path
+// @param output: output file path
+// @param options: options for converting the file
+func SourceToFlatBuffer(input, output string, options Options) error {
+	content, err := os.ReadFile(input)
+	if err != nil {
+		return err
+	}
+	source := string(content)
+	node, err := SourceToNode(&source, input, options, nil)
+	if err != nil {
+		return err
+	}
+	return WriteFlatBuffer(output, node)
+}
+
+// FlatBufferToSource converts the given FlatBuffers file to Go source code and writes it to the given output file.
+// @param input: input file path
+// @param output: output file path
+// @param options: options for converting the file
+func FlatBufferToSource(input, output string, options Options) error {
+	buf, err := os.ReadFile(input)
+	if err != nil {
+		return err
+	}
+	node, err := FlatBufferToNode(buf)
+	if err != nil {
+		return fmt.Errorf("%s: %w", input, err)
+	}
+
+	// Unmarshal the FileNode to a tree and print it
+	unmarshaler := NewUnmarshaller(options)
+	tree := unmarshaler.UnmarshalFileNode(node)
+	outFile, err := os.Create(output)
+	if err != nil {
+		return err
+	}
+	err = printer.Fprint(outFile, unmarshaler.FileSet(), tree)
+	if closeErr := outFile.Close(); err == nil {
+		err = closeErr
+	}
+	return err
+}
+
+// recoverFlatBuffer turns the panic of an accessor reading past a malformed buffer into an error.
+func recoverFlatBuffer(err *error) {
+	if r := recover(); r != nil {
+		*err = fmt.Errorf("malformed FlatBuffers AST file: %v", r)
+	}
+}
+
+// ---------------------------------------------------------------------------
+
+type flatBufferEncoder struct {
+	builder  *flatbuffers.Builder
+	tables   map[any]flatbuffers.UOffsetT
+	filename string
+	err      error
+}
+
+// encodeTable encodes node once, a nil node is the absent table.
+func encodeTable[T any](e *flatBufferEncoder, node *T, encode func() flatbuffers.UOffsetT) flatbuffers.UOffsetT {
+	if node == nil {
+		return 0
+	}
+	if offset, ok := e.tables[node]; ok {
+		return offset
+	}
+	offset := encode()
+	e.tables[node] = offset
+	return offset
+}
+
+// encodeVector encodes a vector of n tables, an empty one is absent.
+func (e *flatBufferEncoder) encodeVector(n int, encode func(i int) flatbuffers.UOffsetT) flatbuffers.UOffsetT {
+	if n == 0 {
+		return 0
+	}
+	offsets := make([]flatbuffers.UOffsetT, n)
+	for i := range offsets {
+		offsets[i] = encode(i)
+		if offsets[i] == 0 && e.err == nil {
+			e.err = errors.New("nil node in a list")
+		}
+	}
+	e.builder.StartVector(flatbuffers.SizeUOffsetT, n, flatbuffers.SizeUOffsetT)
+	for i := n - 1; i >= 0; i-- {
+		e.builder.PrependUOffsetT(offsets[i])
+	}
+	return e.builder.EndVector(n)
+}
+
+// encodePosition encodes a position inline, its filename is the one of the file.
+func (e *flatBufferEncoder) encodePosition(node *PositionNode) flatbuffers.UOffsetT {
+	if e.filename == "" {
+		e.filename = node.Filename
+	} else if node.Filename != e.filename && e.err == nil {
+		e.err = fmt.Errorf("position in %s inside file %s", node.Filename, e.filename)
+	}
+	return astfb.CreatePositionNode(e.builder, int32(node.RefId), int32(node.Offset), int32(node.Line), int32(node.Column))
+}
+
+func (e *flatBufferEncoder) encodeFileNode(node *FileNode) flatbuffers.UOffsetT {
+	meta := e.encodeFileMetaNode(node.Meta)
+	doc := e.encodeCommentGroupNode(node.Doc)
+	name := e.encodeIdentNode(node.Name)
+	decls := e.encodeDeclItems(node.Decls)
+	imports := e.encodeVector(len(node.Imports), func(i int) flatbuffers.UOffsetT {
+		return e.encodeImportSpecNode(node.Imports[i])
+	})
+	unresolved := e.encodeVector(len(node.Unresolved), func(i int) flatbuffers.UOffsetT {
+		return e.encodeIdentNode(node.Unresolved[i])
+	})
+	comments := e.encodeVector(len(node.Comments), func(i int) flatbuffers.UOffsetT {
+		return e.encodeCommentGroupNode(node.Comments[i])
+	})
+	fileSet := e.encodeFileSet(node.FileSet)
+	if node.Package != nil && e.filename == "" {
+		e.filename = node.Package.Filename
+	}
+	filename := e.builder.CreateSharedString(e.filename)
+
+	astfb.FileNodeStart(e.builder)
+	astfb.FileNodeAddRefId(e.builder, int32(node.RefId))
+	astfb.FileNodeAddMeta(e.builder, meta)
+	astfb.FileNodeAddDoc(e.builder, doc)
+	if node.Package != nil {
+		astfb.FileNodeAddPackage(e.builder, e.encodePosition(node.Package))
+	}
+	astfb.FileNodeAddName(e.builder, name)
+	astfb.FileNodeAddDecls(e.builder, decls)
+	astfb.FileNodeAddImports(e.builder, imports)
+	astfb.FileNodeAddUnresolved(e.builder, unresolved)
+	astfb.FileNodeAddComments(e.builder, comments)
+	astfb.FileNodeAddFileSet(e.builder, fileSet)
+	astfb.FileNodeAddFilename(e.builder, filename)
+	return astfb.FileNodeEnd(e.builder)
+}
+
+func (e *flatBufferEncoder) encodeFileSet(fset *token.FileSet) flatbuffers.UOffsetT {
+	if fset == nil {
+		return 0
+	}
+	set, err := writeFileSet(fset)
+	if err != nil {
+		if e.err == nil {
+			e.err = err
+		}
+		return 0
+	}
+
+	files := e.encodeVector(len(set.Files), func(i int) flatbuffers.UOffsetT {
+		file := set.Files[i]
+		name := e.builder.CreateSharedString(file.Name)
+		infos := e.encodeVector(len(file.Infos), func(j int) flatbuffers.UOffsetT {
+			info := file.Infos[j]
+			filename := e.builder.CreateSharedString(info.Filename)
+			astfb.FileSetLineInfoStart(e.builder)
+			astfb.FileSetLineInfoAddOffset(e.builder, int32(info.Offset))
+			astfb.FileSetLineInfoAddFilename(e.builder, filename)
+			astfb.FileSetLineInfoAddLine(e.builder, int32(info.Line))
+			astfb.FileSetLineInfoAddColumn(e.builder, int32(info.Column))
+			return astfb.FileSetLineInfoEnd(e.builder)
+		})
+		var lines flatbuffers.UOffsetT
+		if len(file.Lines) > 0 {
+			astfb.FileSetFileStartLinesVector(e.builder, len(file.Lines))
+			for j := len(file.Lines) - 1; j >= 0; j-- {
+				e.builder.PrependInt32(int32(file.Lines[j]))
+			}
+			lines = e.builder.EndVector(len(file.Lines))
+		}
+		astfb.FileSetFileStart(e.builder)
+		astfb.FileSetFileAddName(e.builder, name)
+		astfb.FileSetFileAddBase(e.builder, int32(file.Base))
+		astfb.FileSetFileAddSize(e.builder, int32(file.Size))
+		astfb.FileSetFileAddLines(e.builder, lines)
+		astfb.FileSetFileAddInfos(e.builder, infos)
+		return astfb.FileSetFileEnd(e.builder)
+	})
+	astfb.FileSetStart(e.builder)
+	astfb.FileSetAddBase(e.builder, int32(set.Base))
+	astfb.FileSetAddFiles(e.builder, files)
+	return astfb.FileSetEnd(e.builder)
+}
+
+// ---------------------------------------------------------------------------
+
+// decodeTable decodes the table at pos once, the nodes shared in the buffer stay shared.
+func decodeTable[R any](d *FlatBufferDecoder, pos flatbuffers.UOffsetT, decode func() *R) *R {
+	if node, ok := d.tables[pos]; ok {
+		return node.(*R)
+	}
+	node := decode()
+	d.tables[pos] = node
+	return node
+}
+
+// decodeVector decodes a vector of n elements, an empty one is nil like in the JSON.
+func decodeVector[R any](n int, decode func(i int) R) []R {
+	if n == 0 {
+		return nil
+	}
+	nodes := make([]R, n)
+	for i := range nodes {
+		nodes[i] = decode(i)
+	}
+	return nodes
+}
+
+// node returns the Node of a node type as recorded by the Marshaller.
+func (d *FlatBufferDecoder) node(nodeType string, ref int32) Node {
+	return Node{
+		NodeType: nodeType,
+		RefId:    int(ref),
+		Id:       nodeTypesMap[nodeType],
+	}
+}
+
+func (d *FlatBufferDecoder) decodePosition(position *astfb.PositionNode) *PositionNode {
+	if position == nil {
+		return nil
+	}
+	return &PositionNode{
+		Node:     d.node("Position", position.RefId()),
+		Filename: d.filename,
+		Offset:   int(position.Offset()),
+		Line:     int(position.Line()),
+		Column:   int(position.Column()),
+	}
+}
+
+func (d *FlatBufferDecoder) decodeFileSet(table *astfb.FileSet, fset *token.FileSet) {
+	if table == nil {
+		return
+	}
+	set := serializedFileSet{Base: int(table.Base())}
+	var file astfb.FileSetFile
+	var info astfb.FileSetLineInfo
+	for i := 0; i < table.FilesLength(); i++ {
+		table.Files(&file, i)
+		current := serializedFile{Name: string(file.Name()), Base: int(file.Base()), Size: int(file.Size())}
+		current.Lines = make([]int, file.LinesLength())
+		for j := range current.Lines {
+			current.Lines[j] = int(file.Lines(j))
+		}
+		for j := 0; j < file.InfosLength(); j++ {
+			file.Infos(&info, j)
+			current.Infos = append(current.Infos, serializedLineInfo{
+				Offset:   int(info.Offset()),
+				Filename: string(info.Filename()),
+				Line:     int(info.Line()),
+				Column:   int(info.Column()),
+			})
+		}
+		set.Files = append(set.Files, current)
+	}
+	readFileSet(fset, set)
+}
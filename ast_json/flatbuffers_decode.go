@@ -0,0 +1,1102 @@
+package ast_json
+
+import (
+	"GoOperatorAST/astfb"
+	flatbuffers "github.com/google/flatbuffers/go"
+)
+
+// Decoders of the node tables of ast.fbs, one per node type of nodes.go.
+
+func (d *FlatBufferDecoder) decodeCommentNode(table *astfb.CommentNode) *CommentNode {
+	if table == nil {
+		return nil
+	}
+	return decodeTable(d, table.Table().Pos, func() *CommentNode {
+		return &CommentNode{
+			Node:  d.node("Comment", table.RefId()),
+			Slash: d.decodePosition(table.Slash(nil)),
+			Text:  string(table.Text()),
+		}
+	})
+}
+
+func (d *FlatBufferDecoder) decodeCommentGroupNode(table *astfb.CommentGroupNode) *CommentGroupNode {
+	if table == nil {
+		return nil
+	}
+	return decodeTable(d, table.Table().Pos, func() *CommentGroupNode {
+		return &CommentGroupNode{
+			Node: d.node("CommentGroup", table.RefId()),
+			List: decodeVector(table.ListLength(), func(i int) *CommentNode {
+				var item astfb.CommentNode
+				table.List(&item, i)
+				return d.decodeCommentNode(&item)
+			}),
+		}
+	})
+}
+
+func (d *FlatBufferDecoder) decodeFieldNode(table *astfb.FieldNode) *FieldNode {
+	if table == nil {
+		return nil
+	}
+	return decodeTable(d, table.Table().Pos, func() *FieldNode {
+		return &FieldNode{
+			Node: d.node("Field", table.RefId()),
+			Doc:  d.decodeCommentGroupNode(table.Doc(nil)),
+			Names: decodeVector(table.NamesLength(), func(i int) *IdentNode {
+				var item astfb.IdentNode
+				table.Names(&item, i)
+				return d.decodeIdentNode(&item)
+			}),
+			Type:    d.decodeExpr(table.TypeType(), table.Type),
+			Tag:     d.decodeBasicLitNode(table.Tag(nil)),
+			Comment: d.decodeCommentGroupNode(table.Comment(nil)),
+		}
+	})
+}
+
+func (d *FlatBufferDecoder) decodeFieldListNode(table *astfb.FieldListNode) *FieldListNode {
+	if table == nil {
+		return nil
+	}
+	return decodeTable(d, table.Table().Pos, func() *FieldListNode {
+		return &FieldListNode{
+			Node:    d.node("FieldList", table.RefId()),
+			Opening: d.decodePosition(table.Opening(nil)),
+			List: decodeVector(table.ListLength(), func(i int) *FieldNode {
+				var item astfb.FieldNode
+				table.List(&item, i)
+				return d.decodeFieldNode(&item)
+			}),
+			Closing: d.decodePosition(table.Closing(nil)),
+		}
+	})
+}
+
+func (d *FlatBufferDecoder) decodeBadExprNode(table *astfb.BadExprNode) *BadExprNode {
+	if table == nil {
+		return nil
+	}
+	return decodeTable(d, table.Table().Pos, func() *BadExprNode {
+		return &BadExprNode{
+			Node: d.node("BadExpr", table.RefId()),
+			From: d.decodePosition(table.From(nil)),
+			To:   d.decodePosition(table.To(nil)),
+		}
+	})
+}
+
+func (d *FlatBufferDecoder) decodeIdentNode(table *astfb.IdentNode) *IdentNode {
+	if table == nil {
+		return nil
+	}
+	return decodeTable(d, table.Table().Pos, func() *IdentNode {
+		return &IdentNode{
+			Node:    d.node("Ident", table.RefId()),
+			NamePos: d.decodePosition(table.NamePos(nil)),
+			Name:    string(table.Name()),
+		}
+	})
+}
+
+func (d *FlatBufferDecoder) decodeEllipsisNode(table *astfb.EllipsisNode) *EllipsisNode {
+	if table == nil {
+		return nil
+	}
+	return decodeTable(d, table.Table().Pos, func() *EllipsisNode {
+		return &EllipsisNode{
+			Node:     d.node("Ellipsis", table.RefId()),
+			Ellipsis: d.decodePosition(table.Ellipsis(nil)),
+			Elt:      d.decodeExpr(table.EltType(), table.Elt),
+		}
+	})
+}
+
+func (d *FlatBufferDecoder) decodeBasicLitNode(table *astfb.BasicLitNode) *BasicLitNode {
+	if table == nil {
+		return nil
+	}
+	return decodeTable(d, table.Table().Pos, func() *BasicLitNode {
+		return &BasicLitNode{
+			Node:     d.node("BasicLit", table.RefId()),
+			ValuePos: d.decodePosition(table.ValuePos(nil)),
+			Kind:     string(table.Kind()),
+			Value:    string(table.Value()),
+		}
+	})
+}
+
+func (d *FlatBufferDecoder) decodeFuncLitNode(table *astfb.FuncLitNode) *FuncLitNode {
+	if table == nil {
+		return nil
+	}
+	return decodeTable(d, table.Table().Pos, func() *FuncLitNode {
+		return &FuncLitNode{
+			Node: d.node("FuncLit", table.RefId()),
+			Type: d.decodeFuncTypeNode(table.Type(nil)),
+			Body: d.decodeBlockStmtNode(table.Body(nil)),
+		}
+	})
+}
+
+func (d *FlatBufferDecoder) decodeCompositeLitNode(table *astfb.CompositeLitNode) *CompositeLitNode {
+	if table == nil {
+		return nil
+	}
+	return decodeTable(d, table.Table().Pos, func() *CompositeLitNode {
+		return &CompositeLitNode{
+			Node:   d.node("CompositeLit", table.RefId()),
+			Type:   d.decodeExpr(table.TypeType(), table.Type),
+			Lbrace: d.decodePosition(table.Lbrace(nil)),
+			Elts: decodeVector(table.EltsLength(), func(i int) IExprNode {
+				var item astfb.ExprItem
+				table.Elts(&item, i)
+				return d.decodeExpr(item.NodeType(), item.Node)
+			}),
+			Rbrace:     d.decodePosition(table.Rbrace(nil)),
+			Incomplete: table.Incomplete(),
+		}
+	})
+}
+
+func (d *FlatBufferDecoder) decodeParenExprNode(table *astfb.ParenExprNode) *ParenExprNode {
+	if table == nil {
+		return nil
+	}
+	return decodeTable(d, table.Table().Pos, func() *ParenExprNode {
+		return &ParenExprNode{
+			Node:   d.node("ParenExpr", table.RefId()),
+			Lparen: d.decodePosition(table.Lparen(nil)),
+			X:      d.decodeExpr(table.XType(), table.X),
+			Rparen: d.decodePosition(table.Rparen(nil)),
+		}
+	})
+}
+
+func (d *FlatBufferDecoder) decodeSelectorExprNode(table *astfb.SelectorExprNode) *SelectorExprNode {
+	if table == nil {
+		return nil
+	}
+	return decodeTable(d, table.Table().Pos, func() *SelectorExprNode {
+		return &SelectorExprNode{
+			Node: d.node("SelectorExpr", table.RefId()),
+			X:    d.decodeExpr(table.XType(), table.X),
+			Sel:  d.decodeIdentNode(table.Sel(nil)),
+		}
+	})
+}
+
+func (d *FlatBufferDecoder) decodeIndexExprNode(table *astfb.IndexExprNode) *IndexExprNode {
+	if table == nil {
+		return nil
+	}
+	return decodeTable(d, table.Table().Pos, func() *IndexExprNode {
+		return &IndexExprNode{
+			Node:   d.node("IndexExpr", table.RefId()),
+			X:      d.decodeExpr(table.XType(), table.X),
+			Lbrack: d.decodePosition(table.Lbrack(nil)),
+			Index:  d.decodeExpr(table.IndexType(), table.Index),
+			Rbrack: d.decodePosition(table.Rbrack(nil)),
+		}
+	})
+}
+
+func (d *FlatBufferDecoder) decodeIndexListExprNode(table *astfb.IndexListExprNode) *IndexListExprNode {
+	if table == nil {
+		return nil
+	}
+	return decodeTable(d, table.Table().Pos, func() *IndexListExprNode {
+		return &IndexListExprNode{
+			Node:   d.node("IndexListExpr", table.RefId()),
+			X:      d.decodeExpr(table.XType(), table.X),
+			Lbrack: d.decodePosition(table.Lbrack(nil)),
+			Indices: decodeVector(table.IndicesLength(), func(i int) IExprNode {
+				var item astfb.ExprItem
+				table.Indices(&item, i)
+				return d.decodeExpr(item.NodeType(), item.Node)
+			}),
+			Rbrack: d.decodePosition(table.Rbrack(nil)),
+		}
+	})
+}
+
+func (d *FlatBufferDecoder) decodeSliceExprNode(table *astfb.SliceExprNode) *SliceExprNode {
+	if table == nil {
+		return nil
+	}
+	return decodeTable(d, table.Table().Pos, func() *SliceExprNode {
+		return &SliceExprNode{
+			Node:   d.node("SliceExpr", table.RefId()),
+			X:      d.decodeExpr(table.XType(), table.X),
+			Lbrack: d.decodePosition(table.Lbrack(nil)),
+			Low:    d.decodeExpr(table.LowType(), table.Low),
+			High:   d.decodeExpr(table.HighType(), table.High),
+			Max:    d.decodeExpr(table.MaxType(), table.Max),
+			Slice3: table.Slice3(),
+			Rbrack: d.decodePosition(table.Rbrack(nil)),
+		}
+	})
+}
+
+func (d *FlatBufferDecoder) decodeTypeAssertExprNode(table *astfb.TypeAssertExprNode) *TypeAssertExprNode {
+	if table == nil {
+		return nil
+	}
+	return decodeTable(d, table.Table().Pos, func() *TypeAssertExprNode {
+		return &TypeAssertExprNode{
+			Node:   d.node("TypeAssertExpr", table.RefId()),
+			X:      d.decodeExpr(table.XType(), table.X),
+			Lparen: d.decodePosition(table.Lparen(nil)),
+			Type:   d.decodeExpr(table.TypeType(), table.Type),
+			Rparen: d.decodePosition(table.Rparen(nil)),
+		}
+	})
+}
+
+func (d *FlatBufferDecoder) decodeCallExprNode(table *astfb.CallExprNode) *CallExprNode {
+	if table == nil {
+		return nil
+	}
+	return decodeTable(d, table.Table().Pos, func() *CallExprNode {
+		return &CallExprNode{
+			Node:   d.node("CallExpr", table.RefId()),
+			Fun:    d.decodeExpr(table.FunType(), table.Fun),
+			Lparen: d.decodePosition(table.Lparen(nil)),
+			Args: decodeVector(table.ArgsLength(), func(i int) IExprNode {
+				var item astfb.ExprItem
+				table.Args(&item, i)
+				return d.decodeExpr(item.NodeType(), item.Node)
+			}),
+			Ellipsis: d.decodePosition(table.Ellipsis(nil)),
+			Rparen:   d.decodePosition(table.Rparen(nil)),
+		}
+	})
+}
+
+func (d *FlatBufferDecoder) decodeStarExprNode(table *astfb.StarExprNode) *StarExprNode {
+	if table == nil {
+		return nil
+	}
+	return decodeTable(d, table.Table().Pos, func() *StarExprNode {
+		return &StarExprNode{
+			Node: d.node("StarExpr", table.RefId()),
+			Star: d.decodePosition(table.Star(nil)),
+			X:    d.decodeExpr(table.XType(), table.X),
+		}
+	})
+}
+
+func (d *FlatBufferDecoder) decodeUnaryExprNode(table *astfb.UnaryExprNode) *UnaryExprNode {
+	if table == nil {
+		return nil
+	}
+	return decodeTable(d, table.Table().Pos, func() *UnaryExprNode {
+		return &UnaryExprNode{
+			Node:  d.node("UnaryExpr", table.RefId()),
+			OpPos: d.decodePosition(table.OpPos(nil)),
+			Op:    string(table.Op()),
+			X:     d.decodeExpr(table.XType(), table.X),
+		}
+	})
+}
+
+func (d *FlatBufferDecoder) decodeBinaryExprNode(table *astfb.BinaryExprNode) *BinaryExprNode {
+	if table == nil {
+		return nil
+	}
+	return decodeTable(d, table.Table().Pos, func() *BinaryExprNode {
+		return &BinaryExprNode{
+			Node:  d.node("BinaryExpr", table.RefId()),
+			X:     d.decodeExpr(table.XType(), table.X),
+			OpPos: d.decodePosition(table.OpPos(nil)),
+			Op:    string(table.Op()),
+			Y:     d.decodeExpr(table.YType(), table.Y),
+		}
+	})
+}
+
+func (d *FlatBufferDecoder) decodeKeyValueExprNode(table *astfb.KeyValueExprNode) *KeyValueExprNode {
+	if table == nil {
+		return nil
+	}
+	return decodeTable(d, table.Table().Pos, func() *KeyValueExprNode {
+		return &KeyValueExprNode{
+			Node:  d.node("KeyValueExpr", table.RefId()),
+			Key:   d.decodeExpr(table.KeyType(), table.Key),
+			Colon: d.decodePosition(table.Colon(nil)),
+			Value: d.decodeExpr(table.ValueType(), table.Value),
+		}
+	})
+}
+
+func (d *FlatBufferDecoder) decodeArrayTypeNode(table *astfb.ArrayTypeNode) *ArrayTypeNode {
+	if table == nil {
+		return nil
+	}
+	return decodeTable(d, table.Table().Pos, func() *ArrayTypeNode {
+		return &ArrayTypeNode{
+			Node:   d.node("ArrayType", table.RefId()),
+			Lbrack: d.decodePosition(table.Lbrack(nil)),
+			Len:    d.decodeExpr(table.LenType(), table.Len),
+			Elt:    d.decodeExpr(table.EltType(), table.Elt),
+		}
+	})
+}
+
+func (d *FlatBufferDecoder) decodeStructTypeNode(table *astfb.StructTypeNode) *StructTypeNode {
+	if table == nil {
+		return nil
+	}
+	return decodeTable(d, table.Table().Pos, func() *StructTypeNode {
+		return &StructTypeNode{
+			Node:       d.node("StructType", table.RefId()),
+			Struct:     d.decodePosition(table.Struct(nil)),
+			Fields:     d.decodeFieldListNode(table.Fields(nil)),
+			Incomplete: table.Incomplete(),
+		}
+	})
+}
+
+func (d *FlatBufferDecoder) decodeFuncTypeNode(table *astfb.FuncTypeNode) *FuncTypeNode {
+	if table == nil {
+		return nil
+	}
+	return decodeTable(d, table.Table().Pos, func() *FuncTypeNode {
+		return &FuncTypeNode{
+			Node:       d.node("FuncType", table.RefId()),
+			Func:       d.decodePosition(table.Func(nil)),
+			TypeParams: d.decodeFieldListNode(table.TypeParams(nil)),
+			Params:     d.decodeFieldListNode(table.Params(nil)),
+			Results:    d.decodeFieldListNode(table.Results(nil)),
+		}
+	})
+}
+
+func (d *FlatBufferDecoder) decodeInterfaceTypeNode(table *astfb.InterfaceTypeNode) *InterfaceTypeNode {
+	if table == nil {
+		return nil
+	}
+	return decodeTable(d, table.Table().Pos, func() *InterfaceTypeNode {
+		return &InterfaceTypeNode{
+			Node:       d.node("InterfaceType", table.RefId()),
+			Interface:  d.decodePosition(table.Interface(nil)),
+			Methods:    d.decodeFieldListNode(table.Methods(nil)),
+			Incomplete: table.Incomplete(),
+		}
+	})
+}
+
+func (d *FlatBufferDecoder) decodeMapTypeNode(table *astfb.MapTypeNode) *MapTypeNode {
+	if table == nil {
+		return nil
+	}
+	return decodeTable(d, table.Table().Pos, func() *MapTypeNode {
+		return &MapTypeNode{
+			Node:  d.node("MapType", table.RefId()),
+			Map:   d.decodePosition(table.Map(nil)),
+			Key:   d.decodeExpr(table.KeyType(), table.Key),
+			Value: d.decodeExpr(table.ValueType(), table.Value),
+		}
+	})
+}
+
+func (d *FlatBufferDecoder) decodeChanTypeNode(table *astfb.ChanTypeNode) *ChanTypeNode {
+	if table == nil {
+		return nil
+	}
+	return decodeTable(d, table.Table().Pos, func() *ChanTypeNode {
+		return &ChanTypeNode{
+			Node:  d.node("ChanType", table.RefId()),
+			Begin: d.decodePosition(table.Begin(nil)),
+			Arrow: d.decodePosition(table.Arrow(nil)),
+			Dir:   string(table.Dir()),
+			Value: d.decodeExpr(table.ValueType(), table.Value),
+		}
+	})
+}
+
+func (d *FlatBufferDecoder) decodeBadStmtNode(table *astfb.BadStmtNode) *BadStmtNode {
+	if table == nil {
+		return nil
+	}
+	return decodeTable(d, table.Table().Pos, func() *BadStmtNode {
+		return &BadStmtNode{
+			Node: d.node("BadStmt", table.RefId()),
+			From: d.decodePosition(table.From(nil)),
+			To:   d.decodePosition(table.To(nil)),
+		}
+	})
+}
+
+func (d *FlatBufferDecoder) decodeDeclStmtNode(table *astfb.DeclStmtNode) *DeclStmtNode {
+	if table == nil {
+		return nil
+	}
+	return decodeTable(d, table.Table().Pos, func() *DeclStmtNode {
+		return &DeclStmtNode{
+			Node: d.node("DeclStmt", table.RefId()),
+			Decl: d.decodeDecl(table.DeclType(), table.Decl),
+		}
+	})
+}
+
+func (d *FlatBufferDecoder) decodeEmptyStmtNode(table *astfb.EmptyStmtNode) *EmptyStmtNode {
+	if table == nil {
+		return nil
+	}
+	return decodeTable(d, table.Table().Pos, func() *EmptyStmtNode {
+		return &EmptyStmtNode{
+			Node:      d.node("EmptyStmt", table.RefId()),
+			Semicolon: d.decodePosition(table.Semicolon(nil)),
+			Implicit:  table.Implicit(),
+		}
+	})
+}
+
+func (d *FlatBufferDecoder) decodeLabeledStmtNode(table *astfb.LabeledStmtNode) *LabeledStmtNode {
+	if table == nil {
+		return nil
+	}
+	return decodeTable(d, table.Table().Pos, func() *LabeledStmtNode {
+		return &LabeledStmtNode{
+			Node:  d.node("LabeledStmt", table.RefId()),
+			Label: d.decodeIdentNode(table.Label(nil)),
+			Colon: d.decodePosition(table.Colon(nil)),
+			Stmt:  d.decodeStmt(table.StmtType(), table.Stmt),
+		}
+	})
+}
+
+func (d *FlatBufferDecoder) decodeExprStmtNode(table *astfb.ExprStmtNode) *ExprStmtNode {
+	if table == nil {
+		return nil
+	}
+	return decodeTable(d, table.Table().Pos, func() *ExprStmtNode {
+		return &ExprStmtNode{
+			Node: d.node("ExprStmt", table.RefId()),
+			X:    d.decodeExpr(table.XType(), table.X),
+		}
+	})
+}
+
+func (d *FlatBufferDecoder) decodeSendStmtNode(table *astfb.SendStmtNode) *SendStmtNode {
+	if table == nil {
+		return nil
+	}
+	return decodeTable(d, table.Table().Pos, func() *SendStmtNode {
+		return &SendStmtNode{
+			Node:  d.node("SendStmt", table.RefId()),
+			Chan:  d.decodeExpr(table.ChanType(), table.Chan),
+			Arrow: d.decodePosition(table.Arrow(nil)),
+			Value: d.decodeExpr(table.ValueType(), table.Value),
+		}
+	})
+}
+
+func (d *FlatBufferDecoder) decodeIncDecStmtNode(table *astfb.IncDecStmtNode) *IncDecStmtNode {
+	if table == nil {
+		return nil
+	}
+	return decodeTable(d, table.Table().Pos, func() *IncDecStmtNode {
+		return &IncDecStmtNode{
+			Node:   d.node("IncDecStmt", table.RefId()),
+			X:      d.decodeExpr(table.XType(), table.X),
+			TokPos: d.decodePosition(table.TokPos(nil)),
+			Tok:    string(table.Tok()),
+		}
+	})
+}
+
+func (d *FlatBufferDecoder) decodeAssignStmtNode(table *astfb.AssignStmtNode) *AssignStmtNode {
+	if table == nil {
+		return nil
+	}
+	return decodeTable(d, table.Table().Pos, func() *AssignStmtNode {
+		return &AssignStmtNode{
+			Node: d.node("AssignStmt", table.RefId()),
+			Lhs: decodeVector(table.LhsLength(), func(i int) IExprNode {
+				var item astfb.ExprItem
+				table.Lhs(&item, i)
+				return d.decodeExpr(item.NodeType(), item.Node)
+			}),
+			TokPos: d.decodePosition(table.TokPos(nil)),
+			Tok:    string(table.Tok()),
+			Rhs: decodeVector(table.RhsLength(), func(i int) IExprNode {
+				var item astfb.ExprItem
+				table.Rhs(&item, i)
+				return d.decodeExpr(item.NodeType(), item.Node)
+			}),
+		}
+	})
+}
+
+func (d *FlatBufferDecoder) decodeGoStmtNode(table *astfb.GoStmtNode) *GoStmtNode {
+	if table == nil {
+		return nil
+	}
+	return decodeTable(d, table.Table().Pos, func() *GoStmtNode {
+		return &GoStmtNode{
+			Node: d.node("GoStmt", table.RefId()),
+			Go:   d.decodePosition(table.Go(nil)),
+			Call: d.decodeCallExprNode(table.Call(nil)),
+		}
+	})
+}
+
+func (d *FlatBufferDecoder) decodeDeferStmtNode(table *astfb.DeferStmtNode) *DeferStmtNode {
+	if table == nil {
+		return nil
+	}
+	return decodeTable(d, table.Table().Pos, func() *DeferStmtNode {
+		return &DeferStmtNode{
+			Node:  d.node("DeferStmt", table.RefId()),
+			Defer: d.decodePosition(table.Defer(nil)),
+			Call:  d.decodeCallExprNode(table.Call(nil)),
+		}
+	})
+}
+
+func (d *FlatBufferDecoder) decodeReturnStmtNode(table *astfb.ReturnStmtNode) *ReturnStmtNode {
+	if table == nil {
+		return nil
+	}
+	return decodeTable(d, table.Table().Pos, func() *ReturnStmtNode {
+		return &ReturnStmtNode{
+			Node:   d.node("ReturnStmt", table.RefId()),
+			Return: d.decodePosition(table.Return(nil)),
+			Results: decodeVector(table.ResultsLength(), func(i int) IExprNode {
+				var item astfb.ExprItem
+				table.Results(&item, i)
+				return d.decodeExpr(item.NodeType(), item.Node)
+			}),
+		}
+	})
+}
+
+func (d *FlatBufferDecoder) decodeBranchStmtNode(table *astfb.BranchStmtNode) *BranchStmtNode {
+	if table == nil {
+		return nil
+	}
+	return decodeTable(d, table.Table().Pos, func() *BranchStmtNode {
+		return &BranchStmtNode{
+			Node:   d.node("BranchStmt", table.RefId()),
+			TokPos: d.decodePosition(table.TokPos(nil)),
+			Tok:    string(table.Tok()),
+			Label:  d.decodeIdentNode(table.Label(nil)),
+		}
+	})
+}
+
+func (d *FlatBufferDecoder) decodeBlockStmtNode(table *astfb.BlockStmtNode) *BlockStmtNode {
+	if table == nil {
+		return nil
+	}
+	return decodeTable(d, table.Table().Pos, func() *BlockStmtNode {
+		return &BlockStmtNode{
+			Node:   d.node("BlockStmt", table.RefId()),
+			Lbrace: d.decodePosition(table.Lbrace(nil)),
+			List: decodeVector(table.ListLength(), func(i int) IStmtNode {
+				var item astfb.StmtItem
+				table.List(&item, i)
+				return d.decodeStmt(item.NodeType(), item.Node)
+			}),
+			Rbrace: d.decodePosition(table.Rbrace(nil)),
+		}
+	})
+}
+
+func (d *FlatBufferDecoder) decodeIfStmtNode(table *astfb.IfStmtNode) *IfStmtNode {
+	if table == nil {
+		return nil
+	}
+	return decodeTable(d, table.Table().Pos, func() *IfStmtNode {
+		return &IfStmtNode{
+			Node: d.node("IfStmt", table.RefId()),
+			If:   d.decodePosition(table.If(nil)),
+			Init: d.decodeStmt(table.InitStmtType(), table.InitStmt),
+			Cond: d.decodeExpr(table.CondType(), table.Cond),
+			Body: d.decodeBlockStmtNode(table.Body(nil)),
+			Else: d.decodeStmt(table.ElseType(), table.Else),
+		}
+	})
+}
+
+func (d *FlatBufferDecoder) decodeCaseClauseNode(table *astfb.CaseClauseNode) *CaseClauseNode {
+	if table == nil {
+		return nil
+	}
+	return decodeTable(d, table.Table().Pos, func() *CaseClauseNode {
+		return &CaseClauseNode{
+			Node: d.node("CaseClause", table.RefId()),
+			Case: d.decodePosition(table.Case(nil)),
+			List: decodeVector(table.ListLength(), func(i int) IExprNode {
+				var item astfb.ExprItem
+				table.List(&item, i)
+				return d.decodeExpr(item.NodeType(), item.Node)
+			}),
+			Colon: d.decodePosition(table.Colon(nil)),
+			Body: decodeVector(table.BodyLength(), func(i int) IStmtNode {
+				var item astfb.StmtItem
+				table.Body(&item, i)
+				return d.decodeStmt(item.NodeType(), item.Node)
+			}),
+		}
+	})
+}
+
+func (d *FlatBufferDecoder) decodeSwitchStmtNode(table *astfb.SwitchStmtNode) *SwitchStmtNode {
+	if table == nil {
+		return nil
+	}
+	return decodeTable(d, table.Table().Pos, func() *SwitchStmtNode {
+		return &SwitchStmtNode{
+			Node:   d.node("SwitchStmt", table.RefId()),
+			Switch: d.decodePosition(table.Switch(nil)),
+			Init:   d.decodeStmt(table.InitStmtType(), table.InitStmt),
+			Tag:    d.decodeExpr(table.TagType(), table.Tag),
+			Body:   d.decodeBlockStmtNode(table.Body(nil)),
+		}
+	})
+}
+
+func (d *FlatBufferDecoder) decodeTypeSwitchStmtNode(table *astfb.TypeSwitchStmtNode) *TypeSwitchStmtNode {
+	if table == nil {
+		return nil
+	}
+	return decodeTable(d, table.Table().Pos, func() *TypeSwitchStmtNode {
+		return &TypeSwitchStmtNode{
+			Node:   d.node("TypeSwitchStmt", table.RefId()),
+			Switch: d.decodePosition(table.Switch(nil)),
+			Init:   d.decodeStmt(table.InitStmtType(), table.InitStmt),
+			Assign: d.decodeStmt(table.AssignType(), table.Assign),
+			Body:   d.decodeBlockStmtNode(table.Body(nil)),
+		}
+	})
+}
+
+func (d *FlatBufferDecoder) decodeCommClauseNode(table *astfb.CommClauseNode) *CommClauseNode {
+	if table == nil {
+		return nil
+	}
+	return decodeTable(d, table.Table().Pos, func() *CommClauseNode {
+		return &CommClauseNode{
+			Node:  d.node("CommClause", table.RefId()),
+			Case:  d.decodePosition(table.Case(nil)),
+			Comm:  d.decodeStmt(table.CommType(), table.Comm),
+			Colon: d.decodePosition(table.Colon(nil)),
+			Body: decodeVector(table.BodyLength(), func(i int) IStmtNode {
+				var item astfb.StmtItem
+				table.Body(&item, i)
+				return d.decodeStmt(item.NodeType(), item.Node)
+			}),
+		}
+	})
+}
+
+func (d *FlatBufferDecoder) decodeSelectStmtNode(table *astfb.SelectStmtNode) *SelectStmtNode {
+	if table == nil {
+		return nil
+	}
+	return decodeTable(d, table.Table().Pos, func() *SelectStmtNode {
+		return &SelectStmtNode{
+			Node:   d.node("SelectStmt", table.RefId()),
+			Select: d.decodePosition(table.Select(nil)),
+			Body:   d.decodeBlockStmtNode(table.Body(nil)),
+		}
+	})
+}
+
+func (d *FlatBufferDecoder) decodeForStmtNode(table *astfb.ForStmtNode) *ForStmtNode {
+	if table == nil {
+		return nil
+	}
+	return decodeTable(d, table.Table().Pos, func() *ForStmtNode {
+		return &ForStmtNode{
+			Node: d.node("ForStmt", table.RefId()),
+			For:  d.decodePosition(table.For(nil)),
+			Init: d.decodeStmt(table.InitStmtType(), table.InitStmt),
+			Cond: d.decodeExpr(table.CondType(), table.Cond),
+			Post: d.decodeStmt(table.PostType(), table.Post),
+			Body: d.decodeBlockStmtNode(table.Body(nil)),
+		}
+	})
+}
+
+func (d *FlatBufferDecoder) decodeRangeStmtNode(table *astfb.RangeStmtNode) *RangeStmtNode {
+	if table == nil {
+		return nil
+	}
+	return decodeTable(d, table.Table().Pos, func() *RangeStmtNode {
+		return &RangeStmtNode{
+			Node:   d.node("RangeStmt", table.RefId()),
+			For:    d.decodePosition(table.For(nil)),
+			Key:    d.decodeExpr(table.KeyType(), table.Key),
+			Value:  d.decodeExpr(table.ValueType(), table.Value),
+			TokPos: d.decodePosition(table.TokPos(nil)),
+			Tok:    string(table.Tok()),
+			X:      d.decodeExpr(table.XType(), table.X),
+			Body:   d.decodeBlockStmtNode(table.Body(nil)),
+		}
+	})
+}
+
+func (d *FlatBufferDecoder) decodeImportSpecNode(table *astfb.ImportSpecNode) *ImportSpecNode {
+	if table == nil {
+		return nil
+	}
+	return decodeTable(d, table.Table().Pos, func() *ImportSpecNode {
+		return &ImportSpecNode{
+			Node:    d.node("ImportSpec", table.RefId()),
+			Doc:     d.decodeCommentGroupNode(table.Doc(nil)),
+			Name:    d.decodeIdentNode(table.Name(nil)),
+			Path:    d.decodeBasicLitNode(table.Path(nil)),
+			Comment: d.decodeCommentGroupNode(table.Comment(nil)),
+			EndPos:  d.decodePosition(table.EndPos(nil)),
+		}
+	})
+}
+
+func (d *FlatBufferDecoder) decodeValueSpecNode(table *astfb.ValueSpecNode) *ValueSpecNode {
+	if table == nil {
+		return nil
+	}
+	return decodeTable(d, table.Table().Pos, func() *ValueSpecNode {
+		return &ValueSpecNode{
+			Node: d.node("ValueSpec", table.RefId()),
+			Doc:  d.decodeCommentGroupNode(table.Doc(nil)),
+			Names: decodeVector(table.NamesLength(), func(i int) *IdentNode {
+				var item astfb.IdentNode
+				table.Names(&item, i)
+				return d.decodeIdentNode(&item)
+			}),
+			Type: d.decodeExpr(table.TypeType(), table.Type),
+			Values: decodeVector(table.ValuesLength(), func(i int) IExprNode {
+				var item astfb.ExprItem
+				table.Values(&item, i)
+				return d.decodeExpr(item.NodeType(), item.Node)
+			}),
+			Comment: d.decodeCommentGroupNode(table.Comment(nil)),
+		}
+	})
+}
+
+func (d *FlatBufferDecoder) decodeTypeSpecNode(table *astfb.TypeSpecNode) *TypeSpecNode {
+	if table == nil {
+		return nil
+	}
+	return decodeTable(d, table.Table().Pos, func() *TypeSpecNode {
+		return &TypeSpecNode{
+			Node:       d.node("TypeSpec", table.RefId()),
+			Doc:        d.decodeCommentGroupNode(table.Doc(nil)),
+			Name:       d.decodeIdentNode(table.Name(nil)),
+			TypeParams: d.decodeFieldListNode(table.TypeParams(nil)),
+			Assign:     d.decodePosition(table.Assign(nil)),
+			Type:       d.decodeExpr(table.TypeType(), table.Type),
+			Comment:    d.decodeCommentGroupNode(table.Comment(nil)),
+		}
+	})
+}
+
+func (d *FlatBufferDecoder) decodeBadDeclNode(table *astfb.BadDeclNode) *BadDeclNode {
+	if table == nil {
+		return nil
+	}
+	return decodeTable(d, table.Table().Pos, func() *BadDeclNode {
+		return &BadDeclNode{
+			Node: d.node("BadDecl", table.RefId()),
+			From: d.decodePosition(table.From(nil)),
+			To:   d.decodePosition(table.To(nil)),
+		}
+	})
+}
+
+func (d *FlatBufferDecoder) decodeGenDeclNode(table *astfb.GenDeclNode) *GenDeclNode {
+	if table == nil {
+		return nil
+	}
+	return decodeTable(d, table.Table().Pos, func() *GenDeclNode {
+		return &GenDeclNode{
+			Node:   d.node("GenDecl", table.RefId()),
+			Doc:    d.decodeCommentGroupNode(table.Doc(nil)),
+			TokPos: d.decodePosition(table.TokPos(nil)),
+			Tok:    string(table.Tok()),
+			Lparen: d.decodePosition(table.Lparen(nil)),
+			Specs: decodeVector(table.SpecsLength(), func(i int) ISpecNode {
+				var item astfb.SpecItem
+				table.Specs(&item, i)
+				return d.decodeSpec(item.NodeType(), item.Node)
+			}),
+			Rparen: d.decodePosition(table.Rparen(nil)),
+		}
+	})
+}
+
+func (d *FlatBufferDecoder) decodeFuncDeclNode(table *astfb.FuncDeclNode) *FuncDeclNode {
+	if table == nil {
+		return nil
+	}
+	return decodeTable(d, table.Table().Pos, func() *FuncDeclNode {
+		return &FuncDeclNode{
+			Node: d.node("FuncDecl", table.RefId()),
+			Doc:  d.decodeCommentGroupNode(table.Doc(nil)),
+			Recv: d.decodeFieldListNode(table.Recv(nil)),
+			Name: d.decodeIdentNode(table.Name(nil)),
+			Type: d.decodeFuncTypeNode(table.Type(nil)),
+			Body: d.decodeBlockStmtNode(table.Body(nil)),
+		}
+	})
+}
+
+func (d *FlatBufferDecoder) decodeFileMetaNode(table *astfb.FileMetaNode) *FileMetaNode {
+	if table == nil {
+		return nil
+	}
+	return decodeTable(d, table.Table().Pos, func() *FileMetaNode {
+		return &FileMetaNode{
+			Path:       string(table.Path()),
+			Category:   string(table.Category()),
+			Constraint: string(table.Constraint()),
+		}
+	})
+}
+
+func (d *FlatBufferDecoder) decodeExpr(kind astfb.Expr, load func(*flatbuffers.Table) bool) IExprNode {
+	var table flatbuffers.Table
+	if kind == astfb.ExprNONE || !load(&table) {
+		return nil
+	}
+	switch kind {
+	case astfb.ExprBadExprNode:
+		node := &astfb.BadExprNode{}
+		node.Init(table.Bytes, table.Pos)
+		return d.decodeBadExprNode(node)
+	case astfb.ExprIdentNode:
+		node := &astfb.IdentNode{}
+		node.Init(table.Bytes, table.Pos)
+		return d.decodeIdentNode(node)
+	case astfb.ExprEllipsisNode:
+		node := &astfb.EllipsisNode{}
+		node.Init(table.Bytes, table.Pos)
+		return d.decodeEllipsisNode(node)
+	case astfb.ExprBasicLitNode:
+		node := &astfb.BasicLitNode{}
+		node.Init(table.Bytes, table.Pos)
+		return d.decodeBasicLitNode(node)
+	case astfb.ExprFuncLitNode:
+		node := &astfb.FuncLitNode{}
+		node.Init(table.Bytes, table.Pos)
+		return d.decodeFuncLitNode(node)
+	case astfb.ExprCompositeLitNode:
+		node := &astfb.CompositeLitNode{}
+		node.Init(table.Bytes, table.Pos)
+		return d.decodeCompositeLitNode(node)
+	case astfb.ExprParenExprNode:
+		node := &astfb.ParenExprNode{}
+		node.Init(table.Bytes, table.Pos)
+		return d.decodeParenExprNode(node)
+	case astfb.ExprSelectorExprNode:
+		node := &astfb.SelectorExprNode{}
+		node.Init(table.Bytes, table.Pos)
+		return d.decodeSelectorExprNode(node)
+	case astfb.ExprIndexExprNode:
+		node := &astfb.IndexExprNode{}
+		node.Init(table.Bytes, table.Pos)
+		return d.decodeIndexExprNode(node)
+	case astfb.ExprIndexListExprNode:
+		node := &astfb.IndexListExprNode{}
+		node.Init(table.Bytes, table.Pos)
+		return d.decodeIndexListExprNode(node)
+	case astfb.ExprSliceExprNode:
+		node := &astfb.SliceExprNode{}
+		node.Init(table.Bytes, table.Pos)
+		return d.decodeSliceExprNode(node)
+	case astfb.ExprTypeAssertExprNode:
+		node := &astfb.TypeAssertExprNode{}
+		node.Init(table.Bytes, table.Pos)
+		return d.decodeTypeAssertExprNode(node)
+	case astfb.ExprCallExprNode:
+		node := &astfb.CallExprNode{}
+		node.Init(table.Bytes, table.Pos)
+		return d.decodeCallExprNode(node)
+	case astfb.ExprStarExprNode:
+		node := &astfb.StarExprNode{}
+		node.Init(table.Bytes, table.Pos)
+		return d.decodeStarExprNode(node)
+	case astfb.ExprUnaryExprNode:
+		node := &astfb.UnaryExprNode{}
+		node.Init(table.Bytes, table.Pos)
+		return d.decodeUnaryExprNode(node)
+	case astfb.ExprBinaryExprNode:
+		node := &astfb.BinaryExprNode{}
+		node.Init(table.Bytes, table.Pos)
+		return d.decodeBinaryExprNode(node)
+	case astfb.ExprKeyValueExprNode:
+		node := &astfb.KeyValueExprNode{}
+		node.Init(table.Bytes, table.Pos)
+		return d.decodeKeyValueExprNode(node)
+	case astfb.ExprArrayTypeNode:
+		node := &astfb.ArrayTypeNode{}
+		node.Init(table.Bytes, table.Pos)
+		return d.decodeArrayTypeNode(node)
+	case astfb.ExprStructTypeNode:
+		node := &astfb.StructTypeNode{}
+		node.Init(table.Bytes, table.Pos)
+		return d.decodeStructTypeNode(node)
+	case astfb.ExprFuncTypeNode:
+		node := &astfb.FuncTypeNode{}
+		node.Init(table.Bytes, table.Pos)
+		return d.decodeFuncTypeNode(node)
+	case astfb.ExprInterfaceTypeNode:
+		node := &astfb.InterfaceTypeNode{}
+		node.Init(table.Bytes, table.Pos)
+		return d.decodeInterfaceTypeNode(node)
+	case astfb.ExprMapTypeNode:
+		node := &astfb.MapTypeNode{}
+		node.Init(table.Bytes, table.Pos)
+		return d.decodeMapTypeNode(node)
+	case astfb.ExprChanTypeNode:
+		node := &astfb.ChanTypeNode{}
+		node.Init(table.Bytes, table.Pos)
+		return d.decodeChanTypeNode(node)
+	}
+	return nil
+}
+
+func (d *FlatBufferDecoder) decodeStmt(kind astfb.Stmt, load func(*flatbuffers.Table) bool) IStmtNode {
+	var table flatbuffers.Table
+	if kind == astfb.StmtNONE || !load(&table) {
+		return nil
+	}
+	switch kind {
+	case astfb.StmtBadStmtNode:
+		node := &astfb.BadStmtNode{}
+		node.Init(table.Bytes, table.Pos)
+		return d.decodeBadStmtNode(node)
+	case astfb.StmtDeclStmtNode:
+		node := &astfb.DeclStmtNode{}
+		node.Init(table.Bytes, table.Pos)
+		return d.decodeDeclStmtNode(node)
+	case astfb.StmtEmptyStmtNode:
+		node := &astfb.EmptyStmtNode{}
+		node.Init(table.Bytes, table.Pos)
+		return d.decodeEmptyStmtNode(node)
+	case astfb.StmtLabeledStmtNode:
+		node := &astfb.LabeledStmtNode{}
+		node.Init(table.Bytes, table.Pos)
+		return d.decodeLabeledStmtNode(node)
+	case astfb.StmtExprStmtNode:
+		node := &astfb.ExprStmtNode{}
+		node.Init(table.Bytes, table.Pos)
+		return d.decodeExprStmtNode(node)
+	case astfb.StmtSendStmtNode:
+		node := &astfb.SendStmtNode{}
+		node.Init(table.Bytes, table.Pos)
+		return d.decodeSendStmtNode(node)
+	case astfb.StmtIncDecStmtNode:
+		node := &astfb.IncDecStmtNode{}
+		node.Init(table.Bytes, table.Pos)
+		return d.decodeIncDecStmtNode(node)
+	case astfb.StmtAssignStmtNode:
+		node := &astfb.AssignStmtNode{}
+		node.Init(table.Bytes, table.Pos)
+		return d.decodeAssignStmtNode(node)
+	case astfb.StmtGoStmtNode:
+		node := &astfb.GoStmtNode{}
+		node.Init(table.Bytes, table.Pos)
+		return d.decodeGoStmtNode(node)
+	case astfb.StmtDeferStmtNode:
+		node := &astfb.DeferStmtNode{}
+		node.Init(table.Bytes, table.Pos)
+		return d.decodeDeferStmtNode(node)
+	case astfb.StmtReturnStmtNode:
+		node := &astfb.ReturnStmtNode{}
+		node.Init(table.Bytes, table.Pos)
+		return d.decodeReturnStmtNode(node)
+	case astfb.StmtBranchStmtNode:
+		node := &astfb.BranchStmtNode{}
+		node.Init(table.Bytes, table.Pos)
+		return d.decodeBranchStmtNode(node)
+	case astfb.StmtBlockStmtNode:
+		node := &astfb.BlockStmtNode{}
+		node.Init(table.Bytes, table.Pos)
+		return d.decodeBlockStmtNode(node)
+	case astfb.StmtIfStmtNode:
+		node := &astfb.IfStmtNode{}
+		node.Init(table.Bytes, table.Pos)
+		return d.decodeIfStmtNode(node)
+	case astfb.StmtCaseClauseNode:
+		node := &astfb.CaseClauseNode{}
+		node.Init(table.Bytes, table.Pos)
+		return d.decodeCaseClauseNode(node)
+	case astfb.StmtSwitchStmtNode:
+		node := &astfb.SwitchStmtNode{}
+		node.Init(table.Bytes, table.Pos)
+		return d.decodeSwitchStmtNode(node)
+	case astfb.StmtTypeSwitchStmtNode:
+		node := &astfb.TypeSwitchStmtNode{}
+		node.Init(table.Bytes, table.Pos)
+		return d.decodeTypeSwitchStmtNode(node)
+	case astfb.StmtCommClauseNode:
+		node := &astfb.CommClauseNode{}
+		node.Init(table.Bytes, table.Pos)
+		return d.decodeCommClauseNode(node)
+	case astfb.StmtSelectStmtNode:
+		node := &astfb.SelectStmtNode{}
+		node.Init(table.Bytes, table.Pos)
+		return d.decodeSelectStmtNode(node)
+	case astfb.StmtForStmtNode:
+		node := &astfb.ForStmtNode{}
+		node.Init(table.Bytes, table.Pos)
+		return d.decodeForStmtNode(node)
+	case astfb.StmtRangeStmtNode:
+		node := &astfb.RangeStmtNode{}
+		node.Init(table.Bytes, table.Pos)
+		return d.decodeRangeStmtNode(node)
+	}
+	return nil
+}
+
+func (d *FlatBufferDecoder) decodeSpec(kind astfb.Spec, load func(*flatbuffers.Table) bool) ISpecNode {
+	var table flatbuffers.Table
+	if kind == astfb.SpecNONE || !load(&table) {
+		return nil
+	}
+	switch kind {
+	case astfb.SpecImportSpecNode:
+		node := &astfb.ImportSpecNode{}
+		node.Init(table.Bytes, table.Pos)
+		return d.decodeImportSpecNode(node)
+	case astfb.SpecValueSpecNode:
+		node := &astfb.ValueSpecNode{}
+		node.Init(table.Bytes, table.Pos)
+		return d.decodeValueSpecNode(node)
+	case astfb.SpecTypeSpecNode:
+		node := &astfb.TypeSpecNode{}
+		node.Init(table.Bytes, table.Pos)
+		return d.decodeTypeSpecNode(node)
+	}
+	return nil
+}
+
+func (d *FlatBufferDecoder) decodeDecl(kind astfb.Decl, load func(*flatbuffers.Table) bool) IDeclNode {
+	var table flatbuffers.Table
+	if kind == astfb.DeclNONE || !load(&table) {
+		return nil
+	}
+	switch kind {
+	case astfb.DeclBadDeclNode:
+		node := &astfb.BadDeclNode{}
+		node.Init(table.Bytes, table.Pos)
+		return d.decodeBadDeclNode(node)
+	case astfb.DeclGenDeclNode:
+		node := &astfb.GenDeclNode{}
+		node.Init(table.Bytes, table.Pos)
+		return d.decodeGenDeclNode(node)
+	case astfb.DeclFuncDeclNode:
+		node := &astfb.FuncDeclNode{}
+		node.Init(table.Bytes, table.Pos)
+		return d.decodeFuncDeclNode(node)
+	}
+	return nil
+}
@@ -0,0 +1,1099 @@
+package ast_json
+
+import (
+	"GoOperatorAST/astfb"
+	flatbuffers "github.com/google/flatbuffers/go"
+)
+
+// Encoders of the node tables of ast.fbs, one per node type of nodes.go.
+
+func (e *flatBufferEncoder) encodeCommentNode(node *CommentNode) flatbuffers.UOffsetT {
+	return encodeTable(e, node, func() flatbuffers.UOffsetT {
+		text := e.builder.CreateSharedString(node.Text)
+		astfb.CommentNodeStart(e.builder)
+		astfb.CommentNodeAddRefId(e.builder, int32(node.RefId))
+		if node.Slash != nil {
+			astfb.CommentNodeAddSlash(e.builder, e.encodePosition(node.Slash))
+		}
+		astfb.CommentNodeAddText(e.builder, text)
+		return astfb.CommentNodeEnd(e.builder)
+	})
+}
+
+func (e *flatBufferEncoder) encodeCommentGroupNode(node *CommentGroupNode) flatbuffers.UOffsetT {
+	return encodeTable(e, node, func() flatbuffers.UOffsetT {
+		list := e.encodeVector(len(node.List), func(i int) flatbuffers.UOffsetT {
+			return e.encodeCommentNode(node.List[i])
+		})
+		astfb.CommentGroupNodeStart(e.builder)
+		astfb.CommentGroupNodeAddRefId(e.builder, int32(node.RefId))
+		astfb.CommentGroupNodeAddList(e.builder, list)
+		return astfb.CommentGroupNodeEnd(e.builder)
+	})
+}
+
+func (e *flatBufferEncoder) encodeFieldNode(node *FieldNode) flatbuffers.UOffsetT {
+	return encodeTable(e, node, func() flatbuffers.UOffsetT {
+		doc := e.encodeCommentGroupNode(node.Doc)
+		names := e.encodeVector(len(node.Names), func(i int) flatbuffers.UOffsetT {
+			return e.encodeIdentNode(node.Names[i])
+		})
+		typeOffsetType, typeOffset := e.encodeExpr(node.Type)
+		tag := e.encodeBasicLitNode(node.Tag)
+		comment := e.encodeCommentGroupNode(node.Comment)
+		astfb.FieldNodeStart(e.builder)
+		astfb.FieldNodeAddRefId(e.builder, int32(node.RefId))
+		astfb.FieldNodeAddDoc(e.builder, doc)
+		astfb.FieldNodeAddNames(e.builder, names)
+		astfb.FieldNodeAddTypeType(e.builder, typeOffsetType)
+		astfb.FieldNodeAddType(e.builder, typeOffset)
+		astfb.FieldNodeAddTag(e.builder, tag)
+		astfb.FieldNodeAddComment(e.builder, comment)
+		return astfb.FieldNodeEnd(e.builder)
+	})
+}
+
+func (e *flatBufferEncoder) encodeFieldListNode(node *FieldListNode) flatbuffers.UOffsetT {
+	return encodeTable(e, node, func() flatbuffers.UOffsetT {
+		list := e.encodeVector(len(node.List), func(i int) flatbuffers.UOffsetT {
+			return e.encodeFieldNode(node.List[i])
+		})
+		astfb.FieldListNodeStart(e.builder)
+		astfb.FieldListNodeAddRefId(e.builder, int32(node.RefId))
+		if node.Opening != nil {
+			astfb.FieldListNodeAddOpening(e.builder, e.encodePosition(node.Opening))
+		}
+		astfb.FieldListNodeAddList(e.builder, list)
+		if node.Closing != nil {
+			astfb.FieldListNodeAddClosing(e.builder, e.encodePosition(node.Closing))
+		}
+		return astfb.FieldListNodeEnd(e.builder)
+	})
+}
+
+func (e *flatBufferEncoder) encodeBadExprNode(node *BadExprNode) flatbuffers.UOffsetT {
+	return encodeTable(e, node, func() flatbuffers.UOffsetT {
+		astfb.BadExprNodeStart(e.builder)
+		astfb.BadExprNodeAddRefId(e.builder, int32(node.RefId))
+		if node.From != nil {
+			astfb.BadExprNodeAddFrom(e.builder, e.encodePosition(node.From))
+		}
+		if node.To != nil {
+			astfb.BadExprNodeAddTo(e.builder, e.encodePosition(node.To))
+		}
+		return astfb.BadExprNodeEnd(e.builder)
+	})
+}
+
+func (e *flatBufferEncoder) encodeIdentNode(node *IdentNode) flatbuffers.UOffsetT {
+	return encodeTable(e, node, func() flatbuffers.UOffsetT {
+		name := e.builder.CreateSharedString(node.Name)
+		astfb.IdentNodeStart(e.builder)
+		astfb.IdentNodeAddRefId(e.builder, int32(node.RefId))
+		if node.NamePos != nil {
+			astfb.IdentNodeAddNamePos(e.builder, e.encodePosition(node.NamePos))
+		}
+		astfb.IdentNodeAddName(e.builder, name)
+		return astfb.IdentNodeEnd(e.builder)
+	})
+}
+
+func (e *flatBufferEncoder) encodeEllipsisNode(node *EllipsisNode) flatbuffers.UOffsetT {
+	return encodeTable(e, node, func() flatbuffers.UOffsetT {
+		eltType, elt := e.encodeExpr(node.Elt)
+		astfb.EllipsisNodeStart(e.builder)
+		astfb.EllipsisNodeAddRefId(e.builder, int32(node.RefId))
+		if node.Ellipsis != nil {
+			astfb.EllipsisNodeAddEllipsis(e.builder, e.encodePosition(node.Ellipsis))
+		}
+		astfb.EllipsisNodeAddEltType(e.builder, eltType)
+		astfb.EllipsisNodeAddElt(e.builder, elt)
+		return astfb.EllipsisNodeEnd(e.builder)
+	})
+}
+
+func (e *flatBufferEncoder) encodeBasicLitNode(node *BasicLitNode) flatbuffers.UOffsetT {
+	return encodeTable(e, node, func() flatbuffers.UOffsetT {
+		kind := e.builder.CreateSharedString(node.Kind)
+		value := e.builder.CreateSharedString(node.Value)
+		astfb.BasicLitNodeStart(e.builder)
+		astfb.BasicLitNodeAddRefId(e.builder, int32(node.RefId))
+		if node.ValuePos != nil {
+			astfb.BasicLitNodeAddValuePos(e.builder, e.encodePosition(node.ValuePos))
+		}
+		astfb.BasicLitNodeAddKind(e.builder, kind)
+		astfb.BasicLitNodeAddValue(e.builder, value)
+		return astfb.BasicLitNodeEnd(e.builder)
+	})
+}
+
+func (e *flatBufferEncoder) encodeFuncLitNode(node *FuncLitNode) flatbuffers.UOffsetT {
+	return encodeTable(e, node, func() flatbuffers.UOffsetT {
+		typeOffset := e.encodeFuncTypeNode(node.Type)
+		body := e.encodeBlockStmtNode(node.Body)
+		astfb.FuncLitNodeStart(e.builder)
+		astfb.FuncLitNodeAddRefId(e.builder, int32(node.RefId))
+		astfb.FuncLitNodeAddType(e.builder, typeOffset)
+		astfb.FuncLitNodeAddBody(e.builder, body)
+		return astfb.FuncLitNodeEnd(e.builder)
+	})
+}
+
+func (e *flatBufferEncoder) encodeCompositeLitNode(node *CompositeLitNode) flatbuffers.UOffsetT {
+	return encodeTable(e, node, func() flatbuffers.UOffsetT {
+		typeOffsetType, typeOffset := e.encodeExpr(node.Type)
+		elts := e.encodeExprItems(node.Elts)
+		astfb.CompositeLitNodeStart(e.builder)
+		astfb.CompositeLitNodeAddRefId(e.builder, int32(node.RefId))
+		astfb.CompositeLitNodeAddTypeType(e.builder, typeOffsetType)
+		astfb.CompositeLitNodeAddType(e.builder, typeOffset)
+		if node.Lbrace != nil {
+			astfb.CompositeLitNodeAddLbrace(e.builder, e.encodePosition(node.Lbrace))
+		}
+		astfb.CompositeLitNodeAddElts(e.builder, elts)
+		if node.Rbrace != nil {
+			astfb.CompositeLitNodeAddRbrace(e.builder, e.encodePosition(node.Rbrace))
+		}
+		astfb.CompositeLitNodeAddIncomplete(e.builder, node.Incomplete)
+		return astfb.CompositeLitNodeEnd(e.builder)
+	})
+}
+
+func (e *flatBufferEncoder) encodeParenExprNode(node *ParenExprNode) flatbuffers.UOffsetT {
+	return encodeTable(e, node, func() flatbuffers.UOffsetT {
+		xType, x := e.encodeExpr(node.X)
+		astfb.ParenExprNodeStart(e.builder)
+		astfb.ParenExprNodeAddRefId(e.builder, int32(node.RefId))
+		if node.Lparen != nil {
+			astfb.ParenExprNodeAddLparen(e.builder, e.encodePosition(node.Lparen))
+		}
+		astfb.ParenExprNodeAddXType(e.builder, xType)
+		astfb.ParenExprNodeAddX(e.builder, x)
+		if node.Rparen != nil {
+			astfb.ParenExprNodeAddRparen(e.builder, e.encodePosition(node.Rparen))
+		}
+		return astfb.ParenExprNodeEnd(e.builder)
+	})
+}
+
+func (e *flatBufferEncoder) encodeSelectorExprNode(node *SelectorExprNode) flatbuffers.UOffsetT {
+	return encodeTable(e, node, func() flatbuffers.UOffsetT {
+		xType, x := e.encodeExpr(node.X)
+		sel := e.encodeIdentNode(node.Sel)
+		astfb.SelectorExprNodeStart(e.builder)
+		astfb.SelectorExprNodeAddRefId(e.builder, int32(node.RefId))
+		astfb.SelectorExprNodeAddXType(e.builder, xType)
+		astfb.SelectorExprNodeAddX(e.builder, x)
+		astfb.SelectorExprNodeAddSel(e.builder, sel)
+		return astfb.SelectorExprNodeEnd(e.builder)
+	})
+}
+
+func (e *flatBufferEncoder) encodeIndexExprNode(node *IndexExprNode) flatbuffers.UOffsetT {
+	return encodeTable(e, node, func() flatbuffers.UOffsetT {
+		xType, x := e.encodeExpr(node.X)
+		indexType, index := e.encodeExpr(node.Index)
+		astfb.IndexExprNodeStart(e.builder)
+		astfb.IndexExprNodeAddRefId(e.builder, int32(node.RefId))
+		astfb.IndexExprNodeAddXType(e.builder, xType)
+		astfb.IndexExprNodeAddX(e.builder, x)
+		if node.Lbrack != nil {
+			astfb.IndexExprNodeAddLbrack(e.builder, e.encodePosition(node.Lbrack))
+		}
+		astfb.IndexExprNodeAddIndexType(e.builder, indexType)
+		astfb.IndexExprNodeAddIndex(e.builder, index)
+		if node.Rbrack != nil {
+			astfb.IndexExprNodeAddRbrack(e.builder, e.encodePosition(node.Rbrack))
+		}
+		return astfb.IndexExprNodeEnd(e.builder)
+	})
+}
+
+func (e *flatBufferEncoder) encodeIndexListExprNode(node *IndexListExprNode) flatbuffers.UOffsetT {
+	return encodeTable(e, node, func() flatbuffers.UOffsetT {
+		xType, x := e.encodeExpr(node.X)
+		indices := e.encodeExprItems(node.Indices)
+		astfb.IndexListExprNodeStart(e.builder)
+		astfb.IndexListExprNodeAddRefId(e.builder, int32(node.RefId))
+		astfb.IndexListExprNodeAddXType(e.builder, xType)
+		astfb.IndexListExprNodeAddX(e.builder, x)
+		if node.Lbrack != nil {
+			astfb.IndexListExprNodeAddLbrack(e.builder, e.encodePosition(node.Lbrack))
+		}
+		astfb.IndexListExprNodeAddIndices(e.builder, indices)
+		if node.Rbrack != nil {
+			astfb.IndexListExprNodeAddRbrack(e.builder, e.encodePosition(node.Rbrack))
+		}
+		return astfb.IndexListExprNodeEnd(e.builder)
+	})
+}
+
+func (e *flatBufferEncoder) encodeSliceExprNode(node *SliceExprNode) flatbuffers.UOffsetT {
+	return encodeTable(e, node, func() flatbuffers.UOffsetT {
+		xType, x := e.encodeExpr(node.X)
+		lowType, low := e.encodeExpr(node.Low)
+		highType, high := e.encodeExpr(node.High)
+		maxType, max := e.encodeExpr(node.Max)
+		astfb.SliceExprNodeStart(e.builder)
+		astfb.SliceExprNodeAddRefId(e.builder, int32(node.RefId))
+		astfb.SliceExprNodeAddXType(e.builder, xType)
+		astfb.SliceExprNodeAddX(e.builder, x)
+		if node.Lbrack != nil {
+			astfb.SliceExprNodeAddLbrack(e.builder, e.encodePosition(node.Lbrack))
+		}
+		astfb.SliceExprNodeAddLowType(e.builder, lowType)
+		astfb.SliceExprNodeAddLow(e.builder, low)
+		astfb.SliceExprNodeAddHighType(e.builder, highType)
+		astfb.SliceExprNodeAddHigh(e.builder, high)
+		astfb.SliceExprNodeAddMaxType(e.builder, maxType)
+		astfb.SliceExprNodeAddMax(e.builder, max)
+		astfb.SliceExprNodeAddSlice3(e.builder, node.Slice3)
+		if node.Rbrack != nil {
+			astfb.SliceExprNodeAddRbrack(e.builder, e.encodePosition(node.Rbrack))
+		}
+		return astfb.SliceExprNodeEnd(e.builder)
+	})
+}
+
+func (e *flatBufferEncoder) encodeTypeAssertExprNode(node *TypeAssertExprNode) flatbuffers.UOffsetT {
+	return encodeTable(e, node, func() flatbuffers.UOffsetT {
+		xType, x := e.encodeExpr(node.X)
+		typeOffsetType, typeOffset := e.encodeExpr(node.Type)
+		astfb.TypeAssertExprNodeStart(e.builder)
+		astfb.TypeAssertExprNodeAddRefId(e.builder, int32(node.RefId))
+		astfb.TypeAssertExprNodeAddXType(e.builder, xType)
+		astfb.TypeAssertExprNodeAddX(e.builder, x)
+		if node.Lparen != nil {
+			astfb.TypeAssertExprNodeAddLparen(e.builder, e.encodePosition(node.Lparen))
+		}
+		astfb.TypeAssertExprNodeAddTypeType(e.builder, typeOffsetType)
+		astfb.TypeAssertExprNodeAddType(e.builder, typeOffset)
+		if node.Rparen != nil {
+			astfb.TypeAssertExprNodeAddRparen(e.builder, e.encodePosition(node.Rparen))
+		}
+		return astfb.TypeAssertExprNodeEnd(e.builder)
+	})
+}
+
+func (e *flatBufferEncoder) encodeCallExprNode(node *CallExprNode) flatbuffers.UOffsetT {
+	return encodeTable(e, node, func() flatbuffers.UOffsetT {
+		funType, fun := e.encodeExpr(node.Fun)
+		args := e.encodeExprItems(node.Args)
+		astfb.CallExprNodeStart(e.builder)
+		astfb.CallExprNodeAddRefId(e.builder, int32(node.RefId))
+		astfb.CallExprNodeAddFunType(e.builder, funType)
+		astfb.CallExprNodeAddFun(e.builder, fun)
+		if node.Lparen != nil {
+			astfb.CallExprNodeAddLparen(e.builder, e.encodePosition(node.Lparen))
+		}
+		astfb.CallExprNodeAddArgs(e.builder, args)
+		if node.Ellipsis != nil {
+			astfb.CallExprNodeAddEllipsis(e.builder, e.encodePosition(node.Ellipsis))
+		}
+		if node.Rparen != nil {
+			astfb.CallExprNodeAddRparen(e.builder, e.encodePosition(node.Rparen))
+		}
+		return astfb.CallExprNodeEnd(e.builder)
+	})
+}
+
+func (e *flatBufferEncoder) encodeStarExprNode(node *StarExprNode) flatbuffers.UOffsetT {
+	return encodeTable(e, node, func() flatbuffers.UOffsetT {
+		xType, x := e.encodeExpr(node.X)
+		astfb.StarExprNodeStart(e.builder)
+		astfb.StarExprNodeAddRefId(e.builder, int32(node.RefId))
+		if node.Star != nil {
+			astfb.StarExprNodeAddStar(e.builder, e.encodePosition(node.Star))
+		}
+		astfb.StarExprNodeAddXType(e.builder, xType)
+		astfb.StarExprNodeAddX(e.builder, x)
+		return astfb.StarExprNodeEnd(e.builder)
+	})
+}
+
+func (e *flatBufferEncoder) encodeUnaryExprNode(node *UnaryExprNode) flatbuffers.UOffsetT {
+	return encodeTable(e, node, func() flatbuffers.UOffsetT {
+		op := e.builder.CreateSharedString(node.Op)
+		xType, x := e.encodeExpr(node.X)
+		astfb.UnaryExprNodeStart(e.builder)
+		astfb.UnaryExprNodeAddRefId(e.builder, int32(node.RefId))
+		if node.OpPos != nil {
+			astfb.UnaryExprNodeAddOpPos(e.builder, e.encodePosition(node.OpPos))
+		}
+		astfb.UnaryExprNodeAddOp(e.builder, op)
+		astfb.UnaryExprNodeAddXType(e.builder, xType)
+		astfb.UnaryExprNodeAddX(e.builder, x)
+		return astfb.UnaryExprNodeEnd(e.builder)
+	})
+}
+
+func (e *flatBufferEncoder) encodeBinaryExprNode(node *BinaryExprNode) flatbuffers.UOffsetT {
+	return encodeTable(e, node, func() flatbuffers.UOffsetT {
+		xType, x := e.encodeExpr(node.X)
+		op := e.builder.CreateSharedString(node.Op)
+		yType, y := e.encodeExpr(node.Y)
+		astfb.BinaryExprNodeStart(e.builder)
+		astfb.BinaryExprNodeAddRefId(e.builder, int32(node.RefId))
+		astfb.BinaryExprNodeAddXType(e.builder, xType)
+		astfb.BinaryExprNodeAddX(e.builder, x)
+		if node.OpPos != nil {
+			astfb.BinaryExprNodeAddOpPos(e.builder, e.encodePosition(node.OpPos))
+		}
+		astfb.BinaryExprNodeAddOp(e.builder, op)
+		astfb.BinaryExprNodeAddYType(e.builder, yType)
+		astfb.BinaryExprNodeAddY(e.builder, y)
+		return astfb.BinaryExprNodeEnd(e.builder)
+	})
+}
+
+func (e *flatBufferEncoder) encodeKeyValueExprNode(node *KeyValueExprNode) flatbuffers.UOffsetT {
+	return encodeTable(e, node, func() flatbuffers.UOffsetT {
+		keyType, key := e.encodeExpr(node.Key)
+		valueType, value := e.encodeExpr(node.Value)
+		astfb.KeyValueExprNodeStart(e.builder)
+		astfb.KeyValueExprNodeAddRefId(e.builder, int32(node.RefId))
+		astfb.KeyValueExprNodeAddKeyType(e.builder, keyType)
+		astfb.KeyValueExprNodeAddKey(e.builder, key)
+		if node.Colon != nil {
+			astfb.KeyValueExprNodeAddColon(e.builder, e.encodePosition(node.Colon))
+		}
+		astfb.KeyValueExprNodeAddValueType(e.builder, valueType)
+		astfb.KeyValueExprNodeAddValue(e.builder, value)
+		return astfb.KeyValueExprNodeEnd(e.builder)
+	})
+}
+
+func (e *flatBufferEncoder) encodeArrayTypeNode(node *ArrayTypeNode) flatbuffers.UOffsetT {
+	return encodeTable(e, node, func() flatbuffers.UOffsetT {
+		lenType, len := e.encodeExpr(node.Len)
+		eltType, elt := e.encodeExpr(node.Elt)
+		astfb.ArrayTypeNodeStart(e.builder)
+		astfb.ArrayTypeNodeAddRefId(e.builder, int32(node.RefId))
+		if node.Lbrack != nil {
+			astfb.ArrayTypeNodeAddLbrack(e.builder, e.encodePosition(node.Lbrack))
+		}
+		astfb.ArrayTypeNodeAddLenType(e.builder, lenType)
+		astfb.ArrayTypeNodeAddLen(e.builder, len)
+		astfb.ArrayTypeNodeAddEltType(e.builder, eltType)
+		astfb.ArrayTypeNodeAddElt(e.builder, elt)
+		return astfb.ArrayTypeNodeEnd(e.builder)
+	})
+}
+
+func (e *flatBufferEncoder) encodeStructTypeNode(node *StructTypeNode) flatbuffers.UOffsetT {
+	return encodeTable(e, node, func() flatbuffers.UOffsetT {
+		fields := e.encodeFieldListNode(node.Fields)
+		astfb.StructTypeNodeStart(e.builder)
+		astfb.StructTypeNodeAddRefId(e.builder, int32(node.RefId))
+		if node.Struct != nil {
+			astfb.StructTypeNodeAddStruct(e.builder, e.encodePosition(node.Struct))
+		}
+		astfb.StructTypeNodeAddFields(e.builder, fields)
+		astfb.StructTypeNodeAddIncomplete(e.builder, node.Incomplete)
+		return astfb.StructTypeNodeEnd(e.builder)
+	})
+}
+
+func (e *flatBufferEncoder) encodeFuncTypeNode(node *FuncTypeNode) flatbuffers.UOffsetT {
+	return encodeTable(e, node, func() flatbuffers.UOffsetT {
+		typeParams := e.encodeFieldListNode(node.TypeParams)
+		params := e.encodeFieldListNode(node.Params)
+		results := e.encodeFieldListNode(node.Results)
+		astfb.FuncTypeNodeStart(e.builder)
+		astfb.FuncTypeNodeAddRefId(e.builder, int32(node.RefId))
+		if node.Func != nil {
+			astfb.FuncTypeNodeAddFunc(e.builder, e.encodePosition(node.Func))
+		}
+		astfb.FuncTypeNodeAddTypeParams(e.builder, typeParams)
+		astfb.FuncTypeNodeAddParams(e.builder, params)
+		astfb.FuncTypeNodeAddResults(e.builder, results)
+		return astfb.FuncTypeNodeEnd(e.builder)
+	})
+}
+
+func (e *flatBufferEncoder) encodeInterfaceTypeNode(node *InterfaceTypeNode) flatbuffers.UOffsetT {
+	return encodeTable(e, node, func() flatbuffers.UOffsetT {
+		methods := e.encodeFieldListNode(node.Methods)
+		astfb.InterfaceTypeNodeStart(e.builder)
+		astfb.InterfaceTypeNodeAddRefId(e.builder, int32(node.RefId))
+		if node.Interface != nil {
+			astfb.InterfaceTypeNodeAddInterface(e.builder, e.encodePosition(node.Interface))
+		}
+		astfb.InterfaceTypeNodeAddMethods(e.builder, methods)
+		astfb.InterfaceTypeNodeAddIncomplete(e.builder, node.Incomplete)
+		return astfb.InterfaceTypeNodeEnd(e.builder)
+	})
+}
+
+func (e *flatBufferEncoder) encodeMapTypeNode(node *MapTypeNode) flatbuffers.UOffsetT {
+	return encodeTable(e, node, func() flatbuffers.UOffsetT {
+		keyType, key := e.encodeExpr(node.Key)
+		valueType, value := e.encodeExpr(node.Value)
+		astfb.MapTypeNodeStart(e.builder)
+		astfb.MapTypeNodeAddRefId(e.builder, int32(node.RefId))
+		if node.Map != nil {
+			astfb.MapTypeNodeAddMap(e.builder, e.encodePosition(node.Map))
+		}
+		astfb.MapTypeNodeAddKeyType(e.builder, keyType)
+		astfb.MapTypeNodeAddKey(e.builder, key)
+		astfb.MapTypeNodeAddValueType(e.builder, valueType)
+		astfb.MapTypeNodeAddValue(e.builder, value)
+		return astfb.MapTypeNodeEnd(e.builder)
+	})
+}
+
+func (e *flatBufferEncoder) encodeChanTypeNode(node *ChanTypeNode) flatbuffers.UOffsetT {
+	return encodeTable(e, node, func() flatbuffers.UOffsetT {
+		dir := e.builder.CreateSharedString(node.Dir)
+		valueType, value := e.encodeExpr(node.Value)
+		astfb.ChanTypeNodeStart(e.builder)
+		astfb.ChanTypeNodeAddRefId(e.builder, int32(node.RefId))
+		if node.Begin != nil {
+			astfb.ChanTypeNodeAddBegin(e.builder, e.encodePosition(node.Begin))
+		}
+		if node.Arrow != nil {
+			astfb.ChanTypeNodeAddArrow(e.builder, e.encodePosition(node.Arrow))
+		}
+		astfb.ChanTypeNodeAddDir(e.builder, dir)
+		astfb.ChanTypeNodeAddValueType(e.builder, valueType)
+		astfb.ChanTypeNodeAddValue(e.builder, value)
+		return astfb.ChanTypeNodeEnd(e.builder)
+	})
+}
+
+func (e *flatBufferEncoder) encodeBadStmtNode(node *BadStmtNode) flatbuffers.UOffsetT {
+	return encodeTable(e, node, func() flatbuffers.UOffsetT {
+		astfb.BadStmtNodeStart(e.builder)
+		astfb.BadStmtNodeAddRefId(e.builder, int32(node.RefId))
+		if node.From != nil {
+			astfb.BadStmtNodeAddFrom(e.builder, e.encodePosition(node.From))
+		}
+		if node.To != nil {
+			astfb.BadStmtNodeAddTo(e.builder, e.encodePosition(node.To))
+		}
+		return astfb.BadStmtNodeEnd(e.builder)
+	})
+}
+
+func (e *flatBufferEncoder) encodeDeclStmtNode(node *DeclStmtNode) flatbuffers.UOffsetT {
+	return encodeTable(e, node, func() flatbuffers.UOffsetT {
+		declType, decl := e.encodeDecl(node.Decl)
+		astfb.DeclStmtNodeStart(e.builder)
+		astfb.DeclStmtNodeAddRefId(e.builder, int32(node.RefId))
+		astfb.DeclStmtNodeAddDeclType(e.builder, declType)
+		astfb.DeclStmtNodeAddDecl(e.builder, decl)
+		return astfb.DeclStmtNodeEnd(e.builder)
+	})
+}
+
+func (e *flatBufferEncoder) encodeEmptyStmtNode(node *EmptyStmtNode) flatbuffers.UOffsetT {
+	return encodeTable(e, node, func() flatbuffers.UOffsetT {
+		astfb.EmptyStmtNodeStart(e.builder)
+		astfb.EmptyStmtNodeAddRefId(e.builder, int32(node.RefId))
+		if node.Semicolon != nil {
+			astfb.EmptyStmtNodeAddSemicolon(e.builder, e.encodePosition(node.Semicolon))
+		}
+		astfb.EmptyStmtNodeAddImplicit(e.builder, node.Implicit)
+		return astfb.EmptyStmtNodeEnd(e.builder)
+	})
+}
+
+func (e *flatBufferEncoder) encodeLabeledStmtNode(node *LabeledStmtNode) flatbuffers.UOffsetT {
+	return encodeTable(e, node, func() flatbuffers.UOffsetT {
+		label := e.encodeIdentNode(node.Label)
+		stmtType, stmt := e.encodeStmt(node.Stmt)
+		astfb.LabeledStmtNodeStart(e.builder)
+		astfb.LabeledStmtNodeAddRefId(e.builder, int32(node.RefId))
+		astfb.LabeledStmtNodeAddLabel(e.builder, label)
+		if node.Colon != nil {
+			astfb.LabeledStmtNodeAddColon(e.builder, e.encodePosition(node.Colon))
+		}
+		astfb.LabeledStmtNodeAddStmtType(e.builder, stmtType)
+		astfb.LabeledStmtNodeAddStmt(e.builder, stmt)
+		return astfb.LabeledStmtNodeEnd(e.builder)
+	})
+}
+
+func (e *flatBufferEncoder) encodeExprStmtNode(node *ExprStmtNode) flatbuffers.UOffsetT {
+	return encodeTable(e, node, func() flatbuffers.UOffsetT {
+		xType, x := e.encodeExpr(node.X)
+		astfb.ExprStmtNodeStart(e.builder)
+		astfb.ExprStmtNodeAddRefId(e.builder, int32(node.RefId))
+		astfb.ExprStmtNodeAddXType(e.builder, xType)
+		astfb.ExprStmtNodeAddX(e.builder, x)
+		return astfb.ExprStmtNodeEnd(e.builder)
+	})
+}
+
+func (e *flatBufferEncoder) encodeSendStmtNode(node *SendStmtNode) flatbuffers.UOffsetT {
+	return encodeTable(e, node, func() flatbuffers.UOffsetT {
+		chanOffsetType, chanOffset := e.encodeExpr(node.Chan)
+		valueType, value := e.encodeExpr(node.Value)
+		astfb.SendStmtNodeStart(e.builder)
+		astfb.SendStmtNodeAddRefId(e.builder, int32(node.RefId))
+		astfb.SendStmtNodeAddChanType(e.builder, chanOffsetType)
+		astfb.SendStmtNodeAddChan(e.builder, chanOffset)
+		if node.Arrow != nil {
+			astfb.SendStmtNodeAddArrow(e.builder, e.encodePosition(node.Arrow))
+		}
+		astfb.SendStmtNodeAddValueType(e.builder, valueType)
+		astfb.SendStmtNodeAddValue(e.builder, value)
+		return astfb.SendStmtNodeEnd(e.builder)
+	})
+}
+
+func (e *flatBufferEncoder) encodeIncDecStmtNode(node *IncDecStmtNode) flatbuffers.UOffsetT {
+	return encodeTable(e, node, func() flatbuffers.UOffsetT {
+		xType, x := e.encodeExpr(node.X)
+		tok := e.builder.CreateSharedString(node.Tok)
+		astfb.IncDecStmtNodeStart(e.builder)
+		astfb.IncDecStmtNodeAddRefId(e.builder, int32(node.RefId))
+		astfb.IncDecStmtNodeAddXType(e.builder, xType)
+		astfb.IncDecStmtNodeAddX(e.builder, x)
+		if node.TokPos != nil {
+			astfb.IncDecStmtNodeAddTokPos(e.builder, e.encodePosition(node.TokPos))
+		}
+		astfb.IncDecStmtNodeAddTok(e.builder, tok)
+		return astfb.IncDecStmtNodeEnd(e.builder)
+	})
+}
+
+func (e *flatBufferEncoder) encodeAssignStmtNode(node *AssignStmtNode) flatbuffers.UOffsetT {
+	return encodeTable(e, node, func() flatbuffers.UOffsetT {
+		lhs := e.encodeExprItems(node.Lhs)
+		tok := e.builder.CreateSharedString(node.Tok)
+		rhs := e.encodeExprItems(node.Rhs)
+		astfb.AssignStmtNodeStart(e.builder)
+		astfb.AssignStmtNodeAddRefId(e.builder, int32(node.RefId))
+		astfb.AssignStmtNodeAddLhs(e.builder, lhs)
+		if node.TokPos != nil {
+			astfb.AssignStmtNodeAddTokPos(e.builder, e.encodePosition(node.TokPos))
+		}
+		astfb.AssignStmtNodeAddTok(e.builder, tok)
+		astfb.AssignStmtNodeAddRhs(e.builder, rhs)
+		return astfb.AssignStmtNodeEnd(e.builder)
+	})
+}
+
+func (e *flatBufferEncoder) encodeGoStmtNode(node *GoStmtNode) flatbuffers.UOffsetT {
+	return encodeTable(e, node, func() flatbuffers.UOffsetT {
+		call := e.encodeCallExprNode(node.Call)
+		astfb.GoStmtNodeStart(e.builder)
+		astfb.GoStmtNodeAddRefId(e.builder, int32(node.RefId))
+		if node.Go != nil {
+			astfb.GoStmtNodeAddGo(e.builder, e.encodePosition(node.Go))
+		}
+		astfb.GoStmtNodeAddCall(e.builder, call)
+		return astfb.GoStmtNodeEnd(e.builder)
+	})
+}
+
+func (e *flatBufferEncoder) encodeDeferStmtNode(node *DeferStmtNode) flatbuffers.UOffsetT {
+	return encodeTable(e, node, func() flatbuffers.UOffsetT {
+		call := e.encodeCallExprNode(node.Call)
+		astfb.DeferStmtNodeStart(e.builder)
+		astfb.DeferStmtNodeAddRefId(e.builder, int32(node.RefId))
+		if node.Defer != nil {
+			astfb.DeferStmtNodeAddDefer(e.builder, e.encodePosition(node.Defer))
+		}
+		astfb.DeferStmtNodeAddCall(e.builder, call)
+		return astfb.DeferStmtNodeEnd(e.builder)
+	})
+}
+
+func (e *flatBufferEncoder) encodeReturnStmtNode(node *ReturnStmtNode) flatbuffers.UOffsetT {
+	return encodeTable(e, node, func() flatbuffers.UOffsetT {
+		results := e.encodeExprItems(node.Results)
+		astfb.ReturnStmtNodeStart(e.builder)
+		astfb.ReturnStmtNodeAddRefId(e.builder, int32(node.RefId))
+		if node.Return != nil {
+			astfb.ReturnStmtNodeAddReturn(e.builder, e.encodePosition(node.Return))
+		}
+		astfb.ReturnStmtNodeAddResults(e.builder, results)
+		return astfb.ReturnStmtNodeEnd(e.builder)
+	})
+}
+
+func (e *flatBufferEncoder) encodeBranchStmtNode(node *BranchStmtNode) flatbuffers.UOffsetT {
+	return encodeTable(e, node, func() flatbuffers.UOffsetT {
+		tok := e.builder.CreateSharedString(node.Tok)
+		label := e.encodeIdentNode(node.Label)
+		astfb.BranchStmtNodeStart(e.builder)
+		astfb.BranchStmtNodeAddRefId(e.builder, int32(node.RefId))
+		if node.TokPos != nil {
+			astfb.BranchStmtNodeAddTokPos(e.builder, e.encodePosition(node.TokPos))
+		}
+		astfb.BranchStmtNodeAddTok(e.builder, tok)
+		astfb.BranchStmtNodeAddLabel(e.builder, label)
+		return astfb.BranchStmtNodeEnd(e.builder)
+	})
+}
+
+func (e *flatBufferEncoder) encodeBlockStmtNode(node *BlockStmtNode) flatbuffers.UOffsetT {
+	return encodeTable(e, node, func() flatbuffers.UOffsetT {
+		list := e.encodeStmtItems(node.List)
+		astfb.BlockStmtNodeStart(e.builder)
+		astfb.BlockStmtNodeAddRefId(e.builder, int32(node.RefId))
+		if node.Lbrace != nil {
+			astfb.BlockStmtNodeAddLbrace(e.builder, e.encodePosition(node.Lbrace))
+		}
+		astfb.BlockStmtNodeAddList(e.builder, list)
+		if node.Rbrace != nil {
+			astfb.BlockStmtNodeAddRbrace(e.builder, e.encodePosition(node.Rbrace))
+		}
+		return astfb.BlockStmtNodeEnd(e.builder)
+	})
+}
+
+func (e *flatBufferEncoder) encodeIfStmtNode(node *IfStmtNode) flatbuffers.UOffsetT {
+	return encodeTable(e, node, func() flatbuffers.UOffsetT {
+		initType, init := e.encodeStmt(node.Init)
+		condType, cond := e.encodeExpr(node.Cond)
+		body := e.encodeBlockStmtNode(node.Body)
+		elseOffsetType, elseOffset := e.encodeStmt(node.Else)
+		astfb.IfStmtNodeStart(e.builder)
+		astfb.IfStmtNodeAddRefId(e.builder, int32(node.RefId))
+		if node.If != nil {
+			astfb.IfStmtNodeAddIf(e.builder, e.encodePosition(node.If))
+		}
+		astfb.IfStmtNodeAddInitStmtType(e.builder, initType)
+		astfb.IfStmtNodeAddInitStmt(e.builder, init)
+		astfb.IfStmtNodeAddCondType(e.builder, condType)
+		astfb.IfStmtNodeAddCond(e.builder, cond)
+		astfb.IfStmtNodeAddBody(e.builder, body)
+		astfb.IfStmtNodeAddElseType(e.builder, elseOffsetType)
+		astfb.IfStmtNodeAddElse(e.builder, elseOffset)
+		return astfb.IfStmtNodeEnd(e.builder)
+	})
+}
+
+func (e *flatBufferEncoder) encodeCaseClauseNode(node *CaseClauseNode) flatbuffers.UOffsetT {
+	return encodeTable(e, node, func() flatbuffers.UOffsetT {
+		list := e.encodeExprItems(node.List)
+		body := e.encodeStmtItems(node.Body)
+		astfb.CaseClauseNodeStart(e.builder)
+		astfb.CaseClauseNodeAddRefId(e.builder, int32(node.RefId))
+		if node.Case != nil {
+			astfb.CaseClauseNodeAddCase(e.builder, e.encodePosition(node.Case))
+		}
+		astfb.CaseClauseNodeAddList(e.builder, list)
+		if node.Colon != nil {
+			astfb.CaseClauseNodeAddColon(e.builder, e.encodePosition(node.Colon))
+		}
+		astfb.CaseClauseNodeAddBody(e.builder, body)
+		return astfb.CaseClauseNodeEnd(e.builder)
+	})
+}
+
+func (e *flatBufferEncoder) encodeSwitchStmtNode(node *SwitchStmtNode) flatbuffers.UOffsetT {
+	return encodeTable(e, node, func() flatbuffers.UOffsetT {
+		initType, init := e.encodeStmt(node.Init)
+		tagType, tag := e.encodeExpr(node.Tag)
+		body := e.encodeBlockStmtNode(node.Body)
+		astfb.SwitchStmtNodeStart(e.builder)
+		astfb.SwitchStmtNodeAddRefId(e.builder, int32(node.RefId))
+		if node.Switch != nil {
+			astfb.SwitchStmtNodeAddSwitch(e.builder, e.encodePosition(node.Switch))
+		}
+		astfb.SwitchStmtNodeAddInitStmtType(e.builder, initType)
+		astfb.SwitchStmtNodeAddInitStmt(e.builder, init)
+		astfb.SwitchStmtNodeAddTagType(e.builder, tagType)
+		astfb.SwitchStmtNodeAddTag(e.builder, tag)
+		astfb.SwitchStmtNodeAddBody(e.builder, body)
+		return astfb.SwitchStmtNodeEnd(e.builder)
+	})
+}
+
+func (e *flatBufferEncoder) encodeTypeSwitchStmtNode(node *TypeSwitchStmtNode) flatbuffers.UOffsetT {
+	return encodeTable(e, node, func() flatbuffers.UOffsetT {
+		initType, init := e.encodeStmt(node.Init)
+		assignType, assign := e.encodeStmt(node.Assign)
+		body := e.encodeBlockStmtNode(node.Body)
+		astfb.TypeSwitchStmtNodeStart(e.builder)
+		astfb.TypeSwitchStmtNodeAddRefId(e.builder, int32(node.RefId))
+		if node.Switch != nil {
+			astfb.TypeSwitchStmtNodeAddSwitch(e.builder, e.encodePosition(node.Switch))
+		}
+		astfb.TypeSwitchStmtNodeAddInitStmtType(e.builder, initType)
+		astfb.TypeSwitchStmtNodeAddInitStmt(e.builder, init)
+		astfb.TypeSwitchStmtNodeAddAssignType(e.builder, assignType)
+		astfb.TypeSwitchStmtNodeAddAssign(e.builder, assign)
+		astfb.TypeSwitchStmtNodeAddBody(e.builder, body)
+		return astfb.TypeSwitchStmtNodeEnd(e.builder)
+	})
+}
+
+func (e *flatBufferEncoder) encodeCommClauseNode(node *CommClauseNode) flatbuffers.UOffsetT {
+	return encodeTable(e, node, func() flatbuffers.UOffsetT {
+		commType, comm := e.encodeStmt(node.Comm)
+		body := e.encodeStmtItems(node.Body)
+		astfb.CommClauseNodeStart(e.builder)
+		astfb.CommClauseNodeAddRefId(e.builder, int32(node.RefId))
+		if node.Case != nil {
+			astfb.CommClauseNodeAddCase(e.builder, e.encodePosition(node.Case))
+		}
+		astfb.CommClauseNodeAddCommType(e.builder, commType)
+		astfb.CommClauseNodeAddComm(e.builder, comm)
+		if node.Colon != nil {
+			astfb.CommClauseNodeAddColon(e.builder, e.encodePosition(node.Colon))
+		}
+		astfb.CommClauseNodeAddBody(e.builder, body)
+		return astfb.CommClauseNodeEnd(e.builder)
+	})
+}
+
+func (e *flatBufferEncoder) encodeSelectStmtNode(node *SelectStmtNode) flatbuffers.UOffsetT {
+	return encodeTable(e, node, func() flatbuffers.UOffsetT {
+		body := e.encodeBlockStmtNode(node.Body)
+		astfb.SelectStmtNodeStart(e.builder)
+		astfb.SelectStmtNodeAddRefId(e.builder, int32(node.RefId))
+		if node.Select != nil {
+			astfb.SelectStmtNodeAddSelect(e.builder, e.encodePosition(node.Select))
+		}
+		astfb.SelectStmtNodeAddBody(e.builder, body)
+		return astfb.SelectStmtNodeEnd(e.builder)
+	})
+}
+
+func (e *flatBufferEncoder) encodeForStmtNode(node *ForStmtNode) flatbuffers.UOffsetT {
+	return encodeTable(e, node, func() flatbuffers.UOffsetT {
+		initType, init := e.encodeStmt(node.Init)
+		condType, cond := e.encodeExpr(node.Cond)
+		postType, post := e.encodeStmt(node.Post)
+		body := e.encodeBlockStmtNode(node.Body)
+		astfb.ForStmtNodeStart(e.builder)
+		astfb.ForStmtNodeAddRefId(e.builder, int32(node.RefId))
+		if node.For != nil {
+			astfb.ForStmtNodeAddFor(e.builder, e.encodePosition(node.For))
+		}
+		astfb.ForStmtNodeAddInitStmtType(e.builder, initType)
+		astfb.ForStmtNodeAddInitStmt(e.builder, init)
+		astfb.ForStmtNodeAddCondType(e.builder, condType)
+		astfb.ForStmtNodeAddCond(e.builder, cond)
+		astfb.ForStmtNodeAddPostType(e.builder, postType)
+		astfb.ForStmtNodeAddPost(e.builder, post)
+		astfb.ForStmtNodeAddBody(e.builder, body)
+		return astfb.ForStmtNodeEnd(e.builder)
+	})
+}
+
+func (e *flatBufferEncoder) encodeRangeStmtNode(node *RangeStmtNode) flatbuffers.UOffsetT {
+	return encodeTable(e, node, func() flatbuffers.UOffsetT {
+		keyType, key := e.encodeExpr(node.Key)
+		valueType, value := e.encodeExpr(node.Value)
+		tok := e.builder.CreateSharedString(node.Tok)
+		xType, x := e.encodeExpr(node.X)
+		body := e.encodeBlockStmtNode(node.Body)
+		astfb.RangeStmtNodeStart(e.builder)
+		astfb.RangeStmtNodeAddRefId(e.builder, int32(node.RefId))
+		if node.For != nil {
+			astfb.RangeStmtNodeAddFor(e.builder, e.encodePosition(node.For))
+		}
+		astfb.RangeStmtNodeAddKeyType(e.builder, keyType)
+		astfb.RangeStmtNodeAddKey(e.builder, key)
+		astfb.RangeStmtNodeAddValueType(e.builder, valueType)
+		astfb.RangeStmtNodeAddValue(e.builder, value)
+		if node.TokPos != nil {
+			astfb.RangeStmtNodeAddTokPos(e.builder, e.encodePosition(node.TokPos))
+		}
+		astfb.RangeStmtNodeAddTok(e.builder, tok)
+		astfb.RangeStmtNodeAddXType(e.builder, xType)
+		astfb.RangeStmtNodeAddX(e.builder, x)
+		astfb.RangeStmtNodeAddBody(e.builder, body)
+		return astfb.RangeStmtNodeEnd(e.builder)
+	})
+}
+
+func (e *flatBufferEncoder) encodeImportSpecNode(node *ImportSpecNode) flatbuffers.UOffsetT {
+	return encodeTable(e, node, func() flatbuffers.UOffsetT {
+		doc := e.encodeCommentGroupNode(node.Doc)
+		name := e.encodeIdentNode(node.Name)
+		path := e.encodeBasicLitNode(node.Path)
+		comment := e.encodeCommentGroupNode(node.Comment)
+		astfb.ImportSpecNodeStart(e.builder)
+		astfb.ImportSpecNodeAddRefId(e.builder, int32(node.RefId))
+		astfb.ImportSpecNodeAddDoc(e.builder, doc)
+		astfb.ImportSpecNodeAddName(e.builder, name)
+		astfb.ImportSpecNodeAddPath(e.builder, path)
+		astfb.ImportSpecNodeAddComment(e.builder, comment)
+		if node.EndPos != nil {
+			astfb.ImportSpecNodeAddEndPos(e.builder, e.encodePosition(node.EndPos))
+		}
+		return astfb.ImportSpecNodeEnd(e.builder)
+	})
+}
+
+func (e *flatBufferEncoder) encodeValueSpecNode(node *ValueSpecNode) flatbuffers.UOffsetT {
+	return encodeTable(e, node, func() flatbuffers.UOffsetT {
+		doc := e.encodeCommentGroupNode(node.Doc)
+		names := e.encodeVector(len(node.Names), func(i int) flatbuffers.UOffsetT {
+			return e.encodeIdentNode(node.Names[i])
+		})
+		typeOffsetType, typeOffset := e.encodeExpr(node.Type)
+		values := e.encodeExprItems(node.Values)
+		comment := e.encodeCommentGroupNode(node.Comment)
+		astfb.ValueSpecNodeStart(e.builder)
+		astfb.ValueSpecNodeAddRefId(e.builder, int32(node.RefId))
+		astfb.ValueSpecNodeAddDoc(e.builder, doc)
+		astfb.ValueSpecNodeAddNames(e.builder, names)
+		astfb.ValueSpecNodeAddTypeType(e.builder, typeOffsetType)
+		astfb.ValueSpecNodeAddType(e.builder, typeOffset)
+		astfb.ValueSpecNodeAddValues(e.builder, values)
+		astfb.ValueSpecNodeAddComment(e.builder, comment)
+		return astfb.ValueSpecNodeEnd(e.builder)
+	})
+}
+
+func (e *flatBufferEncoder) encodeTypeSpecNode(node *TypeSpecNode) flatbuffers.UOffsetT {
+	return encodeTable(e, node, func() flatbuffers.UOffsetT {
+		doc := e.encodeCommentGroupNode(node.Doc)
+		name := e.encodeIdentNode(node.Name)
+		typeParams := e.encodeFieldListNode(node.TypeParams)
+		typeOffsetType, typeOffset := e.encodeExpr(node.Type)
+		comment := e.encodeCommentGroupNode(node.Comment)
+		astfb.TypeSpecNodeStart(e.builder)
+		astfb.TypeSpecNodeAddRefId(e.builder, int32(node.RefId))
+		astfb.TypeSpecNodeAddDoc(e.builder, doc)
+		astfb.TypeSpecNodeAddName(e.builder, name)
+		astfb.TypeSpecNodeAddTypeParams(e.builder, typeParams)
+		if node.Assign != nil {
+			astfb.TypeSpecNodeAddAssign(e.builder, e.encodePosition(node.Assign))
+		}
+		astfb.TypeSpecNodeAddTypeType(e.builder, typeOffsetType)
+		astfb.TypeSpecNodeAddType(e.builder, typeOffset)
+		astfb.TypeSpecNodeAddComment(e.builder, comment)
+		return astfb.TypeSpecNodeEnd(e.builder)
+	})
+}
+
+func (e *flatBufferEncoder) encodeBadDeclNode(node *BadDeclNode) flatbuffers.UOffsetT {
+	return encodeTable(e, node, func() flatbuffers.UOffsetT {
+		astfb.BadDeclNodeStart(e.builder)
+		astfb.BadDeclNodeAddRefId(e.builder, int32(node.RefId))
+		if node.From != nil {
+			astfb.BadDeclNodeAddFrom(e.builder, e.encodePosition(node.From))
+		}
+		if node.To != nil {
+			astfb.BadDeclNodeAddTo(e.builder, e.encodePosition(node.To))
+		}
+		return astfb.BadDeclNodeEnd(e.builder)
+	})
+}
+
+func (e *flatBufferEncoder) encodeGenDeclNode(node *GenDeclNode) flatbuffers.UOffsetT {
+	return encodeTable(e, node, func() flatbuffers.UOffsetT {
+		doc := e.encodeCommentGroupNode(node.Doc)
+		tok := e.builder.CreateSharedString(node.Tok)
+		specs := e.encodeSpecItems(node.Specs)
+		astfb.GenDeclNodeStart(e.builder)
+		astfb.GenDeclNodeAddRefId(e.builder, int32(node.RefId))
+		astfb.GenDeclNodeAddDoc(e.builder, doc)
+		if node.TokPos != nil {
+			astfb.GenDeclNodeAddTokPos(e.builder, e.encodePosition(node.TokPos))
+		}
+		astfb.GenDeclNodeAddTok(e.builder, tok)
+		if node.Lparen != nil {
+			astfb.GenDeclNodeAddLparen(e.builder, e.encodePosition(node.Lparen))
+		}
+		astfb.GenDeclNodeAddSpecs(e.builder, specs)
+		if node.Rparen != nil {
+			astfb.GenDeclNodeAddRparen(e.builder, e.encodePosition(node.Rparen))
+		}
+		return astfb.GenDeclNodeEnd(e.builder)
+	})
+}
+
+func (e *flatBufferEncoder) encodeFuncDeclNode(node *FuncDeclNode) flatbuffers.UOffsetT {
+	return encodeTable(e, node, func() flatbuffers.UOffsetT {
+		doc := e.encodeCommentGroupNode(node.Doc)
+		recv := e.encodeFieldListNode(node.Recv)
+		name := e.encodeIdentNode(node.Name)
+		typeOffset := e.encodeFuncTypeNode(node.Type)
+		body := e.encodeBlockStmtNode(node.Body)
+		astfb.FuncDeclNodeStart(e.builder)
+		astfb.FuncDeclNodeAddRefId(e.builder, int32(node.RefId))
+		astfb.FuncDeclNodeAddDoc(e.builder, doc)
+		astfb.FuncDeclNodeAddRecv(e.builder, recv)
+		astfb.FuncDeclNodeAddName(e.builder, name)
+		astfb.FuncDeclNodeAddType(e.builder, typeOffset)
+		astfb.FuncDeclNodeAddBody(e.builder, body)
+		return astfb.FuncDeclNodeEnd(e.builder)
+	})
+}
+
+func (e *flatBufferEncoder) encodeFileMetaNode(node *FileMetaNode) flatbuffers.UOffsetT {
+	return encodeTable(e, node, func() flatbuffers.UOffsetT {
+		path := e.builder.CreateSharedString(node.Path)
+		category := e.builder.CreateSharedString(node.Category)
+		constraint := e.builder.CreateSharedString(node.Constraint)
+		astfb.FileMetaNodeStart(e.builder)
+		astfb.FileMetaNodeAddPath(e.builder, path)
+		astfb.FileMetaNodeAddCategory(e.builder, category)
+		astfb.FileMetaNodeAddConstraint(e.builder, constraint)
+		return astfb.FileMetaNodeEnd(e.builder)
+	})
+}
+
+func (e *flatBufferEncoder) encodeExpr(node IExprNode) (astfb.Expr, flatbuffers.UOffsetT) {
+	switch node := node.(type) {
+	case *BadExprNode:
+		return astfb.ExprBadExprNode, e.encodeBadExprNode(node)
+	case *IdentNode:
+		return astfb.ExprIdentNode, e.encodeIdentNode(node)
+	case *EllipsisNode:
+		return astfb.ExprEllipsisNode, e.encodeEllipsisNode(node)
+	case *BasicLitNode:
+		return astfb.ExprBasicLitNode, e.encodeBasicLitNode(node)
+	case *FuncLitNode:
+		return astfb.ExprFuncLitNode, e.encodeFuncLitNode(node)
+	case *CompositeLitNode:
+		return astfb.ExprCompositeLitNode, e.encodeCompositeLitNode(node)
+	case *ParenExprNode:
+		return astfb.ExprParenExprNode, e.encodeParenExprNode(node)
+	case *SelectorExprNode:
+		return astfb.ExprSelectorExprNode, e.encodeSelectorExprNode(node)
+	case *IndexExprNode:
+		return astfb.ExprIndexExprNode, e.encodeIndexExprNode(node)
+	case *IndexListExprNode:
+		return astfb.ExprIndexListExprNode, e.encodeIndexListExprNode(node)
+	case *SliceExprNode:
+		return astfb.ExprSliceExprNode, e.encodeSliceExprNode(node)
+	case *TypeAssertExprNode:
+		return astfb.ExprTypeAssertExprNode, e.encodeTypeAssertExprNode(node)
+	case *CallExprNode:
+		return astfb.ExprCallExprNode, e.encodeCallExprNode(node)
+	case *StarExprNode:
+		return astfb.ExprStarExprNode, e.encodeStarExprNode(node)
+	case *UnaryExprNode:
+		return astfb.ExprUnaryExprNode, e.encodeUnaryExprNode(node)
+	case *BinaryExprNode:
+		return astfb.ExprBinaryExprNode, e.encodeBinaryExprNode(node)
+	case *KeyValueExprNode:
+		return astfb.ExprKeyValueExprNode, e.encodeKeyValueExprNode(node)
+	case *ArrayTypeNode:
+		return astfb.ExprArrayTypeNode, e.encodeArrayTypeNode(node)
+	case *StructTypeNode:
+		return astfb.ExprStructTypeNode, e.encodeStructTypeNode(node)
+	case *FuncTypeNode:
+		return astfb.ExprFuncTypeNode, e.encodeFuncTypeNode(node)
+	case *InterfaceTypeNode:
+		return astfb.ExprInterfaceTypeNode, e.encodeInterfaceTypeNode(node)
+	case *MapTypeNode:
+		return astfb.ExprMapTypeNode, e.encodeMapTypeNode(node)
+	case *ChanTypeNode:
+		return astfb.ExprChanTypeNode, e.encodeChanTypeNode(node)
+	}
+	return astfb.ExprNONE, 0
+}
+
+func (e *flatBufferEncoder) encodeExprItems(nodes []IExprNode) flatbuffers.UOffsetT {
+	return e.encodeVector(len(nodes), func(i int) flatbuffers.UOffsetT {
+		kind, node := e.encodeExpr(nodes[i])
+		astfb.ExprItemStart(e.builder)
+		astfb.ExprItemAddNodeType(e.builder, kind)
+		astfb.ExprItemAddNode(e.builder, node)
+		return astfb.ExprItemEnd(e.builder)
+	})
+}
+
+func (e *flatBufferEncoder) encodeStmt(node IStmtNode) (astfb.Stmt, flatbuffers.UOffsetT) {
+	switch node := node.(type) {
+	case *BadStmtNode:
+		return astfb.StmtBadStmtNode, e.encodeBadStmtNode(node)
+	case *DeclStmtNode:
+		return astfb.StmtDeclStmtNode, e.encodeDeclStmtNode(node)
+	case *EmptyStmtNode:
+		return astfb.StmtEmptyStmtNode, e.encodeEmptyStmtNode(node)
+	case *LabeledStmtNode:
+		return astfb.StmtLabeledStmtNode, e.encodeLabeledStmtNode(node)
+	case *ExprStmtNode:
+		return astfb.StmtExprStmtNode, e.encodeExprStmtNode(node)
+	case *SendStmtNode:
+		return astfb.StmtSendStmtNode, e.encodeSendStmtNode(node)
+	case *IncDecStmtNode:
+		return astfb.StmtIncDecStmtNode, e.encodeIncDecStmtNode(node)
+	case *AssignStmtNode:
+		return astfb.StmtAssignStmtNode, e.encodeAssignStmtNode(node)
+	case *GoStmtNode:
+		return astfb.StmtGoStmtNode, e.encodeGoStmtNode(node)
+	case *DeferStmtNode:
+		return astfb.StmtDeferStmtNode, e.encodeDeferStmtNode(node)
+	case *ReturnStmtNode:
+		return astfb.StmtReturnStmtNode, e.encodeReturnStmtNode(node)
+	case *BranchStmtNode:
+		return astfb.StmtBranchStmtNode, e.encodeBranchStmtNode(node)
+	case *BlockStmtNode:
+		return astfb.StmtBlockStmtNode, e.encodeBlockStmtNode(node)
+	case *IfStmtNode:
+		return astfb.StmtIfStmtNode, e.encodeIfStmtNode(node)
+	case *CaseClauseNode:
+		return astfb.StmtCaseClauseNode, e.encodeCaseClauseNode(node)
+	case *SwitchStmtNode:
+		return astfb.StmtSwitchStmtNode, e.encodeSwitchStmtNode(node)
+	case *TypeSwitchStmtNode:
+		return astfb.StmtTypeSwitchStmtNode, e.encodeTypeSwitchStmtNode(node)
+	case *CommClauseNode:
+		return astfb.StmtCommClauseNode, e.encodeCommClauseNode(node)
+	case *SelectStmtNode:
+		return astfb.StmtSelectStmtNode, e.encodeSelectStmtNode(node)
+	case *ForStmtNode:
+		return astfb.StmtForStmtNode, e.encodeForStmtNode(node)
+	case *RangeStmtNode:
+		return astfb.StmtRangeStmtNode, e.encodeRangeStmtNode(node)
+	}
+	return astfb.StmtNONE, 0
+}
+
+func (e *flatBufferEncoder) encodeStmtItems(nodes []IStmtNode) flatbuffers.UOffsetT {
+	return e.encodeVector(len(nodes), func(i int) flatbuffers.UOffsetT {
+		kind, node := e.encodeStmt(nodes[i])
+		astfb.StmtItemStart(e.builder)
+		astfb.StmtItemAddNodeType(e.builder, kind)
+		astfb.StmtItemAddNode(e.builder, node)
+		return astfb.StmtItemEnd(e.builder)
+	})
+}
+
+func (e *flatBufferEncoder) encodeSpec(node ISpecNode) (astfb.Spec, flatbuffers.UOffsetT) {
+	switch node := node.(type) {
+	case *ImportSpecNode:
+		return astfb.SpecImportSpecNode, e.encodeImportSpecNode(node)
+	case *ValueSpecNode:
+		return astfb.SpecValueSpecNode, e.encodeValueSpecNode(node)
+	case *TypeSpecNode:
+		return astfb.SpecTypeSpecNode, e.encodeTypeSpecNode(node)
+	}
+	return astfb.SpecNONE, 0
+}
+
+func (e *flatBufferEncoder) encodeSpecItems(nodes []ISpecNode) flatbuffers.UOffsetT {
+	return e.encodeVector(len(nodes), func(i int) flatbuffers.UOffsetT {
+		kind, node := e.encodeSpec(nodes[i])
+		astfb.SpecItemStart(e.builder)
+		astfb.SpecItemAddNodeType(e.builder, kind)
+		astfb.SpecItemAddNode(e.builder, node)
+		return astfb.SpecItemEnd(e.builder)
+	})
+}
+
+func (e *flatBufferEncoder) encodeDecl(node IDeclNode) (astfb.Decl, flatbuffers.UOffsetT) {
+	switch node := node.(type) {
+	case *BadDeclNode:
+		return astfb.DeclBadDeclNode, e.encodeBadDeclNode(node)
+	case *GenDeclNode:
+		return astfb.DeclGenDeclNode, e.encodeGenDeclNode(node)
+	case *FuncDeclNode:
+		return astfb.DeclFuncDeclNode, e.encodeFuncDeclNode(node)
+	}
+	return astfb.DeclNONE, 0
+}
+
+func (e *flatBufferEncoder) encodeDeclItems(nodes []IDeclNode) flatbuffers.UOffsetT {
+	return e.encodeVector(len(nodes), func(i int) flatbuffers.UOffsetT {
+		kind, node := e.encodeDecl(nodes[i])
+		astfb.DeclItemStart(e.builder)
+		astfb.DeclItemAddNodeType(e.builder, kind)
+		astfb.DeclItemAddNode(e.builder, node)
+		return astfb.DeclItemEnd(e.builder)
+	})
+}
@@ -0,0 +1,161 @@
+package ast_json
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestFlatBufferRoundTrip(t *testing.T) {
+	files, err := listDir(getTestDataRoot(), ".input")
+	if err != nil {
+		t.Fatal(err)
+	}
+	files = append(files, "cli.go")
+	for _, params := range paramsMatrix {
+		options := Options{
+			WithComments:   params.comments,
+			WithPositions:  params.positions,
+			WithReferences: params.references,
+			WithImports:    params.imports,
+		}
+		for _, input := range files {
+			testName := fmt.Sprintf("%s,comments:%t,positions:%t,references:%t,imports:%t",
+				filepath.Base(input), params.comments, params.positions, params.references, params.imports)
+			t.Run(testName, func(t *testing.T) {
+				runFormatRoundTrip(t, flatBufferFormat, input, options)
+			})
+		}
+	}
+}
+
+// nodeFormat writes file nodes to files of a format other than JSON and reads them back.
+type nodeFormat struct {
+	write    func(output string, node *FileNode) error
+	read     func(input string) (*FileNode, error)
+	toSource func(input, output string, options Options) error
+}
+
+var flatBufferFormat = nodeFormat{
+	write: WriteFlatBuffer,
+	read: func(input string) (*FileNode, error) {
+		buf, err := os.ReadFile(input)
+		if err != nil {
+			return nil, err
+		}
+		return FlatBufferToNode(buf)
+	},
+	toSource: FlatBufferToSource,
+}
+
+// runFormatRoundTrip checks that the node of input read back from a file of
+// format encodes to the JSON of the original node and prints to its source.
+func runFormatRoundTrip(t *testing.T, format nodeFormat, input string, options Options) {
+	content, err := os.ReadFile(input)
+	if err != nil {
+		t.Fatal(err)
+	}
+	source := string(content)
+	node, err := SourceToNode(&source, input, options, &FileMetaNode{Path: input, Category: "source"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	dir := t.TempDir()
+	encoded := filepath.Join(dir, "file.bin")
+	err = format.write(encoded, node)
+	if err != nil {
+		t.Fatal(err)
+	}
+	decoded, err := format.read(encoded)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The decoded node encodes to the JSON of the original one
+	want, err := json.Marshal(node)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := json.Marshal(decoded)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(got, want) {
+		t.Fatal("decoded node differs from the encoded one")
+	}
+
+	// The source printed from the file is the one printed from the JSON
+	jsonFile := filepath.Join(dir, "file.json")
+	err = WriteJSON(jsonFile, "", node)
+	if err != nil {
+		t.Fatal(err)
+	}
+	fromJSON := filepath.Join(dir, "json.go")
+	err = JSONToSource(jsonFile, fromJSON, options)
+	if err != nil {
+		t.Fatal(err)
+	}
+	fromFormat := filepath.Join(dir, "bin.go")
+	err = format.toSource(encoded, fromFormat, options)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = compare(fromJSON, fromFormat)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestFlatBufferDecoder(t *testing.T) {
+	content, err := os.ReadFile("cli.go")
+	if err != nil {
+		t.Fatal(err)
+	}
+	source := string(content)
+	options := Options{WithPositions: true, WithReferences: true, WithComments: true}
+	node, err := SourceToNode(&source, "cli.go", options, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	buf, err := NodeToFlatBuffer(node)
+	if err != nil {
+		t.Fatal(err)
+	}
+	decoder, err := NewFlatBufferDecoder(buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := decoder.Root().DeclsLength(); got != len(node.Decls) {
+		t.Errorf("DeclsLength() = %d, want %d", got, len(node.Decls))
+	}
+
+	// A single declaration decodes to the one of the whole file
+	decl := decoder.FuncDecl("JSONToSource")
+	if decl == nil {
+		t.Fatal("JSONToSource not found")
+	}
+	var want *FuncDeclNode
+	for _, d := range node.Decls {
+		if f, ok := d.(*FuncDeclNode); ok && f.Name.Name == "JSONToSource" {
+			want = f
+		}
+	}
+	got, _ := json.Marshal(decl)
+	wantJSON, _ := json.Marshal(want)
+	if !bytes.Equal(got, wantJSON) {
+		t.Error("decoded declaration differs")
+	}
+	if decoder.FuncDecl("missing") != nil {
+		t.Error("missing function found")
+	}
+
+	// Other buffers are rejected
+	for _, buf := range [][]byte{nil, []byte("{}"), buf[:len(buf)/2]} {
+		if _, err := FlatBufferToNode(buf); err == nil {
+			t.Errorf("%d bytes decoded", len(buf))
+		}
+	}
+}
@@ -672,8 +672,20 @@ type FuncDeclNode struct {
 
 // ---------------------------------------------------------------------------
 
+// FileMetaNode describes where a file comes from, it is not part of go/ast.
+type FileMetaNode struct {
+	// Path is the slash separated path of the file in its repository.
+	Path string `json:"Path,omitempty"`
+	// Category is one of source, test, vendor, testdata or generated.
+	Category string `json:"Category,omitempty"`
+	// Constraint is the build constraint implied by the //go:build line and
+	// the _GOOS and _GOARCH file name suffixes, empty when there is none.
+	Constraint string `json:"Constraint,omitempty"`
+}
+
 type FileNode struct {
 	Node
+	Meta       *FileMetaNode       `json:"Meta,omitempty"`
 	Doc        *CommentGroupNode   `json:"Doc,omitempty"`
 	Package    *PositionNode       `json:"Package,omitempty"`
 	Name       *IdentNode          `json:"Name"`
@@ -686,6 +698,7 @@ type FileNode struct {
 }
 type FileNodeAlias struct {
 	Node
+	Meta       *FileMetaNode `json:"Meta,omitempty"`
 	Doc        *CommentGroupNode
 	Package    *PositionNode
 	Name       *IdentNode
@@ -2067,6 +2080,7 @@ func (node *FileNode) UnmarshalJSON(data []byte) error {
 	}
 
 	node.Node = alias.Node
+	node.Meta = alias.Meta
 	node.Doc = alias.Doc
 	node.Package = alias.Package
 	node.Name = alias.Name
@@ -2094,6 +2108,7 @@ func (node *FileNode) UnmarshalJSON(data []byte) error {
 func (node *FileNode) MarshalJSON() ([]byte, error) {
 	alias := &FileNodeAlias{}
 	alias.Node = node.Node
+	alias.Meta = node.Meta
 	alias.Doc = node.Doc
 	alias.Package = node.Package
 	alias.Name = node.Name
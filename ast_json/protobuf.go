@@ -0,0 +1,222 @@
+package ast_json
+
+import (
+	"fmt"
+	"go/ast"
+	"go/printer"
+	"go/token"
+	"io"
+	"os"
+
+	"GoOperatorAST/astpb"
+	"google.golang.org/protobuf/proto"
+)
+
+// The Protocol Buffers format holds the same nodes as the JSON one, in the
+// messages of the schema ast.proto at the root of the repository.
+
+// NodeToProto converts a file node to a FileNode message of the ast.proto schema.
+// @param node: file node to convert
+func NodeToProto(node *FileNode) (*astpb.FileNode, error) {
+	if node == nil {
+		return nil, nil
+	}
+	var fileSet *astpb.FileSet
+	if node.FileSet != nil {
+		set, err := writeFileSet(node.FileSet)
+		if err != nil {
+			return nil, err
+		}
+		fileSet = toProtoFileSet(set)
+	}
+	return &astpb.FileNode{
+		RefId:      int32(node.RefId),
+		Meta:       toProtoFileMetaNode(node.Meta),
+		Doc:        toProtoCommentGroupNode(node.Doc),
+		Package:    toProtoPositionNode(node.Package),
+		Name:       toProtoIdentNode(node.Name),
+		Decls:      convertSlice(node.Decls, toProtoDecl),
+		Imports:    convertSlice(node.Imports, toProtoImportSpecNode),
+		Unresolved: convertSlice(node.Unresolved, toProtoIdentNode),
+		Comments:   convertSlice(node.Comments, toProtoCommentGroupNode),
+		FileSet:    fileSet,
+	}, nil
+}
+
+// ProtoToNode converts a FileNode message of the ast.proto schema to a file node.
+// @param node: message to convert
+func ProtoToNode(node *astpb.FileNode) *FileNode {
+	if node == nil {
+		return nil
+	}
+	file := &FileNode{
+		Node:       protoNode("File", node.RefId),
+		Meta:       fromProtoFileMetaNode(node.Meta),
+		Doc:        fromProtoCommentGroupNode(node.Doc),
+		Package:    fromProtoPositionNode(node.Package),
+		Name:       fromProtoIdentNode(node.Name),
+		Decls:      make([]IDeclNode, len(node.Decls)),
+		Imports:    convertSlice(node.Imports, fromProtoImportSpecNode),
+		Unresolved: convertSlice(node.Unresolved, fromProtoIdentNode),
+		Comments:   convertSlice(node.Comments, fromProtoCommentGroupNode),
+		FileSet:    token.NewFileSet(),
+	}
+	// The Marshaller records the list of declarations even when it is empty
+	for i, decl := range node.Decls {
+		file.Decls[i] = fromProtoDecl(decl)
+	}
+	if node.FileSet != nil {
+		readFileSet(file.FileSet, fromProtoFileSet(node.FileSet))
+	}
+	return file
+}
+
+// MarshalFileProto marshals a file parsed with the FileSet of the marshaller to a FileNode message.
+// @param file: parsed file
+func (m *Marshaller) MarshalFileProto(file *ast.File) (*astpb.FileNode, error) {
+	return NodeToProto(m.MarshalFile(file))
+}
+
+// UnmarshalFileProto unmarshals a FileNode message to a file, positioned in the FileSet of the unmarshaller.
+// @param node: message to unmarshal
+func (um *Unmarshaller) UnmarshalFileProto(node *astpb.FileNode) *ast.File {
+	return um.UnmarshalFileNode(ProtoToNode(node))
+}
+
+// WriteProto encodes node to output through a temporary file renamed into place.
+// @param output: output file path
+// @param node: node to encode
+func WriteProto(output string, node *FileNode) error {
+	message, err := NodeToProto(node)
+	if err != nil {
+		return err
+	}
+	data, err := proto.Marshal(message)
+	if err != nil {
+		return err
+	}
+	return WriteFileAtomic(output, func(out io.Writer) error {
+		_, err := out.Write(data)
+		return err
+	})
+}
+
+// ReadProto decodes the FileNode message of a file written by WriteProto.
+// @param input: input file path
+func ReadProto(input string) (*FileNode, error) {
+	data, err := os.ReadFile(input)
+	if err != nil {
+		return nil, err
+	}
+	var message astpb.FileNode
+	err = proto.Unmarshal(data, &message)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", input, err)
+	}
+	return ProtoToNode(&message), nil
+}
+
+// SourceToProto converts the given Go source code to a Protocol Buffers file.
+// @param input: input file path
+// @param output: output file path
+// @param options: options for converting the file
+func SourceToProto(input, output string, options Options) error {
+	content, err := os.ReadFile(input)
+	if err != nil {
+		return err
+	}
+	source := string(content)
+	node, err := SourceToNode(&source, input, options, nil)
+	if err != nil {
+		return err
+	}
+	return WriteProto(output, node)
+}
+
+// ProtoToSource converts the given Protocol Buffers file to Go source code and writes it to the given output file.
+// @param input: input file path
+// @param output: output file path
+// @param options: options for converting the file
+func ProtoToSource(input, output string, options Options) error {
+	node, err := ReadProto(input)
+	if err != nil {
+		return err
+	}
+
+	// Unmarshal the FileNode to a tree and print it
+	unmarshaler := NewUnmarshaller(options)
+	tree := unmarshaler.UnmarshalFileNode(node)
+	outFile, err := os.Create(output)
+	if err != nil {
+		return err
+	}
+	err = printer.Fprint(outFile, unmarshaler.FileSet(), tree)
+	if closeErr := outFile.Close(); err == nil {
+		err = closeErr
+	}
+	return err
+}
+
+// ---------------------------------------------------------------------------
+
+// convertSlice converts every node of a list, a nil list stays nil.
+func convertSlice[T any, R any](nodes []T, convert func(T) R) []R {
+	if nodes == nil {
+		return nil
+	}
+	result := make([]R, len(nodes))
+	for i, node := range nodes {
+		result[i] = convert(node)
+	}
+	return result
+}
+
+// protoNode returns the Node of a node type as recorded by the Marshaller.
+func protoNode(nodeType string, ref int32) Node {
+	return Node{
+		NodeType: nodeType,
+		RefId:    int(ref),
+		Id:       nodeTypesMap[nodeType],
+	}
+}
+
+func toProtoFileSet(set serializedFileSet) *astpb.FileSet {
+	message := &astpb.FileSet{Base: int32(set.Base)}
+	for _, file := range set.Files {
+		converted := &astpb.FileSetFile{Name: file.Name, Base: int32(file.Base), Size: int32(file.Size)}
+		for _, line := range file.Lines {
+			converted.Lines = append(converted.Lines, int32(line))
+		}
+		for _, info := range file.Infos {
+			converted.Infos = append(converted.Infos, &astpb.FileSetLineInfo{
+				Offset:   int32(info.Offset),
+				Filename: info.Filename,
+				Line:     int32(info.Line),
+				Column:   int32(info.Column),
+			})
+		}
+		message.Files = append(message.Files, converted)
+	}
+	return message
+}
+
+func fromProtoFileSet(message *astpb.FileSet) serializedFileSet {
+	set := serializedFileSet{Base: int(message.Base)}
+	for _, file := range message.Files {
+		converted := serializedFile{Name: file.Name, Base: int(file.Base), Size: int(file.Size)}
+		converted.Lines = make([]int, len(file.Lines))
+		for i, line := range file.Lines {
+			converted.Lines[i] = int(line)
+		}
+		for _, info := range file.Infos {
+			converted.Infos = append(converted.Infos, serializedLineInfo{
+				Offset:   int(info.Offset),
+				Filename: info.Filename,
+				Line:     int(info.Line),
+				Column:   int(info.Column),
+			})
+		}
+		set.Files = append(set.Files, converted)
+	}
+	return set
+}
@@ -0,0 +1,1739 @@
+package ast_json
+
+import "GoOperatorAST/astpb"
+
+// Converters between the node types of nodes.go and the messages of ast.proto.
+
+func toProtoPositionNode(node *PositionNode) *astpb.PositionNode {
+	if node == nil {
+		return nil
+	}
+	return &astpb.PositionNode{
+		RefId:    int32(node.RefId),
+		Filename: node.Filename,
+		Offset:   int32(node.Offset),
+		Line:     int32(node.Line),
+		Column:   int32(node.Column),
+	}
+}
+
+func toProtoCommentNode(node *CommentNode) *astpb.CommentNode {
+	if node == nil {
+		return nil
+	}
+	return &astpb.CommentNode{
+		RefId: int32(node.RefId),
+		Slash: toProtoPositionNode(node.Slash),
+		Text:  node.Text,
+	}
+}
+
+func toProtoCommentGroupNode(node *CommentGroupNode) *astpb.CommentGroupNode {
+	if node == nil {
+		return nil
+	}
+	return &astpb.CommentGroupNode{
+		RefId: int32(node.RefId),
+		List:  convertSlice(node.List, toProtoCommentNode),
+	}
+}
+
+func toProtoFieldNode(node *FieldNode) *astpb.FieldNode {
+	if node == nil {
+		return nil
+	}
+	return &astpb.FieldNode{
+		RefId:   int32(node.RefId),
+		Doc:     toProtoCommentGroupNode(node.Doc),
+		Names:   convertSlice(node.Names, toProtoIdentNode),
+		Type:    toProtoExpr(node.Type),
+		Tag:     toProtoBasicLitNode(node.Tag),
+		Comment: toProtoCommentGroupNode(node.Comment),
+	}
+}
+
+func toProtoFieldListNode(node *FieldListNode) *astpb.FieldListNode {
+	if node == nil {
+		return nil
+	}
+	return &astpb.FieldListNode{
+		RefId:   int32(node.RefId),
+		Opening: toProtoPositionNode(node.Opening),
+		List:    convertSlice(node.List, toProtoFieldNode),
+		Closing: toProtoPositionNode(node.Closing),
+	}
+}
+
+func toProtoBadExprNode(node *BadExprNode) *astpb.BadExprNode {
+	if node == nil {
+		return nil
+	}
+	return &astpb.BadExprNode{
+		RefId: int32(node.RefId),
+		From:  toProtoPositionNode(node.From),
+		To:    toProtoPositionNode(node.To),
+	}
+}
+
+func toProtoIdentNode(node *IdentNode) *astpb.IdentNode {
+	if node == nil {
+		return nil
+	}
+	return &astpb.IdentNode{
+		RefId:   int32(node.RefId),
+		NamePos: toProtoPositionNode(node.NamePos),
+		Name:    node.Name,
+	}
+}
+
+func toProtoEllipsisNode(node *EllipsisNode) *astpb.EllipsisNode {
+	if node == nil {
+		return nil
+	}
+	return &astpb.EllipsisNode{
+		RefId:    int32(node.RefId),
+		Ellipsis: toProtoPositionNode(node.Ellipsis),
+		Elt:      toProtoExpr(node.Elt),
+	}
+}
+
+func toProtoBasicLitNode(node *BasicLitNode) *astpb.BasicLitNode {
+	if node == nil {
+		return nil
+	}
+	return &astpb.BasicLitNode{
+		RefId:    int32(node.RefId),
+		ValuePos: toProtoPositionNode(node.ValuePos),
+		Kind:     node.Kind,
+		Value:    node.Value,
+	}
+}
+
+func toProtoFuncLitNode(node *FuncLitNode) *astpb.FuncLitNode {
+	if node == nil {
+		return nil
+	}
+	return &astpb.FuncLitNode{
+		RefId: int32(node.RefId),
+		Type:  toProtoFuncTypeNode(node.Type),
+		Body:  toProtoBlockStmtNode(node.Body),
+	}
+}
+
+func toProtoCompositeLitNode(node *CompositeLitNode) *astpb.CompositeLitNode {
+	if node == nil {
+		return nil
+	}
+	return &astpb.CompositeLitNode{
+		RefId:      int32(node.RefId),
+		Type:       toProtoExpr(node.Type),
+		Lbrace:     toProtoPositionNode(node.Lbrace),
+		Elts:       convertSlice(node.Elts, toProtoExpr),
+		Rbrace:     toProtoPositionNode(node.Rbrace),
+		Incomplete: node.Incomplete,
+	}
+}
+
+func toProtoParenExprNode(node *ParenExprNode) *astpb.ParenExprNode {
+	if node == nil {
+		return nil
+	}
+	return &astpb.ParenExprNode{
+		RefId:  int32(node.RefId),
+		Lparen: toProtoPositionNode(node.Lparen),
+		X:      toProtoExpr(node.X),
+		Rparen: toProtoPositionNode(node.Rparen),
+	}
+}
+
+func toProtoSelectorExprNode(node *SelectorExprNode) *astpb.SelectorExprNode {
+	if node == nil {
+		return nil
+	}
+	return &astpb.SelectorExprNode{
+		RefId: int32(node.RefId),
+		X:     toProtoExpr(node.X),
+		Sel:   toProtoIdentNode(node.Sel),
+	}
+}
+
+func toProtoIndexExprNode(node *IndexExprNode) *astpb.IndexExprNode {
+	if node == nil {
+		return nil
+	}
+	return &astpb.IndexExprNode{
+		RefId:  int32(node.RefId),
+		X:      toProtoExpr(node.X),
+		Lbrack: toProtoPositionNode(node.Lbrack),
+		Index:  toProtoExpr(node.Index),
+		Rbrack: toProtoPositionNode(node.Rbrack),
+	}
+}
+
+func toProtoIndexListExprNode(node *IndexListExprNode) *astpb.IndexListExprNode {
+	if node == nil {
+		return nil
+	}
+	return &astpb.IndexListExprNode{
+		RefId:   int32(node.RefId),
+		X:       toProtoExpr(node.X),
+		Lbrack:  toProtoPositionNode(node.Lbrack),
+		Indices: convertSlice(node.Indices, toProtoExpr),
+		Rbrack:  toProtoPositionNode(node.Rbrack),
+	}
+}
+
+func toProtoSliceExprNode(node *SliceExprNode) *astpb.SliceExprNode {
+	if node == nil {
+		return nil
+	}
+	return &astpb.SliceExprNode{
+		RefId:  int32(node.RefId),
+		X:      toProtoExpr(node.X),
+		Lbrack: toProtoPositionNode(node.Lbrack),
+		Low:    toProtoExpr(node.Low),
+		High:   toProtoExpr(node.High),
+		Max:    toProtoExpr(node.Max),
+		Slice3: node.Slice3,
+		Rbrack: toProtoPositionNode(node.Rbrack),
+	}
+}
+
+func toProtoTypeAssertExprNode(node *TypeAssertExprNode) *astpb.TypeAssertExprNode {
+	if node == nil {
+		return nil
+	}
+	return &astpb.TypeAssertExprNode{
+		RefId:  int32(node.RefId),
+		X:      toProtoExpr(node.X),
+		Lparen: toProtoPositionNode(node.Lparen),
+		Type:   toProtoExpr(node.Type),
+		Rparen: toProtoPositionNode(node.Rparen),
+	}
+}
+
+func toProtoCallExprNode(node *CallExprNode) *astpb.CallExprNode {
+	if node == nil {
+		return nil
+	}
+	return &astpb.CallExprNode{
+		RefId:    int32(node.RefId),
+		Fun:      toProtoExpr(node.Fun),
+		Lparen:   toProtoPositionNode(node.Lparen),
+		Args:     convertSlice(node.Args, toProtoExpr),
+		Ellipsis: toProtoPositionNode(node.Ellipsis),
+		Rparen:   toProtoPositionNode(node.Rparen),
+	}
+}
+
+func toProtoStarExprNode(node *StarExprNode) *astpb.StarExprNode {
+	if node == nil {
+		return nil
+	}
+	return &astpb.StarExprNode{
+		RefId: int32(node.RefId),
+		Star:  toProtoPositionNode(node.Star),
+		X:     toProtoExpr(node.X),
+	}
+}
+
+func toProtoUnaryExprNode(node *UnaryExprNode) *astpb.UnaryExprNode {
+	if node == nil {
+		return nil
+	}
+	return &astpb.UnaryExprNode{
+		RefId: int32(node.RefId),
+		OpPos: toProtoPositionNode(node.OpPos),
+		Op:    node.Op,
+		X:     toProtoExpr(node.X),
+	}
+}
+
+func toProtoBinaryExprNode(node *BinaryExprNode) *astpb.BinaryExprNode {
+	if node == nil {
+		return nil
+	}
+	return &astpb.BinaryExprNode{
+		RefId: int32(node.RefId),
+		X:     toProtoExpr(node.X),
+		OpPos: toProtoPositionNode(node.OpPos),
+		Op:    node.Op,
+		Y:     toProtoExpr(node.Y),
+	}
+}
+
+func toProtoKeyValueExprNode(node *KeyValueExprNode) *astpb.KeyValueExprNode {
+	if node == nil {
+		return nil
+	}
+	return &astpb.KeyValueExprNode{
+		RefId: int32(node.RefId),
+		Key:   toProtoExpr(node.Key),
+		Colon: toProtoPositionNode(node.Colon),
+		Value: toProtoExpr(node.Value),
+	}
+}
+
+func toProtoArrayTypeNode(node *ArrayTypeNode) *astpb.ArrayTypeNode {
+	if node == nil {
+		return nil
+	}
+	return &astpb.ArrayTypeNode{
+		RefId:  int32(node.RefId),
+		Lbrack: toProtoPositionNode(node.Lbrack),
+		Len:    toProtoExpr(node.Len),
+		Elt:    toProtoExpr(node.Elt),
+	}
+}
+
+func toProtoStructTypeNode(node *StructTypeNode) *astpb.StructTypeNode {
+	if node == nil {
+		return nil
+	}
+	return &astpb.StructTypeNode{
+		RefId:      int32(node.RefId),
+		Struct:     toProtoPositionNode(node.Struct),
+		Fields:     toProtoFieldListNode(node.Fields),
+		Incomplete: node.Incomplete,
+	}
+}
+
+func toProtoFuncTypeNode(node *FuncTypeNode) *astpb.FuncTypeNode {
+	if node == nil {
+		return nil
+	}
+	return &astpb.FuncTypeNode{
+		RefId:      int32(node.RefId),
+		Func:       toProtoPositionNode(node.Func),
+		TypeParams: toProtoFieldListNode(node.TypeParams),
+		Params:     toProtoFieldListNode(node.Params),
+		Results:    toProtoFieldListNode(node.Results),
+	}
+}
+
+func toProtoInterfaceTypeNode(node *InterfaceTypeNode) *astpb.InterfaceTypeNode {
+	if node == nil {
+		return nil
+	}
+	return &astpb.InterfaceTypeNode{
+		RefId:      int32(node.RefId),
+		Interface:  toProtoPositionNode(node.Interface),
+		Methods:    toProtoFieldListNode(node.Methods),
+		Incomplete: node.Incomplete,
+	}
+}
+
+func toProtoMapTypeNode(node *MapTypeNode) *astpb.MapTypeNode {
+	if node == nil {
+		return nil
+	}
+	return &astpb.MapTypeNode{
+		RefId: int32(node.RefId),
+		Map:   toProtoPositionNode(node.Map),
+		Key:   toProtoExpr(node.Key),
+		Value: toProtoExpr(node.Value),
+	}
+}
+
+func toProtoChanTypeNode(node *ChanTypeNode) *astpb.ChanTypeNode {
+	if node == nil {
+		return nil
+	}
+	return &astpb.ChanTypeNode{
+		RefId: int32(node.RefId),
+		Begin: toProtoPositionNode(node.Begin),
+		Arrow: toProtoPositionNode(node.Arrow),
+		Dir:   node.Dir,
+		Value: toProtoExpr(node.Value),
+	}
+}
+
+func toProtoBadStmtNode(node *BadStmtNode) *astpb.BadStmtNode {
+	if node == nil {
+		return nil
+	}
+	return &astpb.BadStmtNode{
+		RefId: int32(node.RefId),
+		From:  toProtoPositionNode(node.From),
+		To:    toProtoPositionNode(node.To),
+	}
+}
+
+func toProtoDeclStmtNode(node *DeclStmtNode) *astpb.DeclStmtNode {
+	if node == nil {
+		return nil
+	}
+	return &astpb.DeclStmtNode{
+		RefId: int32(node.RefId),
+		Decl:  toProtoDecl(node.Decl),
+	}
+}
+
+func toProtoEmptyStmtNode(node *EmptyStmtNode) *astpb.EmptyStmtNode {
+	if node == nil {
+		return nil
+	}
+	return &astpb.EmptyStmtNode{
+		RefId:     int32(node.RefId),
+		Semicolon: toProtoPositionNode(node.Semicolon),
+		Implicit:  node.Implicit,
+	}
+}
+
+func toProtoLabeledStmtNode(node *LabeledStmtNode) *astpb.LabeledStmtNode {
+	if node == nil {
+		return nil
+	}
+	return &astpb.LabeledStmtNode{
+		RefId: int32(node.RefId),
+		Label: toProtoIdentNode(node.Label),
+		Colon: toProtoPositionNode(node.Colon),
+		Stmt:  toProtoStmt(node.Stmt),
+	}
+}
+
+func toProtoExprStmtNode(node *ExprStmtNode) *astpb.ExprStmtNode {
+	if node == nil {
+		return nil
+	}
+	return &astpb.ExprStmtNode{
+		RefId: int32(node.RefId),
+		X:     toProtoExpr(node.X),
+	}
+}
+
+func toProtoSendStmtNode(node *SendStmtNode) *astpb.SendStmtNode {
+	if node == nil {
+		return nil
+	}
+	return &astpb.SendStmtNode{
+		RefId: int32(node.RefId),
+		Chan:  toProtoExpr(node.Chan),
+		Arrow: toProtoPositionNode(node.Arrow),
+		Value: toProtoExpr(node.Value),
+	}
+}
+
+func toProtoIncDecStmtNode(node *IncDecStmtNode) *astpb.IncDecStmtNode {
+	if node == nil {
+		return nil
+	}
+	return &astpb.IncDecStmtNode{
+		RefId:  int32(node.RefId),
+		X:      toProtoExpr(node.X),
+		TokPos: toProtoPositionNode(node.TokPos),
+		Tok:    node.Tok,
+	}
+}
+
+func toProtoAssignStmtNode(node *AssignStmtNode) *astpb.AssignStmtNode {
+	if node == nil {
+		return nil
+	}
+	return &astpb.AssignStmtNode{
+		RefId:  int32(node.RefId),
+		Lhs:    convertSlice(node.Lhs, toProtoExpr),
+		TokPos: toProtoPositionNode(node.TokPos),
+		Tok:    node.Tok,
+		Rhs:    convertSlice(node.Rhs, toProtoExpr),
+	}
+}
+
+func toProtoGoStmtNode(node *GoStmtNode) *astpb.GoStmtNode {
+	if node == nil {
+		return nil
+	}
+	return &astpb.GoStmtNode{
+		RefId: int32(node.RefId),
+		Go:    toProtoPositionNode(node.Go),
+		Call:  toProtoCallExprNode(node.Call),
+	}
+}
+
+func toProtoDeferStmtNode(node *DeferStmtNode) *astpb.DeferStmtNode {
+	if node == nil {
+		return nil
+	}
+	return &astpb.DeferStmtNode{
+		RefId: int32(node.RefId),
+		Defer: toProtoPositionNode(node.Defer),
+		Call:  toProtoCallExprNode(node.Call),
+	}
+}
+
+func toProtoReturnStmtNode(node *ReturnStmtNode) *astpb.ReturnStmtNode {
+	if node == nil {
+		return nil
+	}
+	return &astpb.ReturnStmtNode{
+		RefId:   int32(node.RefId),
+		Return:  toProtoPositionNode(node.Return),
+		Results: convertSlice(node.Results, toProtoExpr),
+	}
+}
+
+func toProtoBranchStmtNode(node *BranchStmtNode) *astpb.BranchStmtNode {
+	if node == nil {
+		return nil
+	}
+	return &astpb.BranchStmtNode{
+		RefId:  int32(node.RefId),
+		TokPos: toProtoPositionNode(node.TokPos),
+		Tok:    node.Tok,
+		Label:  toProtoIdentNode(node.Label),
+	}
+}
+
+func toProtoBlockStmtNode(node *BlockStmtNode) *astpb.BlockStmtNode {
+	if node == nil {
+		return nil
+	}
+	return &astpb.BlockStmtNode{
+		RefId:  int32(node.RefId),
+		Lbrace: toProtoPositionNode(node.Lbrace),
+		List:   convertSlice(node.List, toProtoStmt),
+		Rbrace: toProtoPositionNode(node.Rbrace),
+	}
+}
+
+func toProtoIfStmtNode(node *IfStmtNode) *astpb.IfStmtNode {
+	if node == nil {
+		return nil
+	}
+	return &astpb.IfStmtNode{
+		RefId: int32(node.RefId),
+		If:    toProtoPositionNode(node.If),
+		Init:  toProtoStmt(node.Init),
+		Cond:  toProtoExpr(node.Cond),
+		Body:  toProtoBlockStmtNode(node.Body),
+		Else:  toProtoStmt(node.Else),
+	}
+}
+
+func toProtoCaseClauseNode(node *CaseClauseNode) *astpb.CaseClauseNode {
+	if node == nil {
+		return nil
+	}
+	return &astpb.CaseClauseNode{
+		RefId: int32(node.RefId),
+		Case:  toProtoPositionNode(node.Case),
+		List:  convertSlice(node.List, toProtoExpr),
+		Colon: toProtoPositionNode(node.Colon),
+		Body:  convertSlice(node.Body, toProtoStmt),
+	}
+}
+
+func toProtoSwitchStmtNode(node *SwitchStmtNode) *astpb.SwitchStmtNode {
+	if node == nil {
+		return nil
+	}
+	return &astpb.SwitchStmtNode{
+		RefId:  int32(node.RefId),
+		Switch: toProtoPositionNode(node.Switch),
+		Init:   toProtoStmt(node.Init),
+		Tag:    toProtoExpr(node.Tag),
+		Body:   toProtoBlockStmtNode(node.Body),
+	}
+}
+
+func toProtoTypeSwitchStmtNode(node *TypeSwitchStmtNode) *astpb.TypeSwitchStmtNode {
+	if node == nil {
+		return nil
+	}
+	return &astpb.TypeSwitchStmtNode{
+		RefId:  int32(node.RefId),
+		Switch: toProtoPositionNode(node.Switch),
+		Init:   toProtoStmt(node.Init),
+		Assign: toProtoStmt(node.Assign),
+		Body:   toProtoBlockStmtNode(node.Body),
+	}
+}
+
+func toProtoCommClauseNode(node *CommClauseNode) *astpb.CommClauseNode {
+	if node == nil {
+		return nil
+	}
+	return &astpb.CommClauseNode{
+		RefId: int32(node.RefId),
+		Case:  toProtoPositionNode(node.Case),
+		Comm:  toProtoStmt(node.Comm),
+		Colon: toProtoPositionNode(node.Colon),
+		Body:  convertSlice(node.Body, toProtoStmt),
+	}
+}
+
+func toProtoSelectStmtNode(node *SelectStmtNode) *astpb.SelectStmtNode {
+	if node == nil {
+		return nil
+	}
+	return &astpb.SelectStmtNode{
+		RefId:  int32(node.RefId),
+		Select: toProtoPositionNode(node.Select),
+		Body:   toProtoBlockStmtNode(node.Body),
+	}
+}
+
+func toProtoForStmtNode(node *ForStmtNode) *astpb.ForStmtNode {
+	if node == nil {
+		return nil
+	}
+	return &astpb.ForStmtNode{
+		RefId: int32(node.RefId),
+		For:   toProtoPositionNode(node.For),
+		Init:  toProtoStmt(node.Init),
+		Cond:  toProtoExpr(node.Cond),
+		Post:  toProtoStmt(node.Post),
+		Body:  toProtoBlockStmtNode(node.Body),
+	}
+}
+
+func toProtoRangeStmtNode(node *RangeStmtNode) *astpb.RangeStmtNode {
+	if node == nil {
+		return nil
+	}
+	return &astpb.RangeStmtNode{
+		RefId:  int32(node.RefId),
+		For:    toProtoPositionNode(node.For),
+		Key:    toProtoExpr(node.Key),
+		Value:  toProtoExpr(node.Value),
+		TokPos: toProtoPositionNode(node.TokPos),
+		Tok:    node.Tok,
+		X:      toProtoExpr(node.X),
+		Body:   toProtoBlockStmtNode(node.Body),
+	}
+}
+
+func toProtoImportSpecNode(node *ImportSpecNode) *astpb.ImportSpecNode {
+	if node == nil {
+		return nil
+	}
+	return &astpb.ImportSpecNode{
+		RefId:   int32(node.RefId),
+		Doc:     toProtoCommentGroupNode(node.Doc),
+		Name:    toProtoIdentNode(node.Name),
+		Path:    toProtoBasicLitNode(node.Path),
+		Comment: toProtoCommentGroupNode(node.Comment),
+		EndPos:  toProtoPositionNode(node.EndPos),
+	}
+}
+
+func toProtoValueSpecNode(node *ValueSpecNode) *astpb.ValueSpecNode {
+	if node == nil {
+		return nil
+	}
+	return &astpb.ValueSpecNode{
+		RefId:   int32(node.RefId),
+		Doc:     toProtoCommentGroupNode(node.Doc),
+		Names:   convertSlice(node.Names, toProtoIdentNode),
+		Type:    toProtoExpr(node.Type),
+		Values:  convertSlice(node.Values, toProtoExpr),
+		Comment: toProtoCommentGroupNode(node.Comment),
+	}
+}
+
+func toProtoTypeSpecNode(node *TypeSpecNode) *astpb.TypeSpecNode {
+	if node == nil {
+		return nil
+	}
+	return &astpb.TypeSpecNode{
+		RefId:      int32(node.RefId),
+		Doc:        toProtoCommentGroupNode(node.Doc),
+		Name:       toProtoIdentNode(node.Name),
+		TypeParams: toProtoFieldListNode(node.TypeParams),
+		Assign:     toProtoPositionNode(node.Assign),
+		Type:       toProtoExpr(node.Type),
+		Comment:    toProtoCommentGroupNode(node.Comment),
+	}
+}
+
+func toProtoBadDeclNode(node *BadDeclNode) *astpb.BadDeclNode {
+	if node == nil {
+		return nil
+	}
+	return &astpb.BadDeclNode{
+		RefId: int32(node.RefId),
+		From:  toProtoPositionNode(node.From),
+		To:    toProtoPositionNode(node.To),
+	}
+}
+
+func toProtoGenDeclNode(node *GenDeclNode) *astpb.GenDeclNode {
+	if node == nil {
+		return nil
+	}
+	return &astpb.GenDeclNode{
+		RefId:  int32(node.RefId),
+		Doc:    toProtoCommentGroupNode(node.Doc),
+		TokPos: toProtoPositionNode(node.TokPos),
+		Tok:    node.Tok,
+		Lparen: toProtoPositionNode(node.Lparen),
+		Specs:  convertSlice(node.Specs, toProtoSpec),
+		Rparen: toProtoPositionNode(node.Rparen),
+	}
+}
+
+func toProtoFuncDeclNode(node *FuncDeclNode) *astpb.FuncDeclNode {
+	if node == nil {
+		return nil
+	}
+	return &astpb.FuncDeclNode{
+		RefId: int32(node.RefId),
+		Doc:   toProtoCommentGroupNode(node.Doc),
+		Recv:  toProtoFieldListNode(node.Recv),
+		Name:  toProtoIdentNode(node.Name),
+		Type:  toProtoFuncTypeNode(node.Type),
+		Body:  toProtoBlockStmtNode(node.Body),
+	}
+}
+
+func toProtoFileMetaNode(node *FileMetaNode) *astpb.FileMetaNode {
+	if node == nil {
+		return nil
+	}
+	return &astpb.FileMetaNode{
+		Path:       node.Path,
+		Category:   node.Category,
+		Constraint: node.Constraint,
+	}
+}
+
+func toProtoExpr(node IExprNode) *astpb.Expr {
+	switch node := node.(type) {
+	case *BadExprNode:
+		if node != nil {
+			return &astpb.Expr{Node: &astpb.Expr_BadExpr{BadExpr: toProtoBadExprNode(node)}}
+		}
+	case *IdentNode:
+		if node != nil {
+			return &astpb.Expr{Node: &astpb.Expr_Ident{Ident: toProtoIdentNode(node)}}
+		}
+	case *EllipsisNode:
+		if node != nil {
+			return &astpb.Expr{Node: &astpb.Expr_Ellipsis{Ellipsis: toProtoEllipsisNode(node)}}
+		}
+	case *BasicLitNode:
+		if node != nil {
+			return &astpb.Expr{Node: &astpb.Expr_BasicLit{BasicLit: toProtoBasicLitNode(node)}}
+		}
+	case *FuncLitNode:
+		if node != nil {
+			return &astpb.Expr{Node: &astpb.Expr_FuncLit{FuncLit: toProtoFuncLitNode(node)}}
+		}
+	case *CompositeLitNode:
+		if node != nil {
+			return &astpb.Expr{Node: &astpb.Expr_CompositeLit{CompositeLit: toProtoCompositeLitNode(node)}}
+		}
+	case *ParenExprNode:
+		if node != nil {
+			return &astpb.Expr{Node: &astpb.Expr_ParenExpr{ParenExpr: toProtoParenExprNode(node)}}
+		}
+	case *SelectorExprNode:
+		if node != nil {
+			return &astpb.Expr{Node: &astpb.Expr_SelectorExpr{SelectorExpr: toProtoSelectorExprNode(node)}}
+		}
+	case *IndexExprNode:
+		if node != nil {
+			return &astpb.Expr{Node: &astpb.Expr_IndexExpr{IndexExpr: toProtoIndexExprNode(node)}}
+		}
+	case *IndexListExprNode:
+		if node != nil {
+			return &astpb.Expr{Node: &astpb.Expr_IndexListExpr{IndexListExpr: toProtoIndexListExprNode(node)}}
+		}
+	case *SliceExprNode:
+		if node != nil {
+			return &astpb.Expr{Node: &astpb.Expr_SliceExpr{SliceExpr: toProtoSliceExprNode(node)}}
+		}
+	case *TypeAssertExprNode:
+		if node != nil {
+			return &astpb.Expr{Node: &astpb.Expr_TypeAssertExpr{TypeAssertExpr: toProtoTypeAssertExprNode(node)}}
+		}
+	case *CallExprNode:
+		if node != nil {
+			return &astpb.Expr{Node: &astpb.Expr_CallExpr{CallExpr: toProtoCallExprNode(node)}}
+		}
+	case *StarExprNode:
+		if node != nil {
+			return &astpb.Expr{Node: &astpb.Expr_StarExpr{StarExpr: toProtoStarExprNode(node)}}
+		}
+	case *UnaryExprNode:
+		if node != nil {
+			return &astpb.Expr{Node: &astpb.Expr_UnaryExpr{UnaryExpr: toProtoUnaryExprNode(node)}}
+		}
+	case *BinaryExprNode:
+		if node != nil {
+			return &astpb.Expr{Node: &astpb.Expr_BinaryExpr{BinaryExpr: toProtoBinaryExprNode(node)}}
+		}
+	case *KeyValueExprNode:
+		if node != nil {
+			return &astpb.Expr{Node: &astpb.Expr_KeyValueExpr{KeyValueExpr: toProtoKeyValueExprNode(node)}}
+		}
+	case *ArrayTypeNode:
+		if node != nil {
+			return &astpb.Expr{Node: &astpb.Expr_ArrayType{ArrayType: toProtoArrayTypeNode(node)}}
+		}
+	case *StructTypeNode:
+		if node != nil {
+			return &astpb.Expr{Node: &astpb.Expr_StructType{StructType: toProtoStructTypeNode(node)}}
+		}
+	case *FuncTypeNode:
+		if node != nil {
+			return &astpb.Expr{Node: &astpb.Expr_FuncType{FuncType: toProtoFuncTypeNode(node)}}
+		}
+	case *InterfaceTypeNode:
+		if node != nil {
+			return &astpb.Expr{Node: &astpb.Expr_InterfaceType{InterfaceType: toProtoInterfaceTypeNode(node)}}
+		}
+	case *MapTypeNode:
+		if node != nil {
+			return &astpb.Expr{Node: &astpb.Expr_MapType{MapType: toProtoMapTypeNode(node)}}
+		}
+	case *ChanTypeNode:
+		if node != nil {
+			return &astpb.Expr{Node: &astpb.Expr_ChanType{ChanType: toProtoChanTypeNode(node)}}
+		}
+	}
+	return nil
+}
+
+func toProtoStmt(node IStmtNode) *astpb.Stmt {
+	switch node := node.(type) {
+	case *BadStmtNode:
+		if node != nil {
+			return &astpb.Stmt{Node: &astpb.Stmt_BadStmt{BadStmt: toProtoBadStmtNode(node)}}
+		}
+	case *DeclStmtNode:
+		if node != nil {
+			return &astpb.Stmt{Node: &astpb.Stmt_DeclStmt{DeclStmt: toProtoDeclStmtNode(node)}}
+		}
+	case *EmptyStmtNode:
+		if node != nil {
+			return &astpb.Stmt{Node: &astpb.Stmt_EmptyStmt{EmptyStmt: toProtoEmptyStmtNode(node)}}
+		}
+	case *LabeledStmtNode:
+		if node != nil {
+			return &astpb.Stmt{Node: &astpb.Stmt_LabeledStmt{LabeledStmt: toProtoLabeledStmtNode(node)}}
+		}
+	case *ExprStmtNode:
+		if node != nil {
+			return &astpb.Stmt{Node: &astpb.Stmt_ExprStmt{ExprStmt: toProtoExprStmtNode(node)}}
+		}
+	case *SendStmtNode:
+		if node != nil {
+			return &astpb.Stmt{Node: &astpb.Stmt_SendStmt{SendStmt: toProtoSendStmtNode(node)}}
+		}
+	case *IncDecStmtNode:
+		if node != nil {
+			return &astpb.Stmt{Node: &astpb.Stmt_IncDecStmt{IncDecStmt: toProtoIncDecStmtNode(node)}}
+		}
+	case *AssignStmtNode:
+		if node != nil {
+			return &astpb.Stmt{Node: &astpb.Stmt_AssignStmt{AssignStmt: toProtoAssignStmtNode(node)}}
+		}
+	case *GoStmtNode:
+		if node != nil {
+			return &astpb.Stmt{Node: &astpb.Stmt_GoStmt{GoStmt: toProtoGoStmtNode(node)}}
+		}
+	case *DeferStmtNode:
+		if node != nil {
+			return &astpb.Stmt{Node: &astpb.Stmt_DeferStmt{DeferStmt: toProtoDeferStmtNode(node)}}
+		}
+	case *ReturnStmtNode:
+		if node != nil {
+			return &astpb.Stmt{Node: &astpb.Stmt_ReturnStmt{ReturnStmt: toProtoReturnStmtNode(node)}}
+		}
+	case *BranchStmtNode:
+		if node != nil {
+			return &astpb.Stmt{Node: &astpb.Stmt_BranchStmt{BranchStmt: toProtoBranchStmtNode(node)}}
+		}
+	case *BlockStmtNode:
+		if node != nil {
+			return &astpb.Stmt{Node: &astpb.Stmt_BlockStmt{BlockStmt: toProtoBlockStmtNode(node)}}
+		}
+	case *IfStmtNode:
+		if node != nil {
+			return &astpb.Stmt{Node: &astpb.Stmt_IfStmt{IfStmt: toProtoIfStmtNode(node)}}
+		}
+	case *CaseClauseNode:
+		if node != nil {
+			return &astpb.Stmt{Node: &astpb.Stmt_CaseClause{CaseClause: toProtoCaseClauseNode(node)}}
+		}
+	case *SwitchStmtNode:
+		if node != nil {
+			return &astpb.Stmt{Node: &astpb.Stmt_SwitchStmt{SwitchStmt: toProtoSwitchStmtNode(node)}}
+		}
+	case *TypeSwitchStmtNode:
+		if node != nil {
+			return &astpb.Stmt{Node: &astpb.Stmt_TypeSwitchStmt{TypeSwitchStmt: toProtoTypeSwitchStmtNode(node)}}
+		}
+	case *CommClauseNode:
+		if node != nil {
+			return &astpb.Stmt{Node: &astpb.Stmt_CommClause{CommClause: toProtoCommClauseNode(node)}}
+		}
+	case *SelectStmtNode:
+		if node != nil {
+			return &astpb.Stmt{Node: &astpb.Stmt_SelectStmt{SelectStmt: toProtoSelectStmtNode(node)}}
+		}
+	case *ForStmtNode:
+		if node != nil {
+			return &astpb.Stmt{Node: &astpb.Stmt_ForStmt{ForStmt: toProtoForStmtNode(node)}}
+		}
+	case *RangeStmtNode:
+		if node != nil {
+			return &astpb.Stmt{Node: &astpb.Stmt_RangeStmt{RangeStmt: toProtoRangeStmtNode(node)}}
+		}
+	}
+	return nil
+}
+
+func toProtoSpec(node ISpecNode) *astpb.Spec {
+	switch node := node.(type) {
+	case *ImportSpecNode:
+		if node != nil {
+			return &astpb.Spec{Node: &astpb.Spec_ImportSpec{ImportSpec: toProtoImportSpecNode(node)}}
+		}
+	case *ValueSpecNode:
+		if node != nil {
+			return &astpb.Spec{Node: &astpb.Spec_ValueSpec{ValueSpec: toProtoValueSpecNode(node)}}
+		}
+	case *TypeSpecNode:
+		if node != nil {
+			return &astpb.Spec{Node: &astpb.Spec_TypeSpec{TypeSpec: toProtoTypeSpecNode(node)}}
+		}
+	}
+	return nil
+}
+
+func toProtoDecl(node IDeclNode) *astpb.Decl {
+	switch node := node.(type) {
+	case *BadDeclNode:
+		if node != nil {
+			return &astpb.Decl{Node: &astpb.Decl_BadDecl{BadDecl: toProtoBadDeclNode(node)}}
+		}
+	case *GenDeclNode:
+		if node != nil {
+			return &astpb.Decl{Node: &astpb.Decl_GenDecl{GenDecl: toProtoGenDeclNode(node)}}
+		}
+	case *FuncDeclNode:
+		if node != nil {
+			return &astpb.Decl{Node: &astpb.Decl_FuncDecl{FuncDecl: toProtoFuncDeclNode(node)}}
+		}
+	}
+	return nil
+}
+
+func fromProtoPositionNode(node *astpb.PositionNode) *PositionNode {
+	if node == nil {
+		return nil
+	}
+	return &PositionNode{
+		Node:     protoNode("Position", node.RefId),
+		Filename: node.Filename,
+		Offset:   int(node.Offset),
+		Line:     int(node.Line),
+		Column:   int(node.Column),
+	}
+}
+
+func fromProtoCommentNode(node *astpb.CommentNode) *CommentNode {
+	if node == nil {
+		return nil
+	}
+	return &CommentNode{
+		Node:  protoNode("Comment", node.RefId),
+		Slash: fromProtoPositionNode(node.Slash),
+		Text:  node.Text,
+	}
+}
+
+func fromProtoCommentGroupNode(node *astpb.CommentGroupNode) *CommentGroupNode {
+	if node == nil {
+		return nil
+	}
+	return &CommentGroupNode{
+		Node: protoNode("CommentGroup", node.RefId),
+		List: convertSlice(node.List, fromProtoCommentNode),
+	}
+}
+
+func fromProtoFieldNode(node *astpb.FieldNode) *FieldNode {
+	if node == nil {
+		return nil
+	}
+	return &FieldNode{
+		Node:    protoNode("Field", node.RefId),
+		Doc:     fromProtoCommentGroupNode(node.Doc),
+		Names:   convertSlice(node.Names, fromProtoIdentNode),
+		Type:    fromProtoExpr(node.Type),
+		Tag:     fromProtoBasicLitNode(node.Tag),
+		Comment: fromProtoCommentGroupNode(node.Comment),
+	}
+}
+
+func fromProtoFieldListNode(node *astpb.FieldListNode) *FieldListNode {
+	if node == nil {
+		return nil
+	}
+	return &FieldListNode{
+		Node:    protoNode("FieldList", node.RefId),
+		Opening: fromProtoPositionNode(node.Opening),
+		List:    convertSlice(node.List, fromProtoFieldNode),
+		Closing: fromProtoPositionNode(node.Closing),
+	}
+}
+
+func fromProtoBadExprNode(node *astpb.BadExprNode) *BadExprNode {
+	if node == nil {
+		return nil
+	}
+	return &BadExprNode{
+		Node: protoNode("BadExpr", node.RefId),
+		From: fromProtoPositionNode(node.From),
+		To:   fromProtoPositionNode(node.To),
+	}
+}
+
+func fromProtoIdentNode(node *astpb.IdentNode) *IdentNode {
+	if node == nil {
+		return nil
+	}
+	return &IdentNode{
+		Node:    protoNode("Ident", node.RefId),
+		NamePos: fromProtoPositionNode(node.NamePos),
+		Name:    node.Name,
+	}
+}
+
+func fromProtoEllipsisNode(node *astpb.EllipsisNode) *EllipsisNode {
+	if node == nil {
+		return nil
+	}
+	return &EllipsisNode{
+		Node:     protoNode("Ellipsis", node.RefId),
+		Ellipsis: fromProtoPositionNode(node.Ellipsis),
+		Elt:      fromProtoExpr(node.Elt),
+	}
+}
+
+func fromProtoBasicLitNode(node *astpb.BasicLitNode) *BasicLitNode {
+	if node == nil {
+		return nil
+	}
+	return &BasicLitNode{
+		Node:     protoNode("BasicLit", node.RefId),
+		ValuePos: fromProtoPositionNode(node.ValuePos),
+		Kind:     node.Kind,
+		Value:    node.Value,
+	}
+}
+
+func fromProtoFuncLitNode(node *astpb.FuncLitNode) *FuncLitNode {
+	if node == nil {
+		return nil
+	}
+	return &FuncLitNode{
+		Node: protoNode("FuncLit", node.RefId),
+		Type: fromProtoFuncTypeNode(node.Type),
+		Body: fromProtoBlockStmtNode(node.Body),
+	}
+}
+
+func fromProtoCompositeLitNode(node *astpb.CompositeLitNode) *CompositeLitNode {
+	if node == nil {
+		return nil
+	}
+	return &CompositeLitNode{
+		Node:       protoNode("CompositeLit", node.RefId),
+		Type:       fromProtoExpr(node.Type),
+		Lbrace:     fromProtoPositionNode(node.Lbrace),
+		Elts:       convertSlice(node.Elts, fromProtoExpr),
+		Rbrace:     fromProtoPositionNode(node.Rbrace),
+		Incomplete: node.Incomplete,
+	}
+}
+
+func fromProtoParenExprNode(node *astpb.ParenExprNode) *ParenExprNode {
+	if node == nil {
+		return nil
+	}
+	return &ParenExprNode{
+		Node:   protoNode("ParenExpr", node.RefId),
+		Lparen: fromProtoPositionNode(node.Lparen),
+		X:      fromProtoExpr(node.X),
+		Rparen: fromProtoPositionNode(node.Rparen),
+	}
+}
+
+func fromProtoSelectorExprNode(node *astpb.SelectorExprNode) *SelectorExprNode {
+	if node == nil {
+		return nil
+	}
+	return &SelectorExprNode{
+		Node: protoNode("SelectorExpr", node.RefId),
+		X:    fromProtoExpr(node.X),
+		Sel:  fromProtoIdentNode(node.Sel),
+	}
+}
+
+func fromProtoIndexExprNode(node *astpb.IndexExprNode) *IndexExprNode {
+	if node == nil {
+		return nil
+	}
+	return &IndexExprNode{
+		Node:   protoNode("IndexExpr", node.RefId),
+		X:      fromProtoExpr(node.X),
+		Lbrack: fromProtoPositionNode(node.Lbrack),
+		Index:  fromProtoExpr(node.Index),
+		Rbrack: fromProtoPositionNode(node.Rbrack),
+	}
+}
+
+func fromProtoIndexListExprNode(node *astpb.IndexListExprNode) *IndexListExprNode {
+	if node == nil {
+		return nil
+	}
+	return &IndexListExprNode{
+		Node:    protoNode("IndexListExpr", node.RefId),
+		X:       fromProtoExpr(node.X),
+		Lbrack:  fromProtoPositionNode(node.Lbrack),
+		Indices: convertSlice(node.Indices, fromProtoExpr),
+		Rbrack:  fromProtoPositionNode(node.Rbrack),
+	}
+}
+
+func fromProtoSliceExprNode(node *astpb.SliceExprNode) *SliceExprNode {
+	if node == nil {
+		return nil
+	}
+	return &SliceExprNode{
+		Node:   protoNode("SliceExpr", node.RefId),
+		X:      fromProtoExpr(node.X),
+		Lbrack: fromProtoPositionNode(node.Lbrack),
+		Low:    fromProtoExpr(node.Low),
+		High:   fromProtoExpr(node.High),
+		Max:    fromProtoExpr(node.Max),
+		Slice3: node.Slice3,
+		Rbrack: fromProtoPositionNode(node.Rbrack),
+	}
+}
+
+func fromProtoTypeAssertExprNode(node *astpb.TypeAssertExprNode) *TypeAssertExprNode {
+	if node == nil {
+		return nil
+	}
+	return &TypeAssertExprNode{
+		Node:   protoNode("TypeAssertExpr", node.RefId),
+		X:      fromProtoExpr(node.X),
+		Lparen: fromProtoPositionNode(node.Lparen),
+		Type:   fromProtoExpr(node.Type),
+		Rparen: fromProtoPositionNode(node.Rparen),
+	}
+}
+
+func fromProtoCallExprNode(node *astpb.CallExprNode) *CallExprNode {
+	if node == nil {
+		return nil
+	}
+	return &CallExprNode{
+		Node:     protoNode("CallExpr", node.RefId),
+		Fun:      fromProtoExpr(node.Fun),
+		Lparen:   fromProtoPositionNode(node.Lparen),
+		Args:     convertSlice(node.Args, fromProtoExpr),
+		Ellipsis: fromProtoPositionNode(node.Ellipsis),
+		Rparen:   fromProtoPositionNode(node.Rparen),
+	}
+}
+
+func fromProtoStarExprNode(node *astpb.StarExprNode) *StarExprNode {
+	if node == nil {
+		return nil
+	}
+	return &StarExprNode{
+		Node: protoNode("StarExpr", node.RefId),
+		Star: fromProtoPositionNode(node.Star),
+		X:    fromProtoExpr(node.X),
+	}
+}
+
+func fromProtoUnaryExprNode(node *astpb.UnaryExprNode) *UnaryExprNode {
+	if node == nil {
+		return nil
+	}
+	return &UnaryExprNode{
+		Node:  protoNode("UnaryExpr", node.RefId),
+		OpPos: fromProtoPositionNode(node.OpPos),
+		Op:    node.Op,
+		X:     fromProtoExpr(node.X),
+	}
+}
+
+func fromProtoBinaryExprNode(node *astpb.BinaryExprNode) *BinaryExprNode {
+	if node == nil {
+		return nil
+	}
+	return &BinaryExprNode{
+		Node:  protoNode("BinaryExpr", node.RefId),
+		X:     fromProtoExpr(node.X),
+		OpPos: fromProtoPositionNode(node.OpPos),
+		Op:    node.Op,
+		Y:     fromProtoExpr(node.Y),
+	}
+}
+
+func fromProtoKeyValueExprNode(node *astpb.KeyValueExprNode) *KeyValueExprNode {
+	if node == nil {
+		return nil
+	}
+	return &KeyValueExprNode{
+		Node:  protoNode("KeyValueExpr", node.RefId),
+		Key:   fromProtoExpr(node.Key),
+		Colon: fromProtoPositionNode(node.Colon),
+		Value: fromProtoExpr(node.Value),
+	}
+}
+
+func fromProtoArrayTypeNode(node *astpb.ArrayTypeNode) *ArrayTypeNode {
+	if node == nil {
+		return nil
+	}
+	return &ArrayTypeNode{
+		Node:   protoNode("ArrayType", node.RefId),
+		Lbrack: fromProtoPositionNode(node.Lbrack),
+		Len:    fromProtoExpr(node.Len),
+		Elt:    fromProtoExpr(node.Elt),
+	}
+}
+
+func fromProtoStructTypeNode(node *astpb.StructTypeNode) *StructTypeNode {
+	if node == nil {
+		return nil
+	}
+	return &StructTypeNode{
+		Node:       protoNode("StructType", node.RefId),
+		Struct:     fromProtoPositionNode(node.Struct),
+		Fields:     fromProtoFieldListNode(node.Fields),
+		Incomplete: node.Incomplete,
+	}
+}
+
+func fromProtoFuncTypeNode(node *astpb.FuncTypeNode) *FuncTypeNode {
+	if node == nil {
+		return nil
+	}
+	return &FuncTypeNode{
+		Node:       protoNode("FuncType", node.RefId),
+		Func:       fromProtoPositionNode(node.Func),
+		TypeParams: fromProtoFieldListNode(node.TypeParams),
+		Params:     fromProtoFieldListNode(node.Params),
+		Results:    fromProtoFieldListNode(node.Results),
+	}
+}
+
+func fromProtoInterfaceTypeNode(node *astpb.InterfaceTypeNode) *InterfaceTypeNode {
+	if node == nil {
+		return nil
+	}
+	return &InterfaceTypeNode{
+		Node:       protoNode("InterfaceType", node.RefId),
+		Interface:  fromProtoPositionNode(node.Interface),
+		Methods:    fromProtoFieldListNode(node.Methods),
+		Incomplete: node.Incomplete,
+	}
+}
+
+func fromProtoMapTypeNode(node *astpb.MapTypeNode) *MapTypeNode {
+	if node == nil {
+		return nil
+	}
+	return &MapTypeNode{
+		Node:  protoNode("MapType", node.RefId),
+		Map:   fromProtoPositionNode(node.Map),
+		Key:   fromProtoExpr(node.Key),
+		Value: fromProtoExpr(node.Value),
+	}
+}
+
+func fromProtoChanTypeNode(node *astpb.ChanTypeNode) *ChanTypeNode {
+	if node == nil {
+		return nil
+	}
+	return &ChanTypeNode{
+		Node:  protoNode("ChanType", node.RefId),
+		Begin: fromProtoPositionNode(node.Begin),
+		Arrow: fromProtoPositionNode(node.Arrow),
+		Dir:   node.Dir,
+		Value: fromProtoExpr(node.Value),
+	}
+}
+
+func fromProtoBadStmtNode(node *astpb.BadStmtNode) *BadStmtNode {
+	if node == nil {
+		return nil
+	}
+	return &BadStmtNode{
+		Node: protoNode("BadStmt", node.RefId),
+		From: fromProtoPositionNode(node.From),
+		To:   fromProtoPositionNode(node.To),
+	}
+}
+
+func fromProtoDeclStmtNode(node *astpb.DeclStmtNode) *DeclStmtNode {
+	if node == nil {
+		return nil
+	}
+	return &DeclStmtNode{
+		Node: protoNode("DeclStmt", node.RefId),
+		Decl: fromProtoDecl(node.Decl),
+	}
+}
+
+func fromProtoEmptyStmtNode(node *astpb.EmptyStmtNode) *EmptyStmtNode {
+	if node == nil {
+		return nil
+	}
+	return &EmptyStmtNode{
+		Node:      protoNode("EmptyStmt", node.RefId),
+		Semicolon: fromProtoPositionNode(node.Semicolon),
+		Implicit:  node.Implicit,
+	}
+}
+
+func fromProtoLabeledStmtNode(node *astpb.LabeledStmtNode) *LabeledStmtNode {
+	if node == nil {
+		return nil
+	}
+	return &LabeledStmtNode{
+		Node:  protoNode("LabeledStmt", node.RefId),
+		Label: fromProtoIdentNode(node.Label),
+		Colon: fromProtoPositionNode(node.Colon),
+		Stmt:  fromProtoStmt(node.Stmt),
+	}
+}
+
+func fromProtoExprStmtNode(node *astpb.ExprStmtNode) *ExprStmtNode {
+	if node == nil {
+		return nil
+	}
+	return &ExprStmtNode{
+		Node: protoNode("ExprStmt", node.RefId),
+		X:    fromProtoExpr(node.X),
+	}
+}
+
+func fromProtoSendStmtNode(node *astpb.SendStmtNode) *SendStmtNode {
+	if node == nil {
+		return nil
+	}
+	return &SendStmtNode{
+		Node:  protoNode("SendStmt", node.RefId),
+		Chan:  fromProtoExpr(node.Chan),
+		Arrow: fromProtoPositionNode(node.Arrow),
+		Value: fromProtoExpr(node.Value),
+	}
+}
+
+func fromProtoIncDecStmtNode(node *astpb.IncDecStmtNode) *IncDecStmtNode {
+	if node == nil {
+		return nil
+	}
+	return &IncDecStmtNode{
+		Node:   protoNode("IncDecStmt", node.RefId),
+		X:      fromProtoExpr(node.X),
+		TokPos: fromProtoPositionNode(node.TokPos),
+		Tok:    node.Tok,
+	}
+}
+
+func fromProtoAssignStmtNode(node *astpb.AssignStmtNode) *AssignStmtNode {
+	if node == nil {
+		return nil
+	}
+	return &AssignStmtNode{
+		Node:   protoNode("AssignStmt", node.RefId),
+		Lhs:    convertSlice(node.Lhs, fromProtoExpr),
+		TokPos: fromProtoPositionNode(node.TokPos),
+		Tok:    node.Tok,
+		Rhs:    convertSlice(node.Rhs, fromProtoExpr),
+	}
+}
+
+func fromProtoGoStmtNode(node *astpb.GoStmtNode) *GoStmtNode {
+	if node == nil {
+		return nil
+	}
+	return &GoStmtNode{
+		Node: protoNode("GoStmt", node.RefId),
+		Go:   fromProtoPositionNode(node.Go),
+		Call: fromProtoCallExprNode(node.Call),
+	}
+}
+
+func fromProtoDeferStmtNode(node *astpb.DeferStmtNode) *DeferStmtNode {
+	if node == nil {
+		return nil
+	}
+	return &DeferStmtNode{
+		Node:  protoNode("DeferStmt", node.RefId),
+		Defer: fromProtoPositionNode(node.Defer),
+		Call:  fromProtoCallExprNode(node.Call),
+	}
+}
+
+func fromProtoReturnStmtNode(node *astpb.ReturnStmtNode) *ReturnStmtNode {
+	if node == nil {
+		return nil
+	}
+	return &ReturnStmtNode{
+		Node:    protoNode("ReturnStmt", node.RefId),
+		Return:  fromProtoPositionNode(node.Return),
+		Results: convertSlice(node.Results, fromProtoExpr),
+	}
+}
+
+func fromProtoBranchStmtNode(node *astpb.BranchStmtNode) *BranchStmtNode {
+	if node == nil {
+		return nil
+	}
+	return &BranchStmtNode{
+		Node:   protoNode("BranchStmt", node.RefId),
+		TokPos: fromProtoPositionNode(node.TokPos),
+		Tok:    node.Tok,
+		Label:  fromProtoIdentNode(node.Label),
+	}
+}
+
+func fromProtoBlockStmtNode(node *astpb.BlockStmtNode) *BlockStmtNode {
+	if node == nil {
+		return nil
+	}
+	return &BlockStmtNode{
+		Node:   protoNode("BlockStmt", node.RefId),
+		Lbrace: fromProtoPositionNode(node.Lbrace),
+		List:   convertSlice(node.List, fromProtoStmt),
+		Rbrace: fromProtoPositionNode(node.Rbrace),
+	}
+}
+
+func fromProtoIfStmtNode(node *astpb.IfStmtNode) *IfStmtNode {
+	if node == nil {
+		return nil
+	}
+	return &IfStmtNode{
+		Node: protoNode("IfStmt", node.RefId),
+		If:   fromProtoPositionNode(node.If),
+		Init: fromProtoStmt(node.Init),
+		Cond: fromProtoExpr(node.Cond),
+		Body: fromProtoBlockStmtNode(node.Body),
+		Else: fromProtoStmt(node.Else),
+	}
+}
+
+func fromProtoCaseClauseNode(node *astpb.CaseClauseNode) *CaseClauseNode {
+	if node == nil {
+		return nil
+	}
+	return &CaseClauseNode{
+		Node:  protoNode("CaseClause", node.RefId),
+		Case:  fromProtoPositionNode(node.Case),
+		List:  convertSlice(node.List, fromProtoExpr),
+		Colon: fromProtoPositionNode(node.Colon),
+		Body:  convertSlice(node.Body, fromProtoStmt),
+	}
+}
+
+func fromProtoSwitchStmtNode(node *astpb.SwitchStmtNode) *SwitchStmtNode {
+	if node == nil {
+		return nil
+	}
+	return &SwitchStmtNode{
+		Node:   protoNode("SwitchStmt", node.RefId),
+		Switch: fromProtoPositionNode(node.Switch),
+		Init:   fromProtoStmt(node.Init),
+		Tag:    fromProtoExpr(node.Tag),
+		Body:   fromProtoBlockStmtNode(node.Body),
+	}
+}
+
+func fromProtoTypeSwitchStmtNode(node *astpb.TypeSwitchStmtNode) *TypeSwitchStmtNode {
+	if node == nil {
+		return nil
+	}
+	return &TypeSwitchStmtNode{
+		Node:   protoNode("TypeSwitchStmt", node.RefId),
+		Switch: fromProtoPositionNode(node.Switch),
+		Init:   fromProtoStmt(node.Init),
+		Assign: fromProtoStmt(node.Assign),
+		Body:   fromProtoBlockStmtNode(node.Body),
+	}
+}
+
+func fromProtoCommClauseNode(node *astpb.CommClauseNode) *CommClauseNode {
+	if node == nil {
+		return nil
+	}
+	return &CommClauseNode{
+		Node:  protoNode("CommClause", node.RefId),
+		Case:  fromProtoPositionNode(node.Case),
+		Comm:  fromProtoStmt(node.Comm),
+		Colon: fromProtoPositionNode(node.Colon),
+		Body:  convertSlice(node.Body, fromProtoStmt),
+	}
+}
+
+func fromProtoSelectStmtNode(node *astpb.SelectStmtNode) *SelectStmtNode {
+	if node == nil {
+		return nil
+	}
+	return &SelectStmtNode{
+		Node:   protoNode("SelectStmt", node.RefId),
+		Select: fromProtoPositionNode(node.Select),
+		Body:   fromProtoBlockStmtNode(node.Body),
+	}
+}
+
+func fromProtoForStmtNode(node *astpb.ForStmtNode) *ForStmtNode {
+	if node == nil {
+		return nil
+	}
+	return &ForStmtNode{
+		Node: protoNode("ForStmt", node.RefId),
+		For:  fromProtoPositionNode(node.For),
+		Init: fromProtoStmt(node.Init),
+		Cond: fromProtoExpr(node.Cond),
+		Post: fromProtoStmt(node.Post),
+		Body: fromProtoBlockStmtNode(node.Body),
+	}
+}
+
+func fromProtoRangeStmtNode(node *astpb.RangeStmtNode) *RangeStmtNode {
+	if node == nil {
+		return nil
+	}
+	return &RangeStmtNode{
+		Node:   protoNode("RangeStmt", node.RefId),
+		For:    fromProtoPositionNode(node.For),
+		Key:    fromProtoExpr(node.Key),
+		Value:  fromProtoExpr(node.Value),
+		TokPos: fromProtoPositionNode(node.TokPos),
+		Tok:    node.Tok,
+		X:      fromProtoExpr(node.X),
+		Body:   fromProtoBlockStmtNode(node.Body),
+	}
+}
+
+func fromProtoImportSpecNode(node *astpb.ImportSpecNode) *ImportSpecNode {
+	if node == nil {
+		return nil
+	}
+	return &ImportSpecNode{
+		Node:    protoNode("ImportSpec", node.RefId),
+		Doc:     fromProtoCommentGroupNode(node.Doc),
+		Name:    fromProtoIdentNode(node.Name),
+		Path:    fromProtoBasicLitNode(node.Path),
+		Comment: fromProtoCommentGroupNode(node.Comment),
+		EndPos:  fromProtoPositionNode(node.EndPos),
+	}
+}
+
+func fromProtoValueSpecNode(node *astpb.ValueSpecNode) *ValueSpecNode {
+	if node == nil {
+		return nil
+	}
+	return &ValueSpecNode{
+		Node:    protoNode("ValueSpec", node.RefId),
+		Doc:     fromProtoCommentGroupNode(node.Doc),
+		Names:   convertSlice(node.Names, fromProtoIdentNode),
+		Type:    fromProtoExpr(node.Type),
+		Values:  convertSlice(node.Values, fromProtoExpr),
+		Comment: fromProtoCommentGroupNode(node.Comment),
+	}
+}
+
+func fromProtoTypeSpecNode(node *astpb.TypeSpecNode) *TypeSpecNode {
+	if node == nil {
+		return nil
+	}
+	return &TypeSpecNode{
+		Node:       protoNode("TypeSpec", node.RefId),
+		Doc:        fromProtoCommentGroupNode(node.Doc),
+		Name:       fromProtoIdentNode(node.Name),
+		TypeParams: fromProtoFieldListNode(node.TypeParams),
+		Assign:     fromProtoPositionNode(node.Assign),
+		Type:       fromProtoExpr(node.Type),
+		Comment:    fromProtoCommentGroupNode(node.Comment),
+	}
+}
+
+func fromProtoBadDeclNode(node *astpb.BadDeclNode) *BadDeclNode {
+	if node == nil {
+		return nil
+	}
+	return &BadDeclNode{
+		Node: protoNode("BadDecl", node.RefId),
+		From: fromProtoPositionNode(node.From),
+		To:   fromProtoPositionNode(node.To),
+	}
+}
+
+func fromProtoGenDeclNode(node *astpb.GenDeclNode) *GenDeclNode {
+	if node == nil {
+		return nil
+	}
+	return &GenDeclNode{
+		Node:   protoNode("GenDecl", node.RefId),
+		Doc:    fromProtoCommentGroupNode(node.Doc),
+		TokPos: fromProtoPositionNode(node.TokPos),
+		Tok:    node.Tok,
+		Lparen: fromProtoPositionNode(node.Lparen),
+		Specs:  convertSlice(node.Specs, fromProtoSpec),
+		Rparen: fromProtoPositionNode(node.Rparen),
+	}
+}
+
+func fromProtoFuncDeclNode(node *astpb.FuncDeclNode) *FuncDeclNode {
+	if node == nil {
+		return nil
+	}
+	return &FuncDeclNode{
+		Node: protoNode("FuncDecl", node.RefId),
+		Doc:  fromProtoCommentGroupNode(node.Doc),
+		Recv: fromProtoFieldListNode(node.Recv),
+		Name: fromProtoIdentNode(node.Name),
+		Type: fromProtoFuncTypeNode(node.Type),
+		Body: fromProtoBlockStmtNode(node.Body),
+	}
+}
+
+func fromProtoFileMetaNode(node *astpb.FileMetaNode) *FileMetaNode {
+	if node == nil {
+		return nil
+	}
+	return &FileMetaNode{
+		Path:       node.Path,
+		Category:   node.Category,
+		Constraint: node.Constraint,
+	}
+}
+
+func fromProtoExpr(node *astpb.Expr) IExprNode {
+	switch node := node.GetNode().(type) {
+	case *astpb.Expr_BadExpr:
+		return fromProtoBadExprNode(node.BadExpr)
+	case *astpb.Expr_Ident:
+		return fromProtoIdentNode(node.Ident)
+	case *astpb.Expr_Ellipsis:
+		return fromProtoEllipsisNode(node.Ellipsis)
+	case *astpb.Expr_BasicLit:
+		return fromProtoBasicLitNode(node.BasicLit)
+	case *astpb.Expr_FuncLit:
+		return fromProtoFuncLitNode(node.FuncLit)
+	case *astpb.Expr_CompositeLit:
+		return fromProtoCompositeLitNode(node.CompositeLit)
+	case *astpb.Expr_ParenExpr:
+		return fromProtoParenExprNode(node.ParenExpr)
+	case *astpb.Expr_SelectorExpr:
+		return fromProtoSelectorExprNode(node.SelectorExpr)
+	case *astpb.Expr_IndexExpr:
+		return fromProtoIndexExprNode(node.IndexExpr)
+	case *astpb.Expr_IndexListExpr:
+		return fromProtoIndexListExprNode(node.IndexListExpr)
+	case *astpb.Expr_SliceExpr:
+		return fromProtoSliceExprNode(node.SliceExpr)
+	case *astpb.Expr_TypeAssertExpr:
+		return fromProtoTypeAssertExprNode(node.TypeAssertExpr)
+	case *astpb.Expr_CallExpr:
+		return fromProtoCallExprNode(node.CallExpr)
+	case *astpb.Expr_StarExpr:
+		return fromProtoStarExprNode(node.StarExpr)
+	case *astpb.Expr_UnaryExpr:
+		return fromProtoUnaryExprNode(node.UnaryExpr)
+	case *astpb.Expr_BinaryExpr:
+		return fromProtoBinaryExprNode(node.BinaryExpr)
+	case *astpb.Expr_KeyValueExpr:
+		return fromProtoKeyValueExprNode(node.KeyValueExpr)
+	case *astpb.Expr_ArrayType:
+		return fromProtoArrayTypeNode(node.ArrayType)
+	case *astpb.Expr_StructType:
+		return fromProtoStructTypeNode(node.StructType)
+	case *astpb.Expr_FuncType:
+		return fromProtoFuncTypeNode(node.FuncType)
+	case *astpb.Expr_InterfaceType:
+		return fromProtoInterfaceTypeNode(node.InterfaceType)
+	case *astpb.Expr_MapType:
+		return fromProtoMapTypeNode(node.MapType)
+	case *astpb.Expr_ChanType:
+		return fromProtoChanTypeNode(node.ChanType)
+	}
+	return nil
+}
+
+func fromProtoStmt(node *astpb.Stmt) IStmtNode {
+	switch node := node.GetNode().(type) {
+	case *astpb.Stmt_BadStmt:
+		return fromProtoBadStmtNode(node.BadStmt)
+	case *astpb.Stmt_DeclStmt:
+		return fromProtoDeclStmtNode(node.DeclStmt)
+	case *astpb.Stmt_EmptyStmt:
+		return fromProtoEmptyStmtNode(node.EmptyStmt)
+	case *astpb.Stmt_LabeledStmt:
+		return fromProtoLabeledStmtNode(node.LabeledStmt)
+	case *astpb.Stmt_ExprStmt:
+		return fromProtoExprStmtNode(node.ExprStmt)
+	case *astpb.Stmt_SendStmt:
+		return fromProtoSendStmtNode(node.SendStmt)
+	case *astpb.Stmt_IncDecStmt:
+		return fromProtoIncDecStmtNode(node.IncDecStmt)
+	case *astpb.Stmt_AssignStmt:
+		return fromProtoAssignStmtNode(node.AssignStmt)
+	case *astpb.Stmt_GoStmt:
+		return fromProtoGoStmtNode(node.GoStmt)
+	case *astpb.Stmt_DeferStmt:
+		return fromProtoDeferStmtNode(node.DeferStmt)
+	case *astpb.Stmt_ReturnStmt:
+		return fromProtoReturnStmtNode(node.ReturnStmt)
+	case *astpb.Stmt_BranchStmt:
+		return fromProtoBranchStmtNode(node.BranchStmt)
+	case *astpb.Stmt_BlockStmt:
+		return fromProtoBlockStmtNode(node.BlockStmt)
+	case *astpb.Stmt_IfStmt:
+		return fromProtoIfStmtNode(node.IfStmt)
+	case *astpb.Stmt_CaseClause:
+		return fromProtoCaseClauseNode(node.CaseClause)
+	case *astpb.Stmt_SwitchStmt:
+		return fromProtoSwitchStmtNode(node.SwitchStmt)
+	case *astpb.Stmt_TypeSwitchStmt:
+		return fromProtoTypeSwitchStmtNode(node.TypeSwitchStmt)
+	case *astpb.Stmt_CommClause:
+		return fromProtoCommClauseNode(node.CommClause)
+	case *astpb.Stmt_SelectStmt:
+		return fromProtoSelectStmtNode(node.SelectStmt)
+	case *astpb.Stmt_ForStmt:
+		return fromProtoForStmtNode(node.ForStmt)
+	case *astpb.Stmt_RangeStmt:
+		return fromProtoRangeStmtNode(node.RangeStmt)
+	}
+	return nil
+}
+
+func fromProtoSpec(node *astpb.Spec) ISpecNode {
+	switch node := node.GetNode().(type) {
+	case *astpb.Spec_ImportSpec:
+		return fromProtoImportSpecNode(node.ImportSpec)
+	case *astpb.Spec_ValueSpec:
+		return fromProtoValueSpecNode(node.ValueSpec)
+	case *astpb.Spec_TypeSpec:
+		return fromProtoTypeSpecNode(node.TypeSpec)
+	}
+	return nil
+}
+
+func fromProtoDecl(node *astpb.Decl) IDeclNode {
+	switch node := node.GetNode().(type) {
+	case *astpb.Decl_BadDecl:
+		return fromProtoBadDeclNode(node.BadDecl)
+	case *astpb.Decl_GenDecl:
+		return fromProtoGenDeclNode(node.GenDecl)
+	case *astpb.Decl_FuncDecl:
+		return fromProtoFuncDeclNode(node.FuncDecl)
+	}
+	return nil
+}
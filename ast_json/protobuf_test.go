@@ -0,0 +1,88 @@
+package ast_json
+
+import (
+	"bytes"
+	"fmt"
+	"go/parser"
+	"go/printer"
+	"path/filepath"
+	"testing"
+
+	"GoOperatorAST/astpb"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestProtoRoundTrip(t *testing.T) {
+	files, err := listDir(getTestDataRoot(), ".input")
+	if err != nil {
+		t.Fatal(err)
+	}
+	all := Options{WithComments: true, WithPositions: true, WithReferences: true, WithImports: true}
+	for _, input := range files {
+		t.Run(filepath.Base(input), func(t *testing.T) {
+			runFormatRoundTrip(t, protoFormat, input, all)
+		})
+	}
+	for _, params := range paramsMatrix {
+		options := Options{
+			WithComments:   params.comments,
+			WithPositions:  params.positions,
+			WithReferences: params.references,
+			WithImports:    params.imports,
+		}
+		testName := fmt.Sprintf("cli.go,comments:%t,positions:%t,references:%t,imports:%t",
+			params.comments, params.positions, params.references, params.imports)
+		t.Run(testName, func(t *testing.T) {
+			runFormatRoundTrip(t, protoFormat, "cli.go", options)
+		})
+	}
+}
+
+var protoFormat = nodeFormat{write: WriteProto, read: ReadProto, toSource: ProtoToSource}
+
+func TestProtoGoAST(t *testing.T) {
+	options := Options{WithComments: true, WithPositions: true}
+	marshaller := NewMarshaller(options)
+	tree, err := parser.ParseFile(marshaller.FileSet(), "cli.go", nil, parser.ParseComments)
+	if err != nil {
+		t.Fatal(err)
+	}
+	message, err := marshaller.MarshalFileProto(tree)
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := proto.Marshal(message)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var decoded astpb.FileNode
+	err = proto.Unmarshal(data, &decoded)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var decl *astpb.FuncDeclNode
+	for _, d := range decoded.Decls {
+		if f := d.GetFuncDecl(); f != nil && f.Name.Name == "SourceToJSONWithContent" {
+			decl = f
+		}
+	}
+	if decl == nil || decl.Body == nil {
+		t.Error("SourceToJSONWithContent not found in the decoded message")
+	}
+
+	unmarshaller := NewUnmarshaller(options)
+	file := unmarshaller.UnmarshalFileProto(&decoded)
+	var printed bytes.Buffer
+	err = printer.Fprint(&printed, unmarshaller.FileSet(), file)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var want bytes.Buffer
+	err = printer.Fprint(&want, marshaller.FileSet(), tree)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if printed.String() != want.String() {
+		t.Error("source printed from the message differs")
+	}
+}
@@ -0,0 +1,134 @@
+// Code generated by the FlatBuffers compiler. DO NOT EDIT.
+
+package astfb
+
+import (
+	flatbuffers "github.com/google/flatbuffers/go"
+)
+
+type ArrayTypeNode struct {
+	_tab flatbuffers.Table
+}
+
+func GetRootAsArrayTypeNode(buf []byte, offset flatbuffers.UOffsetT) *ArrayTypeNode {
+	n := flatbuffers.GetUOffsetT(buf[offset:])
+	x := &ArrayTypeNode{}
+	x.Init(buf, n+offset)
+	return x
+}
+
+func FinishArrayTypeNodeBuffer(builder *flatbuffers.Builder, offset flatbuffers.UOffsetT) {
+	builder.Finish(offset)
+}
+
+func GetSizePrefixedRootAsArrayTypeNode(buf []byte, offset flatbuffers.UOffsetT) *ArrayTypeNode {
+	n := flatbuffers.GetUOffsetT(buf[offset+flatbuffers.SizeUint32:])
+	x := &ArrayTypeNode{}
+	x.Init(buf, n+offset+flatbuffers.SizeUint32)
+	return x
+}
+
+func FinishSizePrefixedArrayTypeNodeBuffer(builder *flatbuffers.Builder, offset flatbuffers.UOffsetT) {
+	builder.FinishSizePrefixed(offset)
+}
+
+func (rcv *ArrayTypeNode) Init(buf []byte, i flatbuffers.UOffsetT) {
+	rcv._tab.Bytes = buf
+	rcv._tab.Pos = i
+}
+
+func (rcv *ArrayTypeNode) Table() flatbuffers.Table {
+	return rcv._tab
+}
+
+func (rcv *ArrayTypeNode) RefId() int32 {
+	o := flatbuffers.UOffsetT(rcv._tab.Offset(4))
+	if o != 0 {
+		return rcv._tab.GetInt32(o + rcv._tab.Pos)
+	}
+	return 0
+}
+
+func (rcv *ArrayTypeNode) MutateRefId(n int32) bool {
+	return rcv._tab.MutateInt32Slot(4, n)
+}
+
+func (rcv *ArrayTypeNode) Lbrack(obj *PositionNode) *PositionNode {
+	o := flatbuffers.UOffsetT(rcv._tab.Offset(6))
+	if o != 0 {
+		x := o + rcv._tab.Pos
+		if obj == nil {
+			obj = new(PositionNode)
+		}
+		obj.Init(rcv._tab.Bytes, x)
+		return obj
+	}
+	return nil
+}
+
+func (rcv *ArrayTypeNode) LenType() Expr {
+	o := flatbuffers.UOffsetT(rcv._tab.Offset(8))
+	if o != 0 {
+		return Expr(rcv._tab.GetByte(o + rcv._tab.Pos))
+	}
+	return 0
+}
+
+func (rcv *ArrayTypeNode) MutateLenType(n Expr) bool {
+	return rcv._tab.MutateByteSlot(8, byte(n))
+}
+
+func (rcv *ArrayTypeNode) Len(obj *flatbuffers.Table) bool {
+	o := flatbuffers.UOffsetT(rcv._tab.Offset(10))
+	if o != 0 {
+		rcv._tab.Union(obj, o)
+		return true
+	}
+	return false
+}
+
+func (rcv *ArrayTypeNode) EltType() Expr {
+	o := flatbuffers.UOffsetT(rcv._tab.Offset(12))
+	if o != 0 {
+		return Expr(rcv._tab.GetByte(o + rcv._tab.Pos))
+	}
+	return 0
+}
+
+func (rcv *ArrayTypeNode) MutateEltType(n Expr) bool {
+	return rcv._tab.MutateByteSlot(12, byte(n))
+}
+
+func (rcv *ArrayTypeNode) Elt(obj *flatbuffers.Table) bool {
+	o := flatbuffers.UOffsetT(rcv._tab.Offset(14))
+	if o != 0 {
+		rcv._tab.Union(obj, o)
+		return true
+	}
+	return false
+}
+
+func ArrayTypeNodeStart(builder *flatbuffers.Builder) {
+	builder.StartObject(6)
+}
+func ArrayTypeNodeAddRefId(builder *flatbuffers.Builder, refId int32) {
+	builder.PrependInt32Slot(0, refId, 0)
+}
+func ArrayTypeNodeAddLbrack(builder *flatbuffers.Builder, lbrack flatbuffers.UOffsetT) {
+	builder.PrependStructSlot(1, flatbuffers.UOffsetT(lbrack), 0)
+}
+func ArrayTypeNodeAddLenType(builder *flatbuffers.Builder, lenType Expr) {
+	builder.PrependByteSlot(2, byte(lenType), 0)
+}
+func ArrayTypeNodeAddLen(builder *flatbuffers.Builder, len flatbuffers.UOffsetT) {
+	builder.PrependUOffsetTSlot(3, flatbuffers.UOffsetT(len), 0)
+}
+func ArrayTypeNodeAddEltType(builder *flatbuffers.Builder, eltType Expr) {
+	builder.PrependByteSlot(4, byte(eltType), 0)
+}
+func ArrayTypeNodeAddElt(builder *flatbuffers.Builder, elt flatbuffers.UOffsetT) {
+	builder.PrependUOffsetTSlot(5, flatbuffers.UOffsetT(elt), 0)
+}
+func ArrayTypeNodeEnd(builder *flatbuffers.Builder) flatbuffers.UOffsetT {
+	return builder.EndObject()
+}
@@ -0,0 +1,143 @@
+// Code generated by the FlatBuffers compiler. DO NOT EDIT.
+
+package astfb
+
+import (
+	flatbuffers "github.com/google/flatbuffers/go"
+)
+
+type AssignStmtNode struct {
+	_tab flatbuffers.Table
+}
+
+func GetRootAsAssignStmtNode(buf []byte, offset flatbuffers.UOffsetT) *AssignStmtNode {
+	n := flatbuffers.GetUOffsetT(buf[offset:])
+	x := &AssignStmtNode{}
+	x.Init(buf, n+offset)
+	return x
+}
+
+func FinishAssignStmtNodeBuffer(builder *flatbuffers.Builder, offset flatbuffers.UOffsetT) {
+	builder.Finish(offset)
+}
+
+func GetSizePrefixedRootAsAssignStmtNode(buf []byte, offset flatbuffers.UOffsetT) *AssignStmtNode {
+	n := flatbuffers.GetUOffsetT(buf[offset+flatbuffers.SizeUint32:])
+	x := &AssignStmtNode{}
+	x.Init(buf, n+offset+flatbuffers.SizeUint32)
+	return x
+}
+
+func FinishSizePrefixedAssignStmtNodeBuffer(builder *flatbuffers.Builder, offset flatbuffers.UOffsetT) {
+	builder.FinishSizePrefixed(offset)
+}
+
+func (rcv *AssignStmtNode) Init(buf []byte, i flatbuffers.UOffsetT) {
+	rcv._tab.Bytes = buf
+	rcv._tab.Pos = i
+}
+
+func (rcv *AssignStmtNode) Table() flatbuffers.Table {
+	return rcv._tab
+}
+
+func (rcv *AssignStmtNode) RefId() int32 {
+	o := flatbuffers.UOffsetT(rcv._tab.Offset(4))
+	if o != 0 {
+		return rcv._tab.GetInt32(o + rcv._tab.Pos)
+	}
+	return 0
+}
+
+func (rcv *AssignStmtNode) MutateRefId(n int32) bool {
+	return rcv._tab.MutateInt32Slot(4, n)
+}
+
+func (rcv *AssignStmtNode) Lhs(obj *ExprItem, j int) bool {
+	o := flatbuffers.UOffsetT(rcv._tab.Offset(6))
+	if o != 0 {
+		x := rcv._tab.Vector(o)
+		x += flatbuffers.UOffsetT(j) * 4
+		x = rcv._tab.Indirect(x)
+		obj.Init(rcv._tab.Bytes, x)
+		return true
+	}
+	return false
+}
+
+func (rcv *AssignStmtNode) LhsLength() int {
+	o := flatbuffers.UOffsetT(rcv._tab.Offset(6))
+	if o != 0 {
+		return rcv._tab.VectorLen(o)
+	}
+	return 0
+}
+
+func (rcv *AssignStmtNode) TokPos(obj *PositionNode) *PositionNode {
+	o := flatbuffers.UOffsetT(rcv._tab.Offset(8))
+	if o != 0 {
+		x := o + rcv._tab.Pos
+		if obj == nil {
+			obj = new(PositionNode)
+		}
+		obj.Init(rcv._tab.Bytes, x)
+		return obj
+	}
+	return nil
+}
+
+func (rcv *AssignStmtNode) Tok() []byte {
+	o := flatbuffers.UOffsetT(rcv._tab.Offset(10))
+	if o != 0 {
+		return rcv._tab.ByteVector(o + rcv._tab.Pos)
+	}
+	return nil
+}
+
+func (rcv *AssignStmtNode) Rhs(obj *ExprItem, j int) bool {
+	o := flatbuffers.UOffsetT(rcv._tab.Offset(12))
+	if o != 0 {
+		x := rcv._tab.Vector(o)
+		x += flatbuffers.UOffsetT(j) * 4
+		x = rcv._tab.Indirect(x)
+		obj.Init(rcv._tab.Bytes, x)
+		return true
+	}
+	return false
+}
+
+func (rcv *AssignStmtNode) RhsLength() int {
+	o := flatbuffers.UOffsetT(rcv._tab.Offset(12))
+	if o != 0 {
+		return rcv._tab.VectorLen(o)
+	}
+	return 0
+}
+
+func AssignStmtNodeStart(builder *flatbuffers.Builder) {
+	builder.StartObject(5)
+}
+func AssignStmtNodeAddRefId(builder *flatbuffers.Builder, refId int32) {
+	builder.PrependInt32Slot(0, refId, 0)
+}
+func AssignStmtNodeAddLhs(builder *flatbuffers.Builder, lhs flatbuffers.UOffsetT) {
+	builder.PrependUOffsetTSlot(1, flatbuffers.UOffsetT(lhs), 0)
+}
+func AssignStmtNodeStartLhsVector(builder *flatbuffers.Builder, numElems int) flatbuffers.UOffsetT {
+	return builder.StartVector(4, numElems, 4)
+}
+func AssignStmtNodeAddTokPos(builder *flatbuffers.Builder, tokPos flatbuffers.UOffsetT) {
+	builder.PrependStructSlot(2, flatbuffers.UOffsetT(tokPos), 0)
+}
+func AssignStmtNodeAddTok(builder *flatbuffers.Builder, tok flatbuffers.UOffsetT) {
+	builder.PrependUOffsetTSlot(3, flatbuffers.UOffsetT(tok), 0)
+}
+func AssignStmtNodeAddRhs(builder *flatbuffers.Builder, rhs flatbuffers.UOffsetT) {
+	builder.PrependUOffsetTSlot(4, flatbuffers.UOffsetT(rhs), 0)
+}
+func AssignStmtNodeStartRhsVector(builder *flatbuffers.Builder, numElems int) flatbuffers.UOffsetT {
+	return builder.StartVector(4, numElems, 4)
+}
+func AssignStmtNodeEnd(builder *flatbuffers.Builder) flatbuffers.UOffsetT {
+	return builder.EndObject()
+}
@@ -0,0 +1,96 @@
+// Code generated by the FlatBuffers compiler. DO NOT EDIT.
+
+package astfb
+
+import (
+	flatbuffers "github.com/google/flatbuffers/go"
+)
+
+type BadDeclNode struct {
+	_tab flatbuffers.Table
+}
+
+func GetRootAsBadDeclNode(buf []byte, offset flatbuffers.UOffsetT) *BadDeclNode {
+	n := flatbuffers.GetUOffsetT(buf[offset:])
+	x := &BadDeclNode{}
+	x.Init(buf, n+offset)
+	return x
+}
+
+func FinishBadDeclNodeBuffer(builder *flatbuffers.Builder, offset flatbuffers.UOffsetT) {
+	builder.Finish(offset)
+}
+
+func GetSizePrefixedRootAsBadDeclNode(buf []byte, offset flatbuffers.UOffsetT) *BadDeclNode {
+	n := flatbuffers.GetUOffsetT(buf[offset+flatbuffers.SizeUint32:])
+	x := &BadDeclNode{}
+	x.Init(buf, n+offset+flatbuffers.SizeUint32)
+	return x
+}
+
+func FinishSizePrefixedBadDeclNodeBuffer(builder *flatbuffers.Builder, offset flatbuffers.UOffsetT) {
+	builder.FinishSizePrefixed(offset)
+}
+
+func (rcv *BadDeclNode) Init(buf []byte, i flatbuffers.UOffsetT) {
+	rcv._tab.Bytes = buf
+	rcv._tab.Pos = i
+}
+
+func (rcv *BadDeclNode) Table() flatbuffers.Table {
+	return rcv._tab
+}
+
+func (rcv *BadDeclNode) RefId() int32 {
+	o := flatbuffers.UOffsetT(rcv._tab.Offset(4))
+	if o != 0 {
+		return rcv._tab.GetInt32(o + rcv._tab.Pos)
+	}
+	return 0
+}
+
+func (rcv *BadDeclNode) MutateRefId(n int32) bool {
+	return rcv._tab.MutateInt32Slot(4, n)
+}
+
+func (rcv *BadDeclNode) From(obj *PositionNode) *PositionNode {
+	o := flatbuffers.UOffsetT(rcv._tab.Offset(6))
+	if o != 0 {
+		x := o + rcv._tab.Pos
+		if obj == nil {
+			obj = new(PositionNode)
+		}
+		obj.Init(rcv._tab.Bytes, x)
+		return obj
+	}
+	return nil
+}
+
+func (rcv *BadDeclNode) To(obj *PositionNode) *PositionNode {
+	o := flatbuffers.UOffsetT(rcv._tab.Offset(8))
+	if o != 0 {
+		x := o + rcv._tab.Pos
+		if obj == nil {
+			obj = new(PositionNode)
+		}
+		obj.Init(rcv._tab.Bytes, x)
+		return obj
+	}
+	return nil
+}
+
+func BadDeclNodeStart(builder *flatbuffers.Builder) {
+	builder.StartObject(3)
+}
+func BadDeclNodeAddRefId(builder *flatbuffers.Builder, refId int32) {
+	builder.PrependInt32Slot(0, refId, 0)
+}
+func BadDeclNodeAddFrom(builder *flatbuffers.Builder, from flatbuffers.UOffsetT) {
+	builder.PrependStructSlot(1, flatbuffers.UOffsetT(from), 0)
+}
+func BadDeclNodeAddTo(builder *flatbuffers.Builder, to flatbuffers.UOffsetT) {
+	builder.PrependStructSlot(2, flatbuffers.UOffsetT(to), 0)
+}
+func BadDeclNodeEnd(builder *flatbuffers.Builder) flatbuffers.UOffsetT {
+	return builder.EndObject()
+}
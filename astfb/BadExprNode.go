@@ -0,0 +1,96 @@
+// Code generated by the FlatBuffers compiler. DO NOT EDIT.
+
+package astfb
+
+import (
+	flatbuffers "github.com/google/flatbuffers/go"
+)
+
+type BadExprNode struct {
+	_tab flatbuffers.Table
+}
+
+func GetRootAsBadExprNode(buf []byte, offset flatbuffers.UOffsetT) *BadExprNode {
+	n := flatbuffers.GetUOffsetT(buf[offset:])
+	x := &BadExprNode{}
+	x.Init(buf, n+offset)
+	return x
+}
+
+func FinishBadExprNodeBuffer(builder *flatbuffers.Builder, offset flatbuffers.UOffsetT) {
+	builder.Finish(offset)
+}
+
+func GetSizePrefixedRootAsBadExprNode(buf []byte, offset flatbuffers.UOffsetT) *BadExprNode {
+	n := flatbuffers.GetUOffsetT(buf[offset+flatbuffers.SizeUint32:])
+	x := &BadExprNode{}
+	x.Init(buf, n+offset+flatbuffers.SizeUint32)
+	return x
+}
+
+func FinishSizePrefixedBadExprNodeBuffer(builder *flatbuffers.Builder, offset flatbuffers.UOffsetT) {
+	builder.FinishSizePrefixed(offset)
+}
+
+func (rcv *BadExprNode) Init(buf []byte, i flatbuffers.UOffsetT) {
+	rcv._tab.Bytes = buf
+	rcv._tab.Pos = i
+}
+
+func (rcv *BadExprNode) Table() flatbuffers.Table {
+	return rcv._tab
+}
+
+func (rcv *BadExprNode) RefId() int32 {
+	o := flatbuffers.UOffsetT(rcv._tab.Offset(4))
+	if o != 0 {
+		return rcv._tab.GetInt32(o + rcv._tab.Pos)
+	}
+	return 0
+}
+
+func (rcv *BadExprNode) MutateRefId(n int32) bool {
+	return rcv._tab.MutateInt32Slot(4, n)
+}
+
+func (rcv *BadExprNode) From(obj *PositionNode) *PositionNode {
+	o := flatbuffers.UOffsetT(rcv._tab.Offset(6))
+	if o != 0 {
+		x := o + rcv._tab.Pos
+		if obj == nil {
+			obj = new(PositionNode)
+		}
+		obj.Init(rcv._tab.Bytes, x)
+		return obj
+	}
+	return nil
+}
+
+func (rcv *BadExprNode) To(obj *PositionNode) *PositionNode {
+	o := flatbuffers.UOffsetT(rcv._tab.Offset(8))
+	if o != 0 {
+		x := o + rcv._tab.Pos
+		if obj == nil {
+			obj = new(PositionNode)
+		}
+		obj.Init(rcv._tab.Bytes, x)
+		return obj
+	}
+	return nil
+}
+
+func BadExprNodeStart(builder *flatbuffers.Builder) {
+	builder.StartObject(3)
+}
+func BadExprNodeAddRefId(builder *flatbuffers.Builder, refId int32) {
+	builder.PrependInt32Slot(0, refId, 0)
+}
+func BadExprNodeAddFrom(builder *flatbuffers.Builder, from flatbuffers.UOffsetT) {
+	builder.PrependStructSlot(1, flatbuffers.UOffsetT(from), 0)
+}
+func BadExprNodeAddTo(builder *flatbuffers.Builder, to flatbuffers.UOffsetT) {
+	builder.PrependStructSlot(2, flatbuffers.UOffsetT(to), 0)
+}
+func BadExprNodeEnd(builder *flatbuffers.Builder) flatbuffers.UOffsetT {
+	return builder.EndObject()
+}
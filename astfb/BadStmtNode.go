@@ -0,0 +1,96 @@
+// Code generated by the FlatBuffers compiler. DO NOT EDIT.
+
+package astfb
+
+import (
+	flatbuffers "github.com/google/flatbuffers/go"
+)
+
+type BadStmtNode struct {
+	_tab flatbuffers.Table
+}
+
+func GetRootAsBadStmtNode(buf []byte, offset flatbuffers.UOffsetT) *BadStmtNode {
+	n := flatbuffers.GetUOffsetT(buf[offset:])
+	x := &BadStmtNode{}
+	x.Init(buf, n+offset)
+	return x
+}
+
+func FinishBadStmtNodeBuffer(builder *flatbuffers.Builder, offset flatbuffers.UOffsetT) {
+	builder.Finish(offset)
+}
+
+func GetSizePrefixedRootAsBadStmtNode(buf []byte, offset flatbuffers.UOffsetT) *BadStmtNode {
+	n := flatbuffers.GetUOffsetT(buf[offset+flatbuffers.SizeUint32:])
+	x := &BadStmtNode{}
+	x.Init(buf, n+offset+flatbuffers.SizeUint32)
+	return x
+}
+
+func FinishSizePrefixedBadStmtNodeBuffer(builder *flatbuffers.Builder, offset flatbuffers.UOffsetT) {
+	builder.FinishSizePrefixed(offset)
+}
+
+func (rcv *BadStmtNode) Init(buf []byte, i flatbuffers.UOffsetT) {
+	rcv._tab.Bytes = buf
+	rcv._tab.Pos = i
+}
+
+func (rcv *BadStmtNode) Table() flatbuffers.Table {
+	return rcv._tab
+}
+
+func (rcv *BadStmtNode) RefId() int32 {
+	o := flatbuffers.UOffsetT(rcv._tab.Offset(4))
+	if o != 0 {
+		return rcv._tab.GetInt32(o + rcv._tab.Pos)
+	}
+	return 0
+}
+
+func (rcv *BadStmtNode) MutateRefId(n int32) bool {
+	return rcv._tab.MutateInt32Slot(4, n)
+}
+
+func (rcv *BadStmtNode) From(obj *PositionNode) *PositionNode {
+	o := flatbuffers.UOffsetT(rcv._tab.Offset(6))
+	if o != 0 {
+		x := o + rcv._tab.Pos
+		if obj == nil {
+			obj = new(PositionNode)
+		}
+		obj.Init(rcv._tab.Bytes, x)
+		return obj
+	}
+	return nil
+}
+
+func (rcv *BadStmtNode) To(obj *PositionNode) *PositionNode {
+	o := flatbuffers.UOffsetT(rcv._tab.Offset(8))
+	if o != 0 {
+		x := o + rcv._tab.Pos
+		if obj == nil {
+			obj = new(PositionNode)
+		}
+		obj.Init(rcv._tab.Bytes, x)
+		return obj
+	}
+	return nil
+}
+
+func BadStmtNodeStart(builder *flatbuffers.Builder) {
+	builder.StartObject(3)
+}
+func BadStmtNodeAddRefId(builder *flatbuffers.Builder, refId int32) {
+	builder.PrependInt32Slot(0, refId, 0)
+}
+func BadStmtNodeAddFrom(builder *flatbuffers.Builder, from flatbuffers.UOffsetT) {
+	builder.PrependStructSlot(1, flatbuffers.UOffsetT(from), 0)
+}
+func BadStmtNodeAddTo(builder *flatbuffers.Builder, to flatbuffers.UOffsetT) {
+	builder.PrependStructSlot(2, flatbuffers.UOffsetT(to), 0)
+}
+func BadStmtNodeEnd(builder *flatbuffers.Builder) flatbuffers.UOffsetT {
+	return builder.EndObject()
+}
@@ -0,0 +1,145 @@
+// Code generated by the FlatBuffers compiler. DO NOT EDIT.
+
+package astfb
+
+import (
+	flatbuffers "github.com/google/flatbuffers/go"
+)
+
+type BinaryExprNode struct {
+	_tab flatbuffers.Table
+}
+
+func GetRootAsBinaryExprNode(buf []byte, offset flatbuffers.UOffsetT) *BinaryExprNode {
+	n := flatbuffers.GetUOffsetT(buf[offset:])
+	x := &BinaryExprNode{}
+	x.Init(buf, n+offset)
+	return x
+}
+
+func FinishBinaryExprNodeBuffer(builder *flatbuffers.Builder, offset flatbuffers.UOffsetT) {
+	builder.Finish(offset)
+}
+
+func GetSizePrefixedRootAsBinaryExprNode(buf []byte, offset flatbuffers.UOffsetT) *BinaryExprNode {
+	n := flatbuffers.GetUOffsetT(buf[offset+flatbuffers.SizeUint32:])
+	x := &BinaryExprNode{}
+	x.Init(buf, n+offset+flatbuffers.SizeUint32)
+	return x
+}
+
+func FinishSizePrefixedBinaryExprNodeBuffer(builder *flatbuffers.Builder, offset flatbuffers.UOffsetT) {
+	builder.FinishSizePrefixed(offset)
+}
+
+func (rcv *BinaryExprNode) Init(buf []byte, i flatbuffers.UOffsetT) {
+	rcv._tab.Bytes = buf
+	rcv._tab.Pos = i
+}
+
+func (rcv *BinaryExprNode) Table() flatbuffers.Table {
+	return rcv._tab
+}
+
+func (rcv *BinaryExprNode) RefId() int32 {
+	o := flatbuffers.UOffsetT(rcv._tab.Offset(4))
+	if o != 0 {
+		return rcv._tab.GetInt32(o + rcv._tab.Pos)
+	}
+	return 0
+}
+
+func (rcv *BinaryExprNode) MutateRefId(n int32) bool {
+	return rcv._tab.MutateInt32Slot(4, n)
+}
+
+func (rcv *BinaryExprNode) XType() Expr {
+	o := flatbuffers.UOffsetT(rcv._tab.Offset(6))
+	if o != 0 {
+		return Expr(rcv._tab.GetByte(o + rcv._tab.Pos))
+	}
+	return 0
+}
+
+func (rcv *BinaryExprNode) MutateXType(n Expr) bool {
+	return rcv._tab.MutateByteSlot(6, byte(n))
+}
+
+func (rcv *BinaryExprNode) X(obj *flatbuffers.Table) bool {
+	o := flatbuffers.UOffsetT(rcv._tab.Offset(8))
+	if o != 0 {
+		rcv._tab.Union(obj, o)
+		return true
+	}
+	return false
+}
+
+func (rcv *BinaryExprNode) OpPos(obj *PositionNode) *PositionNode {
+	o := flatbuffers.UOffsetT(rcv._tab.Offset(10))
+	if o != 0 {
+		x := o + rcv._tab.Pos
+		if obj == nil {
+			obj = new(PositionNode)
+		}
+		obj.Init(rcv._tab.Bytes, x)
+		return obj
+	}
+	return nil
+}
+
+func (rcv *BinaryExprNode) Op() []byte {
+	o := flatbuffers.UOffsetT(rcv._tab.Offset(12))
+	if o != 0 {
+		return rcv._tab.ByteVector(o + rcv._tab.Pos)
+	}
+	return nil
+}
+
+func (rcv *BinaryExprNode) YType() Expr {
+	o := flatbuffers.UOffsetT(rcv._tab.Offset(14))
+	if o != 0 {
+		return Expr(rcv._tab.GetByte(o + rcv._tab.Pos))
+	}
+	return 0
+}
+
+func (rcv *BinaryExprNode) MutateYType(n Expr) bool {
+	return rcv._tab.MutateByteSlot(14, byte(n))
+}
+
+func (rcv *BinaryExprNode) Y(obj *flatbuffers.Table) bool {
+	o := flatbuffers.UOffsetT(rcv._tab.Offset(16))
+	if o != 0 {
+		rcv._tab.Union(obj, o)
+		return true
+	}
+	return false
+}
+
+func BinaryExprNodeStart(builder *flatbuffers.Builder) {
+	builder.StartObject(7)
+}
+func BinaryExprNodeAddRefId(builder *flatbuffers.Builder, refId int32) {
+	builder.PrependInt32Slot(0, refId, 0)
+}
+func BinaryExprNodeAddXType(builder *flatbuffers.Builder, xType Expr) {
+	builder.PrependByteSlot(1, byte(xType), 0)
+}
+func BinaryExprNodeAddX(builder *flatbuffers.Builder, x flatbuffers.UOffsetT) {
+	builder.PrependUOffsetTSlot(2, flatbuffers.UOffsetT(x), 0)
+}
+func BinaryExprNodeAddOpPos(builder *flatbuffers.Builder, opPos flatbuffers.UOffsetT) {
+	builder.PrependStructSlot(3, flatbuffers.UOffsetT(opPos), 0)
+}
+func BinaryExprNodeAddOp(builder *flatbuffers.Builder, op flatbuffers.UOffsetT) {
+	builder.PrependUOffsetTSlot(4, flatbuffers.UOffsetT(op), 0)
+}
+func BinaryExprNodeAddYType(builder *flatbuffers.Builder, yType Expr) {
+	builder.PrependByteSlot(5, byte(yType), 0)
+}
+func BinaryExprNodeAddY(builder *flatbuffers.Builder, y flatbuffers.UOffsetT) {
+	builder.PrependUOffsetTSlot(6, flatbuffers.UOffsetT(y), 0)
+}
+func BinaryExprNodeEnd(builder *flatbuffers.Builder) flatbuffers.UOffsetT {
+	return builder.EndObject()
+}
@@ -0,0 +1,134 @@
+// Code generated by the FlatBuffers compiler. DO NOT EDIT.
+
+package astfb
+
+import (
+	flatbuffers "github.com/google/flatbuffers/go"
+)
+
+type ChanTypeNode struct {
+	_tab flatbuffers.Table
+}
+
+func GetRootAsChanTypeNode(buf []byte, offset flatbuffers.UOffsetT) *ChanTypeNode {
+	n := flatbuffers.GetUOffsetT(buf[offset:])
+	x := &ChanTypeNode{}
+	x.Init(buf, n+offset)
+	return x
+}
+
+func FinishChanTypeNodeBuffer(builder *flatbuffers.Builder, offset flatbuffers.UOffsetT) {
+	builder.Finish(offset)
+}
+
+func GetSizePrefixedRootAsChanTypeNode(buf []byte, offset flatbuffers.UOffsetT) *ChanTypeNode {
+	n := flatbuffers.GetUOffsetT(buf[offset+flatbuffers.SizeUint32:])
+	x := &ChanTypeNode{}
+	x.Init(buf, n+offset+flatbuffers.SizeUint32)
+	return x
+}
+
+func FinishSizePrefixedChanTypeNodeBuffer(builder *flatbuffers.Builder, offset flatbuffers.UOffsetT) {
+	builder.FinishSizePrefixed(offset)
+}
+
+func (rcv *ChanTypeNode) Init(buf []byte, i flatbuffers.UOffsetT) {
+	rcv._tab.Bytes = buf
+	rcv._tab.Pos = i
+}
+
+func (rcv *ChanTypeNode) Table() flatbuffers.Table {
+	return rcv._tab
+}
+
+func (rcv *ChanTypeNode) RefId() int32 {
+	o := flatbuffers.UOffsetT(rcv._tab.Offset(4))
+	if o != 0 {
+		return rcv._tab.GetInt32(o + rcv._tab.Pos)
+	}
+	return 0
+}
+
+func (rcv *ChanTypeNode) MutateRefId(n int32) bool {
+	return rcv._tab.MutateInt32Slot(4, n)
+}
+
+func (rcv *ChanTypeNode) Begin(obj *PositionNode) *PositionNode {
+	o := flatbuffers.UOffsetT(rcv._tab.Offset(6))
+	if o != 0 {
+		x := o + rcv._tab.Pos
+		if obj == nil {
+			obj = new(PositionNode)
+		}
+		obj.Init(rcv._tab.Bytes, x)
+		return obj
+	}
+	return nil
+}
+
+func (rcv *ChanTypeNode) Arrow(obj *PositionNode) *PositionNode {
+	o := flatbuffers.UOffsetT(rcv._tab.Offset(8))
+	if o != 0 {
+		x := o + rcv._tab.Pos
+		if obj == nil {
+			obj = new(PositionNode)
+		}
+		obj.Init(rcv._tab.Bytes, x)
+		return obj
+	}
+	return nil
+}
+
+func (rcv *ChanTypeNode) Dir() []byte {
+	o := flatbuffers.UOffsetT(rcv._tab.Offset(10))
+	if o != 0 {
+		return rcv._tab.ByteVector(o + rcv._tab.Pos)
+	}
+	return nil
+}
+
+func (rcv *ChanTypeNode) ValueType() Expr {
+	o := flatbuffers.UOffsetT(rcv._tab.Offset(12))
+	if o != 0 {
+		return Expr(rcv._tab.GetByte(o + rcv._tab.Pos))
+	}
+	return 0
+}
+
+func (rcv *ChanTypeNode) MutateValueType(n Expr) bool {
+	return rcv._tab.MutateByteSlot(12, byte(n))
+}
+
+func (rcv *ChanTypeNode) Value(obj *flatbuffers.Table) bool {
+	o := flatbuffers.UOffsetT(rcv._tab.Offset(14))
+	if o != 0 {
+		rcv._tab.Union(obj, o)
+		return true
+	}
+	return false
+}
+
+func ChanTypeNodeStart(builder *flatbuffers.Builder) {
+	builder.StartObject(6)
+}
+func ChanTypeNodeAddRefId(builder *flatbuffers.Builder, refId int32) {
+	builder.PrependInt32Slot(0, refId, 0)
+}
+func ChanTypeNodeAddBegin(builder *flatbuffers.Builder, begin flatbuffers.UOffsetT) {
+	builder.PrependStructSlot(1, flatbuffers.UOffsetT(begin), 0)
+}
+func ChanTypeNodeAddArrow(builder *flatbuffers.Builder, arrow flatbuffers.UOffsetT) {
+	builder.PrependStructSlot(2, flatbuffers.UOffsetT(arrow), 0)
+}
+func ChanTypeNodeAddDir(builder *flatbuffers.Builder, dir flatbuffers.UOffsetT) {
+	builder.PrependUOffsetTSlot(3, flatbuffers.UOffsetT(dir), 0)
+}
+func ChanTypeNodeAddValueType(builder *flatbuffers.Builder, valueType Expr) {
+	builder.PrependByteSlot(4, byte(valueType), 0)
+}
+func ChanTypeNodeAddValue(builder *flatbuffers.Builder, value flatbuffers.UOffsetT) {
+	builder.PrependUOffsetTSlot(5, flatbuffers.UOffsetT(value), 0)
+}
+func ChanTypeNodeEnd(builder *flatbuffers.Builder) flatbuffers.UOffsetT {
+	return builder.EndObject()
+}
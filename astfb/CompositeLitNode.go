@@ -0,0 +1,164 @@
+// Code generated by the FlatBuffers compiler. DO NOT EDIT.
+
+package astfb
+
+import (
+	flatbuffers "github.com/google/flatbuffers/go"
+)
+
+type CompositeLitNode struct {
+	_tab flatbuffers.Table
+}
+
+func GetRootAsCompositeLitNode(buf []byte, offset flatbuffers.UOffsetT) *CompositeLitNode {
+	n := flatbuffers.GetUOffsetT(buf[offset:])
+	x := &CompositeLitNode{}
+	x.Init(buf, n+offset)
+	return x
+}
+
+func FinishCompositeLitNodeBuffer(builder *flatbuffers.Builder, offset flatbuffers.UOffsetT) {
+	builder.Finish(offset)
+}
+
+func GetSizePrefixedRootAsCompositeLitNode(buf []byte, offset flatbuffers.UOffsetT) *CompositeLitNode {
+	n := flatbuffers.GetUOffsetT(buf[offset+flatbuffers.SizeUint32:])
+	x := &CompositeLitNode{}
+	x.Init(buf, n+offset+flatbuffers.SizeUint32)
+	return x
+}
+
+func FinishSizePrefixedCompositeLitNodeBuffer(builder *flatbuffers.Builder, offset flatbuffers.UOffsetT) {
+	builder.FinishSizePrefixed(offset)
+}
+
+func (rcv *CompositeLitNode) Init(buf []byte, i flatbuffers.UOffsetT) {
+	rcv._tab.Bytes = buf
+	rcv._tab.Pos = i
+}
+
+func (rcv *CompositeLitNode) Table() flatbuffers.Table {
+	return rcv._tab
+}
+
+func (rcv *CompositeLitNode) RefId() int32 {
+	o := flatbuffers.UOffsetT(rcv._tab.Offset(4))
+	if o != 0 {
+		return rcv._tab.GetInt32(o + rcv._tab.Pos)
+	}
+	return 0
+}
+
+func (rcv *CompositeLitNode) MutateRefId(n int32) bool {
+	return rcv._tab.MutateInt32Slot(4, n)
+}
+
+func (rcv *CompositeLitNode) TypeType() Expr {
+	o := flatbuffers.UOffsetT(rcv._tab.Offset(6))
+	if o != 0 {
+		return Expr(rcv._tab.GetByte(o + rcv._tab.Pos))
+	}
+	return 0
+}
+
+func (rcv *CompositeLitNode) MutateTypeType(n Expr) bool {
+	return rcv._tab.MutateByteSlot(6, byte(n))
+}
+
+func (rcv *CompositeLitNode) Type(obj *flatbuffers.Table) bool {
+	o := flatbuffers.UOffsetT(rcv._tab.Offset(8))
+	if o != 0 {
+		rcv._tab.Union(obj, o)
+		return true
+	}
+	return false
+}
+
+func (rcv *CompositeLitNode) Lbrace(obj *PositionNode) *PositionNode {
+	o := flatbuffers.UOffsetT(rcv._tab.Offset(10))
+	if o != 0 {
+		x := o + rcv._tab.Pos
+		if obj == nil {
+			obj = new(PositionNode)
+		}
+		obj.Init(rcv._tab.Bytes, x)
+		return obj
+	}
+	return nil
+}
+
+func (rcv *CompositeLitNode) Elts(obj *ExprItem, j int) bool {
+	o := flatbuffers.UOffsetT(rcv._tab.Offset(12))
+	if o != 0 {
+		x := rcv._tab.Vector(o)
+		x += flatbuffers.UOffsetT(j) * 4
+		x = rcv._tab.Indirect(x)
+		obj.Init(rcv._tab.Bytes, x)
+		return true
+	}
+	return false
+}
+
+func (rcv *CompositeLitNode) EltsLength() int {
+	o := flatbuffers.UOffsetT(rcv._tab.Offset(12))
+	if o != 0 {
+		return rcv._tab.VectorLen(o)
+	}
+	return 0
+}
+
+func (rcv *CompositeLitNode) Rbrace(obj *PositionNode) *PositionNode {
+	o := flatbuffers.UOffsetT(rcv._tab.Offset(14))
+	if o != 0 {
+		x := o + rcv._tab.Pos
+		if obj == nil {
+			obj = new(PositionNode)
+		}
+		obj.Init(rcv._tab.Bytes, x)
+		return obj
+	}
+	return nil
+}
+
+func (rcv *CompositeLitNode) Incomplete() bool {
+	o := flatbuffers.UOffsetT(rcv._tab.Offset(16))
+	if o != 0 {
+		return rcv._tab.GetBool(o + rcv._tab.Pos)
+	}
+	return false
+}
+
+func (rcv *CompositeLitNode) MutateIncomplete(n bool) bool {
+	return rcv._tab.MutateBoolSlot(16, n)
+}
+
+func CompositeLitNodeStart(builder *flatbuffers.Builder) {
+	builder.StartObject(7)
+}
+func CompositeLitNodeAddRefId(builder *flatbuffers.Builder, refId int32) {
+	builder.PrependInt32Slot(0, refId, 0)
+}
+func CompositeLitNodeAddTypeType(builder *flatbuffers.Builder, typeType Expr) {
+	builder.PrependByteSlot(1, byte(typeType), 0)
+}
+func CompositeLitNodeAddType(builder *flatbuffers.Builder, type_ flatbuffers.UOffsetT) {
+	builder.PrependUOffsetTSlot(2, flatbuffers.UOffsetT(type_), 0)
+}
+func CompositeLitNodeAddLbrace(builder *flatbuffers.Builder, lbrace flatbuffers.UOffsetT) {
+	builder.PrependStructSlot(3, flatbuffers.UOffsetT(lbrace), 0)
+}
+func CompositeLitNodeAddElts(builder *flatbuffers.Builder, elts flatbuffers.UOffsetT) {
+	builder.PrependUOffsetTSlot(4, flatbuffers.UOffsetT(elts), 0)
+}
+func CompositeLitNodeStartEltsVector(builder *flatbuffers.Builder, numElems int) flatbuffers.UOffsetT {
+	return builder.StartVector(4, numElems, 4)
+}
+func CompositeLitNodeAddRbrace(builder *flatbuffers.Builder, rbrace flatbuffers.UOffsetT) {
+	builder.PrependStructSlot(5, flatbuffers.UOffsetT(rbrace), 0)
+}
+func CompositeLitNodeAddIncomplete(builder *flatbuffers.Builder, incomplete bool) {
+	builder.PrependBoolSlot(6, incomplete, false)
+}
+func CompositeLitNodeEnd(builder *flatbuffers.Builder) flatbuffers.UOffsetT {
+	return builder.EndObject()
+}
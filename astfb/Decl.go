@@ -0,0 +1,35 @@
+// Code generated by the FlatBuffers compiler. DO NOT EDIT.
+
+package astfb
+
+import "strconv"
+
+type Decl byte
+
+const (
+	DeclNONE         Decl = 0
+	DeclBadDeclNode  Decl = 1
+	DeclGenDeclNode  Decl = 2
+	DeclFuncDeclNode Decl = 3
+)
+
+var EnumNamesDecl = map[Decl]string{
+	DeclNONE:         "NONE",
+	DeclBadDeclNode:  "BadDeclNode",
+	DeclGenDeclNode:  "GenDeclNode",
+	DeclFuncDeclNode: "FuncDeclNode",
+}
+
+var EnumValuesDecl = map[string]Decl{
+	"NONE":         DeclNONE,
+	"BadDeclNode":  DeclBadDeclNode,
+	"GenDeclNode":  DeclGenDeclNode,
+	"FuncDeclNode": DeclFuncDeclNode,
+}
+
+func (v Decl) String() string {
+	if s, ok := EnumNamesDecl[v]; ok {
+		return s
+	}
+	return "Decl(" + strconv.FormatInt(int64(v), 10) + ")"
+}
@@ -0,0 +1,76 @@
+// Code generated by the FlatBuffers compiler. DO NOT EDIT.
+
+package astfb
+
+import (
+	flatbuffers "github.com/google/flatbuffers/go"
+)
+
+type DeclItem struct {
+	_tab flatbuffers.Table
+}
+
+func GetRootAsDeclItem(buf []byte, offset flatbuffers.UOffsetT) *DeclItem {
+	n := flatbuffers.GetUOffsetT(buf[offset:])
+	x := &DeclItem{}
+	x.Init(buf, n+offset)
+	return x
+}
+
+func FinishDeclItemBuffer(builder *flatbuffers.Builder, offset flatbuffers.UOffsetT) {
+	builder.Finish(offset)
+}
+
+func GetSizePrefixedRootAsDeclItem(buf []byte, offset flatbuffers.UOffsetT) *DeclItem {
+	n := flatbuffers.GetUOffsetT(buf[offset+flatbuffers.SizeUint32:])
+	x := &DeclItem{}
+	x.Init(buf, n+offset+flatbuffers.SizeUint32)
+	return x
+}
+
+func FinishSizePrefixedDeclItemBuffer(builder *flatbuffers.Builder, offset flatbuffers.UOffsetT) {
+	builder.FinishSizePrefixed(offset)
+}
+
+func (rcv *DeclItem) Init(buf []byte, i flatbuffers.UOffsetT) {
+	rcv._tab.Bytes = buf
+	rcv._tab.Pos = i
+}
+
+func (rcv *DeclItem) Table() flatbuffers.Table {
+	return rcv._tab
+}
+
+func (rcv *DeclItem) NodeType() Decl {
+	o := flatbuffers.UOffsetT(rcv._tab.Offset(4))
+	if o != 0 {
+		return Decl(rcv._tab.GetByte(o + rcv._tab.Pos))
+	}
+	return 0
+}
+
+func (rcv *DeclItem) MutateNodeType(n Decl) bool {
+	return rcv._tab.MutateByteSlot(4, byte(n))
+}
+
+func (rcv *DeclItem) Node(obj *flatbuffers.Table) bool {
+	o := flatbuffers.UOffsetT(rcv._tab.Offset(6))
+	if o != 0 {
+		rcv._tab.Union(obj, o)
+		return true
+	}
+	return false
+}
+
+func DeclItemStart(builder *flatbuffers.Builder) {
+	builder.StartObject(2)
+}
+func DeclItemAddNodeType(builder *flatbuffers.Builder, nodeType Decl) {
+	builder.PrependByteSlot(0, byte(nodeType), 0)
+}
+func DeclItemAddNode(builder *flatbuffers.Builder, node flatbuffers.UOffsetT) {
+	builder.PrependUOffsetTSlot(1, flatbuffers.UOffsetT(node), 0)
+}
+func DeclItemEnd(builder *flatbuffers.Builder) flatbuffers.UOffsetT {
+	return builder.EndObject()
+}
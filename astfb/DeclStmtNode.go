@@ -0,0 +1,91 @@
+// Code generated by the FlatBuffers compiler. DO NOT EDIT.
+
+package astfb
+
+import (
+	flatbuffers "github.com/google/flatbuffers/go"
+)
+
+type DeclStmtNode struct {
+	_tab flatbuffers.Table
+}
+
+func GetRootAsDeclStmtNode(buf []byte, offset flatbuffers.UOffsetT) *DeclStmtNode {
+	n := flatbuffers.GetUOffsetT(buf[offset:])
+	x := &DeclStmtNode{}
+	x.Init(buf, n+offset)
+	return x
+}
+
+func FinishDeclStmtNodeBuffer(builder *flatbuffers.Builder, offset flatbuffers.UOffsetT) {
+	builder.Finish(offset)
+}
+
+func GetSizePrefixedRootAsDeclStmtNode(buf []byte, offset flatbuffers.UOffsetT) *DeclStmtNode {
+	n := flatbuffers.GetUOffsetT(buf[offset+flatbuffers.SizeUint32:])
+	x := &DeclStmtNode{}
+	x.Init(buf, n+offset+flatbuffers.SizeUint32)
+	return x
+}
+
+func FinishSizePrefixedDeclStmtNodeBuffer(builder *flatbuffers.Builder, offset flatbuffers.UOffsetT) {
+	builder.FinishSizePrefixed(offset)
+}
+
+func (rcv *DeclStmtNode) Init(buf []byte, i flatbuffers.UOffsetT) {
+	rcv._tab.Bytes = buf
+	rcv._tab.Pos = i
+}
+
+func (rcv *DeclStmtNode) Table() flatbuffers.Table {
+	return rcv._tab
+}
+
+func (rcv *DeclStmtNode) RefId() int32 {
+	o := flatbuffers.UOffsetT(rcv._tab.Offset(4))
+	if o != 0 {
+		return rcv._tab.GetInt32(o + rcv._tab.Pos)
+	}
+	return 0
+}
+
+func (rcv *DeclStmtNode) MutateRefId(n int32) bool {
+	return rcv._tab.MutateInt32Slot(4, n)
+}
+
+func (rcv *DeclStmtNode) DeclType() Decl {
+	o := flatbuffers.UOffsetT(rcv._tab.Offset(6))
+	if o != 0 {
+		return Decl(rcv._tab.GetByte(o + rcv._tab.Pos))
+	}
+	return 0
+}
+
+func (rcv *DeclStmtNode) MutateDeclType(n Decl) bool {
+	return rcv._tab.MutateByteSlot(6, byte(n))
+}
+
+func (rcv *DeclStmtNode) Decl(obj *flatbuffers.Table) bool {
+	o := flatbuffers.UOffsetT(rcv._tab.Offset(8))
+	if o != 0 {
+		rcv._tab.Union(obj, o)
+		return true
+	}
+	return false
+}
+
+func DeclStmtNodeStart(builder *flatbuffers.Builder) {
+	builder.StartObject(3)
+}
+func DeclStmtNodeAddRefId(builder *flatbuffers.Builder, refId int32) {
+	builder.PrependInt32Slot(0, refId, 0)
+}
+func DeclStmtNodeAddDeclType(builder *flatbuffers.Builder, declType Decl) {
+	builder.PrependByteSlot(1, byte(declType), 0)
+}
+func DeclStmtNodeAddDecl(builder *flatbuffers.Builder, decl flatbuffers.UOffsetT) {
+	builder.PrependUOffsetTSlot(2, flatbuffers.UOffsetT(decl), 0)
+}
+func DeclStmtNodeEnd(builder *flatbuffers.Builder) flatbuffers.UOffsetT {
+	return builder.EndObject()
+}
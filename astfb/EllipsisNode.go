@@ -0,0 +1,107 @@
+// Code generated by the FlatBuffers compiler. DO NOT EDIT.
+
+package astfb
+
+import (
+	flatbuffers "github.com/google/flatbuffers/go"
+)
+
+type EllipsisNode struct {
+	_tab flatbuffers.Table
+}
+
+func GetRootAsEllipsisNode(buf []byte, offset flatbuffers.UOffsetT) *EllipsisNode {
+	n := flatbuffers.GetUOffsetT(buf[offset:])
+	x := &EllipsisNode{}
+	x.Init(buf, n+offset)
+	return x
+}
+
+func FinishEllipsisNodeBuffer(builder *flatbuffers.Builder, offset flatbuffers.UOffsetT) {
+	builder.Finish(offset)
+}
+
+func GetSizePrefixedRootAsEllipsisNode(buf []byte, offset flatbuffers.UOffsetT) *EllipsisNode {
+	n := flatbuffers.GetUOffsetT(buf[offset+flatbuffers.SizeUint32:])
+	x := &EllipsisNode{}
+	x.Init(buf, n+offset+flatbuffers.SizeUint32)
+	return x
+}
+
+func FinishSizePrefixedEllipsisNodeBuffer(builder *flatbuffers.Builder, offset flatbuffers.UOffsetT) {
+	builder.FinishSizePrefixed(offset)
+}
+
+func (rcv *EllipsisNode) Init(buf []byte, i flatbuffers.UOffsetT) {
+	rcv._tab.Bytes = buf
+	rcv._tab.Pos = i
+}
+
+func (rcv *EllipsisNode) Table() flatbuffers.Table {
+	return rcv._tab
+}
+
+func (rcv *EllipsisNode) RefId() int32 {
+	o := flatbuffers.UOffsetT(rcv._tab.Offset(4))
+	if o != 0 {
+		return rcv._tab.GetInt32(o + rcv._tab.Pos)
+	}
+	return 0
+}
+
+func (rcv *EllipsisNode) MutateRefId(n int32) bool {
+	return rcv._tab.MutateInt32Slot(4, n)
+}
+
+func (rcv *EllipsisNode) Ellipsis(obj *PositionNode) *PositionNode {
+	o := flatbuffers.UOffsetT(rcv._tab.Offset(6))
+	if o != 0 {
+		x := o + rcv._tab.Pos
+		if obj == nil {
+			obj = new(PositionNode)
+		}
+		obj.Init(rcv._tab.Bytes, x)
+		return obj
+	}
+	return nil
+}
+
+func (rcv *EllipsisNode) EltType() Expr {
+	o := flatbuffers.UOffsetT(rcv._tab.Offset(8))
+	if o != 0 {
+		return Expr(rcv._tab.GetByte(o + rcv._tab.Pos))
+	}
+	return 0
+}
+
+func (rcv *EllipsisNode) MutateEltType(n Expr) bool {
+	return rcv._tab.MutateByteSlot(8, byte(n))
+}
+
+func (rcv *EllipsisNode) Elt(obj *flatbuffers.Table) bool {
+	o := flatbuffers.UOffsetT(rcv._tab.Offset(10))
+	if o != 0 {
+		rcv._tab.Union(obj, o)
+		return true
+	}
+	return false
+}
+
+func EllipsisNodeStart(builder *flatbuffers.Builder) {
+	builder.StartObject(4)
+}
+func EllipsisNodeAddRefId(builder *flatbuffers.Builder, refId int32) {
+	builder.PrependInt32Slot(0, refId, 0)
+}
+func EllipsisNodeAddEllipsis(builder *flatbuffers.Builder, ellipsis flatbuffers.UOffsetT) {
+	builder.PrependStructSlot(1, flatbuffers.UOffsetT(ellipsis), 0)
+}
+func EllipsisNodeAddEltType(builder *flatbuffers.Builder, eltType Expr) {
+	builder.PrependByteSlot(2, byte(eltType), 0)
+}
+func EllipsisNodeAddElt(builder *flatbuffers.Builder, elt flatbuffers.UOffsetT) {
+	builder.PrependUOffsetTSlot(3, flatbuffers.UOffsetT(elt), 0)
+}
+func EllipsisNodeEnd(builder *flatbuffers.Builder) flatbuffers.UOffsetT {
+	return builder.EndObject()
+}
@@ -0,0 +1,95 @@
+// Code generated by the FlatBuffers compiler. DO NOT EDIT.
+
+package astfb
+
+import (
+	flatbuffers "github.com/google/flatbuffers/go"
+)
+
+type EmptyStmtNode struct {
+	_tab flatbuffers.Table
+}
+
+func GetRootAsEmptyStmtNode(buf []byte, offset flatbuffers.UOffsetT) *EmptyStmtNode {
+	n := flatbuffers.GetUOffsetT(buf[offset:])
+	x := &EmptyStmtNode{}
+	x.Init(buf, n+offset)
+	return x
+}
+
+func FinishEmptyStmtNodeBuffer(builder *flatbuffers.Builder, offset flatbuffers.UOffsetT) {
+	builder.Finish(offset)
+}
+
+func GetSizePrefixedRootAsEmptyStmtNode(buf []byte, offset flatbuffers.UOffsetT) *EmptyStmtNode {
+	n := flatbuffers.GetUOffsetT(buf[offset+flatbuffers.SizeUint32:])
+	x := &EmptyStmtNode{}
+	x.Init(buf, n+offset+flatbuffers.SizeUint32)
+	return x
+}
+
+func FinishSizePrefixedEmptyStmtNodeBuffer(builder *flatbuffers.Builder, offset flatbuffers.UOffsetT) {
+	builder.FinishSizePrefixed(offset)
+}
+
+func (rcv *EmptyStmtNode) Init(buf []byte, i flatbuffers.UOffsetT) {
+	rcv._tab.Bytes = buf
+	rcv._tab.Pos = i
+}
+
+func (rcv *EmptyStmtNode) Table() flatbuffers.Table {
+	return rcv._tab
+}
+
+func (rcv *EmptyStmtNode) RefId() int32 {
+	o := flatbuffers.UOffsetT(rcv._tab.Offset(4))
+	if o != 0 {
+		return rcv._tab.GetInt32(o + rcv._tab.Pos)
+	}
+	return 0
+}
+
+func (rcv *EmptyStmtNode) MutateRefId(n int32) bool {
+	return rcv._tab.MutateInt32Slot(4, n)
+}
+
+func (rcv *EmptyStmtNode) Semicolon(obj *PositionNode) *PositionNode {
+	o := flatbuffers.UOffsetT(rcv._tab.Offset(6))
+	if o != 0 {
+		x := o + rcv._tab.Pos
+		if obj == nil {
+			obj = new(PositionNode)
+		}
+		obj.Init(rcv._tab.Bytes, x)
+		return obj
+	}
+	return nil
+}
+
+func (rcv *EmptyStmtNode) Implicit() bool {
+	o := flatbuffers.UOffsetT(rcv._tab.Offset(8))
+	if o != 0 {
+		return rcv._tab.GetBool(o + rcv._tab.Pos)
+	}
+	return false
+}
+
+func (rcv *EmptyStmtNode) MutateImplicit(n bool) bool {
+	return rcv._tab.MutateBoolSlot(8, n)
+}
+
+func EmptyStmtNodeStart(builder *flatbuffers.Builder) {
+	builder.StartObject(3)
+}
+func EmptyStmtNodeAddRefId(builder *flatbuffers.Builder, refId int32) {
+	builder.PrependInt32Slot(0, refId, 0)
+}
+func EmptyStmtNodeAddSemicolon(builder *flatbuffers.Builder, semicolon flatbuffers.UOffsetT) {
+	builder.PrependStructSlot(1, flatbuffers.UOffsetT(semicolon), 0)
+}
+func EmptyStmtNodeAddImplicit(builder *flatbuffers.Builder, implicit bool) {
+	builder.PrependBoolSlot(2, implicit, false)
+}
+func EmptyStmtNodeEnd(builder *flatbuffers.Builder) flatbuffers.UOffsetT {
+	return builder.EndObject()
+}
@@ -0,0 +1,95 @@
+// Code generated by the FlatBuffers compiler. DO NOT EDIT.
+
+package astfb
+
+import "strconv"
+
+type Expr byte
+
+const (
+	ExprNONE               Expr = 0
+	ExprBadExprNode        Expr = 1
+	ExprIdentNode          Expr = 2
+	ExprEllipsisNode       Expr = 3
+	ExprBasicLitNode       Expr = 4
+	ExprFuncLitNode        Expr = 5
+	ExprCompositeLitNode   Expr = 6
+	ExprParenExprNode      Expr = 7
+	ExprSelectorExprNode   Expr = 8
+	ExprIndexExprNode      Expr = 9
+	ExprIndexListExprNode  Expr = 10
+	ExprSliceExprNode      Expr = 11
+	ExprTypeAssertExprNode Expr = 12
+	ExprCallExprNode       Expr = 13
+	ExprStarExprNode       Expr = 14
+	ExprUnaryExprNode      Expr = 15
+	ExprBinaryExprNode     Expr = 16
+	ExprKeyValueExprNode   Expr = 17
+	ExprArrayTypeNode      Expr = 18
+	ExprStructTypeNode     Expr = 19
+	ExprFuncTypeNode       Expr = 20
+	ExprInterfaceTypeNode  Expr = 21
+	ExprMapTypeNode        Expr = 22
+	ExprChanTypeNode       Expr = 23
+)
+
+var EnumNamesExpr = map[Expr]string{
+	ExprNONE:               "NONE",
+	ExprBadExprNode:        "BadExprNode",
+	ExprIdentNode:          "IdentNode",
+	ExprEllipsisNode:       "EllipsisNode",
+	ExprBasicLitNode:       "BasicLitNode",
+	ExprFuncLitNode:        "FuncLitNode",
+	ExprCompositeLitNode:   "CompositeLitNode",
+	ExprParenExprNode:      "ParenExprNode",
+	ExprSelectorExprNode:   "SelectorExprNode",
+	ExprIndexExprNode:      "IndexExprNode",
+	ExprIndexListExprNode:  "IndexListExprNode",
+	ExprSliceExprNode:      "SliceExprNode",
+	ExprTypeAssertExprNode: "TypeAssertExprNode",
+	ExprCallExprNode:       "CallExprNode",
+	ExprStarExprNode:       "StarExprNode",
+	ExprUnaryExprNode:      "UnaryExprNode",
+	ExprBinaryExprNode:     "BinaryExprNode",
+	ExprKeyValueExprNode:   "KeyValueExprNode",
+	ExprArrayTypeNode:      "ArrayTypeNode",
+	ExprStructTypeNode:     "StructTypeNode",
+	ExprFuncTypeNode:       "FuncTypeNode",
+	ExprInterfaceTypeNode:  "InterfaceTypeNode",
+	ExprMapTypeNode:        "MapTypeNode",
+	ExprChanTypeNode:       "ChanTypeNode",
+}
+
+var EnumValuesExpr = map[string]Expr{
+	"NONE":               ExprNONE,
+	"BadExprNode":        ExprBadExprNode,
+	"IdentNode":          ExprIdentNode,
+	"EllipsisNode":       ExprEllipsisNode,
+	"BasicLitNode":       ExprBasicLitNode,
+	"FuncLitNode":        ExprFuncLitNode,
+	"CompositeLitNode":   ExprCompositeLitNode,
+	"ParenExprNode":      ExprParenExprNode,
+	"SelectorExprNode":   ExprSelectorExprNode,
+	"IndexExprNode":      ExprIndexExprNode,
+	"IndexListExprNode":  ExprIndexListExprNode,
+	"SliceExprNode":      ExprSliceExprNode,
+	"TypeAssertExprNode": ExprTypeAssertExprNode,
+	"CallExprNode":       ExprCallExprNode,
+	"StarExprNode":       ExprStarExprNode,
+	"UnaryExprNode":      ExprUnaryExprNode,
+	"BinaryExprNode":     ExprBinaryExprNode,
+	"KeyValueExprNode":   ExprKeyValueExprNode,
+	"ArrayTypeNode":      ExprArrayTypeNode,
+	"StructTypeNode":     ExprStructTypeNode,
+	"FuncTypeNode":       ExprFuncTypeNode,
+	"InterfaceTypeNode":  ExprInterfaceTypeNode,
+	"MapTypeNode":        ExprMapTypeNode,
+	"ChanTypeNode":       ExprChanTypeNode,
+}
+
+func (v Expr) String() string {
+	if s, ok := EnumNamesExpr[v]; ok {
+		return s
+	}
+	return "Expr(" + strconv.FormatInt(int64(v), 10) + ")"
+}
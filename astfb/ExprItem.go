@@ -0,0 +1,76 @@
+// Code generated by the FlatBuffers compiler. DO NOT EDIT.
+
+package astfb
+
+import (
+	flatbuffers "github.com/google/flatbuffers/go"
+)
+
+type ExprItem struct {
+	_tab flatbuffers.Table
+}
+
+func GetRootAsExprItem(buf []byte, offset flatbuffers.UOffsetT) *ExprItem {
+	n := flatbuffers.GetUOffsetT(buf[offset:])
+	x := &ExprItem{}
+	x.Init(buf, n+offset)
+	return x
+}
+
+func FinishExprItemBuffer(builder *flatbuffers.Builder, offset flatbuffers.UOffsetT) {
+	builder.Finish(offset)
+}
+
+func GetSizePrefixedRootAsExprItem(buf []byte, offset flatbuffers.UOffsetT) *ExprItem {
+	n := flatbuffers.GetUOffsetT(buf[offset+flatbuffers.SizeUint32:])
+	x := &ExprItem{}
+	x.Init(buf, n+offset+flatbuffers.SizeUint32)
+	return x
+}
+
+func FinishSizePrefixedExprItemBuffer(builder *flatbuffers.Builder, offset flatbuffers.UOffsetT) {
+	builder.FinishSizePrefixed(offset)
+}
+
+func (rcv *ExprItem) Init(buf []byte, i flatbuffers.UOffsetT) {
+	rcv._tab.Bytes = buf
+	rcv._tab.Pos = i
+}
+
+func (rcv *ExprItem) Table() flatbuffers.Table {
+	return rcv._tab
+}
+
+func (rcv *ExprItem) NodeType() Expr {
+	o := flatbuffers.UOffsetT(rcv._tab.Offset(4))
+	if o != 0 {
+		return Expr(rcv._tab.GetByte(o + rcv._tab.Pos))
+	}
+	return 0
+}
+
+func (rcv *ExprItem) MutateNodeType(n Expr) bool {
+	return rcv._tab.MutateByteSlot(4, byte(n))
+}
+
+func (rcv *ExprItem) Node(obj *flatbuffers.Table) bool {
+	o := flatbuffers.UOffsetT(rcv._tab.Offset(6))
+	if o != 0 {
+		rcv._tab.Union(obj, o)
+		return true
+	}
+	return false
+}
+
+func ExprItemStart(builder *flatbuffers.Builder) {
+	builder.StartObject(2)
+}
+func ExprItemAddNodeType(builder *flatbuffers.Builder, nodeType Expr) {
+	builder.PrependByteSlot(0, byte(nodeType), 0)
+}
+func ExprItemAddNode(builder *flatbuffers.Builder, node flatbuffers.UOffsetT) {
+	builder.PrependUOffsetTSlot(1, flatbuffers.UOffsetT(node), 0)
+}
+func ExprItemEnd(builder *flatbuffers.Builder) flatbuffers.UOffsetT {
+	return builder.EndObject()
+}
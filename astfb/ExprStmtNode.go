@@ -0,0 +1,91 @@
+// Code generated by the FlatBuffers compiler. DO NOT EDIT.
+
+package astfb
+
+import (
+	flatbuffers "github.com/google/flatbuffers/go"
+)
+
+type ExprStmtNode struct {
+	_tab flatbuffers.Table
+}
+
+func GetRootAsExprStmtNode(buf []byte, offset flatbuffers.UOffsetT) *ExprStmtNode {
+	n := flatbuffers.GetUOffsetT(buf[offset:])
+	x := &ExprStmtNode{}
+	x.Init(buf, n+offset)
+	return x
+}
+
+func FinishExprStmtNodeBuffer(builder *flatbuffers.Builder, offset flatbuffers.UOffsetT) {
+	builder.Finish(offset)
+}
+
+func GetSizePrefixedRootAsExprStmtNode(buf []byte, offset flatbuffers.UOffsetT) *ExprStmtNode {
+	n := flatbuffers.GetUOffsetT(buf[offset+flatbuffers.SizeUint32:])
+	x := &ExprStmtNode{}
+	x.Init(buf, n+offset+flatbuffers.SizeUint32)
+	return x
+}
+
+func FinishSizePrefixedExprStmtNodeBuffer(builder *flatbuffers.Builder, offset flatbuffers.UOffsetT) {
+	builder.FinishSizePrefixed(offset)
+}
+
+func (rcv *ExprStmtNode) Init(buf []byte, i flatbuffers.UOffsetT) {
+	rcv._tab.Bytes = buf
+	rcv._tab.Pos = i
+}
+
+func (rcv *ExprStmtNode) Table() flatbuffers.Table {
+	return rcv._tab
+}
+
+func (rcv *ExprStmtNode) RefId() int32 {
+	o := flatbuffers.UOffsetT(rcv._tab.Offset(4))
+	if o != 0 {
+		return rcv._tab.GetInt32(o + rcv._tab.Pos)
+	}
+	return 0
+}
+
+func (rcv *ExprStmtNode) MutateRefId(n int32) bool {
+	return rcv._tab.MutateInt32Slot(4, n)
+}
+
+func (rcv *ExprStmtNode) XType() Expr {
+	o := flatbuffers.UOffsetT(rcv._tab.Offset(6))
+	if o != 0 {
+		return Expr(rcv._tab.GetByte(o + rcv._tab.Pos))
+	}
+	return 0
+}
+
+func (rcv *ExprStmtNode) MutateXType(n Expr) bool {
+	return rcv._tab.MutateByteSlot(6, byte(n))
+}
+
+func (rcv *ExprStmtNode) X(obj *flatbuffers.Table) bool {
+	o := flatbuffers.UOffsetT(rcv._tab.Offset(8))
+	if o != 0 {
+		rcv._tab.Union(obj, o)
+		return true
+	}
+	return false
+}
+
+func ExprStmtNodeStart(builder *flatbuffers.Builder) {
+	builder.StartObject(3)
+}
+func ExprStmtNodeAddRefId(builder *flatbuffers.Builder, refId int32) {
+	builder.PrependInt32Slot(0, refId, 0)
+}
+func ExprStmtNodeAddXType(builder *flatbuffers.Builder, xType Expr) {
+	builder.PrependByteSlot(1, byte(xType), 0)
+}
+func ExprStmtNodeAddX(builder *flatbuffers.Builder, x flatbuffers.UOffsetT) {
+	builder.PrependUOffsetTSlot(2, flatbuffers.UOffsetT(x), 0)
+}
+func ExprStmtNodeEnd(builder *flatbuffers.Builder) flatbuffers.UOffsetT {
+	return builder.EndObject()
+}
@@ -0,0 +1,82 @@
+// Code generated by the FlatBuffers compiler. DO NOT EDIT.
+
+package astfb
+
+import (
+	flatbuffers "github.com/google/flatbuffers/go"
+)
+
+type FileMetaNode struct {
+	_tab flatbuffers.Table
+}
+
+func GetRootAsFileMetaNode(buf []byte, offset flatbuffers.UOffsetT) *FileMetaNode {
+	n := flatbuffers.GetUOffsetT(buf[offset:])
+	x := &FileMetaNode{}
+	x.Init(buf, n+offset)
+	return x
+}
+
+func FinishFileMetaNodeBuffer(builder *flatbuffers.Builder, offset flatbuffers.UOffsetT) {
+	builder.Finish(offset)
+}
+
+func GetSizePrefixedRootAsFileMetaNode(buf []byte, offset flatbuffers.UOffsetT) *FileMetaNode {
+	n := flatbuffers.GetUOffsetT(buf[offset+flatbuffers.SizeUint32:])
+	x := &FileMetaNode{}
+	x.Init(buf, n+offset+flatbuffers.SizeUint32)
+	return x
+}
+
+func FinishSizePrefixedFileMetaNodeBuffer(builder *flatbuffers.Builder, offset flatbuffers.UOffsetT) {
+	builder.FinishSizePrefixed(offset)
+}
+
+func (rcv *FileMetaNode) Init(buf []byte, i flatbuffers.UOffsetT) {
+	rcv._tab.Bytes = buf
+	rcv._tab.Pos = i
+}
+
+func (rcv *FileMetaNode) Table() flatbuffers.Table {
+	return rcv._tab
+}
+
+func (rcv *FileMetaNode) Path() []byte {
+	o := flatbuffers.UOffsetT(rcv._tab.Offset(4))
+	if o != 0 {
+		return rcv._tab.ByteVector(o + rcv._tab.Pos)
+	}
+	return nil
+}
+
+func (rcv *FileMetaNode) Category() []byte {
+	o := flatbuffers.UOffsetT(rcv._tab.Offset(6))
+	if o != 0 {
+		return rcv._tab.ByteVector(o + rcv._tab.Pos)
+	}
+	return nil
+}
+
+func (rcv *FileMetaNode) Constraint() []byte {
+	o := flatbuffers.UOffsetT(rcv._tab.Offset(8))
+	if o != 0 {
+		return rcv._tab.ByteVector(o + rcv._tab.Pos)
+	}
+	return nil
+}
+
+func FileMetaNodeStart(builder *flatbuffers.Builder) {
+	builder.StartObject(3)
+}
+func FileMetaNodeAddPath(builder *flatbuffers.Builder, path flatbuffers.UOffsetT) {
+	builder.PrependUOffsetTSlot(0, flatbuffers.UOffsetT(path), 0)
+}
+func FileMetaNodeAddCategory(builder *flatbuffers.Builder, category flatbuffers.UOffsetT) {
+	builder.PrependUOffsetTSlot(1, flatbuffers.UOffsetT(category), 0)
+}
+func FileMetaNodeAddConstraint(builder *flatbuffers.Builder, constraint flatbuffers.UOffsetT) {
+	builder.PrependUOffsetTSlot(2, flatbuffers.UOffsetT(constraint), 0)
+}
+func FileMetaNodeEnd(builder *flatbuffers.Builder) flatbuffers.UOffsetT {
+	return builder.EndObject()
+}
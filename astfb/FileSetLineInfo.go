@@ -0,0 +1,105 @@
+// Code generated by the FlatBuffers compiler. DO NOT EDIT.
+
+package astfb
+
+import (
+	flatbuffers "github.com/google/flatbuffers/go"
+)
+
+type FileSetLineInfo struct {
+	_tab flatbuffers.Table
+}
+
+func GetRootAsFileSetLineInfo(buf []byte, offset flatbuffers.UOffsetT) *FileSetLineInfo {
+	n := flatbuffers.GetUOffsetT(buf[offset:])
+	x := &FileSetLineInfo{}
+	x.Init(buf, n+offset)
+	return x
+}
+
+func FinishFileSetLineInfoBuffer(builder *flatbuffers.Builder, offset flatbuffers.UOffsetT) {
+	builder.Finish(offset)
+}
+
+func GetSizePrefixedRootAsFileSetLineInfo(buf []byte, offset flatbuffers.UOffsetT) *FileSetLineInfo {
+	n := flatbuffers.GetUOffsetT(buf[offset+flatbuffers.SizeUint32:])
+	x := &FileSetLineInfo{}
+	x.Init(buf, n+offset+flatbuffers.SizeUint32)
+	return x
+}
+
+func FinishSizePrefixedFileSetLineInfoBuffer(builder *flatbuffers.Builder, offset flatbuffers.UOffsetT) {
+	builder.FinishSizePrefixed(offset)
+}
+
+func (rcv *FileSetLineInfo) Init(buf []byte, i flatbuffers.UOffsetT) {
+	rcv._tab.Bytes = buf
+	rcv._tab.Pos = i
+}
+
+func (rcv *FileSetLineInfo) Table() flatbuffers.Table {
+	return rcv._tab
+}
+
+func (rcv *FileSetLineInfo) Offset() int32 {
+	o := flatbuffers.UOffsetT(rcv._tab.Offset(4))
+	if o != 0 {
+		return rcv._tab.GetInt32(o + rcv._tab.Pos)
+	}
+	return 0
+}
+
+func (rcv *FileSetLineInfo) MutateOffset(n int32) bool {
+	return rcv._tab.MutateInt32Slot(4, n)
+}
+
+func (rcv *FileSetLineInfo) Filename() []byte {
+	o := flatbuffers.UOffsetT(rcv._tab.Offset(6))
+	if o != 0 {
+		return rcv._tab.ByteVector(o + rcv._tab.Pos)
+	}
+	return nil
+}
+
+func (rcv *FileSetLineInfo) Line() int32 {
+	o := flatbuffers.UOffsetT(rcv._tab.Offset(8))
+	if o != 0 {
+		return rcv._tab.GetInt32(o + rcv._tab.Pos)
+	}
+	return 0
+}
+
+func (rcv *FileSetLineInfo) MutateLine(n int32) bool {
+	return rcv._tab.MutateInt32Slot(8, n)
+}
+
+func (rcv *FileSetLineInfo) Column() int32 {
+	o := flatbuffers.UOffsetT(rcv._tab.Offset(10))
+	if o != 0 {
+		return rcv._tab.GetInt32(o + rcv._tab.Pos)
+	}
+	return 0
+}
+
+func (rcv *FileSetLineInfo) MutateColumn(n int32) bool {
+	return rcv._tab.MutateInt32Slot(10, n)
+}
+
+func FileSetLineInfoStart(builder *flatbuffers.Builder) {
+	builder.StartObject(4)
+}
+func FileSetLineInfoAddOffset(builder *flatbuffers.Builder, offset int32) {
+	builder.PrependInt32Slot(0, offset, 0)
+}
+func FileSetLineInfoAddFilename(builder *flatbuffers.Builder, filename flatbuffers.UOffsetT) {
+	builder.PrependUOffsetTSlot(1, flatbuffers.UOffsetT(filename), 0)
+}
+func FileSetLineInfoAddLine(builder *flatbuffers.Builder, line int32) {
+	builder.PrependInt32Slot(2, line, 0)
+}
+func FileSetLineInfoAddColumn(builder *flatbuffers.Builder, column int32) {
+	builder.PrependInt32Slot(3, column, 0)
+}
+func FileSetLineInfoEnd(builder *flatbuffers.Builder) flatbuffers.UOffsetT {
+	return builder.EndObject()
+}
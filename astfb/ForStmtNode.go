@@ -0,0 +1,177 @@
+// Code generated by the FlatBuffers compiler. DO NOT EDIT.
+
+package astfb
+
+import (
+	flatbuffers "github.com/google/flatbuffers/go"
+)
+
+type ForStmtNode struct {
+	_tab flatbuffers.Table
+}
+
+func GetRootAsForStmtNode(buf []byte, offset flatbuffers.UOffsetT) *ForStmtNode {
+	n := flatbuffers.GetUOffsetT(buf[offset:])
+	x := &ForStmtNode{}
+	x.Init(buf, n+offset)
+	return x
+}
+
+func FinishForStmtNodeBuffer(builder *flatbuffers.Builder, offset flatbuffers.UOffsetT) {
+	builder.Finish(offset)
+}
+
+func GetSizePrefixedRootAsForStmtNode(buf []byte, offset flatbuffers.UOffsetT) *ForStmtNode {
+	n := flatbuffers.GetUOffsetT(buf[offset+flatbuffers.SizeUint32:])
+	x := &ForStmtNode{}
+	x.Init(buf, n+offset+flatbuffers.SizeUint32)
+	return x
+}
+
+func FinishSizePrefixedForStmtNodeBuffer(builder *flatbuffers.Builder, offset flatbuffers.UOffsetT) {
+	builder.FinishSizePrefixed(offset)
+}
+
+func (rcv *ForStmtNode) Init(buf []byte, i flatbuffers.UOffsetT) {
+	rcv._tab.Bytes = buf
+	rcv._tab.Pos = i
+}
+
+func (rcv *ForStmtNode) Table() flatbuffers.Table {
+	return rcv._tab
+}
+
+func (rcv *ForStmtNode) RefId() int32 {
+	o := flatbuffers.UOffsetT(rcv._tab.Offset(4))
+	if o != 0 {
+		return rcv._tab.GetInt32(o + rcv._tab.Pos)
+	}
+	return 0
+}
+
+func (rcv *ForStmtNode) MutateRefId(n int32) bool {
+	return rcv._tab.MutateInt32Slot(4, n)
+}
+
+func (rcv *ForStmtNode) For(obj *PositionNode) *PositionNode {
+	o := flatbuffers.UOffsetT(rcv._tab.Offset(6))
+	if o != 0 {
+		x := o + rcv._tab.Pos
+		if obj == nil {
+			obj = new(PositionNode)
+		}
+		obj.Init(rcv._tab.Bytes, x)
+		return obj
+	}
+	return nil
+}
+
+func (rcv *ForStmtNode) InitStmtType() Stmt {
+	o := flatbuffers.UOffsetT(rcv._tab.Offset(8))
+	if o != 0 {
+		return Stmt(rcv._tab.GetByte(o + rcv._tab.Pos))
+	}
+	return 0
+}
+
+func (rcv *ForStmtNode) MutateInitStmtType(n Stmt) bool {
+	return rcv._tab.MutateByteSlot(8, byte(n))
+}
+
+func (rcv *ForStmtNode) InitStmt(obj *flatbuffers.Table) bool {
+	o := flatbuffers.UOffsetT(rcv._tab.Offset(10))
+	if o != 0 {
+		rcv._tab.Union(obj, o)
+		return true
+	}
+	return false
+}
+
+func (rcv *ForStmtNode) CondType() Expr {
+	o := flatbuffers.UOffsetT(rcv._tab.Offset(12))
+	if o != 0 {
+		return Expr(rcv._tab.GetByte(o + rcv._tab.Pos))
+	}
+	return 0
+}
+
+func (rcv *ForStmtNode) MutateCondType(n Expr) bool {
+	return rcv._tab.MutateByteSlot(12, byte(n))
+}
+
+func (rcv *ForStmtNode) Cond(obj *flatbuffers.Table) bool {
+	o := flatbuffers.UOffsetT(rcv._tab.Offset(14))
+	if o != 0 {
+		rcv._tab.Union(obj, o)
+		return true
+	}
+	return false
+}
+
+func (rcv *ForStmtNode) PostType() Stmt {
+	o := flatbuffers.UOffsetT(rcv._tab.Offset(16))
+	if o != 0 {
+		return Stmt(rcv._tab.GetByte(o + rcv._tab.Pos))
+	}
+	return 0
+}
+
+func (rcv *ForStmtNode) MutatePostType(n Stmt) bool {
+	return rcv._tab.MutateByteSlot(16, byte(n))
+}
+
+func (rcv *ForStmtNode) Post(obj *flatbuffers.Table) bool {
+	o := flatbuffers.UOffsetT(rcv._tab.Offset(18))
+	if o != 0 {
+		rcv._tab.Union(obj, o)
+		return true
+	}
+	return false
+}
+
+func (rcv *ForStmtNode) Body(obj *BlockStmtNode) *BlockStmtNode {
+	o := flatbuffers.UOffsetT(rcv._tab.Offset(20))
+	if o != 0 {
+		x := rcv._tab.Indirect(o + rcv._tab.Pos)
+		if obj == nil {
+			obj = new(BlockStmtNode)
+		}
+		obj.Init(rcv._tab.Bytes, x)
+		return obj
+	}
+	return nil
+}
+
+func ForStmtNodeStart(builder *flatbuffers.Builder) {
+	builder.StartObject(9)
+}
+func ForStmtNodeAddRefId(builder *flatbuffers.Builder, refId int32) {
+	builder.PrependInt32Slot(0, refId, 0)
+}
+func ForStmtNodeAddFor(builder *flatbuffers.Builder, for_ flatbuffers.UOffsetT) {
+	builder.PrependStructSlot(1, flatbuffers.UOffsetT(for_), 0)
+}
+func ForStmtNodeAddInitStmtType(builder *flatbuffers.Builder, initStmtType Stmt) {
+	builder.PrependByteSlot(2, byte(initStmtType), 0)
+}
+func ForStmtNodeAddInitStmt(builder *flatbuffers.Builder, initStmt flatbuffers.UOffsetT) {
+	builder.PrependUOffsetTSlot(3, flatbuffers.UOffsetT(initStmt), 0)
+}
+func ForStmtNodeAddCondType(builder *flatbuffers.Builder, condType Expr) {
+	builder.PrependByteSlot(4, byte(condType), 0)
+}
+func ForStmtNodeAddCond(builder *flatbuffers.Builder, cond flatbuffers.UOffsetT) {
+	builder.PrependUOffsetTSlot(5, flatbuffers.UOffsetT(cond), 0)
+}
+func ForStmtNodeAddPostType(builder *flatbuffers.Builder, postType Stmt) {
+	builder.PrependByteSlot(6, byte(postType), 0)
+}
+func ForStmtNodeAddPost(builder *flatbuffers.Builder, post flatbuffers.UOffsetT) {
+	builder.PrependUOffsetTSlot(7, flatbuffers.UOffsetT(post), 0)
+}
+func ForStmtNodeAddBody(builder *flatbuffers.Builder, body flatbuffers.UOffsetT) {
+	builder.PrependUOffsetTSlot(8, flatbuffers.UOffsetT(body), 0)
+}
+func ForStmtNodeEnd(builder *flatbuffers.Builder) flatbuffers.UOffsetT {
+	return builder.EndObject()
+}
@@ -0,0 +1,177 @@
+// Code generated by the FlatBuffers compiler. DO NOT EDIT.
+
+package astfb
+
+import (
+	flatbuffers "github.com/google/flatbuffers/go"
+)
+
+type IfStmtNode struct {
+	_tab flatbuffers.Table
+}
+
+func GetRootAsIfStmtNode(buf []byte, offset flatbuffers.UOffsetT) *IfStmtNode {
+	n := flatbuffers.GetUOffsetT(buf[offset:])
+	x := &IfStmtNode{}
+	x.Init(buf, n+offset)
+	return x
+}
+
+func FinishIfStmtNodeBuffer(builder *flatbuffers.Builder, offset flatbuffers.UOffsetT) {
+	builder.Finish(offset)
+}
+
+func GetSizePrefixedRootAsIfStmtNode(buf []byte, offset flatbuffers.UOffsetT) *IfStmtNode {
+	n := flatbuffers.GetUOffsetT(buf[offset+flatbuffers.SizeUint32:])
+	x := &IfStmtNode{}
+	x.Init(buf, n+offset+flatbuffers.SizeUint32)
+	return x
+}
+
+func FinishSizePrefixedIfStmtNodeBuffer(builder *flatbuffers.Builder, offset flatbuffers.UOffsetT) {
+	builder.FinishSizePrefixed(offset)
+}
+
+func (rcv *IfStmtNode) Init(buf []byte, i flatbuffers.UOffsetT) {
+	rcv._tab.Bytes = buf
+	rcv._tab.Pos = i
+}
+
+func (rcv *IfStmtNode) Table() flatbuffers.Table {
+	return rcv._tab
+}
+
+func (rcv *IfStmtNode) RefId() int32 {
+	o := flatbuffers.UOffsetT(rcv._tab.Offset(4))
+	if o != 0 {
+		return rcv._tab.GetInt32(o + rcv._tab.Pos)
+	}
+	return 0
+}
+
+func (rcv *IfStmtNode) MutateRefId(n int32) bool {
+	return rcv._tab.MutateInt32Slot(4, n)
+}
+
+func (rcv *IfStmtNode) If(obj *PositionNode) *PositionNode {
+	o := flatbuffers.UOffsetT(rcv._tab.Offset(6))
+	if o != 0 {
+		x := o + rcv._tab.Pos
+		if obj == nil {
+			obj = new(PositionNode)
+		}
+		obj.Init(rcv._tab.Bytes, x)
+		return obj
+	}
+	return nil
+}
+
+func (rcv *IfStmtNode) InitStmtType() Stmt {
+	o := flatbuffers.UOffsetT(rcv._tab.Offset(8))
+	if o != 0 {
+		return Stmt(rcv._tab.GetByte(o + rcv._tab.Pos))
+	}
+	return 0
+}
+
+func (rcv *IfStmtNode) MutateInitStmtType(n Stmt) bool {
+	return rcv._tab.MutateByteSlot(8, byte(n))
+}
+
+func (rcv *IfStmtNode) InitStmt(obj *flatbuffers.Table) bool {
+	o := flatbuffers.UOffsetT(rcv._tab.Offset(10))
+	if o != 0 {
+		rcv._tab.Union(obj, o)
+		return true
+	}
+	return false
+}
+
+func (rcv *IfStmtNode) CondType() Expr {
+	o := flatbuffers.UOffsetT(rcv._tab.Offset(12))
+	if o != 0 {
+		return Expr(rcv._tab.GetByte(o + rcv._tab.Pos))
+	}
+	return 0
+}
+
+func (rcv *IfStmtNode) MutateCondType(n Expr) bool {
+	return rcv._tab.MutateByteSlot(12, byte(n))
+}
+
+func (rcv *IfStmtNode) Cond(obj *flatbuffers.Table) bool {
+	o := flatbuffers.UOffsetT(rcv._tab.Offset(14))
+	if o != 0 {
+		rcv._tab.Union(obj, o)
+		return true
+	}
+	return false
+}
+
+func (rcv *IfStmtNode) Body(obj *BlockStmtNode) *BlockStmtNode {
+	o := flatbuffers.UOffsetT(rcv._tab.Offset(16))
+	if o != 0 {
+		x := rcv._tab.Indirect(o + rcv._tab.Pos)
+		if obj == nil {
+			obj = new(BlockStmtNode)
+		}
+		obj.Init(rcv._tab.Bytes, x)
+		return obj
+	}
+	return nil
+}
+
+func (rcv *IfStmtNode) ElseType() Stmt {
+	o := flatbuffers.UOffsetT(rcv._tab.Offset(18))
+	if o != 0 {
+		return Stmt(rcv._tab.GetByte(o + rcv._tab.Pos))
+	}
+	return 0
+}
+
+func (rcv *IfStmtNode) MutateElseType(n Stmt) bool {
+	return rcv._tab.MutateByteSlot(18, byte(n))
+}
+
+func (rcv *IfStmtNode) Else(obj *flatbuffers.Table) bool {
+	o := flatbuffers.UOffsetT(rcv._tab.Offset(20))
+	if o != 0 {
+		rcv._tab.Union(obj, o)
+		return true
+	}
+	return false
+}
+
+func IfStmtNodeStart(builder *flatbuffers.Builder) {
+	builder.StartObject(9)
+}
+func IfStmtNodeAddRefId(builder *flatbuffers.Builder, refId int32) {
+	builder.PrependInt32Slot(0, refId, 0)
+}
+func IfStmtNodeAddIf(builder *flatbuffers.Builder, if_ flatbuffers.UOffsetT) {
+	builder.PrependStructSlot(1, flatbuffers.UOffsetT(if_), 0)
+}
+func IfStmtNodeAddInitStmtType(builder *flatbuffers.Builder, initStmtType Stmt) {
+	builder.PrependByteSlot(2, byte(initStmtType), 0)
+}
+func IfStmtNodeAddInitStmt(builder *flatbuffers.Builder, initStmt flatbuffers.UOffsetT) {
+	builder.PrependUOffsetTSlot(3, flatbuffers.UOffsetT(initStmt), 0)
+}
+func IfStmtNodeAddCondType(builder *flatbuffers.Builder, condType Expr) {
+	builder.PrependByteSlot(4, byte(condType), 0)
+}
+func IfStmtNodeAddCond(builder *flatbuffers.Builder, cond flatbuffers.UOffsetT) {
+	builder.PrependUOffsetTSlot(5, flatbuffers.UOffsetT(cond), 0)
+}
+func IfStmtNodeAddBody(builder *flatbuffers.Builder, body flatbuffers.UOffsetT) {
+	builder.PrependUOffsetTSlot(6, flatbuffers.UOffsetT(body), 0)
+}
+func IfStmtNodeAddElseType(builder *flatbuffers.Builder, elseType Stmt) {
+	builder.PrependByteSlot(7, byte(elseType), 0)
+}
+func IfStmtNodeAddElse(builder *flatbuffers.Builder, else_ flatbuffers.UOffsetT) {
+	builder.PrependUOffsetTSlot(8, flatbuffers.UOffsetT(else_), 0)
+}
+func IfStmtNodeEnd(builder *flatbuffers.Builder) flatbuffers.UOffsetT {
+	return builder.EndObject()
+}
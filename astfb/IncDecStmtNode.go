@@ -0,0 +1,118 @@
+// Code generated by the FlatBuffers compiler. DO NOT EDIT.
+
+package astfb
+
+import (
+	flatbuffers "github.com/google/flatbuffers/go"
+)
+
+type IncDecStmtNode struct {
+	_tab flatbuffers.Table
+}
+
+func GetRootAsIncDecStmtNode(buf []byte, offset flatbuffers.UOffsetT) *IncDecStmtNode {
+	n := flatbuffers.GetUOffsetT(buf[offset:])
+	x := &IncDecStmtNode{}
+	x.Init(buf, n+offset)
+	return x
+}
+
+func FinishIncDecStmtNodeBuffer(builder *flatbuffers.Builder, offset flatbuffers.UOffsetT) {
+	builder.Finish(offset)
+}
+
+func GetSizePrefixedRootAsIncDecStmtNode(buf []byte, offset flatbuffers.UOffsetT) *IncDecStmtNode {
+	n := flatbuffers.GetUOffsetT(buf[offset+flatbuffers.SizeUint32:])
+	x := &IncDecStmtNode{}
+	x.Init(buf, n+offset+flatbuffers.SizeUint32)
+	return x
+}
+
+func FinishSizePrefixedIncDecStmtNodeBuffer(builder *flatbuffers.Builder, offset flatbuffers.UOffsetT) {
+	builder.FinishSizePrefixed(offset)
+}
+
+func (rcv *IncDecStmtNode) Init(buf []byte, i flatbuffers.UOffsetT) {
+	rcv._tab.Bytes = buf
+	rcv._tab.Pos = i
+}
+
+func (rcv *IncDecStmtNode) Table() flatbuffers.Table {
+	return rcv._tab
+}
+
+func (rcv *IncDecStmtNode) RefId() int32 {
+	o := flatbuffers.UOffsetT(rcv._tab.Offset(4))
+	if o != 0 {
+		return rcv._tab.GetInt32(o + rcv._tab.Pos)
+	}
+	return 0
+}
+
+func (rcv *IncDecStmtNode) MutateRefId(n int32) bool {
+	return rcv._tab.MutateInt32Slot(4, n)
+}
+
+func (rcv *IncDecStmtNode) XType() Expr {
+	o := flatbuffers.UOffsetT(rcv._tab.Offset(6))
+	if o != 0 {
+		return Expr(rcv._tab.GetByte(o + rcv._tab.Pos))
+	}
+	return 0
+}
+
+func (rcv *IncDecStmtNode) MutateXType(n Expr) bool {
+	return rcv._tab.MutateByteSlot(6, byte(n))
+}
+
+func (rcv *IncDecStmtNode) X(obj *flatbuffers.Table) bool {
+	o := flatbuffers.UOffsetT(rcv._tab.Offset(8))
+	if o != 0 {
+		rcv._tab.Union(obj, o)
+		return true
+	}
+	return false
+}
+
+func (rcv *IncDecStmtNode) TokPos(obj *PositionNode) *PositionNode {
+	o := flatbuffers.UOffsetT(rcv._tab.Offset(10))
+	if o != 0 {
+		x := o + rcv._tab.Pos
+		if obj == nil {
+			obj = new(PositionNode)
+		}
+		obj.Init(rcv._tab.Bytes, x)
+		return obj
+	}
+	return nil
+}
+
+func (rcv *IncDecStmtNode) Tok() []byte {
+	o := flatbuffers.UOffsetT(rcv._tab.Offset(12))
+	if o != 0 {
+		return rcv._tab.ByteVector(o + rcv._tab.Pos)
+	}
+	return nil
+}
+
+func IncDecStmtNodeStart(builder *flatbuffers.Builder) {
+	builder.StartObject(5)
+}
+func IncDecStmtNodeAddRefId(builder *flatbuffers.Builder, refId int32) {
+	builder.PrependInt32Slot(0, refId, 0)
+}
+func IncDecStmtNodeAddXType(builder *flatbuffers.Builder, xType Expr) {
+	builder.PrependByteSlot(1, byte(xType), 0)
+}
+func IncDecStmtNodeAddX(builder *flatbuffers.Builder, x flatbuffers.UOffsetT) {
+	builder.PrependUOffsetTSlot(2, flatbuffers.UOffsetT(x), 0)
+}
+func IncDecStmtNodeAddTokPos(builder *flatbuffers.Builder, tokPos flatbuffers.UOffsetT) {
+	builder.PrependStructSlot(3, flatbuffers.UOffsetT(tokPos), 0)
+}
+func IncDecStmtNodeAddTok(builder *flatbuffers.Builder, tok flatbuffers.UOffsetT) {
+	builder.PrependUOffsetTSlot(4, flatbuffers.UOffsetT(tok), 0)
+}
+func IncDecStmtNodeEnd(builder *flatbuffers.Builder) flatbuffers.UOffsetT {
+	return builder.EndObject()
+}
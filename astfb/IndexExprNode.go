@@ -0,0 +1,150 @@
+// Code generated by the FlatBuffers compiler. DO NOT EDIT.
+
+package astfb
+
+import (
+	flatbuffers "github.com/google/flatbuffers/go"
+)
+
+type IndexExprNode struct {
+	_tab flatbuffers.Table
+}
+
+func GetRootAsIndexExprNode(buf []byte, offset flatbuffers.UOffsetT) *IndexExprNode {
+	n := flatbuffers.GetUOffsetT(buf[offset:])
+	x := &IndexExprNode{}
+	x.Init(buf, n+offset)
+	return x
+}
+
+func FinishIndexExprNodeBuffer(builder *flatbuffers.Builder, offset flatbuffers.UOffsetT) {
+	builder.Finish(offset)
+}
+
+func GetSizePrefixedRootAsIndexExprNode(buf []byte, offset flatbuffers.UOffsetT) *IndexExprNode {
+	n := flatbuffers.GetUOffsetT(buf[offset+flatbuffers.SizeUint32:])
+	x := &IndexExprNode{}
+	x.Init(buf, n+offset+flatbuffers.SizeUint32)
+	return x
+}
+
+func FinishSizePrefixedIndexExprNodeBuffer(builder *flatbuffers.Builder, offset flatbuffers.UOffsetT) {
+	builder.FinishSizePrefixed(offset)
+}
+
+func (rcv *IndexExprNode) Init(buf []byte, i flatbuffers.UOffsetT) {
+	rcv._tab.Bytes = buf
+	rcv._tab.Pos = i
+}
+
+func (rcv *IndexExprNode) Table() flatbuffers.Table {
+	return rcv._tab
+}
+
+func (rcv *IndexExprNode) RefId() int32 {
+	o := flatbuffers.UOffsetT(rcv._tab.Offset(4))
+	if o != 0 {
+		return rcv._tab.GetInt32(o + rcv._tab.Pos)
+	}
+	return 0
+}
+
+func (rcv *IndexExprNode) MutateRefId(n int32) bool {
+	return rcv._tab.MutateInt32Slot(4, n)
+}
+
+func (rcv *IndexExprNode) XType() Expr {
+	o := flatbuffers.UOffsetT(rcv._tab.Offset(6))
+	if o != 0 {
+		return Expr(rcv._tab.GetByte(o + rcv._tab.Pos))
+	}
+	return 0
+}
+
+func (rcv *IndexExprNode) MutateXType(n Expr) bool {
+	return rcv._tab.MutateByteSlot(6, byte(n))
+}
+
+func (rcv *IndexExprNode) X(obj *flatbuffers.Table) bool {
+	o := flatbuffers.UOffsetT(rcv._tab.Offset(8))
+	if o != 0 {
+		rcv._tab.Union(obj, o)
+		return true
+	}
+	return false
+}
+
+func (rcv *IndexExprNode) Lbrack(obj *PositionNode) *PositionNode {
+	o := flatbuffers.UOffsetT(rcv._tab.Offset(10))
+	if o != 0 {
+		x := o + rcv._tab.Pos
+		if obj == nil {
+			obj = new(PositionNode)
+		}
+		obj.Init(rcv._tab.Bytes, x)
+		return obj
+	}
+	return nil
+}
+
+func (rcv *IndexExprNode) IndexType() Expr {
+	o := flatbuffers.UOffsetT(rcv._tab.Offset(12))
+	if o != 0 {
+		return Expr(rcv._tab.GetByte(o + rcv._tab.Pos))
+	}
+	return 0
+}
+
+func (rcv *IndexExprNode) MutateIndexType(n Expr) bool {
+	return rcv._tab.MutateByteSlot(12, byte(n))
+}
+
+func (rcv *IndexExprNode) Index(obj *flatbuffers.Table) bool {
+	o := flatbuffers.UOffsetT(rcv._tab.Offset(14))
+	if o != 0 {
+		rcv._tab.Union(obj, o)
+		return true
+	}
+	return false
+}
+
+func (rcv *IndexExprNode) Rbrack(obj *PositionNode) *PositionNode {
+	o := flatbuffers.UOffsetT(rcv._tab.Offset(16))
+	if o != 0 {
+		x := o + rcv._tab.Pos
+		if obj == nil {
+			obj = new(PositionNode)
+		}
+		obj.Init(rcv._tab.Bytes, x)
+		return obj
+	}
+	return nil
+}
+
+func IndexExprNodeStart(builder *flatbuffers.Builder) {
+	builder.StartObject(7)
+}
+func IndexExprNodeAddRefId(builder *flatbuffers.Builder, refId int32) {
+	builder.PrependInt32Slot(0, refId, 0)
+}
+func IndexExprNodeAddXType(builder *flatbuffers.Builder, xType Expr) {
+	builder.PrependByteSlot(1, byte(xType), 0)
+}
+func IndexExprNodeAddX(builder *flatbuffers.Builder, x flatbuffers.UOffsetT) {
+	builder.PrependUOffsetTSlot(2, flatbuffers.UOffsetT(x), 0)
+}
+func IndexExprNodeAddLbrack(builder *flatbuffers.Builder, lbrack flatbuffers.UOffsetT) {
+	builder.PrependStructSlot(3, flatbuffers.UOffsetT(lbrack), 0)
+}
+func IndexExprNodeAddIndexType(builder *flatbuffers.Builder, indexType Expr) {
+	builder.PrependByteSlot(4, byte(indexType), 0)
+}
+func IndexExprNodeAddIndex(builder *flatbuffers.Builder, index flatbuffers.UOffsetT) {
+	builder.PrependUOffsetTSlot(5, flatbuffers.UOffsetT(index), 0)
+}
+func IndexExprNodeAddRbrack(builder *flatbuffers.Builder, rbrack flatbuffers.UOffsetT) {
+	builder.PrependStructSlot(6, flatbuffers.UOffsetT(rbrack), 0)
+}
+func IndexExprNodeEnd(builder *flatbuffers.Builder) flatbuffers.UOffsetT {
+	return builder.EndObject()
+}
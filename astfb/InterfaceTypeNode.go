@@ -0,0 +1,111 @@
+// Code generated by the FlatBuffers compiler. DO NOT EDIT.
+
+package astfb
+
+import (
+	flatbuffers "github.com/google/flatbuffers/go"
+)
+
+type InterfaceTypeNode struct {
+	_tab flatbuffers.Table
+}
+
+func GetRootAsInterfaceTypeNode(buf []byte, offset flatbuffers.UOffsetT) *InterfaceTypeNode {
+	n := flatbuffers.GetUOffsetT(buf[offset:])
+	x := &InterfaceTypeNode{}
+	x.Init(buf, n+offset)
+	return x
+}
+
+func FinishInterfaceTypeNodeBuffer(builder *flatbuffers.Builder, offset flatbuffers.UOffsetT) {
+	builder.Finish(offset)
+}
+
+func GetSizePrefixedRootAsInterfaceTypeNode(buf []byte, offset flatbuffers.UOffsetT) *InterfaceTypeNode {
+	n := flatbuffers.GetUOffsetT(buf[offset+flatbuffers.SizeUint32:])
+	x := &InterfaceTypeNode{}
+	x.Init(buf, n+offset+flatbuffers.SizeUint32)
+	return x
+}
+
+func FinishSizePrefixedInterfaceTypeNodeBuffer(builder *flatbuffers.Builder, offset flatbuffers.UOffsetT) {
+	builder.FinishSizePrefixed(offset)
+}
+
+func (rcv *InterfaceTypeNode) Init(buf []byte, i flatbuffers.UOffsetT) {
+	rcv._tab.Bytes = buf
+	rcv._tab.Pos = i
+}
+
+func (rcv *InterfaceTypeNode) Table() flatbuffers.Table {
+	return rcv._tab
+}
+
+func (rcv *InterfaceTypeNode) RefId() int32 {
+	o := flatbuffers.UOffsetT(rcv._tab.Offset(4))
+	if o != 0 {
+		return rcv._tab.GetInt32(o + rcv._tab.Pos)
+	}
+	return 0
+}
+
+func (rcv *InterfaceTypeNode) MutateRefId(n int32) bool {
+	return rcv._tab.MutateInt32Slot(4, n)
+}
+
+func (rcv *InterfaceTypeNode) Interface(obj *PositionNode) *PositionNode {
+	o := flatbuffers.UOffsetT(rcv._tab.Offset(6))
+	if o != 0 {
+		x := o + rcv._tab.Pos
+		if obj == nil {
+			obj = new(PositionNode)
+		}
+		obj.Init(rcv._tab.Bytes, x)
+		return obj
+	}
+	return nil
+}
+
+func (rcv *InterfaceTypeNode) Methods(obj *FieldListNode) *FieldListNode {
+	o := flatbuffers.UOffsetT(rcv._tab.Offset(8))
+	if o != 0 {
+		x := rcv._tab.Indirect(o + rcv._tab.Pos)
+		if obj == nil {
+			obj = new(FieldListNode)
+		}
+		obj.Init(rcv._tab.Bytes, x)
+		return obj
+	}
+	return nil
+}
+
+func (rcv *InterfaceTypeNode) Incomplete() bool {
+	o := flatbuffers.UOffsetT(rcv._tab.Offset(10))
+	if o != 0 {
+		return rcv._tab.GetBool(o + rcv._tab.Pos)
+	}
+	return false
+}
+
+func (rcv *InterfaceTypeNode) MutateIncomplete(n bool) bool {
+	return rcv._tab.MutateBoolSlot(10, n)
+}
+
+func InterfaceTypeNodeStart(builder *flatbuffers.Builder) {
+	builder.StartObject(4)
+}
+func InterfaceTypeNodeAddRefId(builder *flatbuffers.Builder, refId int32) {
+	builder.PrependInt32Slot(0, refId, 0)
+}
+func InterfaceTypeNodeAddInterface(builder *flatbuffers.Builder, interface_ flatbuffers.UOffsetT) {
+	builder.PrependStructSlot(1, flatbuffers.UOffsetT(interface_), 0)
+}
+func InterfaceTypeNodeAddMethods(builder *flatbuffers.Builder, methods flatbuffers.UOffsetT) {
+	builder.PrependUOffsetTSlot(2, flatbuffers.UOffsetT(methods), 0)
+}
+func InterfaceTypeNodeAddIncomplete(builder *flatbuffers.Builder, incomplete bool) {
+	builder.PrependBoolSlot(3, incomplete, false)
+}
+func InterfaceTypeNodeEnd(builder *flatbuffers.Builder) flatbuffers.UOffsetT {
+	return builder.EndObject()
+}
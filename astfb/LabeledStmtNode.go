@@ -0,0 +1,123 @@
+// Code generated by the FlatBuffers compiler. DO NOT EDIT.
+
+package astfb
+
+import (
+	flatbuffers "github.com/google/flatbuffers/go"
+)
+
+type LabeledStmtNode struct {
+	_tab flatbuffers.Table
+}
+
+func GetRootAsLabeledStmtNode(buf []byte, offset flatbuffers.UOffsetT) *LabeledStmtNode {
+	n := flatbuffers.GetUOffsetT(buf[offset:])
+	x := &LabeledStmtNode{}
+	x.Init(buf, n+offset)
+	return x
+}
+
+func FinishLabeledStmtNodeBuffer(builder *flatbuffers.Builder, offset flatbuffers.UOffsetT) {
+	builder.Finish(offset)
+}
+
+func GetSizePrefixedRootAsLabeledStmtNode(buf []byte, offset flatbuffers.UOffsetT) *LabeledStmtNode {
+	n := flatbuffers.GetUOffsetT(buf[offset+flatbuffers.SizeUint32:])
+	x := &LabeledStmtNode{}
+	x.Init(buf, n+offset+flatbuffers.SizeUint32)
+	return x
+}
+
+func FinishSizePrefixedLabeledStmtNodeBuffer(builder *flatbuffers.Builder, offset flatbuffers.UOffsetT) {
+	builder.FinishSizePrefixed(offset)
+}
+
+func (rcv *LabeledStmtNode) Init(buf []byte, i flatbuffers.UOffsetT) {
+	rcv._tab.Bytes = buf
+	rcv._tab.Pos = i
+}
+
+func (rcv *LabeledStmtNode) Table() flatbuffers.Table {
+	return rcv._tab
+}
+
+func (rcv *LabeledStmtNode) RefId() int32 {
+	o := flatbuffers.UOffsetT(rcv._tab.Offset(4))
+	if o != 0 {
+		return rcv._tab.GetInt32(o + rcv._tab.Pos)
+	}
+	return 0
+}
+
+func (rcv *LabeledStmtNode) MutateRefId(n int32) bool {
+	return rcv._tab.MutateInt32Slot(4, n)
+}
+
+func (rcv *LabeledStmtNode) Label(obj *IdentNode) *IdentNode {
+	o := flatbuffers.UOffsetT(rcv._tab.Offset(6))
+	if o != 0 {
+		x := rcv._tab.Indirect(o + rcv._tab.Pos)
+		if obj == nil {
+			obj = new(IdentNode)
+		}
+		obj.Init(rcv._tab.Bytes, x)
+		return obj
+	}
+	return nil
+}
+
+func (rcv *LabeledStmtNode) Colon(obj *PositionNode) *PositionNode {
+	o := flatbuffers.UOffsetT(rcv._tab.Offset(8))
+	if o != 0 {
+		x := o + rcv._tab.Pos
+		if obj == nil {
+			obj = new(PositionNode)
+		}
+		obj.Init(rcv._tab.Bytes, x)
+		return obj
+	}
+	return nil
+}
+
+func (rcv *LabeledStmtNode) StmtType() Stmt {
+	o := flatbuffers.UOffsetT(rcv._tab.Offset(10))
+	if o != 0 {
+		return Stmt(rcv._tab.GetByte(o + rcv._tab.Pos))
+	}
+	return 0
+}
+
+func (rcv *LabeledStmtNode) MutateStmtType(n Stmt) bool {
+	return rcv._tab.MutateByteSlot(10, byte(n))
+}
+
+func (rcv *LabeledStmtNode) Stmt(obj *flatbuffers.Table) bool {
+	o := flatbuffers.UOffsetT(rcv._tab.Offset(12))
+	if o != 0 {
+		rcv._tab.Union(obj, o)
+		return true
+	}
+	return false
+}
+
+func LabeledStmtNodeStart(builder *flatbuffers.Builder) {
+	builder.StartObject(5)
+}
+func LabeledStmtNodeAddRefId(builder *flatbuffers.Builder, refId int32) {
+	builder.PrependInt32Slot(0, refId, 0)
+}
+func LabeledStmtNodeAddLabel(builder *flatbuffers.Builder, label flatbuffers.UOffsetT) {
+	builder.PrependUOffsetTSlot(1, flatbuffers.UOffsetT(label), 0)
+}
+func LabeledStmtNodeAddColon(builder *flatbuffers.Builder, colon flatbuffers.UOffsetT) {
+	builder.PrependStructSlot(2, flatbuffers.UOffsetT(colon), 0)
+}
+func LabeledStmtNodeAddStmtType(builder *flatbuffers.Builder, stmtType Stmt) {
+	builder.PrependByteSlot(3, byte(stmtType), 0)
+}
+func LabeledStmtNodeAddStmt(builder *flatbuffers.Builder, stmt flatbuffers.UOffsetT) {
+	builder.PrependUOffsetTSlot(4, flatbuffers.UOffsetT(stmt), 0)
+}
+func LabeledStmtNodeEnd(builder *flatbuffers.Builder) flatbuffers.UOffsetT {
+	return builder.EndObject()
+}
@@ -0,0 +1,134 @@
+// Code generated by the FlatBuffers compiler. DO NOT EDIT.
+
+package astfb
+
+import (
+	flatbuffers "github.com/google/flatbuffers/go"
+)
+
+type MapTypeNode struct {
+	_tab flatbuffers.Table
+}
+
+func GetRootAsMapTypeNode(buf []byte, offset flatbuffers.UOffsetT) *MapTypeNode {
+	n := flatbuffers.GetUOffsetT(buf[offset:])
+	x := &MapTypeNode{}
+	x.Init(buf, n+offset)
+	return x
+}
+
+func FinishMapTypeNodeBuffer(builder *flatbuffers.Builder, offset flatbuffers.UOffsetT) {
+	builder.Finish(offset)
+}
+
+func GetSizePrefixedRootAsMapTypeNode(buf []byte, offset flatbuffers.UOffsetT) *MapTypeNode {
+	n := flatbuffers.GetUOffsetT(buf[offset+flatbuffers.SizeUint32:])
+	x := &MapTypeNode{}
+	x.Init(buf, n+offset+flatbuffers.SizeUint32)
+	return x
+}
+
+func FinishSizePrefixedMapTypeNodeBuffer(builder *flatbuffers.Builder, offset flatbuffers.UOffsetT) {
+	builder.FinishSizePrefixed(offset)
+}
+
+func (rcv *MapTypeNode) Init(buf []byte, i flatbuffers.UOffsetT) {
+	rcv._tab.Bytes = buf
+	rcv._tab.Pos = i
+}
+
+func (rcv *MapTypeNode) Table() flatbuffers.Table {
+	return rcv._tab
+}
+
+func (rcv *MapTypeNode) RefId() int32 {
+	o := flatbuffers.UOffsetT(rcv._tab.Offset(4))
+	if o != 0 {
+		return rcv._tab.GetInt32(o + rcv._tab.Pos)
+	}
+	return 0
+}
+
+func (rcv *MapTypeNode) MutateRefId(n int32) bool {
+	return rcv._tab.MutateInt32Slot(4, n)
+}
+
+func (rcv *MapTypeNode) Map(obj *PositionNode) *PositionNode {
+	o := flatbuffers.UOffsetT(rcv._tab.Offset(6))
+	if o != 0 {
+		x := o + rcv._tab.Pos
+		if obj == nil {
+			obj = new(PositionNode)
+		}
+		obj.Init(rcv._tab.Bytes, x)
+		return obj
+	}
+	return nil
+}
+
+func (rcv *MapTypeNode) KeyType() Expr {
+	o := flatbuffers.UOffsetT(rcv._tab.Offset(8))
+	if o != 0 {
+		return Expr(rcv._tab.GetByte(o + rcv._tab.Pos))
+	}
+	return 0
+}
+
+func (rcv *MapTypeNode) MutateKeyType(n Expr) bool {
+	return rcv._tab.MutateByteSlot(8, byte(n))
+}
+
+func (rcv *MapTypeNode) Key(obj *flatbuffers.Table) bool {
+	o := flatbuffers.UOffsetT(rcv._tab.Offset(10))
+	if o != 0 {
+		rcv._tab.Union(obj, o)
+		return true
+	}
+	return false
+}
+
+func (rcv *MapTypeNode) ValueType() Expr {
+	o := flatbuffers.UOffsetT(rcv._tab.Offset(12))
+	if o != 0 {
+		return Expr(rcv._tab.GetByte(o + rcv._tab.Pos))
+	}
+	return 0
+}
+
+func (rcv *MapTypeNode) MutateValueType(n Expr) bool {
+	return rcv._tab.MutateByteSlot(12, byte(n))
+}
+
+func (rcv *MapTypeNode) Value(obj *flatbuffers.Table) bool {
+	o := flatbuffers.UOffsetT(rcv._tab.Offset(14))
+	if o != 0 {
+		rcv._tab.Union(obj, o)
+		return true
+	}
+	return false
+}
+
+func MapTypeNodeStart(builder *flatbuffers.Builder) {
+	builder.StartObject(6)
+}
+func MapTypeNodeAddRefId(builder *flatbuffers.Builder, refId int32) {
+	builder.PrependInt32Slot(0, refId, 0)
+}
+func MapTypeNodeAddMap(builder *flatbuffers.Builder, map_ flatbuffers.UOffsetT) {
+	builder.PrependStructSlot(1, flatbuffers.UOffsetT(map_), 0)
+}
+func MapTypeNodeAddKeyType(builder *flatbuffers.Builder, keyType Expr) {
+	builder.PrependByteSlot(2, byte(keyType), 0)
+}
+func MapTypeNodeAddKey(builder *flatbuffers.Builder, key flatbuffers.UOffsetT) {
+	builder.PrependUOffsetTSlot(3, flatbuffers.UOffsetT(key), 0)
+}
+func MapTypeNodeAddValueType(builder *flatbuffers.Builder, valueType Expr) {
+	builder.PrependByteSlot(4, byte(valueType), 0)
+}
+func MapTypeNodeAddValue(builder *flatbuffers.Builder, value flatbuffers.UOffsetT) {
+	builder.PrependUOffsetTSlot(5, flatbuffers.UOffsetT(value), 0)
+}
+func MapTypeNodeEnd(builder *flatbuffers.Builder) flatbuffers.UOffsetT {
+	return builder.EndObject()
+}
@@ -0,0 +1,57 @@
+// Code generated by the FlatBuffers compiler. DO NOT EDIT.
+
+package astfb
+
+import (
+	flatbuffers "github.com/google/flatbuffers/go"
+)
+
+type PositionNode struct {
+	_tab flatbuffers.Struct
+}
+
+func (rcv *PositionNode) Init(buf []byte, i flatbuffers.UOffsetT) {
+	rcv._tab.Bytes = buf
+	rcv._tab.Pos = i
+}
+
+func (rcv *PositionNode) Table() flatbuffers.Table {
+	return rcv._tab.Table
+}
+
+func (rcv *PositionNode) RefId() int32 {
+	return rcv._tab.GetInt32(rcv._tab.Pos + flatbuffers.UOffsetT(0))
+}
+func (rcv *PositionNode) MutateRefId(n int32) bool {
+	return rcv._tab.MutateInt32(rcv._tab.Pos+flatbuffers.UOffsetT(0), n)
+}
+
+func (rcv *PositionNode) Offset() int32 {
+	return rcv._tab.GetInt32(rcv._tab.Pos + flatbuffers.UOffsetT(4))
+}
+func (rcv *PositionNode) MutateOffset(n int32) bool {
+	return rcv._tab.MutateInt32(rcv._tab.Pos+flatbuffers.UOffsetT(4), n)
+}
+
+func (rcv *PositionNode) Line() int32 {
+	return rcv._tab.GetInt32(rcv._tab.Pos + flatbuffers.UOffsetT(8))
+}
+func (rcv *PositionNode) MutateLine(n int32) bool {
+	return rcv._tab.MutateInt32(rcv._tab.Pos+flatbuffers.UOffsetT(8), n)
+}
+
+func (rcv *PositionNode) Column() int32 {
+	return rcv._tab.GetInt32(rcv._tab.Pos + flatbuffers.UOffsetT(12))
+}
+func (rcv *PositionNode) MutateColumn(n int32) bool {
+	return rcv._tab.MutateInt32(rcv._tab.Pos+flatbuffers.UOffsetT(12), n)
+}
+
+func CreatePositionNode(builder *flatbuffers.Builder, refId int32, offset int32, line int32, column int32) flatbuffers.UOffsetT {
+	builder.Prep(4, 16)
+	builder.PrependInt32(column)
+	builder.PrependInt32(line)
+	builder.PrependInt32(offset)
+	builder.PrependInt32(refId)
+	return builder.Offset()
+}
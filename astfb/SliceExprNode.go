@@ -0,0 +1,219 @@
+// Code generated by the FlatBuffers compiler. DO NOT EDIT.
+
+package astfb
+
+import (
+	flatbuffers "github.com/google/flatbuffers/go"
+)
+
+type SliceExprNode struct {
+	_tab flatbuffers.Table
+}
+
+func GetRootAsSliceExprNode(buf []byte, offset flatbuffers.UOffsetT) *SliceExprNode {
+	n := flatbuffers.GetUOffsetT(buf[offset:])
+	x := &SliceExprNode{}
+	x.Init(buf, n+offset)
+	return x
+}
+
+func FinishSliceExprNodeBuffer(builder *flatbuffers.Builder, offset flatbuffers.UOffsetT) {
+	builder.Finish(offset)
+}
+
+func GetSizePrefixedRootAsSliceExprNode(buf []byte, offset flatbuffers.UOffsetT) *SliceExprNode {
+	n := flatbuffers.GetUOffsetT(buf[offset+flatbuffers.SizeUint32:])
+	x := &SliceExprNode{}
+	x.Init(buf, n+offset+flatbuffers.SizeUint32)
+	return x
+}
+
+func FinishSizePrefixedSliceExprNodeBuffer(builder *flatbuffers.Builder, offset flatbuffers.UOffsetT) {
+	builder.FinishSizePrefixed(offset)
+}
+
+func (rcv *SliceExprNode) Init(buf []byte, i flatbuffers.UOffsetT) {
+	rcv._tab.Bytes = buf
+	rcv._tab.Pos = i
+}
+
+func (rcv *SliceExprNode) Table() flatbuffers.Table {
+	return rcv._tab
+}
+
+func (rcv *SliceExprNode) RefId() int32 {
+	o := flatbuffers.UOffsetT(rcv._tab.Offset(4))
+	if o != 0 {
+		return rcv._tab.GetInt32(o + rcv._tab.Pos)
+	}
+	return 0
+}
+
+func (rcv *SliceExprNode) MutateRefId(n int32) bool {
+	return rcv._tab.MutateInt32Slot(4, n)
+}
+
+func (rcv *SliceExprNode) XType() Expr {
+	o := flatbuffers.UOffsetT(rcv._tab.Offset(6))
+	if o != 0 {
+		return Expr(rcv._tab.GetByte(o + rcv._tab.Pos))
+	}
+	return 0
+}
+
+func (rcv *SliceExprNode) MutateXType(n Expr) bool {
+	return rcv._tab.MutateByteSlot(6, byte(n))
+}
+
+func (rcv *SliceExprNode) X(obj *flatbuffers.Table) bool {
+	o := flatbuffers.UOffsetT(rcv._tab.Offset(8))
+	if o != 0 {
+		rcv._tab.Union(obj, o)
+		return true
+	}
+	return false
+}
+
+func (rcv *SliceExprNode) Lbrack(obj *PositionNode) *PositionNode {
+	o := flatbuffers.UOffsetT(rcv._tab.Offset(10))
+	if o != 0 {
+		x := o + rcv._tab.Pos
+		if obj == nil {
+			obj = new(PositionNode)
+		}
+		obj.Init(rcv._tab.Bytes, x)
+		return obj
+	}
+	return nil
+}
+
+func (rcv *SliceExprNode) LowType() Expr {
+	o := flatbuffers.UOffsetT(rcv._tab.Offset(12))
+	if o != 0 {
+		return Expr(rcv._tab.GetByte(o + rcv._tab.Pos))
+	}
+	return 0
+}
+
+func (rcv *SliceExprNode) MutateLowType(n Expr) bool {
+	return rcv._tab.MutateByteSlot(12, byte(n))
+}
+
+func (rcv *SliceExprNode) Low(obj *flatbuffers.Table) bool {
+	o := flatbuffers.UOffsetT(rcv._tab.Offset(14))
+	if o != 0 {
+		rcv._tab.Union(obj, o)
+		return true
+	}
+	return false
+}
+
+func (rcv *SliceExprNode) HighType() Expr {
+	o := flatbuffers.UOffsetT(rcv._tab.Offset(16))
+	if o != 0 {
+		return Expr(rcv._tab.GetByte(o + rcv._tab.Pos))
+	}
+	return 0
+}
+
+func (rcv *SliceExprNode) MutateHighType(n Expr) bool {
+	return rcv._tab.MutateByteSlot(16, byte(n))
+}
+
+func (rcv *SliceExprNode) High(obj *flatbuffers.Table) bool {
+	o := flatbuffers.UOffsetT(rcv._tab.Offset(18))
+	if o != 0 {
+		rcv._tab.Union(obj, o)
+		return true
+	}
+	return false
+}
+
+func (rcv *SliceExprNode) MaxType() Expr {
+	o := flatbuffers.UOffsetT(rcv._tab.Offset(20))
+	if o != 0 {
+		return Expr(rcv._tab.GetByte(o + rcv._tab.Pos))
+	}
+	return 0
+}
+
+func (rcv *SliceExprNode) MutateMaxType(n Expr) bool {
+	return rcv._tab.MutateByteSlot(20, byte(n))
+}
+
+func (rcv *SliceExprNode) Max(obj *flatbuffers.Table) bool {
+	o := flatbuffers.UOffsetT(rcv._tab.Offset(22))
+	if o != 0 {
+		rcv._tab.Union(obj, o)
+		return true
+	}
+	return false
+}
+
+func (rcv *SliceExprNode) Slice3() bool {
+	o := flatbuffers.UOffsetT(rcv._tab.Offset(24))
+	if o != 0 {
+		return rcv._tab.GetBool(o + rcv._tab.Pos)
+	}
+	return false
+}
+
+func (rcv *SliceExprNode) MutateSlice3(n bool) bool {
+	return rcv._tab.MutateBoolSlot(24, n)
+}
+
+func (rcv *SliceExprNode) Rbrack(obj *PositionNode) *PositionNode {
+	o := flatbuffers.UOffsetT(rcv._tab.Offset(26))
+	if o != 0 {
+		x := o + rcv._tab.Pos
+		if obj == nil {
+			obj = new(PositionNode)
+		}
+		obj.Init(rcv._tab.Bytes, x)
+		return obj
+	}
+	return nil
+}
+
+func SliceExprNodeStart(builder *flatbuffers.Builder) {
+	builder.StartObject(12)
+}
+func SliceExprNodeAddRefId(builder *flatbuffers.Builder, refId int32) {
+	builder.PrependInt32Slot(0, refId, 0)
+}
+func SliceExprNodeAddXType(builder *flatbuffers.Builder, xType Expr) {
+	builder.PrependByteSlot(1, byte(xType), 0)
+}
+func SliceExprNodeAddX(builder *flatbuffers.Builder, x flatbuffers.UOffsetT) {
+	builder.PrependUOffsetTSlot(2, flatbuffers.UOffsetT(x), 0)
+}
+func SliceExprNodeAddLbrack(builder *flatbuffers.Builder, lbrack flatbuffers.UOffsetT) {
+	builder.PrependStructSlot(3, flatbuffers.UOffsetT(lbrack), 0)
+}
+func SliceExprNodeAddLowType(builder *flatbuffers.Builder, lowType Expr) {
+	builder.PrependByteSlot(4, byte(lowType), 0)
+}
+func SliceExprNodeAddLow(builder *flatbuffers.Builder, low flatbuffers.UOffsetT) {
+	builder.PrependUOffsetTSlot(5, flatbuffers.UOffsetT(low), 0)
+}
+func SliceExprNodeAddHighType(builder *flatbuffers.Builder, highType Expr) {
+	builder.PrependByteSlot(6, byte(highType), 0)
+}
+func SliceExprNodeAddHigh(builder *flatbuffers.Builder, high flatbuffers.UOffsetT) {
+	builder.PrependUOffsetTSlot(7, flatbuffers.UOffsetT(high), 0)
+}
+func SliceExprNodeAddMaxType(builder *flatbuffers.Builder, maxType Expr) {
+	builder.PrependByteSlot(8, byte(maxType), 0)
+}
+func SliceExprNodeAddMax(builder *flatbuffers.Builder, max flatbuffers.UOffsetT) {
+	builder.PrependUOffsetTSlot(9, flatbuffers.UOffsetT(max), 0)
+}
+func SliceExprNodeAddSlice3(builder *flatbuffers.Builder, slice3 bool) {
+	builder.PrependBoolSlot(10, slice3, false)
+}
+func SliceExprNodeAddRbrack(builder *flatbuffers.Builder, rbrack flatbuffers.UOffsetT) {
+	builder.PrependStructSlot(11, flatbuffers.UOffsetT(rbrack), 0)
+}
+func SliceExprNodeEnd(builder *flatbuffers.Builder) flatbuffers.UOffsetT {
+	return builder.EndObject()
+}
@@ -0,0 +1,35 @@
+// Code generated by the FlatBuffers compiler. DO NOT EDIT.
+
+package astfb
+
+import "strconv"
+
+type Spec byte
+
+const (
+	SpecNONE           Spec = 0
+	SpecImportSpecNode Spec = 1
+	SpecValueSpecNode  Spec = 2
+	SpecTypeSpecNode   Spec = 3
+)
+
+var EnumNamesSpec = map[Spec]string{
+	SpecNONE:           "NONE",
+	SpecImportSpecNode: "ImportSpecNode",
+	SpecValueSpecNode:  "ValueSpecNode",
+	SpecTypeSpecNode:   "TypeSpecNode",
+}
+
+var EnumValuesSpec = map[string]Spec{
+	"NONE":           SpecNONE,
+	"ImportSpecNode": SpecImportSpecNode,
+	"ValueSpecNode":  SpecValueSpecNode,
+	"TypeSpecNode":   SpecTypeSpecNode,
+}
+
+func (v Spec) String() string {
+	if s, ok := EnumNamesSpec[v]; ok {
+		return s
+	}
+	return "Spec(" + strconv.FormatInt(int64(v), 10) + ")"
+}
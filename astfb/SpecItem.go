@@ -0,0 +1,76 @@
+// Code generated by the FlatBuffers compiler. DO NOT EDIT.
+
+package astfb
+
+import (
+	flatbuffers "github.com/google/flatbuffers/go"
+)
+
+type SpecItem struct {
+	_tab flatbuffers.Table
+}
+
+func GetRootAsSpecItem(buf []byte, offset flatbuffers.UOffsetT) *SpecItem {
+	n := flatbuffers.GetUOffsetT(buf[offset:])
+	x := &SpecItem{}
+	x.Init(buf, n+offset)
+	return x
+}
+
+func FinishSpecItemBuffer(builder *flatbuffers.Builder, offset flatbuffers.UOffsetT) {
+	builder.Finish(offset)
+}
+
+func GetSizePrefixedRootAsSpecItem(buf []byte, offset flatbuffers.UOffsetT) *SpecItem {
+	n := flatbuffers.GetUOffsetT(buf[offset+flatbuffers.SizeUint32:])
+	x := &SpecItem{}
+	x.Init(buf, n+offset+flatbuffers.SizeUint32)
+	return x
+}
+
+func FinishSizePrefixedSpecItemBuffer(builder *flatbuffers.Builder, offset flatbuffers.UOffsetT) {
+	builder.FinishSizePrefixed(offset)
+}
+
+func (rcv *SpecItem) Init(buf []byte, i flatbuffers.UOffsetT) {
+	rcv._tab.Bytes = buf
+	rcv._tab.Pos = i
+}
+
+func (rcv *SpecItem) Table() flatbuffers.Table {
+	return rcv._tab
+}
+
+func (rcv *SpecItem) NodeType() Spec {
+	o := flatbuffers.UOffsetT(rcv._tab.Offset(4))
+	if o != 0 {
+		return Spec(rcv._tab.GetByte(o + rcv._tab.Pos))
+	}
+	return 0
+}
+
+func (rcv *SpecItem) MutateNodeType(n Spec) bool {
+	return rcv._tab.MutateByteSlot(4, byte(n))
+}
+
+func (rcv *SpecItem) Node(obj *flatbuffers.Table) bool {
+	o := flatbuffers.UOffsetT(rcv._tab.Offset(6))
+	if o != 0 {
+		rcv._tab.Union(obj, o)
+		return true
+	}
+	return false
+}
+
+func SpecItemStart(builder *flatbuffers.Builder) {
+	builder.StartObject(2)
+}
+func SpecItemAddNodeType(builder *flatbuffers.Builder, nodeType Spec) {
+	builder.PrependByteSlot(0, byte(nodeType), 0)
+}
+func SpecItemAddNode(builder *flatbuffers.Builder, node flatbuffers.UOffsetT) {
+	builder.PrependUOffsetTSlot(1, flatbuffers.UOffsetT(node), 0)
+}
+func SpecItemEnd(builder *flatbuffers.Builder) flatbuffers.UOffsetT {
+	return builder.EndObject()
+}
@@ -0,0 +1,89 @@
+// Code generated by the FlatBuffers compiler. DO NOT EDIT.
+
+package astfb
+
+import "strconv"
+
+type Stmt byte
+
+const (
+	StmtNONE               Stmt = 0
+	StmtBadStmtNode        Stmt = 1
+	StmtDeclStmtNode       Stmt = 2
+	StmtEmptyStmtNode      Stmt = 3
+	StmtLabeledStmtNode    Stmt = 4
+	StmtExprStmtNode       Stmt = 5
+	StmtSendStmtNode       Stmt = 6
+	StmtIncDecStmtNode     Stmt = 7
+	StmtAssignStmtNode     Stmt = 8
+	StmtGoStmtNode         Stmt = 9
+	StmtDeferStmtNode      Stmt = 10
+	StmtReturnStmtNode     Stmt = 11
+	StmtBranchStmtNode     Stmt = 12
+	StmtBlockStmtNode      Stmt = 13
+	StmtIfStmtNode         Stmt = 14
+	StmtCaseClauseNode     Stmt = 15
+	StmtSwitchStmtNode     Stmt = 16
+	StmtTypeSwitchStmtNode Stmt = 17
+	StmtCommClauseNode     Stmt = 18
+	StmtSelectStmtNode     Stmt = 19
+	StmtForStmtNode        Stmt = 20
+	StmtRangeStmtNode      Stmt = 21
+)
+
+var EnumNamesStmt = map[Stmt]string{
+	StmtNONE:               "NONE",
+	StmtBadStmtNode:        "BadStmtNode",
+	StmtDeclStmtNode:       "DeclStmtNode",
+	StmtEmptyStmtNode:      "EmptyStmtNode",
+	StmtLabeledStmtNode:    "LabeledStmtNode",
+	StmtExprStmtNode:       "ExprStmtNode",
+	StmtSendStmtNode:       "SendStmtNode",
+	StmtIncDecStmtNode:     "IncDecStmtNode",
+	StmtAssignStmtNode:     "AssignStmtNode",
+	StmtGoStmtNode:         "GoStmtNode",
+	StmtDeferStmtNode:      "DeferStmtNode",
+	StmtReturnStmtNode:     "ReturnStmtNode",
+	StmtBranchStmtNode:     "BranchStmtNode",
+	StmtBlockStmtNode:      "BlockStmtNode",
+	StmtIfStmtNode:         "IfStmtNode",
+	StmtCaseClauseNode:     "CaseClauseNode",
+	StmtSwitchStmtNode:     "SwitchStmtNode",
+	StmtTypeSwitchStmtNode: "TypeSwitchStmtNode",
+	StmtCommClauseNode:     "CommClauseNode",
+	StmtSelectStmtNode:     "SelectStmtNode",
+	StmtForStmtNode:        "ForStmtNode",
+	StmtRangeStmtNode:      "RangeStmtNode",
+}
+
+var EnumValuesStmt = map[string]Stmt{
+	"NONE":               StmtNONE,
+	"BadStmtNode":        StmtBadStmtNode,
+	"DeclStmtNode":       StmtDeclStmtNode,
+	"EmptyStmtNode":      StmtEmptyStmtNode,
+	"LabeledStmtNode":    StmtLabeledStmtNode,
+	"ExprStmtNode":       StmtExprStmtNode,
+	"SendStmtNode":       StmtSendStmtNode,
+	"IncDecStmtNode":     StmtIncDecStmtNode,
+	"AssignStmtNode":     StmtAssignStmtNode,
+	"GoStmtNode":         StmtGoStmtNode,
+	"DeferStmtNode":      StmtDeferStmtNode,
+	"ReturnStmtNode":     StmtReturnStmtNode,
+	"BranchStmtNode":     StmtBranchStmtNode,
+	"BlockStmtNode":      StmtBlockStmtNode,
+	"IfStmtNode":         StmtIfStmtNode,
+	"CaseClauseNode":     StmtCaseClauseNode,
+	"SwitchStmtNode":     StmtSwitchStmtNode,
+	"TypeSwitchStmtNode": StmtTypeSwitchStmtNode,
+	"CommClauseNode":     StmtCommClauseNode,
+	"SelectStmtNode":     StmtSelectStmtNode,
+	"ForStmtNode":        StmtForStmtNode,
+	"RangeStmtNode":      StmtRangeStmtNode,
+}
+
+func (v Stmt) String() string {
+	if s, ok := EnumNamesStmt[v]; ok {
+		return s
+	}
+	return "Stmt(" + strconv.FormatInt(int64(v), 10) + ")"
+}
@@ -0,0 +1,76 @@
+// Code generated by the FlatBuffers compiler. DO NOT EDIT.
+
+package astfb
+
+import (
+	flatbuffers "github.com/google/flatbuffers/go"
+)
+
+type StmtItem struct {
+	_tab flatbuffers.Table
+}
+
+func GetRootAsStmtItem(buf []byte, offset flatbuffers.UOffsetT) *StmtItem {
+	n := flatbuffers.GetUOffsetT(buf[offset:])
+	x := &StmtItem{}
+	x.Init(buf, n+offset)
+	return x
+}
+
+func FinishStmtItemBuffer(builder *flatbuffers.Builder, offset flatbuffers.UOffsetT) {
+	builder.Finish(offset)
+}
+
+func GetSizePrefixedRootAsStmtItem(buf []byte, offset flatbuffers.UOffsetT) *StmtItem {
+	n := flatbuffers.GetUOffsetT(buf[offset+flatbuffers.SizeUint32:])
+	x := &StmtItem{}
+	x.Init(buf, n+offset+flatbuffers.SizeUint32)
+	return x
+}
+
+func FinishSizePrefixedStmtItemBuffer(builder *flatbuffers.Builder, offset flatbuffers.UOffsetT) {
+	builder.FinishSizePrefixed(offset)
+}
+
+func (rcv *StmtItem) Init(buf []byte, i flatbuffers.UOffsetT) {
+	rcv._tab.Bytes = buf
+	rcv._tab.Pos = i
+}
+
+func (rcv *StmtItem) Table() flatbuffers.Table {
+	return rcv._tab
+}
+
+func (rcv *StmtItem) NodeType() Stmt {
+	o := flatbuffers.UOffsetT(rcv._tab.Offset(4))
+	if o != 0 {
+		return Stmt(rcv._tab.GetByte(o + rcv._tab.Pos))
+	}
+	return 0
+}
+
+func (rcv *StmtItem) MutateNodeType(n Stmt) bool {
+	return rcv._tab.MutateByteSlot(4, byte(n))
+}
+
+func (rcv *StmtItem) Node(obj *flatbuffers.Table) bool {
+	o := flatbuffers.UOffsetT(rcv._tab.Offset(6))
+	if o != 0 {
+		rcv._tab.Union(obj, o)
+		return true
+	}
+	return false
+}
+
+func StmtItemStart(builder *flatbuffers.Builder) {
+	builder.StartObject(2)
+}
+func StmtItemAddNodeType(builder *flatbuffers.Builder, nodeType Stmt) {
+	builder.PrependByteSlot(0, byte(nodeType), 0)
+}
+func StmtItemAddNode(builder *flatbuffers.Builder, node flatbuffers.UOffsetT) {
+	builder.PrependUOffsetTSlot(1, flatbuffers.UOffsetT(node), 0)
+}
+func StmtItemEnd(builder *flatbuffers.Builder) flatbuffers.UOffsetT {
+	return builder.EndObject()
+}
@@ -0,0 +1,111 @@
+// Code generated by the FlatBuffers compiler. DO NOT EDIT.
+
+package astfb
+
+import (
+	flatbuffers "github.com/google/flatbuffers/go"
+)
+
+type StructTypeNode struct {
+	_tab flatbuffers.Table
+}
+
+func GetRootAsStructTypeNode(buf []byte, offset flatbuffers.UOffsetT) *StructTypeNode {
+	n := flatbuffers.GetUOffsetT(buf[offset:])
+	x := &StructTypeNode{}
+	x.Init(buf, n+offset)
+	return x
+}
+
+func FinishStructTypeNodeBuffer(builder *flatbuffers.Builder, offset flatbuffers.UOffsetT) {
+	builder.Finish(offset)
+}
+
+func GetSizePrefixedRootAsStructTypeNode(buf []byte, offset flatbuffers.UOffsetT) *StructTypeNode {
+	n := flatbuffers.GetUOffsetT(buf[offset+flatbuffers.SizeUint32:])
+	x := &StructTypeNode{}
+	x.Init(buf, n+offset+flatbuffers.SizeUint32)
+	return x
+}
+
+func FinishSizePrefixedStructTypeNodeBuffer(builder *flatbuffers.Builder, offset flatbuffers.UOffsetT) {
+	builder.FinishSizePrefixed(offset)
+}
+
+func (rcv *StructTypeNode) Init(buf []byte, i flatbuffers.UOffsetT) {
+	rcv._tab.Bytes = buf
+	rcv._tab.Pos = i
+}
+
+func (rcv *StructTypeNode) Table() flatbuffers.Table {
+	return rcv._tab
+}
+
+func (rcv *StructTypeNode) RefId() int32 {
+	o := flatbuffers.UOffsetT(rcv._tab.Offset(4))
+	if o != 0 {
+		return rcv._tab.GetInt32(o + rcv._tab.Pos)
+	}
+	return 0
+}
+
+func (rcv *StructTypeNode) MutateRefId(n int32) bool {
+	return rcv._tab.MutateInt32Slot(4, n)
+}
+
+func (rcv *StructTypeNode) Struct(obj *PositionNode) *PositionNode {
+	o := flatbuffers.UOffsetT(rcv._tab.Offset(6))
+	if o != 0 {
+		x := o + rcv._tab.Pos
+		if obj == nil {
+			obj = new(PositionNode)
+		}
+		obj.Init(rcv._tab.Bytes, x)
+		return obj
+	}
+	return nil
+}
+
+func (rcv *StructTypeNode) Fields(obj *FieldListNode) *FieldListNode {
+	o := flatbuffers.UOffsetT(rcv._tab.Offset(8))
+	if o != 0 {
+		x := rcv._tab.Indirect(o + rcv._tab.Pos)
+		if obj == nil {
+			obj = new(FieldListNode)
+		}
+		obj.Init(rcv._tab.Bytes, x)
+		return obj
+	}
+	return nil
+}
+
+func (rcv *StructTypeNode) Incomplete() bool {
+	o := flatbuffers.UOffsetT(rcv._tab.Offset(10))
+	if o != 0 {
+		return rcv._tab.GetBool(o + rcv._tab.Pos)
+	}
+	return false
+}
+
+func (rcv *StructTypeNode) MutateIncomplete(n bool) bool {
+	return rcv._tab.MutateBoolSlot(10, n)
+}
+
+func StructTypeNodeStart(builder *flatbuffers.Builder) {
+	builder.StartObject(4)
+}
+func StructTypeNodeAddRefId(builder *flatbuffers.Builder, refId int32) {
+	builder.PrependInt32Slot(0, refId, 0)
+}
+func StructTypeNodeAddStruct(builder *flatbuffers.Builder, struct_ flatbuffers.UOffsetT) {
+	builder.PrependStructSlot(1, flatbuffers.UOffsetT(struct_), 0)
+}
+func StructTypeNodeAddFields(builder *flatbuffers.Builder, fields flatbuffers.UOffsetT) {
+	builder.PrependUOffsetTSlot(2, flatbuffers.UOffsetT(fields), 0)
+}
+func StructTypeNodeAddIncomplete(builder *flatbuffers.Builder, incomplete bool) {
+	builder.PrependBoolSlot(3, incomplete, false)
+}
+func StructTypeNodeEnd(builder *flatbuffers.Builder) flatbuffers.UOffsetT {
+	return builder.EndObject()
+}
@@ -0,0 +1,150 @@
+// Code generated by the FlatBuffers compiler. DO NOT EDIT.
+
+package astfb
+
+import (
+	flatbuffers "github.com/google/flatbuffers/go"
+)
+
+type SwitchStmtNode struct {
+	_tab flatbuffers.Table
+}
+
+func GetRootAsSwitchStmtNode(buf []byte, offset flatbuffers.UOffsetT) *SwitchStmtNode {
+	n := flatbuffers.GetUOffsetT(buf[offset:])
+	x := &SwitchStmtNode{}
+	x.Init(buf, n+offset)
+	return x
+}
+
+func FinishSwitchStmtNodeBuffer(builder *flatbuffers.Builder, offset flatbuffers.UOffsetT) {
+	builder.Finish(offset)
+}
+
+func GetSizePrefixedRootAsSwitchStmtNode(buf []byte, offset flatbuffers.UOffsetT) *SwitchStmtNode {
+	n := flatbuffers.GetUOffsetT(buf[offset+flatbuffers.SizeUint32:])
+	x := &SwitchStmtNode{}
+	x.Init(buf, n+offset+flatbuffers.SizeUint32)
+	return x
+}
+
+func FinishSizePrefixedSwitchStmtNodeBuffer(builder *flatbuffers.Builder, offset flatbuffers.UOffsetT) {
+	builder.FinishSizePrefixed(offset)
+}
+
+func (rcv *SwitchStmtNode) Init(buf []byte, i flatbuffers.UOffsetT) {
+	rcv._tab.Bytes = buf
+	rcv._tab.Pos = i
+}
+
+func (rcv *SwitchStmtNode) Table() flatbuffers.Table {
+	return rcv._tab
+}
+
+func (rcv *SwitchStmtNode) RefId() int32 {
+	o := flatbuffers.UOffsetT(rcv._tab.Offset(4))
+	if o != 0 {
+		return rcv._tab.GetInt32(o + rcv._tab.Pos)
+	}
+	return 0
+}
+
+func (rcv *SwitchStmtNode) MutateRefId(n int32) bool {
+	return rcv._tab.MutateInt32Slot(4, n)
+}
+
+func (rcv *SwitchStmtNode) Switch(obj *PositionNode) *PositionNode {
+	o := flatbuffers.UOffsetT(rcv._tab.Offset(6))
+	if o != 0 {
+		x := o + rcv._tab.Pos
+		if obj == nil {
+			obj = new(PositionNode)
+		}
+		obj.Init(rcv._tab.Bytes, x)
+		return obj
+	}
+	return nil
+}
+
+func (rcv *SwitchStmtNode) InitStmtType() Stmt {
+	o := flatbuffers.UOffsetT(rcv._tab.Offset(8))
+	if o != 0 {
+		return Stmt(rcv._tab.GetByte(o + rcv._tab.Pos))
+	}
+	return 0
+}
+
+func (rcv *SwitchStmtNode) MutateInitStmtType(n Stmt) bool {
+	return rcv._tab.MutateByteSlot(8, byte(n))
+}
+
+func (rcv *SwitchStmtNode) InitStmt(obj *flatbuffers.Table) bool {
+	o := flatbuffers.UOffsetT(rcv._tab.Offset(10))
+	if o != 0 {
+		rcv._tab.Union(obj, o)
+		return true
+	}
+	return false
+}
+
+func (rcv *SwitchStmtNode) TagType() Expr {
+	o := flatbuffers.UOffsetT(rcv._tab.Offset(12))
+	if o != 0 {
+		return Expr(rcv._tab.GetByte(o + rcv._tab.Pos))
+	}
+	return 0
+}
+
+func (rcv *SwitchStmtNode) MutateTagType(n Expr) bool {
+	return rcv._tab.MutateByteSlot(12, byte(n))
+}
+
+func (rcv *SwitchStmtNode) Tag(obj *flatbuffers.Table) bool {
+	o := flatbuffers.UOffsetT(rcv._tab.Offset(14))
+	if o != 0 {
+		rcv._tab.Union(obj, o)
+		return true
+	}
+	return false
+}
+
+func (rcv *SwitchStmtNode) Body(obj *BlockStmtNode) *BlockStmtNode {
+	o := flatbuffers.UOffsetT(rcv._tab.Offset(16))
+	if o != 0 {
+		x := rcv._tab.Indirect(o + rcv._tab.Pos)
+		if obj == nil {
+			obj = new(BlockStmtNode)
+		}
+		obj.Init(rcv._tab.Bytes, x)
+		return obj
+	}
+	return nil
+}
+
+func SwitchStmtNodeStart(builder *flatbuffers.Builder) {
+	builder.StartObject(7)
+}
+func SwitchStmtNodeAddRefId(builder *flatbuffers.Builder, refId int32) {
+	builder.PrependInt32Slot(0, refId, 0)
+}
+func SwitchStmtNodeAddSwitch(builder *flatbuffers.Builder, switch_ flatbuffers.UOffsetT) {
+	builder.PrependStructSlot(1, flatbuffers.UOffsetT(switch_), 0)
+}
+func SwitchStmtNodeAddInitStmtType(builder *flatbuffers.Builder, initStmtType Stmt) {
+	builder.PrependByteSlot(2, byte(initStmtType), 0)
+}
+func SwitchStmtNodeAddInitStmt(builder *flatbuffers.Builder, initStmt flatbuffers.UOffsetT) {
+	builder.PrependUOffsetTSlot(3, flatbuffers.UOffsetT(initStmt), 0)
+}
+func SwitchStmtNodeAddTagType(builder *flatbuffers.Builder, tagType Expr) {
+	builder.PrependByteSlot(4, byte(tagType), 0)
+}
+func SwitchStmtNodeAddTag(builder *flatbuffers.Builder, tag flatbuffers.UOffsetT) {
+	builder.PrependUOffsetTSlot(5, flatbuffers.UOffsetT(tag), 0)
+}
+func SwitchStmtNodeAddBody(builder *flatbuffers.Builder, body flatbuffers.UOffsetT) {
+	builder.PrependUOffsetTSlot(6, flatbuffers.UOffsetT(body), 0)
+}
+func SwitchStmtNodeEnd(builder *flatbuffers.Builder) flatbuffers.UOffsetT {
+	return builder.EndObject()
+}
@@ -0,0 +1,150 @@
+// Code generated by the FlatBuffers compiler. DO NOT EDIT.
+
+package astfb
+
+import (
+	flatbuffers "github.com/google/flatbuffers/go"
+)
+
+type TypeSwitchStmtNode struct {
+	_tab flatbuffers.Table
+}
+
+func GetRootAsTypeSwitchStmtNode(buf []byte, offset flatbuffers.UOffsetT) *TypeSwitchStmtNode {
+	n := flatbuffers.GetUOffsetT(buf[offset:])
+	x := &TypeSwitchStmtNode{}
+	x.Init(buf, n+offset)
+	return x
+}
+
+func FinishTypeSwitchStmtNodeBuffer(builder *flatbuffers.Builder, offset flatbuffers.UOffsetT) {
+	builder.Finish(offset)
+}
+
+func GetSizePrefixedRootAsTypeSwitchStmtNode(buf []byte, offset flatbuffers.UOffsetT) *TypeSwitchStmtNode {
+	n := flatbuffers.GetUOffsetT(buf[offset+flatbuffers.SizeUint32:])
+	x := &TypeSwitchStmtNode{}
+	x.Init(buf, n+offset+flatbuffers.SizeUint32)
+	return x
+}
+
+func FinishSizePrefixedTypeSwitchStmtNodeBuffer(builder *flatbuffers.Builder, offset flatbuffers.UOffsetT) {
+	builder.FinishSizePrefixed(offset)
+}
+
+func (rcv *TypeSwitchStmtNode) Init(buf []byte, i flatbuffers.UOffsetT) {
+	rcv._tab.Bytes = buf
+	rcv._tab.Pos = i
+}
+
+func (rcv *TypeSwitchStmtNode) Table() flatbuffers.Table {
+	return rcv._tab
+}
+
+func (rcv *TypeSwitchStmtNode) RefId() int32 {
+	o := flatbuffers.UOffsetT(rcv._tab.Offset(4))
+	if o != 0 {
+		return rcv._tab.GetInt32(o + rcv._tab.Pos)
+	}
+	return 0
+}
+
+func (rcv *TypeSwitchStmtNode) MutateRefId(n int32) bool {
+	return rcv._tab.MutateInt32Slot(4, n)
+}
+
+func (rcv *TypeSwitchStmtNode) Switch(obj *PositionNode) *PositionNode {
+	o := flatbuffers.UOffsetT(rcv._tab.Offset(6))
+	if o != 0 {
+		x := o + rcv._tab.Pos
+		if obj == nil {
+			obj = new(PositionNode)
+		}
+		obj.Init(rcv._tab.Bytes, x)
+		return obj
+	}
+	return nil
+}
+
+func (rcv *TypeSwitchStmtNode) InitStmtType() Stmt {
+	o := flatbuffers.UOffsetT(rcv._tab.Offset(8))
+	if o != 0 {
+		return Stmt(rcv._tab.GetByte(o + rcv._tab.Pos))
+	}
+	return 0
+}
+
+func (rcv *TypeSwitchStmtNode) MutateInitStmtType(n Stmt) bool {
+	return rcv._tab.MutateByteSlot(8, byte(n))
+}
+
+func (rcv *TypeSwitchStmtNode) InitStmt(obj *flatbuffers.Table) bool {
+	o := flatbuffers.UOffsetT(rcv._tab.Offset(10))
+	if o != 0 {
+		rcv._tab.Union(obj, o)
+		return true
+	}
+	return false
+}
+
+func (rcv *TypeSwitchStmtNode) AssignType() Stmt {
+	o := flatbuffers.UOffsetT(rcv._tab.Offset(12))
+	if o != 0 {
+		return Stmt(rcv._tab.GetByte(o + rcv._tab.Pos))
+	}
+	return 0
+}
+
+func (rcv *TypeSwitchStmtNode) MutateAssignType(n Stmt) bool {
+	return rcv._tab.MutateByteSlot(12, byte(n))
+}
+
+func (rcv *TypeSwitchStmtNode) Assign(obj *flatbuffers.Table) bool {
+	o := flatbuffers.UOffsetT(rcv._tab.Offset(14))
+	if o != 0 {
+		rcv._tab.Union(obj, o)
+		return true
+	}
+	return false
+}
+
+func (rcv *TypeSwitchStmtNode) Body(obj *BlockStmtNode) *BlockStmtNode {
+	o := flatbuffers.UOffsetT(rcv._tab.Offset(16))
+	if o != 0 {
+		x := rcv._tab.Indirect(o + rcv._tab.Pos)
+		if obj == nil {
+			obj = new(BlockStmtNode)
+		}
+		obj.Init(rcv._tab.Bytes, x)
+		return obj
+	}
+	return nil
+}
+
+func TypeSwitchStmtNodeStart(builder *flatbuffers.Builder) {
+	builder.StartObject(7)
+}
+func TypeSwitchStmtNodeAddRefId(builder *flatbuffers.Builder, refId int32) {
+	builder.PrependInt32Slot(0, refId, 0)
+}
+func TypeSwitchStmtNodeAddSwitch(builder *flatbuffers.Builder, switch_ flatbuffers.UOffsetT) {
+	builder.PrependStructSlot(1, flatbuffers.UOffsetT(switch_), 0)
+}
+func TypeSwitchStmtNodeAddInitStmtType(builder *flatbuffers.Builder, initStmtType Stmt) {
+	builder.PrependByteSlot(2, byte(initStmtType), 0)
+}
+func TypeSwitchStmtNodeAddInitStmt(builder *flatbuffers.Builder, initStmt flatbuffers.UOffsetT) {
+	builder.PrependUOffsetTSlot(3, flatbuffers.UOffsetT(initStmt), 0)
+}
+func TypeSwitchStmtNodeAddAssignType(builder *flatbuffers.Builder, assignType Stmt) {
+	builder.PrependByteSlot(4, byte(assignType), 0)
+}
+func TypeSwitchStmtNodeAddAssign(builder *flatbuffers.Builder, assign flatbuffers.UOffsetT) {
+	builder.PrependUOffsetTSlot(5, flatbuffers.UOffsetT(assign), 0)
+}
+func TypeSwitchStmtNodeAddBody(builder *flatbuffers.Builder, body flatbuffers.UOffsetT) {
+	builder.PrependUOffsetTSlot(6, flatbuffers.UOffsetT(body), 0)
+}
+func TypeSwitchStmtNodeEnd(builder *flatbuffers.Builder) flatbuffers.UOffsetT {
+	return builder.EndObject()
+}
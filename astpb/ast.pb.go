@@ -0,0 +1,7394 @@
+// Schema of the AST of a Go file, mirroring the node types of ast_json.
+// Every message of a node starts with the ref_id of the node, its NodeType is
+// the name of the message without the Node suffix. Interface fields hold one
+// of the nodes implementing the interface.
+// Regenerate the Go package with:
+//
+//	protoc --go_out=. --go_opt=module=GoOperatorAST ast.proto
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.34.2
+// 	protoc        (unknown)
+// source: ast.proto
+
+package astpb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Expr is one of the nodes implementing IExprNode.
+type Expr struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Node:
+	//	*Expr_BadExpr
+	//	*Expr_Ident
+	//	*Expr_Ellipsis
+	//	*Expr_BasicLit
+	//	*Expr_FuncLit
+	//	*Expr_CompositeLit
+	//	*Expr_ParenExpr
+	//	*Expr_SelectorExpr
+	//	*Expr_IndexExpr
+	//	*Expr_IndexListExpr
+	//	*Expr_SliceExpr
+	//	*Expr_TypeAssertExpr
+	//	*Expr_CallExpr
+	//	*Expr_StarExpr
+	//	*Expr_UnaryExpr
+	//	*Expr_BinaryExpr
+	//	*Expr_KeyValueExpr
+	//	*Expr_ArrayType
+	//	*Expr_StructType
+	//	*Expr_FuncType
+	//	*Expr_InterfaceType
+	//	*Expr_MapType
+	//	*Expr_ChanType
+	Node isExpr_Node `protobuf_oneof:"node"`
+}
+
+func (x *Expr) Reset() {
+	*x = Expr{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ast_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Expr) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Expr) ProtoMessage() {}
+
+func (x *Expr) ProtoReflect() protoreflect.Message {
+	mi := &file_ast_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Expr.ProtoReflect.Descriptor instead.
+func (*Expr) Descriptor() ([]byte, []int) {
+	return file_ast_proto_rawDescGZIP(), []int{0}
+}
+
+func (m *Expr) GetNode() isExpr_Node {
+	if m != nil {
+		return m.Node
+	}
+	return nil
+}
+
+func (x *Expr) GetBadExpr() *BadExprNode {
+	if x, ok := x.GetNode().(*Expr_BadExpr); ok {
+		return x.BadExpr
+	}
+	return nil
+}
+
+func (x *Expr) GetIdent() *IdentNode {
+	if x, ok := x.GetNode().(*Expr_Ident); ok {
+		return x.Ident
+	}
+	return nil
+}
+
+func (x *Expr) GetEllipsis() *EllipsisNode {
+	if x, ok := x.GetNode().(*Expr_Ellipsis); ok {
+		return x.Ellipsis
+	}
+	return nil
+}
+
+func (x *Expr) GetBasicLit() *BasicLitNode {
+	if x, ok := x.GetNode().(*Expr_BasicLit); ok {
+		return x.BasicLit
+	}
+	return nil
+}
+
+func (x *Expr) GetFuncLit() *FuncLitNode {
+	if x, ok := x.GetNode().(*Expr_FuncLit); ok {
+		return x.FuncLit
+	}
+	return nil
+}
+
+func (x *Expr) GetCompositeLit() *CompositeLitNode {
+	if x, ok := x.GetNode().(*Expr_CompositeLit); ok {
+		return x.CompositeLit
+	}
+	return nil
+}
+
+func (x *Expr) GetParenExpr() *ParenExprNode {
+	if x, ok := x.GetNode().(*Expr_ParenExpr); ok {
+		return x.ParenExpr
+	}
+	return nil
+}
+
+func (x *Expr) GetSelectorExpr() *SelectorExprNode {
+	if x, ok := x.GetNode().(*Expr_SelectorExpr); ok {
+		return x.SelectorExpr
+	}
+	return nil
+}
+
+func (x *Expr) GetIndexExpr() *IndexExprNode {
+	if x, ok := x.GetNode().(*Expr_IndexExpr); ok {
+		return x.IndexExpr
+	}
+	return nil
+}
+
+func (x *Expr) GetIndexListExpr() *IndexListExprNode {
+	if x, ok := x.GetNode().(*Expr_IndexListExpr); ok {
+		return x.IndexListExpr
+	}
+	return nil
+}
+
+func (x *Expr) GetSliceExpr() *SliceExprNode {
+	if x, ok := x.GetNode().(*Expr_SliceExpr); ok {
+		return x.SliceExpr
+	}
+	return nil
+}
+
+func (x *Expr) GetTypeAssertExpr() *TypeAssertExprNode {
+	if x, ok := x.GetNode().(*Expr_TypeAssertExpr); ok {
+		return x.TypeAssertExpr
+	}
+	return nil
+}
+
+func (x *Expr) GetCallExpr() *CallExprNode {
+	if x, ok := x.GetNode().(*Expr_CallExpr); ok {
+		return x.CallExpr
+	}
+	return nil
+}
+
+func (x *Expr) GetStarExpr() *StarExprNode {
+	if x, ok := x.GetNode().(*Expr_StarExpr); ok {
+		return x.StarExpr
+	}
+	return nil
+}
+
+func (x *Expr) GetUnaryExpr() *UnaryExprNode {
+	if x, ok := x.GetNode().(*Expr_UnaryExpr); ok {
+		return x.UnaryExpr
+	}
+	return nil
+}
+
+func (x *Expr) GetBinaryExpr() *BinaryExprNode {
+	if x, ok := x.GetNode().(*Expr_BinaryExpr); ok {
+		return x.BinaryExpr
+	}
+	return nil
+}
+
+func (x *Expr) GetKeyValueExpr() *KeyValueExprNode {
+	if x, ok := x.GetNode().(*Expr_KeyValueExpr); ok {
+		return x.KeyValueExpr
+	}
+	return nil
+}
+
+func (x *Expr) GetArrayType() *ArrayTypeNode {
+	if x, ok := x.GetNode().(*Expr_ArrayType); ok {
+		return x.ArrayType
+	}
+	return nil
+}
+
+func (x *Expr) GetStructType() *StructTypeNode {
+	if x, ok := x.GetNode().(*Expr_StructType); ok {
+		return x.StructType
+	}
+	return nil
+}
+
+func (x *Expr) GetFuncType() *FuncTypeNode {
+	if x, ok := x.GetNode().(*Expr_FuncType); ok {
+		return x.FuncType
+	}
+	return nil
+}
+
+func (x *Expr) GetInterfaceType() *InterfaceTypeNode {
+	if x, ok := x.GetNode().(*Expr_InterfaceType); ok {
+		return x.InterfaceType
+	}
+	return nil
+}
+
+func (x *Expr) GetMapType() *MapTypeNode {
+	if x, ok := x.GetNode().(*Expr_MapType); ok {
+		return x.MapType
+	}
+	return nil
+}
+
+func (x *Expr) GetChanType() *ChanTypeNode {
+	if x, ok := x.GetNode().(*Expr_ChanType); ok {
+		return x.ChanType
+	}
+	return nil
+}
+
+type isExpr_Node interface {
+	isExpr_Node()
+}
+
+type Expr_BadExpr struct {
+	BadExpr *BadExprNode `protobuf:"bytes,1,opt,name=bad_expr,json=badExpr,proto3,oneof"`
+}
+
+type Expr_Ident struct {
+	Ident *IdentNode `protobuf:"bytes,2,opt,name=ident,proto3,oneof"`
+}
+
+type Expr_Ellipsis struct {
+	Ellipsis *EllipsisNode `protobuf:"bytes,3,opt,name=ellipsis,proto3,oneof"`
+}
+
+type Expr_BasicLit struct {
+	BasicLit *BasicLitNode `protobuf:"bytes,4,opt,name=basic_lit,json=basicLit,proto3,oneof"`
+}
+
+type Expr_FuncLit struct {
+	FuncLit *FuncLitNode `protobuf:"bytes,5,opt,name=func_lit,json=funcLit,proto3,oneof"`
+}
+
+type Expr_CompositeLit struct {
+	CompositeLit *CompositeLitNode `protobuf:"bytes,6,opt,name=composite_lit,json=compositeLit,proto3,oneof"`
+}
+
+type Expr_ParenExpr struct {
+	ParenExpr *ParenExprNode `protobuf:"bytes,7,opt,name=paren_expr,json=parenExpr,proto3,oneof"`
+}
+
+type Expr_SelectorExpr struct {
+	SelectorExpr *SelectorExprNode `protobuf:"bytes,8,opt,name=selector_expr,json=selectorExpr,proto3,oneof"`
+}
+
+type Expr_IndexExpr struct {
+	IndexExpr *IndexExprNode `protobuf:"bytes,9,opt,name=index_expr,json=indexExpr,proto3,oneof"`
+}
+
+type Expr_IndexListExpr struct {
+	IndexListExpr *IndexListExprNode `protobuf:"bytes,10,opt,name=index_list_expr,json=indexListExpr,proto3,oneof"`
+}
+
+type Expr_SliceExpr struct {
+	SliceExpr *SliceExprNode `protobuf:"bytes,11,opt,name=slice_expr,json=sliceExpr,proto3,oneof"`
+}
+
+type Expr_TypeAssertExpr struct {
+	TypeAssertExpr *TypeAssertExprNode `protobuf:"bytes,12,opt,name=type_assert_expr,json=typeAssertExpr,proto3,oneof"`
+}
+
+type Expr_CallExpr struct {
+	CallExpr *CallExprNode `protobuf:"bytes,13,opt,name=call_expr,json=callExpr,proto3,oneof"`
+}
+
+type Expr_StarExpr struct {
+	StarExpr *StarExprNode `protobuf:"bytes,14,opt,name=star_expr,json=starExpr,proto3,oneof"`
+}
+
+type Expr_UnaryExpr struct {
+	UnaryExpr *UnaryExprNode `protobuf:"bytes,15,opt,name=unary_expr,json=unaryExpr,proto3,oneof"`
+}
+
+type Expr_BinaryExpr struct {
+	BinaryExpr *BinaryExprNode `protobuf:"bytes,16,opt,name=binary_expr,json=binaryExpr,proto3,oneof"`
+}
+
+type Expr_KeyValueExpr struct {
+	KeyValueExpr *KeyValueExprNode `protobuf:"bytes,17,opt,name=key_value_expr,json=keyValueExpr,proto3,oneof"`
+}
+
+type Expr_ArrayType struct {
+	ArrayType *ArrayTypeNode `protobuf:"bytes,18,opt,name=array_type,json=arrayType,proto3,oneof"`
+}
+
+type Expr_StructType struct {
+	StructType *StructTypeNode `protobuf:"bytes,19,opt,name=struct_type,json=structType,proto3,oneof"`
+}
+
+type Expr_FuncType struct {
+	FuncType *FuncTypeNode `protobuf:"bytes,20,opt,name=func_type,json=funcType,proto3,oneof"`
+}
+
+type Expr_InterfaceType struct {
+	InterfaceType *InterfaceTypeNode `protobuf:"bytes,21,opt,name=interface_type,json=interfaceType,proto3,oneof"`
+}
+
+type Expr_MapType struct {
+	MapType *MapTypeNode `protobuf:"bytes,22,opt,name=map_type,json=mapType,proto3,oneof"`
+}
+
+type Expr_ChanType struct {
+	ChanType *ChanTypeNode `protobuf:"bytes,23,opt,name=chan_type,json=chanType,proto3,oneof"`
+}
+
+func (*Expr_BadExpr) isExpr_Node() {}
+
+func (*Expr_Ident) isExpr_Node() {}
+
+func (*Expr_Ellipsis) isExpr_Node() {}
+
+func (*Expr_BasicLit) isExpr_Node() {}
+
+func (*Expr_FuncLit) isExpr_Node() {}
+
+func (*Expr_CompositeLit) isExpr_Node() {}
+
+func (*Expr_ParenExpr) isExpr_Node() {}
+
+func (*Expr_SelectorExpr) isExpr_Node() {}
+
+func (*Expr_IndexExpr) isExpr_Node() {}
+
+func (*Expr_IndexListExpr) isExpr_Node() {}
+
+func (*Expr_SliceExpr) isExpr_Node() {}
+
+func (*Expr_TypeAssertExpr) isExpr_Node() {}
+
+func (*Expr_CallExpr) isExpr_Node() {}
+
+func (*Expr_StarExpr) isExpr_Node() {}
+
+func (*Expr_UnaryExpr) isExpr_Node() {}
+
+func (*Expr_BinaryExpr) isExpr_Node() {}
+
+func (*Expr_KeyValueExpr) isExpr_Node() {}
+
+func (*Expr_ArrayType) isExpr_Node() {}
+
+func (*Expr_StructType) isExpr_Node() {}
+
+func (*Expr_FuncType) isExpr_Node() {}
+
+func (*Expr_InterfaceType) isExpr_Node() {}
+
+func (*Expr_MapType) isExpr_Node() {}
+
+func (*Expr_ChanType) isExpr_Node() {}
+
+// Stmt is one of the nodes implementing IStmtNode.
+type Stmt struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Node:
+	//	*Stmt_BadStmt
+	//	*Stmt_DeclStmt
+	//	*Stmt_EmptyStmt
+	//	*Stmt_LabeledStmt
+	//	*Stmt_ExprStmt
+	//	*Stmt_SendStmt
+	//	*Stmt_IncDecStmt
+	//	*Stmt_AssignStmt
+	//	*Stmt_GoStmt
+	//	*Stmt_DeferStmt
+	//	*Stmt_ReturnStmt
+	//	*Stmt_BranchStmt
+	//	*Stmt_BlockStmt
+	//	*Stmt_IfStmt
+	//	*Stmt_CaseClause
+	//	*Stmt_SwitchStmt
+	//	*Stmt_TypeSwitchStmt
+	//	*Stmt_CommClause
+	//	*Stmt_SelectStmt
+	//	*Stmt_ForStmt
+	//	*Stmt_RangeStmt
+	Node isStmt_Node `protobuf_oneof:"node"`
+}
+
+func (x *Stmt) Reset() {
+	*x = Stmt{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ast_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Stmt) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Stmt) ProtoMessage() {}
+
+func (x *Stmt) ProtoReflect() protoreflect.Message {
+	mi := &file_ast_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Stmt.ProtoReflect.Descriptor instead.
+func (*Stmt) Descriptor() ([]byte, []int) {
+	return file_ast_proto_rawDescGZIP(), []int{1}
+}
+
+func (m *Stmt) GetNode() isStmt_Node {
+	if m != nil {
+		return m.Node
+	}
+	return nil
+}
+
+func (x *Stmt) GetBadStmt() *BadStmtNode {
+	if x, ok := x.GetNode().(*Stmt_BadStmt); ok {
+		return x.BadStmt
+	}
+	return nil
+}
+
+func (x *Stmt) GetDeclStmt() *DeclStmtNode {
+	if x, ok := x.GetNode().(*Stmt_DeclStmt); ok {
+		return x.DeclStmt
+	}
+	return nil
+}
+
+func (x *Stmt) GetEmptyStmt() *EmptyStmtNode {
+	if x, ok := x.GetNode().(*Stmt_EmptyStmt); ok {
+		return x.EmptyStmt
+	}
+	return nil
+}
+
+func (x *Stmt) GetLabeledStmt() *LabeledStmtNode {
+	if x, ok := x.GetNode().(*Stmt_LabeledStmt); ok {
+		return x.LabeledStmt
+	}
+	return nil
+}
+
+func (x *Stmt) GetExprStmt() *ExprStmtNode {
+	if x, ok := x.GetNode().(*Stmt_ExprStmt); ok {
+		return x.ExprStmt
+	}
+	return nil
+}
+
+func (x *Stmt) GetSendStmt() *SendStmtNode {
+	if x, ok := x.GetNode().(*Stmt_SendStmt); ok {
+		return x.SendStmt
+	}
+	return nil
+}
+
+func (x *Stmt) GetIncDecStmt() *IncDecStmtNode {
+	if x, ok := x.GetNode().(*Stmt_IncDecStmt); ok {
+		return x.IncDecStmt
+	}
+	return nil
+}
+
+func (x *Stmt) GetAssignStmt() *AssignStmtNode {
+	if x, ok := x.GetNode().(*Stmt_AssignStmt); ok {
+		return x.AssignStmt
+	}
+	return nil
+}
+
+func (x *Stmt) GetGoStmt() *GoStmtNode {
+	if x, ok := x.GetNode().(*Stmt_GoStmt); ok {
+		return x.GoStmt
+	}
+	return nil
+}
+
+func (x *Stmt) GetDeferStmt() *DeferStmtNode {
+	if x, ok := x.GetNode().(*Stmt_DeferStmt); ok {
+		return x.DeferStmt
+	}
+	return nil
+}
+
+func (x *Stmt) GetReturnStmt() *ReturnStmtNode {
+	if x, ok := x.GetNode().(*Stmt_ReturnStmt); ok {
+		return x.ReturnStmt
+	}
+	return nil
+}
+
+func (x *Stmt) GetBranchStmt() *BranchStmtNode {
+	if x, ok := x.GetNode().(*Stmt_BranchStmt); ok {
+		return x.BranchStmt
+	}
+	return nil
+}
+
+func (x *Stmt) GetBlockStmt() *BlockStmtNode {
+	if x, ok := x.GetNode().(*Stmt_BlockStmt); ok {
+		return x.BlockStmt
+	}
+	return nil
+}
+
+func (x *Stmt) GetIfStmt() *IfStmtNode {
+	if x, ok := x.GetNode().(*Stmt_IfStmt); ok {
+		return x.IfStmt
+	}
+	return nil
+}
+
+func (x *Stmt) GetCaseClause() *CaseClauseNode {
+	if x, ok := x.GetNode().(*Stmt_CaseClause); ok {
+		return x.CaseClause
+	}
+	return nil
+}
+
+func (x *Stmt) GetSwitchStmt() *SwitchStmtNode {
+	if x, ok := x.GetNode().(*Stmt_SwitchStmt); ok {
+		return x.SwitchStmt
+	}
+	return nil
+}
+
+func (x *Stmt) GetTypeSwitchStmt() *TypeSwitchStmtNode {
+	if x, ok := x.GetNode().(*Stmt_TypeSwitchStmt); ok {
+		return x.TypeSwitchStmt
+	}
+	return nil
+}
+
+func (x *Stmt) GetCommClause() *CommClauseNode {
+	if x, ok := x.GetNode().(*Stmt_CommClause); ok {
+		return x.CommClause
+	}
+	return nil
+}
+
+func (x *Stmt) GetSelectStmt() *SelectStmtNode {
+	if x, ok := x.GetNode().(*Stmt_SelectStmt); ok {
+		return x.SelectStmt
+	}
+	return nil
+}
+
+func (x *Stmt) GetForStmt() *ForStmtNode {
+	if x, ok := x.GetNode().(*Stmt_ForStmt); ok {
+		return x.ForStmt
+	}
+	return nil
+}
+
+func (x *Stmt) GetRangeStmt() *RangeStmtNode {
+	if x, ok := x.GetNode().(*Stmt_RangeStmt); ok {
+		return x.RangeStmt
+	}
+	return nil
+}
+
+type isStmt_Node interface {
+	isStmt_Node()
+}
+
+type Stmt_BadStmt struct {
+	BadStmt *BadStmtNode `protobuf:"bytes,1,opt,name=bad_stmt,json=badStmt,proto3,oneof"`
+}
+
+type Stmt_DeclStmt struct {
+	DeclStmt *DeclStmtNode `protobuf:"bytes,2,opt,name=decl_stmt,json=declStmt,proto3,oneof"`
+}
+
+type Stmt_EmptyStmt struct {
+	EmptyStmt *EmptyStmtNode `protobuf:"bytes,3,opt,name=empty_stmt,json=emptyStmt,proto3,oneof"`
+}
+
+type Stmt_LabeledStmt struct {
+	LabeledStmt *LabeledStmtNode `protobuf:"bytes,4,opt,name=labeled_stmt,json=labeledStmt,proto3,oneof"`
+}
+
+type Stmt_ExprStmt struct {
+	ExprStmt *ExprStmtNode `protobuf:"bytes,5,opt,name=expr_stmt,json=exprStmt,proto3,oneof"`
+}
+
+type Stmt_SendStmt struct {
+	SendStmt *SendStmtNode `protobuf:"bytes,6,opt,name=send_stmt,json=sendStmt,proto3,oneof"`
+}
+
+type Stmt_IncDecStmt struct {
+	IncDecStmt *IncDecStmtNode `protobuf:"bytes,7,opt,name=inc_dec_stmt,json=incDecStmt,proto3,oneof"`
+}
+
+type Stmt_AssignStmt struct {
+	AssignStmt *AssignStmtNode `protobuf:"bytes,8,opt,name=assign_stmt,json=assignStmt,proto3,oneof"`
+}
+
+type Stmt_GoStmt struct {
+	GoStmt *GoStmtNode `protobuf:"bytes,9,opt,name=go_stmt,json=goStmt,proto3,oneof"`
+}
+
+type Stmt_DeferStmt struct {
+	DeferStmt *DeferStmtNode `protobuf:"bytes,10,opt,name=defer_stmt,json=deferStmt,proto3,oneof"`
+}
+
+type Stmt_ReturnStmt struct {
+	ReturnStmt *ReturnStmtNode `protobuf:"bytes,11,opt,name=return_stmt,json=returnStmt,proto3,oneof"`
+}
+
+type Stmt_BranchStmt struct {
+	BranchStmt *BranchStmtNode `protobuf:"bytes,12,opt,name=branch_stmt,json=branchStmt,proto3,oneof"`
+}
+
+type Stmt_BlockStmt struct {
+	BlockStmt *BlockStmtNode `protobuf:"bytes,13,opt,name=block_stmt,json=blockStmt,proto3,oneof"`
+}
+
+type Stmt_IfStmt struct {
+	IfStmt *IfStmtNode `protobuf:"bytes,14,opt,name=if_stmt,json=ifStmt,proto3,oneof"`
+}
+
+type Stmt_CaseClause struct {
+	CaseClause *CaseClauseNode `protobuf:"bytes,15,opt,name=case_clause,json=caseClause,proto3,oneof"`
+}
+
+type Stmt_SwitchStmt struct {
+	SwitchStmt *SwitchStmtNode `protobuf:"bytes,16,opt,name=switch_stmt,json=switchStmt,proto3,oneof"`
+}
+
+type Stmt_TypeSwitchStmt struct {
+	TypeSwitchStmt *TypeSwitchStmtNode `protobuf:"bytes,17,opt,name=type_switch_stmt,json=typeSwitchStmt,proto3,oneof"`
+}
+
+type Stmt_CommClause struct {
+	CommClause *CommClauseNode `protobuf:"bytes,18,opt,name=comm_clause,json=commClause,proto3,oneof"`
+}
+
+type Stmt_SelectStmt struct {
+	SelectStmt *SelectStmtNode `protobuf:"bytes,19,opt,name=select_stmt,json=selectStmt,proto3,oneof"`
+}
+
+type Stmt_ForStmt struct {
+	ForStmt *ForStmtNode `protobuf:"bytes,20,opt,name=for_stmt,json=forStmt,proto3,oneof"`
+}
+
+type Stmt_RangeStmt struct {
+	RangeStmt *RangeStmtNode `protobuf:"bytes,21,opt,name=range_stmt,json=rangeStmt,proto3,oneof"`
+}
+
+func (*Stmt_BadStmt) isStmt_Node() {}
+
+func (*Stmt_DeclStmt) isStmt_Node() {}
+
+func (*Stmt_EmptyStmt) isStmt_Node() {}
+
+func (*Stmt_LabeledStmt) isStmt_Node() {}
+
+func (*Stmt_ExprStmt) isStmt_Node() {}
+
+func (*Stmt_SendStmt) isStmt_Node() {}
+
+func (*Stmt_IncDecStmt) isStmt_Node() {}
+
+func (*Stmt_AssignStmt) isStmt_Node() {}
+
+func (*Stmt_GoStmt) isStmt_Node() {}
+
+func (*Stmt_DeferStmt) isStmt_Node() {}
+
+func (*Stmt_ReturnStmt) isStmt_Node() {}
+
+func (*Stmt_BranchStmt) isStmt_Node() {}
+
+func (*Stmt_BlockStmt) isStmt_Node() {}
+
+func (*Stmt_IfStmt) isStmt_Node() {}
+
+func (*Stmt_CaseClause) isStmt_Node() {}
+
+func (*Stmt_SwitchStmt) isStmt_Node() {}
+
+func (*Stmt_TypeSwitchStmt) isStmt_Node() {}
+
+func (*Stmt_CommClause) isStmt_Node() {}
+
+func (*Stmt_SelectStmt) isStmt_Node() {}
+
+func (*Stmt_ForStmt) isStmt_Node() {}
+
+func (*Stmt_RangeStmt) isStmt_Node() {}
+
+// Spec is one of the nodes implementing ISpecNode.
+type Spec struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Node:
+	//	*Spec_ImportSpec
+	//	*Spec_ValueSpec
+	//	*Spec_TypeSpec
+	Node isSpec_Node `protobuf_oneof:"node"`
+}
+
+func (x *Spec) Reset() {
+	*x = Spec{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ast_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Spec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Spec) ProtoMessage() {}
+
+func (x *Spec) ProtoReflect() protoreflect.Message {
+	mi := &file_ast_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Spec.ProtoReflect.Descriptor instead.
+func (*Spec) Descriptor() ([]byte, []int) {
+	return file_ast_proto_rawDescGZIP(), []int{2}
+}
+
+func (m *Spec) GetNode() isSpec_Node {
+	if m != nil {
+		return m.Node
+	}
+	return nil
+}
+
+func (x *Spec) GetImportSpec() *ImportSpecNode {
+	if x, ok := x.GetNode().(*Spec_ImportSpec); ok {
+		return x.ImportSpec
+	}
+	return nil
+}
+
+func (x *Spec) GetValueSpec() *ValueSpecNode {
+	if x, ok := x.GetNode().(*Spec_ValueSpec); ok {
+		return x.ValueSpec
+	}
+	return nil
+}
+
+func (x *Spec) GetTypeSpec() *TypeSpecNode {
+	if x, ok := x.GetNode().(*Spec_TypeSpec); ok {
+		return x.TypeSpec
+	}
+	return nil
+}
+
+type isSpec_Node interface {
+	isSpec_Node()
+}
+
+type Spec_ImportSpec struct {
+	ImportSpec *ImportSpecNode `protobuf:"bytes,1,opt,name=import_spec,json=importSpec,proto3,oneof"`
+}
+
+type Spec_ValueSpec struct {
+	ValueSpec *ValueSpecNode `protobuf:"bytes,2,opt,name=value_spec,json=valueSpec,proto3,oneof"`
+}
+
+type Spec_TypeSpec struct {
+	TypeSpec *TypeSpecNode `protobuf:"bytes,3,opt,name=type_spec,json=typeSpec,proto3,oneof"`
+}
+
+func (*Spec_ImportSpec) isSpec_Node() {}
+
+func (*Spec_ValueSpec) isSpec_Node() {}
+
+func (*Spec_TypeSpec) isSpec_Node() {}
+
+// Decl is one of the nodes implementing IDeclNode.
+type Decl struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Node:
+	//	*Decl_BadDecl
+	//	*Decl_GenDecl
+	//	*Decl_FuncDecl
+	Node isDecl_Node `protobuf_oneof:"node"`
+}
+
+func (x *Decl) Reset() {
+	*x = Decl{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ast_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Decl) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Decl) ProtoMessage() {}
+
+func (x *Decl) ProtoReflect() protoreflect.Message {
+	mi := &file_ast_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Decl.ProtoReflect.Descriptor instead.
+func (*Decl) Descriptor() ([]byte, []int) {
+	return file_ast_proto_rawDescGZIP(), []int{3}
+}
+
+func (m *Decl) GetNode() isDecl_Node {
+	if m != nil {
+		return m.Node
+	}
+	return nil
+}
+
+func (x *Decl) GetBadDecl() *BadDeclNode {
+	if x, ok := x.GetNode().(*Decl_BadDecl); ok {
+		return x.BadDecl
+	}
+	return nil
+}
+
+func (x *Decl) GetGenDecl() *GenDeclNode {
+	if x, ok := x.GetNode().(*Decl_GenDecl); ok {
+		return x.GenDecl
+	}
+	return nil
+}
+
+func (x *Decl) GetFuncDecl() *FuncDeclNode {
+	if x, ok := x.GetNode().(*Decl_FuncDecl); ok {
+		return x.FuncDecl
+	}
+	return nil
+}
+
+type isDecl_Node interface {
+	isDecl_Node()
+}
+
+type Decl_BadDecl struct {
+	BadDecl *BadDeclNode `protobuf:"bytes,1,opt,name=bad_decl,json=badDecl,proto3,oneof"`
+}
+
+type Decl_GenDecl struct {
+	GenDecl *GenDeclNode `protobuf:"bytes,2,opt,name=gen_decl,json=genDecl,proto3,oneof"`
+}
+
+type Decl_FuncDecl struct {
+	FuncDecl *FuncDeclNode `protobuf:"bytes,3,opt,name=func_decl,json=funcDecl,proto3,oneof"`
+}
+
+func (*Decl_BadDecl) isDecl_Node() {}
+
+func (*Decl_GenDecl) isDecl_Node() {}
+
+func (*Decl_FuncDecl) isDecl_Node() {}
+
+type PositionNode struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RefId    int32  `protobuf:"varint,1,opt,name=ref_id,json=refId,proto3" json:"ref_id,omitempty"`
+	Filename string `protobuf:"bytes,2,opt,name=filename,proto3" json:"filename,omitempty"`
+	Offset   int32  `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`
+	Line     int32  `protobuf:"varint,4,opt,name=line,proto3" json:"line,omitempty"`
+	Column   int32  `protobuf:"varint,5,opt,name=column,proto3" json:"column,omitempty"`
+}
+
+func (x *PositionNode) Reset() {
+	*x = PositionNode{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ast_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PositionNode) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PositionNode) ProtoMessage() {}
+
+func (x *PositionNode) ProtoReflect() protoreflect.Message {
+	mi := &file_ast_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PositionNode.ProtoReflect.Descriptor instead.
+func (*PositionNode) Descriptor() ([]byte, []int) {
+	return file_ast_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *PositionNode) GetRefId() int32 {
+	if x != nil {
+		return x.RefId
+	}
+	return 0
+}
+
+func (x *PositionNode) GetFilename() string {
+	if x != nil {
+		return x.Filename
+	}
+	return ""
+}
+
+func (x *PositionNode) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *PositionNode) GetLine() int32 {
+	if x != nil {
+		return x.Line
+	}
+	return 0
+}
+
+func (x *PositionNode) GetColumn() int32 {
+	if x != nil {
+		return x.Column
+	}
+	return 0
+}
+
+type CommentNode struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RefId int32         `protobuf:"varint,1,opt,name=ref_id,json=refId,proto3" json:"ref_id,omitempty"`
+	Slash *PositionNode `protobuf:"bytes,2,opt,name=slash,proto3" json:"slash,omitempty"`
+	Text  string        `protobuf:"bytes,3,opt,name=text,proto3" json:"text,omitempty"`
+}
+
+func (x *CommentNode) Reset() {
+	*x = CommentNode{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ast_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CommentNode) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CommentNode) ProtoMessage() {}
+
+func (x *CommentNode) ProtoReflect() protoreflect.Message {
+	mi := &file_ast_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CommentNode.ProtoReflect.Descriptor instead.
+func (*CommentNode) Descriptor() ([]byte, []int) {
+	return file_ast_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *CommentNode) GetRefId() int32 {
+	if x != nil {
+		return x.RefId
+	}
+	return 0
+}
+
+func (x *CommentNode) GetSlash() *PositionNode {
+	if x != nil {
+		return x.Slash
+	}
+	return nil
+}
+
+func (x *CommentNode) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+type CommentGroupNode struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RefId int32          `protobuf:"varint,1,opt,name=ref_id,json=refId,proto3" json:"ref_id,omitempty"`
+	List  []*CommentNode `protobuf:"bytes,2,rep,name=list,proto3" json:"list,omitempty"`
+}
+
+func (x *CommentGroupNode) Reset() {
+	*x = CommentGroupNode{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ast_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CommentGroupNode) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CommentGroupNode) ProtoMessage() {}
+
+func (x *CommentGroupNode) ProtoReflect() protoreflect.Message {
+	mi := &file_ast_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CommentGroupNode.ProtoReflect.Descriptor instead.
+func (*CommentGroupNode) Descriptor() ([]byte, []int) {
+	return file_ast_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *CommentGroupNode) GetRefId() int32 {
+	if x != nil {
+		return x.RefId
+	}
+	return 0
+}
+
+func (x *CommentGroupNode) GetList() []*CommentNode {
+	if x != nil {
+		return x.List
+	}
+	return nil
+}
+
+type FieldNode struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RefId   int32             `protobuf:"varint,1,opt,name=ref_id,json=refId,proto3" json:"ref_id,omitempty"`
+	Doc     *CommentGroupNode `protobuf:"bytes,2,opt,name=doc,proto3" json:"doc,omitempty"`
+	Names   []*IdentNode      `protobuf:"bytes,3,rep,name=names,proto3" json:"names,omitempty"`
+	Type    *Expr             `protobuf:"bytes,4,opt,name=type,proto3" json:"type,omitempty"`
+	Tag     *BasicLitNode     `protobuf:"bytes,5,opt,name=tag,proto3" json:"tag,omitempty"`
+	Comment *CommentGroupNode `protobuf:"bytes,6,opt,name=comment,proto3" json:"comment,omitempty"`
+}
+
+func (x *FieldNode) Reset() {
+	*x = FieldNode{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ast_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FieldNode) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FieldNode) ProtoMessage() {}
+
+func (x *FieldNode) ProtoReflect() protoreflect.Message {
+	mi := &file_ast_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FieldNode.ProtoReflect.Descriptor instead.
+func (*FieldNode) Descriptor() ([]byte, []int) {
+	return file_ast_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *FieldNode) GetRefId() int32 {
+	if x != nil {
+		return x.RefId
+	}
+	return 0
+}
+
+func (x *FieldNode) GetDoc() *CommentGroupNode {
+	if x != nil {
+		return x.Doc
+	}
+	return nil
+}
+
+func (x *FieldNode) GetNames() []*IdentNode {
+	if x != nil {
+		return x.Names
+	}
+	return nil
+}
+
+func (x *FieldNode) GetType() *Expr {
+	if x != nil {
+		return x.Type
+	}
+	return nil
+}
+
+func (x *FieldNode) GetTag() *BasicLitNode {
+	if x != nil {
+		return x.Tag
+	}
+	return nil
+}
+
+func (x *FieldNode) GetComment() *CommentGroupNode {
+	if x != nil {
+		return x.Comment
+	}
+	return nil
+}
+
+type FieldListNode struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RefId   int32         `protobuf:"varint,1,opt,name=ref_id,json=refId,proto3" json:"ref_id,omitempty"`
+	Opening *PositionNode `protobuf:"bytes,2,opt,name=opening,proto3" json:"opening,omitempty"`
+	List    []*FieldNode  `protobuf:"bytes,3,rep,name=list,proto3" json:"list,omitempty"`
+	Closing *PositionNode `protobuf:"bytes,4,opt,name=closing,proto3" json:"closing,omitempty"`
+}
+
+func (x *FieldListNode) Reset() {
+	*x = FieldListNode{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ast_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FieldListNode) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FieldListNode) ProtoMessage() {}
+
+func (x *FieldListNode) ProtoReflect() protoreflect.Message {
+	mi := &file_ast_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FieldListNode.ProtoReflect.Descriptor instead.
+func (*FieldListNode) Descriptor() ([]byte, []int) {
+	return file_ast_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *FieldListNode) GetRefId() int32 {
+	if x != nil {
+		return x.RefId
+	}
+	return 0
+}
+
+func (x *FieldListNode) GetOpening() *PositionNode {
+	if x != nil {
+		return x.Opening
+	}
+	return nil
+}
+
+func (x *FieldListNode) GetList() []*FieldNode {
+	if x != nil {
+		return x.List
+	}
+	return nil
+}
+
+func (x *FieldListNode) GetClosing() *PositionNode {
+	if x != nil {
+		return x.Closing
+	}
+	return nil
+}
+
+type BadExprNode struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RefId int32         `protobuf:"varint,1,opt,name=ref_id,json=refId,proto3" json:"ref_id,omitempty"`
+	From  *PositionNode `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
+	To    *PositionNode `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`
+}
+
+func (x *BadExprNode) Reset() {
+	*x = BadExprNode{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ast_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BadExprNode) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BadExprNode) ProtoMessage() {}
+
+func (x *BadExprNode) ProtoReflect() protoreflect.Message {
+	mi := &file_ast_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BadExprNode.ProtoReflect.Descriptor instead.
+func (*BadExprNode) Descriptor() ([]byte, []int) {
+	return file_ast_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *BadExprNode) GetRefId() int32 {
+	if x != nil {
+		return x.RefId
+	}
+	return 0
+}
+
+func (x *BadExprNode) GetFrom() *PositionNode {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *BadExprNode) GetTo() *PositionNode {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+type IdentNode struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RefId   int32         `protobuf:"varint,1,opt,name=ref_id,json=refId,proto3" json:"ref_id,omitempty"`
+	NamePos *PositionNode `protobuf:"bytes,2,opt,name=name_pos,json=namePos,proto3" json:"name_pos,omitempty"`
+	Name    string        `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *IdentNode) Reset() {
+	*x = IdentNode{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ast_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IdentNode) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IdentNode) ProtoMessage() {}
+
+func (x *IdentNode) ProtoReflect() protoreflect.Message {
+	mi := &file_ast_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IdentNode.ProtoReflect.Descriptor instead.
+func (*IdentNode) Descriptor() ([]byte, []int) {
+	return file_ast_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *IdentNode) GetRefId() int32 {
+	if x != nil {
+		return x.RefId
+	}
+	return 0
+}
+
+func (x *IdentNode) GetNamePos() *PositionNode {
+	if x != nil {
+		return x.NamePos
+	}
+	return nil
+}
+
+func (x *IdentNode) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type EllipsisNode struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RefId    int32         `protobuf:"varint,1,opt,name=ref_id,json=refId,proto3" json:"ref_id,omitempty"`
+	Ellipsis *PositionNode `protobuf:"bytes,2,opt,name=ellipsis,proto3" json:"ellipsis,omitempty"`
+	Elt      *Expr         `protobuf:"bytes,3,opt,name=elt,proto3" json:"elt,omitempty"`
+}
+
+func (x *EllipsisNode) Reset() {
+	*x = EllipsisNode{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ast_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EllipsisNode) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EllipsisNode) ProtoMessage() {}
+
+func (x *EllipsisNode) ProtoReflect() protoreflect.Message {
+	mi := &file_ast_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EllipsisNode.ProtoReflect.Descriptor instead.
+func (*EllipsisNode) Descriptor() ([]byte, []int) {
+	return file_ast_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *EllipsisNode) GetRefId() int32 {
+	if x != nil {
+		return x.RefId
+	}
+	return 0
+}
+
+func (x *EllipsisNode) GetEllipsis() *PositionNode {
+	if x != nil {
+		return x.Ellipsis
+	}
+	return nil
+}
+
+func (x *EllipsisNode) GetElt() *Expr {
+	if x != nil {
+		return x.Elt
+	}
+	return nil
+}
+
+type BasicLitNode struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RefId    int32         `protobuf:"varint,1,opt,name=ref_id,json=refId,proto3" json:"ref_id,omitempty"`
+	ValuePos *PositionNode `protobuf:"bytes,2,opt,name=value_pos,json=valuePos,proto3" json:"value_pos,omitempty"`
+	Kind     string        `protobuf:"bytes,3,opt,name=kind,proto3" json:"kind,omitempty"`
+	Value    string        `protobuf:"bytes,4,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *BasicLitNode) Reset() {
+	*x = BasicLitNode{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ast_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BasicLitNode) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BasicLitNode) ProtoMessage() {}
+
+func (x *BasicLitNode) ProtoReflect() protoreflect.Message {
+	mi := &file_ast_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BasicLitNode.ProtoReflect.Descriptor instead.
+func (*BasicLitNode) Descriptor() ([]byte, []int) {
+	return file_ast_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *BasicLitNode) GetRefId() int32 {
+	if x != nil {
+		return x.RefId
+	}
+	return 0
+}
+
+func (x *BasicLitNode) GetValuePos() *PositionNode {
+	if x != nil {
+		return x.ValuePos
+	}
+	return nil
+}
+
+func (x *BasicLitNode) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *BasicLitNode) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+type FuncLitNode struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RefId int32          `protobuf:"varint,1,opt,name=ref_id,json=refId,proto3" json:"ref_id,omitempty"`
+	Type  *FuncTypeNode  `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	Body  *BlockStmtNode `protobuf:"bytes,3,opt,name=body,proto3" json:"body,omitempty"`
+}
+
+func (x *FuncLitNode) Reset() {
+	*x = FuncLitNode{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ast_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FuncLitNode) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FuncLitNode) ProtoMessage() {}
+
+func (x *FuncLitNode) ProtoReflect() protoreflect.Message {
+	mi := &file_ast_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FuncLitNode.ProtoReflect.Descriptor instead.
+func (*FuncLitNode) Descriptor() ([]byte, []int) {
+	return file_ast_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *FuncLitNode) GetRefId() int32 {
+	if x != nil {
+		return x.RefId
+	}
+	return 0
+}
+
+func (x *FuncLitNode) GetType() *FuncTypeNode {
+	if x != nil {
+		return x.Type
+	}
+	return nil
+}
+
+func (x *FuncLitNode) GetBody() *BlockStmtNode {
+	if x != nil {
+		return x.Body
+	}
+	return nil
+}
+
+type CompositeLitNode struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RefId      int32         `protobuf:"varint,1,opt,name=ref_id,json=refId,proto3" json:"ref_id,omitempty"`
+	Type       *Expr         `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	Lbrace     *PositionNode `protobuf:"bytes,3,opt,name=lbrace,proto3" json:"lbrace,omitempty"`
+	Elts       []*Expr       `protobuf:"bytes,4,rep,name=elts,proto3" json:"elts,omitempty"`
+	Rbrace     *PositionNode `protobuf:"bytes,5,opt,name=rbrace,proto3" json:"rbrace,omitempty"`
+	Incomplete bool          `protobuf:"varint,6,opt,name=incomplete,proto3" json:"incomplete,omitempty"`
+}
+
+func (x *CompositeLitNode) Reset() {
+	*x = CompositeLitNode{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ast_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CompositeLitNode) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompositeLitNode) ProtoMessage() {}
+
+func (x *CompositeLitNode) ProtoReflect() protoreflect.Message {
+	mi := &file_ast_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompositeLitNode.ProtoReflect.Descriptor instead.
+func (*CompositeLitNode) Descriptor() ([]byte, []int) {
+	return file_ast_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *CompositeLitNode) GetRefId() int32 {
+	if x != nil {
+		return x.RefId
+	}
+	return 0
+}
+
+func (x *CompositeLitNode) GetType() *Expr {
+	if x != nil {
+		return x.Type
+	}
+	return nil
+}
+
+func (x *CompositeLitNode) GetLbrace() *PositionNode {
+	if x != nil {
+		return x.Lbrace
+	}
+	return nil
+}
+
+func (x *CompositeLitNode) GetElts() []*Expr {
+	if x != nil {
+		return x.Elts
+	}
+	return nil
+}
+
+func (x *CompositeLitNode) GetRbrace() *PositionNode {
+	if x != nil {
+		return x.Rbrace
+	}
+	return nil
+}
+
+func (x *CompositeLitNode) GetIncomplete() bool {
+	if x != nil {
+		return x.Incomplete
+	}
+	return false
+}
+
+type ParenExprNode struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RefId  int32         `protobuf:"varint,1,opt,name=ref_id,json=refId,proto3" json:"ref_id,omitempty"`
+	Lparen *PositionNode `protobuf:"bytes,2,opt,name=lparen,proto3" json:"lparen,omitempty"`
+	X      *Expr         `protobuf:"bytes,3,opt,name=x,proto3" json:"x,omitempty"`
+	Rparen *PositionNode `protobuf:"bytes,4,opt,name=rparen,proto3" json:"rparen,omitempty"`
+}
+
+func (x *ParenExprNode) Reset() {
+	*x = ParenExprNode{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ast_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ParenExprNode) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ParenExprNode) ProtoMessage() {}
+
+func (x *ParenExprNode) ProtoReflect() protoreflect.Message {
+	mi := &file_ast_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ParenExprNode.ProtoReflect.Descriptor instead.
+func (*ParenExprNode) Descriptor() ([]byte, []int) {
+	return file_ast_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ParenExprNode) GetRefId() int32 {
+	if x != nil {
+		return x.RefId
+	}
+	return 0
+}
+
+func (x *ParenExprNode) GetLparen() *PositionNode {
+	if x != nil {
+		return x.Lparen
+	}
+	return nil
+}
+
+func (x *ParenExprNode) GetX() *Expr {
+	if x != nil {
+		return x.X
+	}
+	return nil
+}
+
+func (x *ParenExprNode) GetRparen() *PositionNode {
+	if x != nil {
+		return x.Rparen
+	}
+	return nil
+}
+
+type SelectorExprNode struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RefId int32      `protobuf:"varint,1,opt,name=ref_id,json=refId,proto3" json:"ref_id,omitempty"`
+	X     *Expr      `protobuf:"bytes,2,opt,name=x,proto3" json:"x,omitempty"`
+	Sel   *IdentNode `protobuf:"bytes,3,opt,name=sel,proto3" json:"sel,omitempty"`
+}
+
+func (x *SelectorExprNode) Reset() {
+	*x = SelectorExprNode{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ast_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SelectorExprNode) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SelectorExprNode) ProtoMessage() {}
+
+func (x *SelectorExprNode) ProtoReflect() protoreflect.Message {
+	mi := &file_ast_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SelectorExprNode.ProtoReflect.Descriptor instead.
+func (*SelectorExprNode) Descriptor() ([]byte, []int) {
+	return file_ast_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *SelectorExprNode) GetRefId() int32 {
+	if x != nil {
+		return x.RefId
+	}
+	return 0
+}
+
+func (x *SelectorExprNode) GetX() *Expr {
+	if x != nil {
+		return x.X
+	}
+	return nil
+}
+
+func (x *SelectorExprNode) GetSel() *IdentNode {
+	if x != nil {
+		return x.Sel
+	}
+	return nil
+}
+
+type IndexExprNode struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RefId  int32         `protobuf:"varint,1,opt,name=ref_id,json=refId,proto3" json:"ref_id,omitempty"`
+	X      *Expr         `protobuf:"bytes,2,opt,name=x,proto3" json:"x,omitempty"`
+	Lbrack *PositionNode `protobuf:"bytes,3,opt,name=lbrack,proto3" json:"lbrack,omitempty"`
+	Index  *Expr         `protobuf:"bytes,4,opt,name=index,proto3" json:"index,omitempty"`
+	Rbrack *PositionNode `protobuf:"bytes,5,opt,name=rbrack,proto3" json:"rbrack,omitempty"`
+}
+
+func (x *IndexExprNode) Reset() {
+	*x = IndexExprNode{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ast_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IndexExprNode) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IndexExprNode) ProtoMessage() {}
+
+func (x *IndexExprNode) ProtoReflect() protoreflect.Message {
+	mi := &file_ast_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IndexExprNode.ProtoReflect.Descriptor instead.
+func (*IndexExprNode) Descriptor() ([]byte, []int) {
+	return file_ast_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *IndexExprNode) GetRefId() int32 {
+	if x != nil {
+		return x.RefId
+	}
+	return 0
+}
+
+func (x *IndexExprNode) GetX() *Expr {
+	if x != nil {
+		return x.X
+	}
+	return nil
+}
+
+func (x *IndexExprNode) GetLbrack() *PositionNode {
+	if x != nil {
+		return x.Lbrack
+	}
+	return nil
+}
+
+func (x *IndexExprNode) GetIndex() *Expr {
+	if x != nil {
+		return x.Index
+	}
+	return nil
+}
+
+func (x *IndexExprNode) GetRbrack() *PositionNode {
+	if x != nil {
+		return x.Rbrack
+	}
+	return nil
+}
+
+type IndexListExprNode struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RefId   int32         `protobuf:"varint,1,opt,name=ref_id,json=refId,proto3" json:"ref_id,omitempty"`
+	X       *Expr         `protobuf:"bytes,2,opt,name=x,proto3" json:"x,omitempty"`
+	Lbrack  *PositionNode `protobuf:"bytes,3,opt,name=lbrack,proto3" json:"lbrack,omitempty"`
+	Indices []*Expr       `protobuf:"bytes,4,rep,name=indices,proto3" json:"indices,omitempty"`
+	Rbrack  *PositionNode `protobuf:"bytes,5,opt,name=rbrack,proto3" json:"rbrack,omitempty"`
+}
+
+func (x *IndexListExprNode) Reset() {
+	*x = IndexListExprNode{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ast_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IndexListExprNode) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IndexListExprNode) ProtoMessage() {}
+
+func (x *IndexListExprNode) ProtoReflect() protoreflect.Message {
+	mi := &file_ast_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IndexListExprNode.ProtoReflect.Descriptor instead.
+func (*IndexListExprNode) Descriptor() ([]byte, []int) {
+	return file_ast_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *IndexListExprNode) GetRefId() int32 {
+	if x != nil {
+		return x.RefId
+	}
+	return 0
+}
+
+func (x *IndexListExprNode) GetX() *Expr {
+	if x != nil {
+		return x.X
+	}
+	return nil
+}
+
+func (x *IndexListExprNode) GetLbrack() *PositionNode {
+	if x != nil {
+		return x.Lbrack
+	}
+	return nil
+}
+
+func (x *IndexListExprNode) GetIndices() []*Expr {
+	if x != nil {
+		return x.Indices
+	}
+	return nil
+}
+
+func (x *IndexListExprNode) GetRbrack() *PositionNode {
+	if x != nil {
+		return x.Rbrack
+	}
+	return nil
+}
+
+type SliceExprNode struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RefId  int32         `protobuf:"varint,1,opt,name=ref_id,json=refId,proto3" json:"ref_id,omitempty"`
+	X      *Expr         `protobuf:"bytes,2,opt,name=x,proto3" json:"x,omitempty"`
+	Lbrack *PositionNode `protobuf:"bytes,3,opt,name=lbrack,proto3" json:"lbrack,omitempty"`
+	Low    *Expr         `protobuf:"bytes,4,opt,name=low,proto3" json:"low,omitempty"`
+	High   *Expr         `protobuf:"bytes,5,opt,name=high,proto3" json:"high,omitempty"`
+	Max    *Expr         `protobuf:"bytes,6,opt,name=max,proto3" json:"max,omitempty"`
+	Slice3 bool          `protobuf:"varint,7,opt,name=slice3,proto3" json:"slice3,omitempty"`
+	Rbrack *PositionNode `protobuf:"bytes,8,opt,name=rbrack,proto3" json:"rbrack,omitempty"`
+}
+
+func (x *SliceExprNode) Reset() {
+	*x = SliceExprNode{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ast_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SliceExprNode) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SliceExprNode) ProtoMessage() {}
+
+func (x *SliceExprNode) ProtoReflect() protoreflect.Message {
+	mi := &file_ast_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SliceExprNode.ProtoReflect.Descriptor instead.
+func (*SliceExprNode) Descriptor() ([]byte, []int) {
+	return file_ast_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *SliceExprNode) GetRefId() int32 {
+	if x != nil {
+		return x.RefId
+	}
+	return 0
+}
+
+func (x *SliceExprNode) GetX() *Expr {
+	if x != nil {
+		return x.X
+	}
+	return nil
+}
+
+func (x *SliceExprNode) GetLbrack() *PositionNode {
+	if x != nil {
+		return x.Lbrack
+	}
+	return nil
+}
+
+func (x *SliceExprNode) GetLow() *Expr {
+	if x != nil {
+		return x.Low
+	}
+	return nil
+}
+
+func (x *SliceExprNode) GetHigh() *Expr {
+	if x != nil {
+		return x.High
+	}
+	return nil
+}
+
+func (x *SliceExprNode) GetMax() *Expr {
+	if x != nil {
+		return x.Max
+	}
+	return nil
+}
+
+func (x *SliceExprNode) GetSlice3() bool {
+	if x != nil {
+		return x.Slice3
+	}
+	return false
+}
+
+func (x *SliceExprNode) GetRbrack() *PositionNode {
+	if x != nil {
+		return x.Rbrack
+	}
+	return nil
+}
+
+type TypeAssertExprNode struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RefId  int32         `protobuf:"varint,1,opt,name=ref_id,json=refId,proto3" json:"ref_id,omitempty"`
+	X      *Expr         `protobuf:"bytes,2,opt,name=x,proto3" json:"x,omitempty"`
+	Lparen *PositionNode `protobuf:"bytes,3,opt,name=lparen,proto3" json:"lparen,omitempty"`
+	Type   *Expr         `protobuf:"bytes,4,opt,name=type,proto3" json:"type,omitempty"`
+	Rparen *PositionNode `protobuf:"bytes,5,opt,name=rparen,proto3" json:"rparen,omitempty"`
+}
+
+func (x *TypeAssertExprNode) Reset() {
+	*x = TypeAssertExprNode{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ast_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TypeAssertExprNode) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TypeAssertExprNode) ProtoMessage() {}
+
+func (x *TypeAssertExprNode) ProtoReflect() protoreflect.Message {
+	mi := &file_ast_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TypeAssertExprNode.ProtoReflect.Descriptor instead.
+func (*TypeAssertExprNode) Descriptor() ([]byte, []int) {
+	return file_ast_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *TypeAssertExprNode) GetRefId() int32 {
+	if x != nil {
+		return x.RefId
+	}
+	return 0
+}
+
+func (x *TypeAssertExprNode) GetX() *Expr {
+	if x != nil {
+		return x.X
+	}
+	return nil
+}
+
+func (x *TypeAssertExprNode) GetLparen() *PositionNode {
+	if x != nil {
+		return x.Lparen
+	}
+	return nil
+}
+
+func (x *TypeAssertExprNode) GetType() *Expr {
+	if x != nil {
+		return x.Type
+	}
+	return nil
+}
+
+func (x *TypeAssertExprNode) GetRparen() *PositionNode {
+	if x != nil {
+		return x.Rparen
+	}
+	return nil
+}
+
+type CallExprNode struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RefId    int32         `protobuf:"varint,1,opt,name=ref_id,json=refId,proto3" json:"ref_id,omitempty"`
+	Fun      *Expr         `protobuf:"bytes,2,opt,name=fun,proto3" json:"fun,omitempty"`
+	Lparen   *PositionNode `protobuf:"bytes,3,opt,name=lparen,proto3" json:"lparen,omitempty"`
+	Args     []*Expr       `protobuf:"bytes,4,rep,name=args,proto3" json:"args,omitempty"`
+	Ellipsis *PositionNode `protobuf:"bytes,5,opt,name=ellipsis,proto3" json:"ellipsis,omitempty"`
+	Rparen   *PositionNode `protobuf:"bytes,6,opt,name=rparen,proto3" json:"rparen,omitempty"`
+}
+
+func (x *CallExprNode) Reset() {
+	*x = CallExprNode{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ast_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CallExprNode) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CallExprNode) ProtoMessage() {}
+
+func (x *CallExprNode) ProtoReflect() protoreflect.Message {
+	mi := &file_ast_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CallExprNode.ProtoReflect.Descriptor instead.
+func (*CallExprNode) Descriptor() ([]byte, []int) {
+	return file_ast_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *CallExprNode) GetRefId() int32 {
+	if x != nil {
+		return x.RefId
+	}
+	return 0
+}
+
+func (x *CallExprNode) GetFun() *Expr {
+	if x != nil {
+		return x.Fun
+	}
+	return nil
+}
+
+func (x *CallExprNode) GetLparen() *PositionNode {
+	if x != nil {
+		return x.Lparen
+	}
+	return nil
+}
+
+func (x *CallExprNode) GetArgs() []*Expr {
+	if x != nil {
+		return x.Args
+	}
+	return nil
+}
+
+func (x *CallExprNode) GetEllipsis() *PositionNode {
+	if x != nil {
+		return x.Ellipsis
+	}
+	return nil
+}
+
+func (x *CallExprNode) GetRparen() *PositionNode {
+	if x != nil {
+		return x.Rparen
+	}
+	return nil
+}
+
+type StarExprNode struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RefId int32         `protobuf:"varint,1,opt,name=ref_id,json=refId,proto3" json:"ref_id,omitempty"`
+	Star  *PositionNode `protobuf:"bytes,2,opt,name=star,proto3" json:"star,omitempty"`
+	X     *Expr         `protobuf:"bytes,3,opt,name=x,proto3" json:"x,omitempty"`
+}
+
+func (x *StarExprNode) Reset() {
+	*x = StarExprNode{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ast_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StarExprNode) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StarExprNode) ProtoMessage() {}
+
+func (x *StarExprNode) ProtoReflect() protoreflect.Message {
+	mi := &file_ast_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StarExprNode.ProtoReflect.Descriptor instead.
+func (*StarExprNode) Descriptor() ([]byte, []int) {
+	return file_ast_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *StarExprNode) GetRefId() int32 {
+	if x != nil {
+		return x.RefId
+	}
+	return 0
+}
+
+func (x *StarExprNode) GetStar() *PositionNode {
+	if x != nil {
+		return x.Star
+	}
+	return nil
+}
+
+func (x *StarExprNode) GetX() *Expr {
+	if x != nil {
+		return x.X
+	}
+	return nil
+}
+
+type UnaryExprNode struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RefId int32         `protobuf:"varint,1,opt,name=ref_id,json=refId,proto3" json:"ref_id,omitempty"`
+	OpPos *PositionNode `protobuf:"bytes,2,opt,name=op_pos,json=opPos,proto3" json:"op_pos,omitempty"`
+	Op    string        `protobuf:"bytes,3,opt,name=op,proto3" json:"op,omitempty"`
+	X     *Expr         `protobuf:"bytes,4,opt,name=x,proto3" json:"x,omitempty"`
+}
+
+func (x *UnaryExprNode) Reset() {
+	*x = UnaryExprNode{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ast_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UnaryExprNode) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnaryExprNode) ProtoMessage() {}
+
+func (x *UnaryExprNode) ProtoReflect() protoreflect.Message {
+	mi := &file_ast_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnaryExprNode.ProtoReflect.Descriptor instead.
+func (*UnaryExprNode) Descriptor() ([]byte, []int) {
+	return file_ast_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *UnaryExprNode) GetRefId() int32 {
+	if x != nil {
+		return x.RefId
+	}
+	return 0
+}
+
+func (x *UnaryExprNode) GetOpPos() *PositionNode {
+	if x != nil {
+		return x.OpPos
+	}
+	return nil
+}
+
+func (x *UnaryExprNode) GetOp() string {
+	if x != nil {
+		return x.Op
+	}
+	return ""
+}
+
+func (x *UnaryExprNode) GetX() *Expr {
+	if x != nil {
+		return x.X
+	}
+	return nil
+}
+
+type BinaryExprNode struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RefId int32         `protobuf:"varint,1,opt,name=ref_id,json=refId,proto3" json:"ref_id,omitempty"`
+	X     *Expr         `protobuf:"bytes,2,opt,name=x,proto3" json:"x,omitempty"`
+	OpPos *PositionNode `protobuf:"bytes,3,opt,name=op_pos,json=opPos,proto3" json:"op_pos,omitempty"`
+	Op    string        `protobuf:"bytes,4,opt,name=op,proto3" json:"op,omitempty"`
+	Y     *Expr         `protobuf:"bytes,5,opt,name=y,proto3" json:"y,omitempty"`
+}
+
+func (x *BinaryExprNode) Reset() {
+	*x = BinaryExprNode{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ast_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BinaryExprNode) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BinaryExprNode) ProtoMessage() {}
+
+func (x *BinaryExprNode) ProtoReflect() protoreflect.Message {
+	mi := &file_ast_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BinaryExprNode.ProtoReflect.Descriptor instead.
+func (*BinaryExprNode) Descriptor() ([]byte, []int) {
+	return file_ast_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *BinaryExprNode) GetRefId() int32 {
+	if x != nil {
+		return x.RefId
+	}
+	return 0
+}
+
+func (x *BinaryExprNode) GetX() *Expr {
+	if x != nil {
+		return x.X
+	}
+	return nil
+}
+
+func (x *BinaryExprNode) GetOpPos() *PositionNode {
+	if x != nil {
+		return x.OpPos
+	}
+	return nil
+}
+
+func (x *BinaryExprNode) GetOp() string {
+	if x != nil {
+		return x.Op
+	}
+	return ""
+}
+
+func (x *BinaryExprNode) GetY() *Expr {
+	if x != nil {
+		return x.Y
+	}
+	return nil
+}
+
+type KeyValueExprNode struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RefId int32         `protobuf:"varint,1,opt,name=ref_id,json=refId,proto3" json:"ref_id,omitempty"`
+	Key   *Expr         `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	Colon *PositionNode `protobuf:"bytes,3,opt,name=colon,proto3" json:"colon,omitempty"`
+	Value *Expr         `protobuf:"bytes,4,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *KeyValueExprNode) Reset() {
+	*x = KeyValueExprNode{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ast_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *KeyValueExprNode) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KeyValueExprNode) ProtoMessage() {}
+
+func (x *KeyValueExprNode) ProtoReflect() protoreflect.Message {
+	mi := &file_ast_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KeyValueExprNode.ProtoReflect.Descriptor instead.
+func (*KeyValueExprNode) Descriptor() ([]byte, []int) {
+	return file_ast_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *KeyValueExprNode) GetRefId() int32 {
+	if x != nil {
+		return x.RefId
+	}
+	return 0
+}
+
+func (x *KeyValueExprNode) GetKey() *Expr {
+	if x != nil {
+		return x.Key
+	}
+	return nil
+}
+
+func (x *KeyValueExprNode) GetColon() *PositionNode {
+	if x != nil {
+		return x.Colon
+	}
+	return nil
+}
+
+func (x *KeyValueExprNode) GetValue() *Expr {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+type ArrayTypeNode struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RefId  int32         `protobuf:"varint,1,opt,name=ref_id,json=refId,proto3" json:"ref_id,omitempty"`
+	Lbrack *PositionNode `protobuf:"bytes,2,opt,name=lbrack,proto3" json:"lbrack,omitempty"`
+	Len    *Expr         `protobuf:"bytes,3,opt,name=len,proto3" json:"len,omitempty"`
+	Elt    *Expr         `protobuf:"bytes,4,opt,name=elt,proto3" json:"elt,omitempty"`
+}
+
+func (x *ArrayTypeNode) Reset() {
+	*x = ArrayTypeNode{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ast_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ArrayTypeNode) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ArrayTypeNode) ProtoMessage() {}
+
+func (x *ArrayTypeNode) ProtoReflect() protoreflect.Message {
+	mi := &file_ast_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ArrayTypeNode.ProtoReflect.Descriptor instead.
+func (*ArrayTypeNode) Descriptor() ([]byte, []int) {
+	return file_ast_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *ArrayTypeNode) GetRefId() int32 {
+	if x != nil {
+		return x.RefId
+	}
+	return 0
+}
+
+func (x *ArrayTypeNode) GetLbrack() *PositionNode {
+	if x != nil {
+		return x.Lbrack
+	}
+	return nil
+}
+
+func (x *ArrayTypeNode) GetLen() *Expr {
+	if x != nil {
+		return x.Len
+	}
+	return nil
+}
+
+func (x *ArrayTypeNode) GetElt() *Expr {
+	if x != nil {
+		return x.Elt
+	}
+	return nil
+}
+
+type StructTypeNode struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RefId      int32          `protobuf:"varint,1,opt,name=ref_id,json=refId,proto3" json:"ref_id,omitempty"`
+	Struct     *PositionNode  `protobuf:"bytes,2,opt,name=struct,proto3" json:"struct,omitempty"`
+	Fields     *FieldListNode `protobuf:"bytes,3,opt,name=fields,proto3" json:"fields,omitempty"`
+	Incomplete bool           `protobuf:"varint,4,opt,name=incomplete,proto3" json:"incomplete,omitempty"`
+}
+
+func (x *StructTypeNode) Reset() {
+	*x = StructTypeNode{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ast_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StructTypeNode) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StructTypeNode) ProtoMessage() {}
+
+func (x *StructTypeNode) ProtoReflect() protoreflect.Message {
+	mi := &file_ast_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StructTypeNode.ProtoReflect.Descriptor instead.
+func (*StructTypeNode) Descriptor() ([]byte, []int) {
+	return file_ast_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *StructTypeNode) GetRefId() int32 {
+	if x != nil {
+		return x.RefId
+	}
+	return 0
+}
+
+func (x *StructTypeNode) GetStruct() *PositionNode {
+	if x != nil {
+		return x.Struct
+	}
+	return nil
+}
+
+func (x *StructTypeNode) GetFields() *FieldListNode {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+func (x *StructTypeNode) GetIncomplete() bool {
+	if x != nil {
+		return x.Incomplete
+	}
+	return false
+}
+
+type FuncTypeNode struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RefId      int32          `protobuf:"varint,1,opt,name=ref_id,json=refId,proto3" json:"ref_id,omitempty"`
+	Func       *PositionNode  `protobuf:"bytes,2,opt,name=func,proto3" json:"func,omitempty"`
+	TypeParams *FieldListNode `protobuf:"bytes,3,opt,name=type_params,json=typeParams,proto3" json:"type_params,omitempty"`
+	Params     *FieldListNode `protobuf:"bytes,4,opt,name=params,proto3" json:"params,omitempty"`
+	Results    *FieldListNode `protobuf:"bytes,5,opt,name=results,proto3" json:"results,omitempty"`
+}
+
+func (x *FuncTypeNode) Reset() {
+	*x = FuncTypeNode{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ast_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FuncTypeNode) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FuncTypeNode) ProtoMessage() {}
+
+func (x *FuncTypeNode) ProtoReflect() protoreflect.Message {
+	mi := &file_ast_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FuncTypeNode.ProtoReflect.Descriptor instead.
+func (*FuncTypeNode) Descriptor() ([]byte, []int) {
+	return file_ast_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *FuncTypeNode) GetRefId() int32 {
+	if x != nil {
+		return x.RefId
+	}
+	return 0
+}
+
+func (x *FuncTypeNode) GetFunc() *PositionNode {
+	if x != nil {
+		return x.Func
+	}
+	return nil
+}
+
+func (x *FuncTypeNode) GetTypeParams() *FieldListNode {
+	if x != nil {
+		return x.TypeParams
+	}
+	return nil
+}
+
+func (x *FuncTypeNode) GetParams() *FieldListNode {
+	if x != nil {
+		return x.Params
+	}
+	return nil
+}
+
+func (x *FuncTypeNode) GetResults() *FieldListNode {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+type InterfaceTypeNode struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RefId      int32          `protobuf:"varint,1,opt,name=ref_id,json=refId,proto3" json:"ref_id,omitempty"`
+	Interface  *PositionNode  `protobuf:"bytes,2,opt,name=interface,proto3" json:"interface,omitempty"`
+	Methods    *FieldListNode `protobuf:"bytes,3,opt,name=methods,proto3" json:"methods,omitempty"`
+	Incomplete bool           `protobuf:"varint,4,opt,name=incomplete,proto3" json:"incomplete,omitempty"`
+}
+
+func (x *InterfaceTypeNode) Reset() {
+	*x = InterfaceTypeNode{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ast_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InterfaceTypeNode) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InterfaceTypeNode) ProtoMessage() {}
+
+func (x *InterfaceTypeNode) ProtoReflect() protoreflect.Message {
+	mi := &file_ast_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InterfaceTypeNode.ProtoReflect.Descriptor instead.
+func (*InterfaceTypeNode) Descriptor() ([]byte, []int) {
+	return file_ast_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *InterfaceTypeNode) GetRefId() int32 {
+	if x != nil {
+		return x.RefId
+	}
+	return 0
+}
+
+func (x *InterfaceTypeNode) GetInterface() *PositionNode {
+	if x != nil {
+		return x.Interface
+	}
+	return nil
+}
+
+func (x *InterfaceTypeNode) GetMethods() *FieldListNode {
+	if x != nil {
+		return x.Methods
+	}
+	return nil
+}
+
+func (x *InterfaceTypeNode) GetIncomplete() bool {
+	if x != nil {
+		return x.Incomplete
+	}
+	return false
+}
+
+type MapTypeNode struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RefId int32         `protobuf:"varint,1,opt,name=ref_id,json=refId,proto3" json:"ref_id,omitempty"`
+	Map   *PositionNode `protobuf:"bytes,2,opt,name=map,proto3" json:"map,omitempty"`
+	Key   *Expr         `protobuf:"bytes,3,opt,name=key,proto3" json:"key,omitempty"`
+	Value *Expr         `protobuf:"bytes,4,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *MapTypeNode) Reset() {
+	*x = MapTypeNode{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ast_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MapTypeNode) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MapTypeNode) ProtoMessage() {}
+
+func (x *MapTypeNode) ProtoReflect() protoreflect.Message {
+	mi := &file_ast_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MapTypeNode.ProtoReflect.Descriptor instead.
+func (*MapTypeNode) Descriptor() ([]byte, []int) {
+	return file_ast_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *MapTypeNode) GetRefId() int32 {
+	if x != nil {
+		return x.RefId
+	}
+	return 0
+}
+
+func (x *MapTypeNode) GetMap() *PositionNode {
+	if x != nil {
+		return x.Map
+	}
+	return nil
+}
+
+func (x *MapTypeNode) GetKey() *Expr {
+	if x != nil {
+		return x.Key
+	}
+	return nil
+}
+
+func (x *MapTypeNode) GetValue() *Expr {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+type ChanTypeNode struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RefId int32         `protobuf:"varint,1,opt,name=ref_id,json=refId,proto3" json:"ref_id,omitempty"`
+	Begin *PositionNode `protobuf:"bytes,2,opt,name=begin,proto3" json:"begin,omitempty"`
+	Arrow *PositionNode `protobuf:"bytes,3,opt,name=arrow,proto3" json:"arrow,omitempty"`
+	Dir   string        `protobuf:"bytes,4,opt,name=dir,proto3" json:"dir,omitempty"`
+	Value *Expr         `protobuf:"bytes,5,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *ChanTypeNode) Reset() {
+	*x = ChanTypeNode{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ast_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChanTypeNode) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChanTypeNode) ProtoMessage() {}
+
+func (x *ChanTypeNode) ProtoReflect() protoreflect.Message {
+	mi := &file_ast_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChanTypeNode.ProtoReflect.Descriptor instead.
+func (*ChanTypeNode) Descriptor() ([]byte, []int) {
+	return file_ast_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *ChanTypeNode) GetRefId() int32 {
+	if x != nil {
+		return x.RefId
+	}
+	return 0
+}
+
+func (x *ChanTypeNode) GetBegin() *PositionNode {
+	if x != nil {
+		return x.Begin
+	}
+	return nil
+}
+
+func (x *ChanTypeNode) GetArrow() *PositionNode {
+	if x != nil {
+		return x.Arrow
+	}
+	return nil
+}
+
+func (x *ChanTypeNode) GetDir() string {
+	if x != nil {
+		return x.Dir
+	}
+	return ""
+}
+
+func (x *ChanTypeNode) GetValue() *Expr {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+type BadStmtNode struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RefId int32         `protobuf:"varint,1,opt,name=ref_id,json=refId,proto3" json:"ref_id,omitempty"`
+	From  *PositionNode `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
+	To    *PositionNode `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`
+}
+
+func (x *BadStmtNode) Reset() {
+	*x = BadStmtNode{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ast_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BadStmtNode) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BadStmtNode) ProtoMessage() {}
+
+func (x *BadStmtNode) ProtoReflect() protoreflect.Message {
+	mi := &file_ast_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BadStmtNode.ProtoReflect.Descriptor instead.
+func (*BadStmtNode) Descriptor() ([]byte, []int) {
+	return file_ast_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *BadStmtNode) GetRefId() int32 {
+	if x != nil {
+		return x.RefId
+	}
+	return 0
+}
+
+func (x *BadStmtNode) GetFrom() *PositionNode {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *BadStmtNode) GetTo() *PositionNode {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+type DeclStmtNode struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RefId int32 `protobuf:"varint,1,opt,name=ref_id,json=refId,proto3" json:"ref_id,omitempty"`
+	Decl  *Decl `protobuf:"bytes,2,opt,name=decl,proto3" json:"decl,omitempty"`
+}
+
+func (x *DeclStmtNode) Reset() {
+	*x = DeclStmtNode{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ast_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeclStmtNode) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeclStmtNode) ProtoMessage() {}
+
+func (x *DeclStmtNode) ProtoReflect() protoreflect.Message {
+	mi := &file_ast_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeclStmtNode.ProtoReflect.Descriptor instead.
+func (*DeclStmtNode) Descriptor() ([]byte, []int) {
+	return file_ast_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *DeclStmtNode) GetRefId() int32 {
+	if x != nil {
+		return x.RefId
+	}
+	return 0
+}
+
+func (x *DeclStmtNode) GetDecl() *Decl {
+	if x != nil {
+		return x.Decl
+	}
+	return nil
+}
+
+type EmptyStmtNode struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RefId     int32         `protobuf:"varint,1,opt,name=ref_id,json=refId,proto3" json:"ref_id,omitempty"`
+	Semicolon *PositionNode `protobuf:"bytes,2,opt,name=semicolon,proto3" json:"semicolon,omitempty"`
+	Implicit  bool          `protobuf:"varint,3,opt,name=implicit,proto3" json:"implicit,omitempty"`
+}
+
+func (x *EmptyStmtNode) Reset() {
+	*x = EmptyStmtNode{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ast_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EmptyStmtNode) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EmptyStmtNode) ProtoMessage() {}
+
+func (x *EmptyStmtNode) ProtoReflect() protoreflect.Message {
+	mi := &file_ast_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EmptyStmtNode.ProtoReflect.Descriptor instead.
+func (*EmptyStmtNode) Descriptor() ([]byte, []int) {
+	return file_ast_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *EmptyStmtNode) GetRefId() int32 {
+	if x != nil {
+		return x.RefId
+	}
+	return 0
+}
+
+func (x *EmptyStmtNode) GetSemicolon() *PositionNode {
+	if x != nil {
+		return x.Semicolon
+	}
+	return nil
+}
+
+func (x *EmptyStmtNode) GetImplicit() bool {
+	if x != nil {
+		return x.Implicit
+	}
+	return false
+}
+
+type LabeledStmtNode struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RefId int32         `protobuf:"varint,1,opt,name=ref_id,json=refId,proto3" json:"ref_id,omitempty"`
+	Label *IdentNode    `protobuf:"bytes,2,opt,name=label,proto3" json:"label,omitempty"`
+	Colon *PositionNode `protobuf:"bytes,3,opt,name=colon,proto3" json:"colon,omitempty"`
+	Stmt  *Stmt         `protobuf:"bytes,4,opt,name=stmt,proto3" json:"stmt,omitempty"`
+}
+
+func (x *LabeledStmtNode) Reset() {
+	*x = LabeledStmtNode{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ast_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LabeledStmtNode) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LabeledStmtNode) ProtoMessage() {}
+
+func (x *LabeledStmtNode) ProtoReflect() protoreflect.Message {
+	mi := &file_ast_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LabeledStmtNode.ProtoReflect.Descriptor instead.
+func (*LabeledStmtNode) Descriptor() ([]byte, []int) {
+	return file_ast_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *LabeledStmtNode) GetRefId() int32 {
+	if x != nil {
+		return x.RefId
+	}
+	return 0
+}
+
+func (x *LabeledStmtNode) GetLabel() *IdentNode {
+	if x != nil {
+		return x.Label
+	}
+	return nil
+}
+
+func (x *LabeledStmtNode) GetColon() *PositionNode {
+	if x != nil {
+		return x.Colon
+	}
+	return nil
+}
+
+func (x *LabeledStmtNode) GetStmt() *Stmt {
+	if x != nil {
+		return x.Stmt
+	}
+	return nil
+}
+
+type ExprStmtNode struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RefId int32 `protobuf:"varint,1,opt,name=ref_id,json=refId,proto3" json:"ref_id,omitempty"`
+	X     *Expr `protobuf:"bytes,2,opt,name=x,proto3" json:"x,omitempty"`
+}
+
+func (x *ExprStmtNode) Reset() {
+	*x = ExprStmtNode{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ast_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExprStmtNode) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExprStmtNode) ProtoMessage() {}
+
+func (x *ExprStmtNode) ProtoReflect() protoreflect.Message {
+	mi := &file_ast_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExprStmtNode.ProtoReflect.Descriptor instead.
+func (*ExprStmtNode) Descriptor() ([]byte, []int) {
+	return file_ast_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *ExprStmtNode) GetRefId() int32 {
+	if x != nil {
+		return x.RefId
+	}
+	return 0
+}
+
+func (x *ExprStmtNode) GetX() *Expr {
+	if x != nil {
+		return x.X
+	}
+	return nil
+}
+
+type SendStmtNode struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RefId int32         `protobuf:"varint,1,opt,name=ref_id,json=refId,proto3" json:"ref_id,omitempty"`
+	Chan  *Expr         `protobuf:"bytes,2,opt,name=chan,proto3" json:"chan,omitempty"`
+	Arrow *PositionNode `protobuf:"bytes,3,opt,name=arrow,proto3" json:"arrow,omitempty"`
+	Value *Expr         `protobuf:"bytes,4,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *SendStmtNode) Reset() {
+	*x = SendStmtNode{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ast_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SendStmtNode) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendStmtNode) ProtoMessage() {}
+
+func (x *SendStmtNode) ProtoReflect() protoreflect.Message {
+	mi := &file_ast_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendStmtNode.ProtoReflect.Descriptor instead.
+func (*SendStmtNode) Descriptor() ([]byte, []int) {
+	return file_ast_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *SendStmtNode) GetRefId() int32 {
+	if x != nil {
+		return x.RefId
+	}
+	return 0
+}
+
+func (x *SendStmtNode) GetChan() *Expr {
+	if x != nil {
+		return x.Chan
+	}
+	return nil
+}
+
+func (x *SendStmtNode) GetArrow() *PositionNode {
+	if x != nil {
+		return x.Arrow
+	}
+	return nil
+}
+
+func (x *SendStmtNode) GetValue() *Expr {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+type IncDecStmtNode struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RefId  int32         `protobuf:"varint,1,opt,name=ref_id,json=refId,proto3" json:"ref_id,omitempty"`
+	X      *Expr         `protobuf:"bytes,2,opt,name=x,proto3" json:"x,omitempty"`
+	TokPos *PositionNode `protobuf:"bytes,3,opt,name=tok_pos,json=tokPos,proto3" json:"tok_pos,omitempty"`
+	Tok    string        `protobuf:"bytes,4,opt,name=tok,proto3" json:"tok,omitempty"`
+}
+
+func (x *IncDecStmtNode) Reset() {
+	*x = IncDecStmtNode{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ast_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IncDecStmtNode) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IncDecStmtNode) ProtoMessage() {}
+
+func (x *IncDecStmtNode) ProtoReflect() protoreflect.Message {
+	mi := &file_ast_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IncDecStmtNode.ProtoReflect.Descriptor instead.
+func (*IncDecStmtNode) Descriptor() ([]byte, []int) {
+	return file_ast_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *IncDecStmtNode) GetRefId() int32 {
+	if x != nil {
+		return x.RefId
+	}
+	return 0
+}
+
+func (x *IncDecStmtNode) GetX() *Expr {
+	if x != nil {
+		return x.X
+	}
+	return nil
+}
+
+func (x *IncDecStmtNode) GetTokPos() *PositionNode {
+	if x != nil {
+		return x.TokPos
+	}
+	return nil
+}
+
+func (x *IncDecStmtNode) GetTok() string {
+	if x != nil {
+		return x.Tok
+	}
+	return ""
+}
+
+type AssignStmtNode struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RefId  int32         `protobuf:"varint,1,opt,name=ref_id,json=refId,proto3" json:"ref_id,omitempty"`
+	Lhs    []*Expr       `protobuf:"bytes,2,rep,name=lhs,proto3" json:"lhs,omitempty"`
+	TokPos *PositionNode `protobuf:"bytes,3,opt,name=tok_pos,json=tokPos,proto3" json:"tok_pos,omitempty"`
+	Tok    string        `protobuf:"bytes,4,opt,name=tok,proto3" json:"tok,omitempty"`
+	Rhs    []*Expr       `protobuf:"bytes,5,rep,name=rhs,proto3" json:"rhs,omitempty"`
+}
+
+func (x *AssignStmtNode) Reset() {
+	*x = AssignStmtNode{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ast_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AssignStmtNode) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AssignStmtNode) ProtoMessage() {}
+
+func (x *AssignStmtNode) ProtoReflect() protoreflect.Message {
+	mi := &file_ast_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AssignStmtNode.ProtoReflect.Descriptor instead.
+func (*AssignStmtNode) Descriptor() ([]byte, []int) {
+	return file_ast_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *AssignStmtNode) GetRefId() int32 {
+	if x != nil {
+		return x.RefId
+	}
+	return 0
+}
+
+func (x *AssignStmtNode) GetLhs() []*Expr {
+	if x != nil {
+		return x.Lhs
+	}
+	return nil
+}
+
+func (x *AssignStmtNode) GetTokPos() *PositionNode {
+	if x != nil {
+		return x.TokPos
+	}
+	return nil
+}
+
+func (x *AssignStmtNode) GetTok() string {
+	if x != nil {
+		return x.Tok
+	}
+	return ""
+}
+
+func (x *AssignStmtNode) GetRhs() []*Expr {
+	if x != nil {
+		return x.Rhs
+	}
+	return nil
+}
+
+type GoStmtNode struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RefId int32         `protobuf:"varint,1,opt,name=ref_id,json=refId,proto3" json:"ref_id,omitempty"`
+	Go    *PositionNode `protobuf:"bytes,2,opt,name=go,proto3" json:"go,omitempty"`
+	Call  *CallExprNode `protobuf:"bytes,3,opt,name=call,proto3" json:"call,omitempty"`
+}
+
+func (x *GoStmtNode) Reset() {
+	*x = GoStmtNode{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ast_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GoStmtNode) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GoStmtNode) ProtoMessage() {}
+
+func (x *GoStmtNode) ProtoReflect() protoreflect.Message {
+	mi := &file_ast_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GoStmtNode.ProtoReflect.Descriptor instead.
+func (*GoStmtNode) Descriptor() ([]byte, []int) {
+	return file_ast_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *GoStmtNode) GetRefId() int32 {
+	if x != nil {
+		return x.RefId
+	}
+	return 0
+}
+
+func (x *GoStmtNode) GetGo() *PositionNode {
+	if x != nil {
+		return x.Go
+	}
+	return nil
+}
+
+func (x *GoStmtNode) GetCall() *CallExprNode {
+	if x != nil {
+		return x.Call
+	}
+	return nil
+}
+
+type DeferStmtNode struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RefId int32         `protobuf:"varint,1,opt,name=ref_id,json=refId,proto3" json:"ref_id,omitempty"`
+	Defer *PositionNode `protobuf:"bytes,2,opt,name=defer,proto3" json:"defer,omitempty"`
+	Call  *CallExprNode `protobuf:"bytes,3,opt,name=call,proto3" json:"call,omitempty"`
+}
+
+func (x *DeferStmtNode) Reset() {
+	*x = DeferStmtNode{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ast_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeferStmtNode) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeferStmtNode) ProtoMessage() {}
+
+func (x *DeferStmtNode) ProtoReflect() protoreflect.Message {
+	mi := &file_ast_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeferStmtNode.ProtoReflect.Descriptor instead.
+func (*DeferStmtNode) Descriptor() ([]byte, []int) {
+	return file_ast_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *DeferStmtNode) GetRefId() int32 {
+	if x != nil {
+		return x.RefId
+	}
+	return 0
+}
+
+func (x *DeferStmtNode) GetDefer() *PositionNode {
+	if x != nil {
+		return x.Defer
+	}
+	return nil
+}
+
+func (x *DeferStmtNode) GetCall() *CallExprNode {
+	if x != nil {
+		return x.Call
+	}
+	return nil
+}
+
+type ReturnStmtNode struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RefId   int32         `protobuf:"varint,1,opt,name=ref_id,json=refId,proto3" json:"ref_id,omitempty"`
+	Return  *PositionNode `protobuf:"bytes,2,opt,name=return,proto3" json:"return,omitempty"`
+	Results []*Expr       `protobuf:"bytes,3,rep,name=results,proto3" json:"results,omitempty"`
+}
+
+func (x *ReturnStmtNode) Reset() {
+	*x = ReturnStmtNode{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ast_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReturnStmtNode) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReturnStmtNode) ProtoMessage() {}
+
+func (x *ReturnStmtNode) ProtoReflect() protoreflect.Message {
+	mi := &file_ast_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReturnStmtNode.ProtoReflect.Descriptor instead.
+func (*ReturnStmtNode) Descriptor() ([]byte, []int) {
+	return file_ast_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *ReturnStmtNode) GetRefId() int32 {
+	if x != nil {
+		return x.RefId
+	}
+	return 0
+}
+
+func (x *ReturnStmtNode) GetReturn() *PositionNode {
+	if x != nil {
+		return x.Return
+	}
+	return nil
+}
+
+func (x *ReturnStmtNode) GetResults() []*Expr {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+type BranchStmtNode struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RefId  int32         `protobuf:"varint,1,opt,name=ref_id,json=refId,proto3" json:"ref_id,omitempty"`
+	TokPos *PositionNode `protobuf:"bytes,2,opt,name=tok_pos,json=tokPos,proto3" json:"tok_pos,omitempty"`
+	Tok    string        `protobuf:"bytes,3,opt,name=tok,proto3" json:"tok,omitempty"`
+	Label  *IdentNode    `protobuf:"bytes,4,opt,name=label,proto3" json:"label,omitempty"`
+}
+
+func (x *BranchStmtNode) Reset() {
+	*x = BranchStmtNode{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ast_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BranchStmtNode) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BranchStmtNode) ProtoMessage() {}
+
+func (x *BranchStmtNode) ProtoReflect() protoreflect.Message {
+	mi := &file_ast_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BranchStmtNode.ProtoReflect.Descriptor instead.
+func (*BranchStmtNode) Descriptor() ([]byte, []int) {
+	return file_ast_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *BranchStmtNode) GetRefId() int32 {
+	if x != nil {
+		return x.RefId
+	}
+	return 0
+}
+
+func (x *BranchStmtNode) GetTokPos() *PositionNode {
+	if x != nil {
+		return x.TokPos
+	}
+	return nil
+}
+
+func (x *BranchStmtNode) GetTok() string {
+	if x != nil {
+		return x.Tok
+	}
+	return ""
+}
+
+func (x *BranchStmtNode) GetLabel() *IdentNode {
+	if x != nil {
+		return x.Label
+	}
+	return nil
+}
+
+type BlockStmtNode struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RefId  int32         `protobuf:"varint,1,opt,name=ref_id,json=refId,proto3" json:"ref_id,omitempty"`
+	Lbrace *PositionNode `protobuf:"bytes,2,opt,name=lbrace,proto3" json:"lbrace,omitempty"`
+	List   []*Stmt       `protobuf:"bytes,3,rep,name=list,proto3" json:"list,omitempty"`
+	Rbrace *PositionNode `protobuf:"bytes,4,opt,name=rbrace,proto3" json:"rbrace,omitempty"`
+}
+
+func (x *BlockStmtNode) Reset() {
+	*x = BlockStmtNode{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ast_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BlockStmtNode) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlockStmtNode) ProtoMessage() {}
+
+func (x *BlockStmtNode) ProtoReflect() protoreflect.Message {
+	mi := &file_ast_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlockStmtNode.ProtoReflect.Descriptor instead.
+func (*BlockStmtNode) Descriptor() ([]byte, []int) {
+	return file_ast_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *BlockStmtNode) GetRefId() int32 {
+	if x != nil {
+		return x.RefId
+	}
+	return 0
+}
+
+func (x *BlockStmtNode) GetLbrace() *PositionNode {
+	if x != nil {
+		return x.Lbrace
+	}
+	return nil
+}
+
+func (x *BlockStmtNode) GetList() []*Stmt {
+	if x != nil {
+		return x.List
+	}
+	return nil
+}
+
+func (x *BlockStmtNode) GetRbrace() *PositionNode {
+	if x != nil {
+		return x.Rbrace
+	}
+	return nil
+}
+
+type IfStmtNode struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RefId int32          `protobuf:"varint,1,opt,name=ref_id,json=refId,proto3" json:"ref_id,omitempty"`
+	If    *PositionNode  `protobuf:"bytes,2,opt,name=if,proto3" json:"if,omitempty"`
+	Init  *Stmt          `protobuf:"bytes,3,opt,name=init,proto3" json:"init,omitempty"`
+	Cond  *Expr          `protobuf:"bytes,4,opt,name=cond,proto3" json:"cond,omitempty"`
+	Body  *BlockStmtNode `protobuf:"bytes,5,opt,name=body,proto3" json:"body,omitempty"`
+	Else  *Stmt          `protobuf:"bytes,6,opt,name=else,proto3" json:"else,omitempty"`
+}
+
+func (x *IfStmtNode) Reset() {
+	*x = IfStmtNode{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ast_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IfStmtNode) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IfStmtNode) ProtoMessage() {}
+
+func (x *IfStmtNode) ProtoReflect() protoreflect.Message {
+	mi := &file_ast_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IfStmtNode.ProtoReflect.Descriptor instead.
+func (*IfStmtNode) Descriptor() ([]byte, []int) {
+	return file_ast_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *IfStmtNode) GetRefId() int32 {
+	if x != nil {
+		return x.RefId
+	}
+	return 0
+}
+
+func (x *IfStmtNode) GetIf() *PositionNode {
+	if x != nil {
+		return x.If
+	}
+	return nil
+}
+
+func (x *IfStmtNode) GetInit() *Stmt {
+	if x != nil {
+		return x.Init
+	}
+	return nil
+}
+
+func (x *IfStmtNode) GetCond() *Expr {
+	if x != nil {
+		return x.Cond
+	}
+	return nil
+}
+
+func (x *IfStmtNode) GetBody() *BlockStmtNode {
+	if x != nil {
+		return x.Body
+	}
+	return nil
+}
+
+func (x *IfStmtNode) GetElse() *Stmt {
+	if x != nil {
+		return x.Else
+	}
+	return nil
+}
+
+type CaseClauseNode struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RefId int32         `protobuf:"varint,1,opt,name=ref_id,json=refId,proto3" json:"ref_id,omitempty"`
+	Case  *PositionNode `protobuf:"bytes,2,opt,name=case,proto3" json:"case,omitempty"`
+	List  []*Expr       `protobuf:"bytes,3,rep,name=list,proto3" json:"list,omitempty"`
+	Colon *PositionNode `protobuf:"bytes,4,opt,name=colon,proto3" json:"colon,omitempty"`
+	Body  []*Stmt       `protobuf:"bytes,5,rep,name=body,proto3" json:"body,omitempty"`
+}
+
+func (x *CaseClauseNode) Reset() {
+	*x = CaseClauseNode{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ast_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CaseClauseNode) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CaseClauseNode) ProtoMessage() {}
+
+func (x *CaseClauseNode) ProtoReflect() protoreflect.Message {
+	mi := &file_ast_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CaseClauseNode.ProtoReflect.Descriptor instead.
+func (*CaseClauseNode) Descriptor() ([]byte, []int) {
+	return file_ast_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *CaseClauseNode) GetRefId() int32 {
+	if x != nil {
+		return x.RefId
+	}
+	return 0
+}
+
+func (x *CaseClauseNode) GetCase() *PositionNode {
+	if x != nil {
+		return x.Case
+	}
+	return nil
+}
+
+func (x *CaseClauseNode) GetList() []*Expr {
+	if x != nil {
+		return x.List
+	}
+	return nil
+}
+
+func (x *CaseClauseNode) GetColon() *PositionNode {
+	if x != nil {
+		return x.Colon
+	}
+	return nil
+}
+
+func (x *CaseClauseNode) GetBody() []*Stmt {
+	if x != nil {
+		return x.Body
+	}
+	return nil
+}
+
+type SwitchStmtNode struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RefId  int32          `protobuf:"varint,1,opt,name=ref_id,json=refId,proto3" json:"ref_id,omitempty"`
+	Switch *PositionNode  `protobuf:"bytes,2,opt,name=switch,proto3" json:"switch,omitempty"`
+	Init   *Stmt          `protobuf:"bytes,3,opt,name=init,proto3" json:"init,omitempty"`
+	Tag    *Expr          `protobuf:"bytes,4,opt,name=tag,proto3" json:"tag,omitempty"`
+	Body   *BlockStmtNode `protobuf:"bytes,5,opt,name=body,proto3" json:"body,omitempty"`
+}
+
+func (x *SwitchStmtNode) Reset() {
+	*x = SwitchStmtNode{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ast_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SwitchStmtNode) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SwitchStmtNode) ProtoMessage() {}
+
+func (x *SwitchStmtNode) ProtoReflect() protoreflect.Message {
+	mi := &file_ast_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SwitchStmtNode.ProtoReflect.Descriptor instead.
+func (*SwitchStmtNode) Descriptor() ([]byte, []int) {
+	return file_ast_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *SwitchStmtNode) GetRefId() int32 {
+	if x != nil {
+		return x.RefId
+	}
+	return 0
+}
+
+func (x *SwitchStmtNode) GetSwitch() *PositionNode {
+	if x != nil {
+		return x.Switch
+	}
+	return nil
+}
+
+func (x *SwitchStmtNode) GetInit() *Stmt {
+	if x != nil {
+		return x.Init
+	}
+	return nil
+}
+
+func (x *SwitchStmtNode) GetTag() *Expr {
+	if x != nil {
+		return x.Tag
+	}
+	return nil
+}
+
+func (x *SwitchStmtNode) GetBody() *BlockStmtNode {
+	if x != nil {
+		return x.Body
+	}
+	return nil
+}
+
+type TypeSwitchStmtNode struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RefId  int32          `protobuf:"varint,1,opt,name=ref_id,json=refId,proto3" json:"ref_id,omitempty"`
+	Switch *PositionNode  `protobuf:"bytes,2,opt,name=switch,proto3" json:"switch,omitempty"`
+	Init   *Stmt          `protobuf:"bytes,3,opt,name=init,proto3" json:"init,omitempty"`
+	Assign *Stmt          `protobuf:"bytes,4,opt,name=assign,proto3" json:"assign,omitempty"`
+	Body   *BlockStmtNode `protobuf:"bytes,5,opt,name=body,proto3" json:"body,omitempty"`
+}
+
+func (x *TypeSwitchStmtNode) Reset() {
+	*x = TypeSwitchStmtNode{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ast_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TypeSwitchStmtNode) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TypeSwitchStmtNode) ProtoMessage() {}
+
+func (x *TypeSwitchStmtNode) ProtoReflect() protoreflect.Message {
+	mi := &file_ast_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TypeSwitchStmtNode.ProtoReflect.Descriptor instead.
+func (*TypeSwitchStmtNode) Descriptor() ([]byte, []int) {
+	return file_ast_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *TypeSwitchStmtNode) GetRefId() int32 {
+	if x != nil {
+		return x.RefId
+	}
+	return 0
+}
+
+func (x *TypeSwitchStmtNode) GetSwitch() *PositionNode {
+	if x != nil {
+		return x.Switch
+	}
+	return nil
+}
+
+func (x *TypeSwitchStmtNode) GetInit() *Stmt {
+	if x != nil {
+		return x.Init
+	}
+	return nil
+}
+
+func (x *TypeSwitchStmtNode) GetAssign() *Stmt {
+	if x != nil {
+		return x.Assign
+	}
+	return nil
+}
+
+func (x *TypeSwitchStmtNode) GetBody() *BlockStmtNode {
+	if x != nil {
+		return x.Body
+	}
+	return nil
+}
+
+type CommClauseNode struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RefId int32         `protobuf:"varint,1,opt,name=ref_id,json=refId,proto3" json:"ref_id,omitempty"`
+	Case  *PositionNode `protobuf:"bytes,2,opt,name=case,proto3" json:"case,omitempty"`
+	Comm  *Stmt         `protobuf:"bytes,3,opt,name=comm,proto3" json:"comm,omitempty"`
+	Colon *PositionNode `protobuf:"bytes,4,opt,name=colon,proto3" json:"colon,omitempty"`
+	Body  []*Stmt       `protobuf:"bytes,5,rep,name=body,proto3" json:"body,omitempty"`
+}
+
+func (x *CommClauseNode) Reset() {
+	*x = CommClauseNode{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ast_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CommClauseNode) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CommClauseNode) ProtoMessage() {}
+
+func (x *CommClauseNode) ProtoReflect() protoreflect.Message {
+	mi := &file_ast_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CommClauseNode.ProtoReflect.Descriptor instead.
+func (*CommClauseNode) Descriptor() ([]byte, []int) {
+	return file_ast_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *CommClauseNode) GetRefId() int32 {
+	if x != nil {
+		return x.RefId
+	}
+	return 0
+}
+
+func (x *CommClauseNode) GetCase() *PositionNode {
+	if x != nil {
+		return x.Case
+	}
+	return nil
+}
+
+func (x *CommClauseNode) GetComm() *Stmt {
+	if x != nil {
+		return x.Comm
+	}
+	return nil
+}
+
+func (x *CommClauseNode) GetColon() *PositionNode {
+	if x != nil {
+		return x.Colon
+	}
+	return nil
+}
+
+func (x *CommClauseNode) GetBody() []*Stmt {
+	if x != nil {
+		return x.Body
+	}
+	return nil
+}
+
+type SelectStmtNode struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RefId  int32          `protobuf:"varint,1,opt,name=ref_id,json=refId,proto3" json:"ref_id,omitempty"`
+	Select *PositionNode  `protobuf:"bytes,2,opt,name=select,proto3" json:"select,omitempty"`
+	Body   *BlockStmtNode `protobuf:"bytes,3,opt,name=body,proto3" json:"body,omitempty"`
+}
+
+func (x *SelectStmtNode) Reset() {
+	*x = SelectStmtNode{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ast_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SelectStmtNode) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SelectStmtNode) ProtoMessage() {}
+
+func (x *SelectStmtNode) ProtoReflect() protoreflect.Message {
+	mi := &file_ast_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SelectStmtNode.ProtoReflect.Descriptor instead.
+func (*SelectStmtNode) Descriptor() ([]byte, []int) {
+	return file_ast_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *SelectStmtNode) GetRefId() int32 {
+	if x != nil {
+		return x.RefId
+	}
+	return 0
+}
+
+func (x *SelectStmtNode) GetSelect() *PositionNode {
+	if x != nil {
+		return x.Select
+	}
+	return nil
+}
+
+func (x *SelectStmtNode) GetBody() *BlockStmtNode {
+	if x != nil {
+		return x.Body
+	}
+	return nil
+}
+
+type ForStmtNode struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RefId int32          `protobuf:"varint,1,opt,name=ref_id,json=refId,proto3" json:"ref_id,omitempty"`
+	For   *PositionNode  `protobuf:"bytes,2,opt,name=for,proto3" json:"for,omitempty"`
+	Init  *Stmt          `protobuf:"bytes,3,opt,name=init,proto3" json:"init,omitempty"`
+	Cond  *Expr          `protobuf:"bytes,4,opt,name=cond,proto3" json:"cond,omitempty"`
+	Post  *Stmt          `protobuf:"bytes,5,opt,name=post,proto3" json:"post,omitempty"`
+	Body  *BlockStmtNode `protobuf:"bytes,6,opt,name=body,proto3" json:"body,omitempty"`
+}
+
+func (x *ForStmtNode) Reset() {
+	*x = ForStmtNode{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ast_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ForStmtNode) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ForStmtNode) ProtoMessage() {}
+
+func (x *ForStmtNode) ProtoReflect() protoreflect.Message {
+	mi := &file_ast_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ForStmtNode.ProtoReflect.Descriptor instead.
+func (*ForStmtNode) Descriptor() ([]byte, []int) {
+	return file_ast_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *ForStmtNode) GetRefId() int32 {
+	if x != nil {
+		return x.RefId
+	}
+	return 0
+}
+
+func (x *ForStmtNode) GetFor() *PositionNode {
+	if x != nil {
+		return x.For
+	}
+	return nil
+}
+
+func (x *ForStmtNode) GetInit() *Stmt {
+	if x != nil {
+		return x.Init
+	}
+	return nil
+}
+
+func (x *ForStmtNode) GetCond() *Expr {
+	if x != nil {
+		return x.Cond
+	}
+	return nil
+}
+
+func (x *ForStmtNode) GetPost() *Stmt {
+	if x != nil {
+		return x.Post
+	}
+	return nil
+}
+
+func (x *ForStmtNode) GetBody() *BlockStmtNode {
+	if x != nil {
+		return x.Body
+	}
+	return nil
+}
+
+type RangeStmtNode struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RefId  int32          `protobuf:"varint,1,opt,name=ref_id,json=refId,proto3" json:"ref_id,omitempty"`
+	For    *PositionNode  `protobuf:"bytes,2,opt,name=for,proto3" json:"for,omitempty"`
+	Key    *Expr          `protobuf:"bytes,3,opt,name=key,proto3" json:"key,omitempty"`
+	Value  *Expr          `protobuf:"bytes,4,opt,name=value,proto3" json:"value,omitempty"`
+	TokPos *PositionNode  `protobuf:"bytes,5,opt,name=tok_pos,json=tokPos,proto3" json:"tok_pos,omitempty"`
+	Tok    string         `protobuf:"bytes,6,opt,name=tok,proto3" json:"tok,omitempty"`
+	X      *Expr          `protobuf:"bytes,7,opt,name=x,proto3" json:"x,omitempty"`
+	Body   *BlockStmtNode `protobuf:"bytes,8,opt,name=body,proto3" json:"body,omitempty"`
+}
+
+func (x *RangeStmtNode) Reset() {
+	*x = RangeStmtNode{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ast_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RangeStmtNode) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RangeStmtNode) ProtoMessage() {}
+
+func (x *RangeStmtNode) ProtoReflect() protoreflect.Message {
+	mi := &file_ast_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RangeStmtNode.ProtoReflect.Descriptor instead.
+func (*RangeStmtNode) Descriptor() ([]byte, []int) {
+	return file_ast_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *RangeStmtNode) GetRefId() int32 {
+	if x != nil {
+		return x.RefId
+	}
+	return 0
+}
+
+func (x *RangeStmtNode) GetFor() *PositionNode {
+	if x != nil {
+		return x.For
+	}
+	return nil
+}
+
+func (x *RangeStmtNode) GetKey() *Expr {
+	if x != nil {
+		return x.Key
+	}
+	return nil
+}
+
+func (x *RangeStmtNode) GetValue() *Expr {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+func (x *RangeStmtNode) GetTokPos() *PositionNode {
+	if x != nil {
+		return x.TokPos
+	}
+	return nil
+}
+
+func (x *RangeStmtNode) GetTok() string {
+	if x != nil {
+		return x.Tok
+	}
+	return ""
+}
+
+func (x *RangeStmtNode) GetX() *Expr {
+	if x != nil {
+		return x.X
+	}
+	return nil
+}
+
+func (x *RangeStmtNode) GetBody() *BlockStmtNode {
+	if x != nil {
+		return x.Body
+	}
+	return nil
+}
+
+type ImportSpecNode struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RefId   int32             `protobuf:"varint,1,opt,name=ref_id,json=refId,proto3" json:"ref_id,omitempty"`
+	Doc     *CommentGroupNode `protobuf:"bytes,2,opt,name=doc,proto3" json:"doc,omitempty"`
+	Name    *IdentNode        `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Path    *BasicLitNode     `protobuf:"bytes,4,opt,name=path,proto3" json:"path,omitempty"`
+	Comment *CommentGroupNode `protobuf:"bytes,5,opt,name=comment,proto3" json:"comment,omitempty"`
+	EndPos  *PositionNode     `protobuf:"bytes,6,opt,name=end_pos,json=endPos,proto3" json:"end_pos,omitempty"`
+}
+
+func (x *ImportSpecNode) Reset() {
+	*x = ImportSpecNode{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ast_proto_msgTypes[53]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ImportSpecNode) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportSpecNode) ProtoMessage() {}
+
+func (x *ImportSpecNode) ProtoReflect() protoreflect.Message {
+	mi := &file_ast_proto_msgTypes[53]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportSpecNode.ProtoReflect.Descriptor instead.
+func (*ImportSpecNode) Descriptor() ([]byte, []int) {
+	return file_ast_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *ImportSpecNode) GetRefId() int32 {
+	if x != nil {
+		return x.RefId
+	}
+	return 0
+}
+
+func (x *ImportSpecNode) GetDoc() *CommentGroupNode {
+	if x != nil {
+		return x.Doc
+	}
+	return nil
+}
+
+func (x *ImportSpecNode) GetName() *IdentNode {
+	if x != nil {
+		return x.Name
+	}
+	return nil
+}
+
+func (x *ImportSpecNode) GetPath() *BasicLitNode {
+	if x != nil {
+		return x.Path
+	}
+	return nil
+}
+
+func (x *ImportSpecNode) GetComment() *CommentGroupNode {
+	if x != nil {
+		return x.Comment
+	}
+	return nil
+}
+
+func (x *ImportSpecNode) GetEndPos() *PositionNode {
+	if x != nil {
+		return x.EndPos
+	}
+	return nil
+}
+
+type ValueSpecNode struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RefId   int32             `protobuf:"varint,1,opt,name=ref_id,json=refId,proto3" json:"ref_id,omitempty"`
+	Doc     *CommentGroupNode `protobuf:"bytes,2,opt,name=doc,proto3" json:"doc,omitempty"`
+	Names   []*IdentNode      `protobuf:"bytes,3,rep,name=names,proto3" json:"names,omitempty"`
+	Type    *Expr             `protobuf:"bytes,4,opt,name=type,proto3" json:"type,omitempty"`
+	Values  []*Expr           `protobuf:"bytes,5,rep,name=values,proto3" json:"values,omitempty"`
+	Comment *CommentGroupNode `protobuf:"bytes,6,opt,name=comment,proto3" json:"comment,omitempty"`
+}
+
+func (x *ValueSpecNode) Reset() {
+	*x = ValueSpecNode{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ast_proto_msgTypes[54]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ValueSpecNode) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValueSpecNode) ProtoMessage() {}
+
+func (x *ValueSpecNode) ProtoReflect() protoreflect.Message {
+	mi := &file_ast_proto_msgTypes[54]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValueSpecNode.ProtoReflect.Descriptor instead.
+func (*ValueSpecNode) Descriptor() ([]byte, []int) {
+	return file_ast_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *ValueSpecNode) GetRefId() int32 {
+	if x != nil {
+		return x.RefId
+	}
+	return 0
+}
+
+func (x *ValueSpecNode) GetDoc() *CommentGroupNode {
+	if x != nil {
+		return x.Doc
+	}
+	return nil
+}
+
+func (x *ValueSpecNode) GetNames() []*IdentNode {
+	if x != nil {
+		return x.Names
+	}
+	return nil
+}
+
+func (x *ValueSpecNode) GetType() *Expr {
+	if x != nil {
+		return x.Type
+	}
+	return nil
+}
+
+func (x *ValueSpecNode) GetValues() []*Expr {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+func (x *ValueSpecNode) GetComment() *CommentGroupNode {
+	if x != nil {
+		return x.Comment
+	}
+	return nil
+}
+
+type TypeSpecNode struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RefId      int32             `protobuf:"varint,1,opt,name=ref_id,json=refId,proto3" json:"ref_id,omitempty"`
+	Doc        *CommentGroupNode `protobuf:"bytes,2,opt,name=doc,proto3" json:"doc,omitempty"`
+	Name       *IdentNode        `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	TypeParams *FieldListNode    `protobuf:"bytes,4,opt,name=type_params,json=typeParams,proto3" json:"type_params,omitempty"`
+	Assign     *PositionNode     `protobuf:"bytes,5,opt,name=assign,proto3" json:"assign,omitempty"`
+	Type       *Expr             `protobuf:"bytes,6,opt,name=type,proto3" json:"type,omitempty"`
+	Comment    *CommentGroupNode `protobuf:"bytes,7,opt,name=comment,proto3" json:"comment,omitempty"`
+}
+
+func (x *TypeSpecNode) Reset() {
+	*x = TypeSpecNode{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ast_proto_msgTypes[55]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TypeSpecNode) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TypeSpecNode) ProtoMessage() {}
+
+func (x *TypeSpecNode) ProtoReflect() protoreflect.Message {
+	mi := &file_ast_proto_msgTypes[55]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TypeSpecNode.ProtoReflect.Descriptor instead.
+func (*TypeSpecNode) Descriptor() ([]byte, []int) {
+	return file_ast_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *TypeSpecNode) GetRefId() int32 {
+	if x != nil {
+		return x.RefId
+	}
+	return 0
+}
+
+func (x *TypeSpecNode) GetDoc() *CommentGroupNode {
+	if x != nil {
+		return x.Doc
+	}
+	return nil
+}
+
+func (x *TypeSpecNode) GetName() *IdentNode {
+	if x != nil {
+		return x.Name
+	}
+	return nil
+}
+
+func (x *TypeSpecNode) GetTypeParams() *FieldListNode {
+	if x != nil {
+		return x.TypeParams
+	}
+	return nil
+}
+
+func (x *TypeSpecNode) GetAssign() *PositionNode {
+	if x != nil {
+		return x.Assign
+	}
+	return nil
+}
+
+func (x *TypeSpecNode) GetType() *Expr {
+	if x != nil {
+		return x.Type
+	}
+	return nil
+}
+
+func (x *TypeSpecNode) GetComment() *CommentGroupNode {
+	if x != nil {
+		return x.Comment
+	}
+	return nil
+}
+
+type BadDeclNode struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RefId int32         `protobuf:"varint,1,opt,name=ref_id,json=refId,proto3" json:"ref_id,omitempty"`
+	From  *PositionNode `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
+	To    *PositionNode `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`
+}
+
+func (x *BadDeclNode) Reset() {
+	*x = BadDeclNode{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ast_proto_msgTypes[56]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BadDeclNode) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BadDeclNode) ProtoMessage() {}
+
+func (x *BadDeclNode) ProtoReflect() protoreflect.Message {
+	mi := &file_ast_proto_msgTypes[56]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BadDeclNode.ProtoReflect.Descriptor instead.
+func (*BadDeclNode) Descriptor() ([]byte, []int) {
+	return file_ast_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *BadDeclNode) GetRefId() int32 {
+	if x != nil {
+		return x.RefId
+	}
+	return 0
+}
+
+func (x *BadDeclNode) GetFrom() *PositionNode {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *BadDeclNode) GetTo() *PositionNode {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+type GenDeclNode struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RefId  int32             `protobuf:"varint,1,opt,name=ref_id,json=refId,proto3" json:"ref_id,omitempty"`
+	Doc    *CommentGroupNode `protobuf:"bytes,2,opt,name=doc,proto3" json:"doc,omitempty"`
+	TokPos *PositionNode     `protobuf:"bytes,3,opt,name=tok_pos,json=tokPos,proto3" json:"tok_pos,omitempty"`
+	Tok    string            `protobuf:"bytes,4,opt,name=tok,proto3" json:"tok,omitempty"`
+	Lparen *PositionNode     `protobuf:"bytes,5,opt,name=lparen,proto3" json:"lparen,omitempty"`
+	Specs  []*Spec           `protobuf:"bytes,6,rep,name=specs,proto3" json:"specs,omitempty"`
+	Rparen *PositionNode     `protobuf:"bytes,7,opt,name=rparen,proto3" json:"rparen,omitempty"`
+}
+
+func (x *GenDeclNode) Reset() {
+	*x = GenDeclNode{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ast_proto_msgTypes[57]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GenDeclNode) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenDeclNode) ProtoMessage() {}
+
+func (x *GenDeclNode) ProtoReflect() protoreflect.Message {
+	mi := &file_ast_proto_msgTypes[57]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GenDeclNode.ProtoReflect.Descriptor instead.
+func (*GenDeclNode) Descriptor() ([]byte, []int) {
+	return file_ast_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *GenDeclNode) GetRefId() int32 {
+	if x != nil {
+		return x.RefId
+	}
+	return 0
+}
+
+func (x *GenDeclNode) GetDoc() *CommentGroupNode {
+	if x != nil {
+		return x.Doc
+	}
+	return nil
+}
+
+func (x *GenDeclNode) GetTokPos() *PositionNode {
+	if x != nil {
+		return x.TokPos
+	}
+	return nil
+}
+
+func (x *GenDeclNode) GetTok() string {
+	if x != nil {
+		return x.Tok
+	}
+	return ""
+}
+
+func (x *GenDeclNode) GetLparen() *PositionNode {
+	if x != nil {
+		return x.Lparen
+	}
+	return nil
+}
+
+func (x *GenDeclNode) GetSpecs() []*Spec {
+	if x != nil {
+		return x.Specs
+	}
+	return nil
+}
+
+func (x *GenDeclNode) GetRparen() *PositionNode {
+	if x != nil {
+		return x.Rparen
+	}
+	return nil
+}
+
+type FuncDeclNode struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RefId int32             `protobuf:"varint,1,opt,name=ref_id,json=refId,proto3" json:"ref_id,omitempty"`
+	Doc   *CommentGroupNode `protobuf:"bytes,2,opt,name=doc,proto3" json:"doc,omitempty"`
+	Recv  *FieldListNode    `protobuf:"bytes,3,opt,name=recv,proto3" json:"recv,omitempty"`
+	Name  *IdentNode        `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	Type  *FuncTypeNode     `protobuf:"bytes,5,opt,name=type,proto3" json:"type,omitempty"`
+	Body  *BlockStmtNode    `protobuf:"bytes,6,opt,name=body,proto3" json:"body,omitempty"`
+}
+
+func (x *FuncDeclNode) Reset() {
+	*x = FuncDeclNode{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ast_proto_msgTypes[58]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FuncDeclNode) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FuncDeclNode) ProtoMessage() {}
+
+func (x *FuncDeclNode) ProtoReflect() protoreflect.Message {
+	mi := &file_ast_proto_msgTypes[58]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FuncDeclNode.ProtoReflect.Descriptor instead.
+func (*FuncDeclNode) Descriptor() ([]byte, []int) {
+	return file_ast_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *FuncDeclNode) GetRefId() int32 {
+	if x != nil {
+		return x.RefId
+	}
+	return 0
+}
+
+func (x *FuncDeclNode) GetDoc() *CommentGroupNode {
+	if x != nil {
+		return x.Doc
+	}
+	return nil
+}
+
+func (x *FuncDeclNode) GetRecv() *FieldListNode {
+	if x != nil {
+		return x.Recv
+	}
+	return nil
+}
+
+func (x *FuncDeclNode) GetName() *IdentNode {
+	if x != nil {
+		return x.Name
+	}
+	return nil
+}
+
+func (x *FuncDeclNode) GetType() *FuncTypeNode {
+	if x != nil {
+		return x.Type
+	}
+	return nil
+}
+
+func (x *FuncDeclNode) GetBody() *BlockStmtNode {
+	if x != nil {
+		return x.Body
+	}
+	return nil
+}
+
+type FileMetaNode struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Path       string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	Category   string `protobuf:"bytes,2,opt,name=category,proto3" json:"category,omitempty"`
+	Constraint string `protobuf:"bytes,3,opt,name=constraint,proto3" json:"constraint,omitempty"`
+}
+
+func (x *FileMetaNode) Reset() {
+	*x = FileMetaNode{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ast_proto_msgTypes[59]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FileMetaNode) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FileMetaNode) ProtoMessage() {}
+
+func (x *FileMetaNode) ProtoReflect() protoreflect.Message {
+	mi := &file_ast_proto_msgTypes[59]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FileMetaNode.ProtoReflect.Descriptor instead.
+func (*FileMetaNode) Descriptor() ([]byte, []int) {
+	return file_ast_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *FileMetaNode) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *FileMetaNode) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *FileMetaNode) GetConstraint() string {
+	if x != nil {
+		return x.Constraint
+	}
+	return ""
+}
+
+type FileNode struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RefId      int32               `protobuf:"varint,1,opt,name=ref_id,json=refId,proto3" json:"ref_id,omitempty"`
+	Meta       *FileMetaNode       `protobuf:"bytes,2,opt,name=meta,proto3" json:"meta,omitempty"`
+	Doc        *CommentGroupNode   `protobuf:"bytes,3,opt,name=doc,proto3" json:"doc,omitempty"`
+	Package    *PositionNode       `protobuf:"bytes,4,opt,name=package,proto3" json:"package,omitempty"`
+	Name       *IdentNode          `protobuf:"bytes,5,opt,name=name,proto3" json:"name,omitempty"`
+	Decls      []*Decl             `protobuf:"bytes,6,rep,name=decls,proto3" json:"decls,omitempty"`
+	Imports    []*ImportSpecNode   `protobuf:"bytes,7,rep,name=imports,proto3" json:"imports,omitempty"`
+	Unresolved []*IdentNode        `protobuf:"bytes,8,rep,name=unresolved,proto3" json:"unresolved,omitempty"`
+	Comments   []*CommentGroupNode `protobuf:"bytes,9,rep,name=comments,proto3" json:"comments,omitempty"`
+	FileSet    *FileSet            `protobuf:"bytes,10,opt,name=file_set,json=fileSet,proto3" json:"file_set,omitempty"`
+}
+
+func (x *FileNode) Reset() {
+	*x = FileNode{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ast_proto_msgTypes[60]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FileNode) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FileNode) ProtoMessage() {}
+
+func (x *FileNode) ProtoReflect() protoreflect.Message {
+	mi := &file_ast_proto_msgTypes[60]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FileNode.ProtoReflect.Descriptor instead.
+func (*FileNode) Descriptor() ([]byte, []int) {
+	return file_ast_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *FileNode) GetRefId() int32 {
+	if x != nil {
+		return x.RefId
+	}
+	return 0
+}
+
+func (x *FileNode) GetMeta() *FileMetaNode {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+func (x *FileNode) GetDoc() *CommentGroupNode {
+	if x != nil {
+		return x.Doc
+	}
+	return nil
+}
+
+func (x *FileNode) GetPackage() *PositionNode {
+	if x != nil {
+		return x.Package
+	}
+	return nil
+}
+
+func (x *FileNode) GetName() *IdentNode {
+	if x != nil {
+		return x.Name
+	}
+	return nil
+}
+
+func (x *FileNode) GetDecls() []*Decl {
+	if x != nil {
+		return x.Decls
+	}
+	return nil
+}
+
+func (x *FileNode) GetImports() []*ImportSpecNode {
+	if x != nil {
+		return x.Imports
+	}
+	return nil
+}
+
+func (x *FileNode) GetUnresolved() []*IdentNode {
+	if x != nil {
+		return x.Unresolved
+	}
+	return nil
+}
+
+func (x *FileNode) GetComments() []*CommentGroupNode {
+	if x != nil {
+		return x.Comments
+	}
+	return nil
+}
+
+func (x *FileNode) GetFileSet() *FileSet {
+	if x != nil {
+		return x.FileSet
+	}
+	return nil
+}
+
+// FileSet holds the token.FileSet of a FileNode as written by its Write method.
+type FileSet struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Base  int32          `protobuf:"varint,1,opt,name=base,proto3" json:"base,omitempty"`
+	Files []*FileSetFile `protobuf:"bytes,2,rep,name=files,proto3" json:"files,omitempty"`
+}
+
+func (x *FileSet) Reset() {
+	*x = FileSet{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ast_proto_msgTypes[61]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FileSet) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FileSet) ProtoMessage() {}
+
+func (x *FileSet) ProtoReflect() protoreflect.Message {
+	mi := &file_ast_proto_msgTypes[61]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FileSet.ProtoReflect.Descriptor instead.
+func (*FileSet) Descriptor() ([]byte, []int) {
+	return file_ast_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *FileSet) GetBase() int32 {
+	if x != nil {
+		return x.Base
+	}
+	return 0
+}
+
+func (x *FileSet) GetFiles() []*FileSetFile {
+	if x != nil {
+		return x.Files
+	}
+	return nil
+}
+
+type FileSetFile struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name  string             `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Base  int32              `protobuf:"varint,2,opt,name=base,proto3" json:"base,omitempty"`
+	Size  int32              `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`
+	Lines []int32            `protobuf:"varint,4,rep,packed,name=lines,proto3" json:"lines,omitempty"`
+	Infos []*FileSetLineInfo `protobuf:"bytes,5,rep,name=infos,proto3" json:"infos,omitempty"`
+}
+
+func (x *FileSetFile) Reset() {
+	*x = FileSetFile{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ast_proto_msgTypes[62]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FileSetFile) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FileSetFile) ProtoMessage() {}
+
+func (x *FileSetFile) ProtoReflect() protoreflect.Message {
+	mi := &file_ast_proto_msgTypes[62]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FileSetFile.ProtoReflect.Descriptor instead.
+func (*FileSetFile) Descriptor() ([]byte, []int) {
+	return file_ast_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *FileSetFile) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *FileSetFile) GetBase() int32 {
+	if x != nil {
+		return x.Base
+	}
+	return 0
+}
+
+func (x *FileSetFile) GetSize() int32 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *FileSetFile) GetLines() []int32 {
+	if x != nil {
+		return x.Lines
+	}
+	return nil
+}
+
+func (x *FileSetFile) GetInfos() []*FileSetLineInfo {
+	if x != nil {
+		return x.Infos
+	}
+	return nil
+}
+
+type FileSetLineInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Offset   int32  `protobuf:"varint,1,opt,name=offset,proto3" json:"offset,omitempty"`
+	Filename string `protobuf:"bytes,2,opt,name=filename,proto3" json:"filename,omitempty"`
+	Line     int32  `protobuf:"varint,3,opt,name=line,proto3" json:"line,omitempty"`
+	Column   int32  `protobuf:"varint,4,opt,name=column,proto3" json:"column,omitempty"`
+}
+
+func (x *FileSetLineInfo) Reset() {
+	*x = FileSetLineInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ast_proto_msgTypes[63]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FileSetLineInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FileSetLineInfo) ProtoMessage() {}
+
+func (x *FileSetLineInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_ast_proto_msgTypes[63]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FileSetLineInfo.ProtoReflect.Descriptor instead.
+func (*FileSetLineInfo) Descriptor() ([]byte, []int) {
+	return file_ast_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *FileSetLineInfo) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *FileSetLineInfo) GetFilename() string {
+	if x != nil {
+		return x.Filename
+	}
+	return ""
+}
+
+func (x *FileSetLineInfo) GetLine() int32 {
+	if x != nil {
+		return x.Line
+	}
+	return 0
+}
+
+func (x *FileSetLineInfo) GetColumn() int32 {
+	if x != nil {
+		return x.Column
+	}
+	return 0
+}
+
+var File_ast_proto protoreflect.FileDescriptor
+
+var file_ast_proto_rawDesc = []byte{
+	0x0a, 0x09, 0x61, 0x73, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x05, 0x67, 0x6f, 0x61,
+	0x73, 0x74, 0x22, 0x98, 0x0a, 0x0a, 0x04, 0x45, 0x78, 0x70, 0x72, 0x12, 0x2f, 0x0a, 0x08, 0x62,
+	0x61, 0x64, 0x5f, 0x65, 0x78, 0x70, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e,
+	0x67, 0x6f, 0x61, 0x73, 0x74, 0x2e, 0x42, 0x61, 0x64, 0x45, 0x78, 0x70, 0x72, 0x4e, 0x6f, 0x64,
+	0x65, 0x48, 0x00, 0x52, 0x07, 0x62, 0x61, 0x64, 0x45, 0x78, 0x70, 0x72, 0x12, 0x28, 0x0a, 0x05,
+	0x69, 0x64, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x67, 0x6f,
+	0x61, 0x73, 0x74, 0x2e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x48, 0x00, 0x52,
+	0x05, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x12, 0x31, 0x0a, 0x08, 0x65, 0x6c, 0x6c, 0x69, 0x70, 0x73,
+	0x69, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x67, 0x6f, 0x61, 0x73, 0x74,
+	0x2e, 0x45, 0x6c, 0x6c, 0x69, 0x70, 0x73, 0x69, 0x73, 0x4e, 0x6f, 0x64, 0x65, 0x48, 0x00, 0x52,
+	0x08, 0x65, 0x6c, 0x6c, 0x69, 0x70, 0x73, 0x69, 0x73, 0x12, 0x32, 0x0a, 0x09, 0x62, 0x61, 0x73,
+	0x69, 0x63, 0x5f, 0x6c, 0x69, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x67,
+	0x6f, 0x61, 0x73, 0x74, 0x2e, 0x42, 0x61, 0x73, 0x69, 0x63, 0x4c, 0x69, 0x74, 0x4e, 0x6f, 0x64,
+	0x65, 0x48, 0x00, 0x52, 0x08, 0x62, 0x61, 0x73, 0x69, 0x63, 0x4c, 0x69, 0x74, 0x12, 0x2f, 0x0a,
+	0x08, 0x66, 0x75, 0x6e, 0x63, 0x5f, 0x6c, 0x69, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x12, 0x2e, 0x67, 0x6f, 0x61, 0x73, 0x74, 0x2e, 0x46, 0x75, 0x6e, 0x63, 0x4c, 0x69, 0x74, 0x4e,
+	0x6f, 0x64, 0x65, 0x48, 0x00, 0x52, 0x07, 0x66, 0x75, 0x6e, 0x63, 0x4c, 0x69, 0x74, 0x12, 0x3e,
+	0x0a, 0x0d, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x65, 0x5f, 0x6c, 0x69, 0x74, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x61, 0x73, 0x74, 0x2e, 0x43, 0x6f,
+	0x6d, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x65, 0x4c, 0x69, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x48, 0x00,
+	0x52, 0x0c, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x65, 0x4c, 0x69, 0x74, 0x12, 0x35,
+	0x0a, 0x0a, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x5f, 0x65, 0x78, 0x70, 0x72, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x61, 0x73, 0x74, 0x2e, 0x50, 0x61, 0x72, 0x65, 0x6e,
+	0x45, 0x78, 0x70, 0x72, 0x4e, 0x6f, 0x64, 0x65, 0x48, 0x00, 0x52, 0x09, 0x70, 0x61, 0x72, 0x65,
+	0x6e, 0x45, 0x78, 0x70, 0x72, 0x12, 0x3e, 0x0a, 0x0d, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f,
+	0x72, 0x5f, 0x65, 0x78, 0x70, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67,
+	0x6f, 0x61, 0x73, 0x74, 0x2e, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x45, 0x78, 0x70,
+	0x72, 0x4e, 0x6f, 0x64, 0x65, 0x48, 0x00, 0x52, 0x0c, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f,
+	0x72, 0x45, 0x78, 0x70, 0x72, 0x12, 0x35, 0x0a, 0x0a, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x5f, 0x65,
+	0x78, 0x70, 0x72, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x61, 0x73,
+	0x74, 0x2e, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x45, 0x78, 0x70, 0x72, 0x4e, 0x6f, 0x64, 0x65, 0x48,
+	0x00, 0x52, 0x09, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x45, 0x78, 0x70, 0x72, 0x12, 0x42, 0x0a, 0x0f,
+	0x69, 0x6e, 0x64, 0x65, 0x78, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x5f, 0x65, 0x78, 0x70, 0x72, 0x18,
+	0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x67, 0x6f, 0x61, 0x73, 0x74, 0x2e, 0x49, 0x6e,
+	0x64, 0x65, 0x78, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x78, 0x70, 0x72, 0x4e, 0x6f, 0x64, 0x65, 0x48,
+	0x00, 0x52, 0x0d, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x78, 0x70, 0x72,
+	0x12, 0x35, 0x0a, 0x0a, 0x73, 0x6c, 0x69, 0x63, 0x65, 0x5f, 0x65, 0x78, 0x70, 0x72, 0x18, 0x0b,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x61, 0x73, 0x74, 0x2e, 0x53, 0x6c, 0x69,
+	0x63, 0x65, 0x45, 0x78, 0x70, 0x72, 0x4e, 0x6f, 0x64, 0x65, 0x48, 0x00, 0x52, 0x09, 0x73, 0x6c,
+	0x69, 0x63, 0x65, 0x45, 0x78, 0x70, 0x72, 0x12, 0x45, 0x0a, 0x10, 0x74, 0x79, 0x70, 0x65, 0x5f,
+	0x61, 0x73, 0x73, 0x65, 0x72, 0x74, 0x5f, 0x65, 0x78, 0x70, 0x72, 0x18, 0x0c, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x61, 0x73, 0x74, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x41, 0x73,
+	0x73, 0x65, 0x72, 0x74, 0x45, 0x78, 0x70, 0x72, 0x4e, 0x6f, 0x64, 0x65, 0x48, 0x00, 0x52, 0x0e,
+	0x74, 0x79, 0x70, 0x65, 0x41, 0x73, 0x73, 0x65, 0x72, 0x74, 0x45, 0x78, 0x70, 0x72, 0x12, 0x32,
+	0x0a, 0x09, 0x63, 0x61, 0x6c, 0x6c, 0x5f, 0x65, 0x78, 0x70, 0x72, 0x18, 0x0d, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x13, 0x2e, 0x67, 0x6f, 0x61, 0x73, 0x74, 0x2e, 0x43, 0x61, 0x6c, 0x6c, 0x45, 0x78,
+	0x70, 0x72, 0x4e, 0x6f, 0x64, 0x65, 0x48, 0x00, 0x52, 0x08, 0x63, 0x61, 0x6c, 0x6c, 0x45, 0x78,
+	0x70, 0x72, 0x12, 0x32, 0x0a, 0x09, 0x73, 0x74, 0x61, 0x72, 0x5f, 0x65, 0x78, 0x70, 0x72, 0x18,
+	0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x67, 0x6f, 0x61, 0x73, 0x74, 0x2e, 0x53, 0x74,
+	0x61, 0x72, 0x45, 0x78, 0x70, 0x72, 0x4e, 0x6f, 0x64, 0x65, 0x48, 0x00, 0x52, 0x08, 0x73, 0x74,
+	0x61, 0x72, 0x45, 0x78, 0x70, 0x72, 0x12, 0x35, 0x0a, 0x0a, 0x75, 0x6e, 0x61, 0x72, 0x79, 0x5f,
+	0x65, 0x78, 0x70, 0x72, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x61,
+	0x73, 0x74, 0x2e, 0x55, 0x6e, 0x61, 0x72, 0x79, 0x45, 0x78, 0x70, 0x72, 0x4e, 0x6f, 0x64, 0x65,
+	0x48, 0x00, 0x52, 0x09, 0x75, 0x6e, 0x61, 0x72, 0x79, 0x45, 0x78, 0x70, 0x72, 0x12, 0x38, 0x0a,
+	0x0b, 0x62, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x5f, 0x65, 0x78, 0x70, 0x72, 0x18, 0x10, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x15, 0x2e, 0x67, 0x6f, 0x61, 0x73, 0x74, 0x2e, 0x42, 0x69, 0x6e, 0x61, 0x72,
+	0x79, 0x45, 0x78, 0x70, 0x72, 0x4e, 0x6f, 0x64, 0x65, 0x48, 0x00, 0x52, 0x0a, 0x62, 0x69, 0x6e,
+	0x61, 0x72, 0x79, 0x45, 0x78, 0x70, 0x72, 0x12, 0x3f, 0x0a, 0x0e, 0x6b, 0x65, 0x79, 0x5f, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x5f, 0x65, 0x78, 0x70, 0x72, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x17, 0x2e, 0x67, 0x6f, 0x61, 0x73, 0x74, 0x2e, 0x4b, 0x65, 0x79, 0x56, 0x61, 0x6c, 0x75, 0x65,
+	0x45, 0x78, 0x70, 0x72, 0x4e, 0x6f, 0x64, 0x65, 0x48, 0x00, 0x52, 0x0c, 0x6b, 0x65, 0x79, 0x56,
+	0x61, 0x6c, 0x75, 0x65, 0x45, 0x78, 0x70, 0x72, 0x12, 0x35, 0x0a, 0x0a, 0x61, 0x72, 0x72, 0x61,
+	0x79, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x12, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67,
+	0x6f, 0x61, 0x73, 0x74, 0x2e, 0x41, 0x72, 0x72, 0x61, 0x79, 0x54, 0x79, 0x70, 0x65, 0x4e, 0x6f,
+	0x64, 0x65, 0x48, 0x00, 0x52, 0x09, 0x61, 0x72, 0x72, 0x61, 0x79, 0x54, 0x79, 0x70, 0x65, 0x12,
+	0x38, 0x0a, 0x0b, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x13,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x67, 0x6f, 0x61, 0x73, 0x74, 0x2e, 0x53, 0x74, 0x72,
+	0x75, 0x63, 0x74, 0x54, 0x79, 0x70, 0x65, 0x4e, 0x6f, 0x64, 0x65, 0x48, 0x00, 0x52, 0x0a, 0x73,
+	0x74, 0x72, 0x75, 0x63, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x32, 0x0a, 0x09, 0x66, 0x75, 0x6e,
+	0x63, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x14, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x67,
+	0x6f, 0x61, 0x73, 0x74, 0x2e, 0x46, 0x75, 0x6e, 0x63, 0x54, 0x79, 0x70, 0x65, 0x4e, 0x6f, 0x64,
+	0x65, 0x48, 0x00, 0x52, 0x08, 0x66, 0x75, 0x6e, 0x63, 0x54, 0x79, 0x70, 0x65, 0x12, 0x41, 0x0a,
+	0x0e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18,
+	0x15, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x67, 0x6f, 0x61, 0x73, 0x74, 0x2e, 0x49, 0x6e,
+	0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x4e, 0x6f, 0x64, 0x65, 0x48,
+	0x00, 0x52, 0x0d, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65,
+	0x12, 0x2f, 0x0a, 0x08, 0x6d, 0x61, 0x70, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x16, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x12, 0x2e, 0x67, 0x6f, 0x61, 0x73, 0x74, 0x2e, 0x4d, 0x61, 0x70, 0x54, 0x79,
+	0x70, 0x65, 0x4e, 0x6f, 0x64, 0x65, 0x48, 0x00, 0x52, 0x07, 0x6d, 0x61, 0x70, 0x54, 0x79, 0x70,
+	0x65, 0x12, 0x32, 0x0a, 0x09, 0x63, 0x68, 0x61, 0x6e, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x17,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x67, 0x6f, 0x61, 0x73, 0x74, 0x2e, 0x43, 0x68, 0x61,
+	0x6e, 0x54, 0x79, 0x70, 0x65, 0x4e, 0x6f, 0x64, 0x65, 0x48, 0x00, 0x52, 0x08, 0x63, 0x68, 0x61,
+	0x6e, 0x54, 0x79, 0x70, 0x65, 0x42, 0x06, 0x0a, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x22, 0x99, 0x09,
+	0x0a, 0x04, 0x53, 0x74, 0x6d, 0x74, 0x12, 0x2f, 0x0a, 0x08, 0x62, 0x61, 0x64, 0x5f, 0x73, 0x74,
+	0x6d, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x67, 0x6f, 0x61, 0x73, 0x74,
+	0x2e, 0x42, 0x61, 0x64, 0x53, 0x74, 0x6d, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x48, 0x00, 0x52, 0x07,
+	0x62, 0x61, 0x64, 0x53, 0x74, 0x6d, 0x74, 0x12, 0x32, 0x0a, 0x09, 0x64, 0x65, 0x63, 0x6c, 0x5f,
+	0x73, 0x74, 0x6d, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x67, 0x6f, 0x61,
+	0x73, 0x74, 0x2e, 0x44, 0x65, 0x63, 0x6c, 0x53, 0x74, 0x6d, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x48,
+	0x00, 0x52, 0x08, 0x64, 0x65, 0x63, 0x6c, 0x53, 0x74, 0x6d, 0x74, 0x12, 0x35, 0x0a, 0x0a, 0x65,
+	0x6d, 0x70, 0x74, 0x79, 0x5f, 0x73, 0x74, 0x6d, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x14, 0x2e, 0x67, 0x6f, 0x61, 0x73, 0x74, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x53, 0x74, 0x6d,
+	0x74, 0x4e, 0x6f, 0x64, 0x65, 0x48, 0x00, 0x52, 0x09, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x53, 0x74,
+	0x6d, 0x74, 0x12, 0x3b, 0x0a, 0x0c, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x65, 0x64, 0x5f, 0x73, 0x74,
+	0x6d, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x67, 0x6f, 0x61, 0x73, 0x74,
+	0x2e, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x65, 0x64, 0x53, 0x74, 0x6d, 0x74, 0x4e, 0x6f, 0x64, 0x65,
+	0x48, 0x00, 0x52, 0x0b, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x65, 0x64, 0x53, 0x74, 0x6d, 0x74, 0x12,
+	0x32, 0x0a, 0x09, 0x65, 0x78, 0x70, 0x72, 0x5f, 0x73, 0x74, 0x6d, 0x74, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x13, 0x2e, 0x67, 0x6f, 0x61, 0x73, 0x74, 0x2e, 0x45, 0x78, 0x70, 0x72, 0x53,
+	0x74, 0x6d, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x48, 0x00, 0x52, 0x08, 0x65, 0x78, 0x70, 0x72, 0x53,
+	0x74, 0x6d, 0x74, 0x12, 0x32, 0x0a, 0x09, 0x73, 0x65, 0x6e, 0x64, 0x5f, 0x73, 0x74, 0x6d, 0x74,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x67, 0x6f, 0x61, 0x73, 0x74, 0x2e, 0x53,
+	0x65, 0x6e, 0x64, 0x53, 0x74, 0x6d, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x48, 0x00, 0x52, 0x08, 0x73,
+	0x65, 0x6e, 0x64, 0x53, 0x74, 0x6d, 0x74, 0x12, 0x39, 0x0a, 0x0c, 0x69, 0x6e, 0x63, 0x5f, 0x64,
+	0x65, 0x63, 0x5f, 0x73, 0x74, 0x6d, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e,
+	0x67, 0x6f, 0x61, 0x73, 0x74, 0x2e, 0x49, 0x6e, 0x63, 0x44, 0x65, 0x63, 0x53, 0x74, 0x6d, 0x74,
+	0x4e, 0x6f, 0x64, 0x65, 0x48, 0x00, 0x52, 0x0a, 0x69, 0x6e, 0x63, 0x44, 0x65, 0x63, 0x53, 0x74,
+	0x6d, 0x74, 0x12, 0x38, 0x0a, 0x0b, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x5f, 0x73, 0x74, 0x6d,
+	0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x67, 0x6f, 0x61, 0x73, 0x74, 0x2e,
+	0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x53, 0x74, 0x6d, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x48, 0x00,
+	0x52, 0x0a, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x53, 0x74, 0x6d, 0x74, 0x12, 0x2c, 0x0a, 0x07,
+	0x67, 0x6f, 0x5f, 0x73, 0x74, 0x6d, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e,
+	0x67, 0x6f, 0x61, 0x73, 0x74, 0x2e, 0x47, 0x6f, 0x53, 0x74, 0x6d, 0x74, 0x4e, 0x6f, 0x64, 0x65,
+	0x48, 0x00, 0x52, 0x06, 0x67, 0x6f, 0x53, 0x74, 0x6d, 0x74, 0x12, 0x35, 0x0a, 0x0a, 0x64, 0x65,
+	0x66, 0x65, 0x72, 0x5f, 0x73, 0x74, 0x6d, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14,
+	0x2e, 0x67, 0x6f, 0x61, 0x73, 0x74, 0x2e, 0x44, 0x65, 0x66, 0x65, 0x72, 0x53, 0x74, 0x6d, 0x74,
+	0x4e, 0x6f, 0x64, 0x65, 0x48, 0x00, 0x52, 0x09, 0x64, 0x65, 0x66, 0x65, 0x72, 0x53, 0x74, 0x6d,
+	0x74, 0x12, 0x38, 0x0a, 0x0b, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x5f, 0x73, 0x74, 0x6d, 0x74,
+	0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x67, 0x6f, 0x61, 0x73, 0x74, 0x2e, 0x52,
+	0x65, 0x74, 0x75, 0x72, 0x6e, 0x53, 0x74, 0x6d, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x48, 0x00, 0x52,
+	0x0a, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x53, 0x74, 0x6d, 0x74, 0x12, 0x38, 0x0a, 0x0b, 0x62,
+	0x72, 0x61, 0x6e, 0x63, 0x68, 0x5f, 0x73, 0x74, 0x6d, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x15, 0x2e, 0x67, 0x6f, 0x61, 0x73, 0x74, 0x2e, 0x42, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x53,
+	0x74, 0x6d, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x48, 0x00, 0x52, 0x0a, 0x62, 0x72, 0x61, 0x6e, 0x63,
+	0x68, 0x53, 0x74, 0x6d, 0x74, 0x12, 0x35, 0x0a, 0x0a, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x73,
+	0x74, 0x6d, 0x74, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x61, 0x73,
+	0x74, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x53, 0x74, 0x6d, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x48,
+	0x00, 0x52, 0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x53, 0x74, 0x6d, 0x74, 0x12, 0x2c, 0x0a, 0x07,
+	0x69, 0x66, 0x5f, 0x73, 0x74, 0x6d, 0x74, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e,
+	0x67, 0x6f, 0x61, 0x73, 0x74, 0x2e, 0x49, 0x66, 0x53, 0x74, 0x6d, 0x74, 0x4e, 0x6f, 0x64, 0x65,
+	0x48, 0x00, 0x52, 0x06, 0x69, 0x66, 0x53, 0x74, 0x6d, 0x74, 0x12, 0x38, 0x0a, 0x0b, 0x63, 0x61,
+	0x73, 0x65, 0x5f, 0x63, 0x6c, 0x61, 0x75, 0x73, 0x65, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x15, 0x2e, 0x67, 0x6f, 0x61, 0x73, 0x74, 0x2e, 0x43, 0x61, 0x73, 0x65, 0x43, 0x6c, 0x61, 0x75,
+	0x73, 0x65, 0x4e, 0x6f, 0x64, 0x65, 0x48, 0x00, 0x52, 0x0a, 0x63, 0x61, 0x73, 0x65, 0x43, 0x6c,
+	0x61, 0x75, 0x73, 0x65, 0x12, 0x38, 0x0a, 0x0b, 0x73, 0x77, 0x69, 0x74, 0x63, 0x68, 0x5f, 0x73,
+	0x74, 0x6d, 0x74, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x67, 0x6f, 0x61, 0x73,
+	0x74, 0x2e, 0x53, 0x77, 0x69, 0x74, 0x63, 0x68, 0x53, 0x74, 0x6d, 0x74, 0x4e, 0x6f, 0x64, 0x65,
+	0x48, 0x00, 0x52, 0x0a, 0x73, 0x77, 0x69, 0x74, 0x63, 0x68, 0x53, 0x74, 0x6d, 0x74, 0x12, 0x45,
+	0x0a, 0x10, 0x74, 0x79, 0x70, 0x65, 0x5f, 0x73, 0x77, 0x69, 0x74, 0x63, 0x68, 0x5f, 0x73, 0x74,
+	0x6d, 0x74, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x61, 0x73, 0x74,
+	0x2e, 0x54, 0x79, 0x70, 0x65, 0x53, 0x77, 0x69, 0x74, 0x63, 0x68, 0x53, 0x74, 0x6d, 0x74, 0x4e,
+	0x6f, 0x64, 0x65, 0x48, 0x00, 0x52, 0x0e, 0x74, 0x79, 0x70, 0x65, 0x53, 0x77, 0x69, 0x74, 0x63,
+	0x68, 0x53, 0x74, 0x6d, 0x74, 0x12, 0x38, 0x0a, 0x0b, 0x63, 0x6f, 0x6d, 0x6d, 0x5f, 0x63, 0x6c,
+	0x61, 0x75, 0x73, 0x65, 0x18, 0x12, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x67, 0x6f, 0x61,
+	0x73, 0x74, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x43, 0x6c, 0x61, 0x75, 0x73, 0x65, 0x4e, 0x6f, 0x64,
+	0x65, 0x48, 0x00, 0x52, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x43, 0x6c, 0x61, 0x75, 0x73, 0x65, 0x12,
+	0x38, 0x0a, 0x0b, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x5f, 0x73, 0x74, 0x6d, 0x74, 0x18, 0x13,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x67, 0x6f, 0x61, 0x73, 0x74, 0x2e, 0x53, 0x65, 0x6c,
+	0x65, 0x63, 0x74, 0x53, 0x74, 0x6d, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x48, 0x00, 0x52, 0x0a, 0x73,
+	0x65, 0x6c, 0x65, 0x63, 0x74, 0x53, 0x74, 0x6d, 0x74, 0x12, 0x2f, 0x0a, 0x08, 0x66, 0x6f, 0x72,
+	0x5f, 0x73, 0x74, 0x6d, 0x74, 0x18, 0x14, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x67, 0x6f,
+	0x61, 0x73, 0x74, 0x2e, 0x46, 0x6f, 0x72, 0x53, 0x74, 0x6d, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x48,
+	0x00, 0x52, 0x07, 0x66, 0x6f, 0x72, 0x53, 0x74, 0x6d, 0x74, 0x12, 0x35, 0x0a, 0x0a, 0x72, 0x61,
+	0x6e, 0x67, 0x65, 0x5f, 0x73, 0x74, 0x6d, 0x74, 0x18, 0x15, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14,
+	0x2e, 0x67, 0x6f, 0x61, 0x73, 0x74, 0x2e, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x53, 0x74, 0x6d, 0x74,
+	0x4e, 0x6f, 0x64, 0x65, 0x48, 0x00, 0x52, 0x09, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x53, 0x74, 0x6d,
+	0x74, 0x42, 0x06, 0x0a, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x22, 0xb3, 0x01, 0x0a, 0x04, 0x53, 0x70,
+	0x65, 0x63, 0x12, 0x38, 0x0a, 0x0b, 0x69, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x73, 0x70, 0x65,
+	0x63, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x67, 0x6f, 0x61, 0x73, 0x74, 0x2e,
+	0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x53, 0x70, 0x65, 0x63, 0x4e, 0x6f, 0x64, 0x65, 0x48, 0x00,
+	0x52, 0x0a, 0x69, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x53, 0x70, 0x65, 0x63, 0x12, 0x35, 0x0a, 0x0a,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x5f, 0x73, 0x70, 0x65, 0x63, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x14, 0x2e, 0x67, 0x6f, 0x61, 0x73, 0x74, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x53, 0x70,
+	0x65, 0x63, 0x4e, 0x6f, 0x64, 0x65, 0x48, 0x00, 0x52, 0x09, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x53,
+	0x70, 0x65, 0x63, 0x12, 0x32, 0x0a, 0x09, 0x74, 0x79, 0x70, 0x65, 0x5f, 0x73, 0x70, 0x65, 0x63,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x67, 0x6f, 0x61, 0x73, 0x74, 0x2e, 0x54,
+	0x79, 0x70, 0x65, 0x53, 0x70, 0x65, 0x63, 0x4e, 0x6f, 0x64, 0x65, 0x48, 0x00, 0x52, 0x08, 0x74,
+	0x79, 0x70, 0x65, 0x53, 0x70, 0x65, 0x63, 0x42, 0x06, 0x0a, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x22,
+	0xa4, 0x01, 0x0a, 0x04, 0x44, 0x65, 0x63, 0x6c, 0x12, 0x2f, 0x0a, 0x08, 0x62, 0x61, 0x64, 0x5f,
+	0x64, 0x65, 0x63, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x67, 0x6f, 0x61,
+	0x73, 0x74, 0x2e, 0x42, 0x61, 0x64, 0x44, 0x65, 0x63, 0x6c, 0x4e, 0x6f, 0x64, 0x65, 0x48, 0x00,
+	0x52, 0x07, 0x62, 0x61, 0x64, 0x44, 0x65, 0x63, 0x6c, 0x12, 0x2f, 0x0a, 0x08, 0x67, 0x65, 0x6e,
+	0x5f, 0x64, 0x65, 0x63, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x67, 0x6f,
+	0x61, 0x73, 0x74, 0x2e, 0x47, 0x65, 0x6e, 0x44, 0x65, 0x63, 0x6c, 0x4e, 0x6f, 0x64, 0x65, 0x48,
+	0x00, 0x52, 0x07, 0x67, 0x65, 0x6e, 0x44, 0x65, 0x63, 0x6c, 0x12, 0x32, 0x0a, 0x09, 0x66, 0x75,
+	0x6e, 0x63, 0x5f, 0x64, 0x65, 0x63, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e,
+	0x67, 0x6f, 0x61, 0x73, 0x74, 0x2e, 0x46, 0x75, 0x6e, 0x63, 0x44, 0x65, 0x63, 0x6c, 0x4e, 0x6f,
+	0x64, 0x65, 0x48, 0x00, 0x52, 0x08, 0x66, 0x75, 0x6e, 0x63, 0x44, 0x65, 0x63, 0x6c, 0x42, 0x06,
+	0x0a, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x22, 0x85, 0x01, 0x0a, 0x0c, 0x50, 0x6f, 0x73, 0x69, 0x74,
+	0x69, 0x6f, 0x6e, 0x4e, 0x6f, 0x64, 0x65, 0x12, 0x15, 0x0a, 0x06, 0x72, 0x65, 0x66, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x72, 0x65, 0x66, 0x49, 0x64, 0x12, 0x1a,
+	0x0a, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66,
+	0x66, 0x73, 0x65, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73,
+	0x65, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x04, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x22, 0x63,
+	0x0a, 0x0b, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x12, 0x15, 0x0a,
+	0x06, 0x72, 0x65, 0x66, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x72,
+	0x65, 0x66, 0x49, 0x64, 0x12, 0x29, 0x0a, 0x05, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x67, 0x6f, 0x61, 0x73, 0x74, 0x2e, 0x50, 0x6f, 0x73, 0x69,
+	0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x05, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x12,
+	0x12, 0x0a, 0x04, 0x74, 0x65, 0x78, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74,
+	0x65, 0x78, 0x74, 0x22, 0x51, 0x0a, 0x10, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x47, 0x72,
+	0x6f, 0x75, 0x70, 0x4e, 0x6f, 0x64, 0x65, 0x12, 0x15, 0x0a, 0x06, 0x72, 0x65, 0x66, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x72, 0x65, 0x66, 0x49, 0x64, 0x12, 0x26,
+	0x0a, 0x04, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x67,
+	0x6f, 0x61, 0x73, 0x74, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x4e, 0x6f, 0x64, 0x65,
+	0x52, 0x04, 0x6c, 0x69, 0x73, 0x74, 0x22, 0xf0, 0x01, 0x0a, 0x09, 0x46, 0x69, 0x65, 0x6c, 0x64,
+	0x4e, 0x6f, 0x64, 0x65, 0x12, 0x15, 0x0a, 0x06, 0x72, 0x65, 0x66, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x72, 0x65, 0x66, 0x49, 0x64, 0x12, 0x29, 0x0a, 0x03, 0x64,
+	0x6f, 0x63, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x61, 0x73, 0x74,
+	0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4e, 0x6f, 0x64,
+	0x65, 0x52, 0x03, 0x64, 0x6f, 0x63, 0x12, 0x26, 0x0a, 0x05, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x18,
+	0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x67, 0x6f, 0x61, 0x73, 0x74, 0x2e, 0x49, 0x64,
+	0x65, 0x6e, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x05, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x12, 0x1f,
+	0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x67,
+	0x6f, 0x61, 0x73, 0x74, 0x2e, 0x45, 0x78, 0x70, 0x72, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12,
+	0x25, 0x0a, 0x03, 0x74, 0x61, 0x67, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x67,
+	0x6f, 0x61, 0x73, 0x74, 0x2e, 0x42, 0x61, 0x73, 0x69, 0x63, 0x4c, 0x69, 0x74, 0x4e, 0x6f, 0x64,
+	0x65, 0x52, 0x03, 0x74, 0x61, 0x67, 0x12, 0x31, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
+	0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x61, 0x73, 0x74, 0x2e,
+	0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4e, 0x6f, 0x64, 0x65,
+	0x52, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0xaa, 0x01, 0x0a, 0x0d, 0x46, 0x69,
+	0x65, 0x6c, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x12, 0x15, 0x0a, 0x06, 0x72,
+	0x65, 0x66, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x72, 0x65, 0x66,
+	0x49, 0x64, 0x12, 0x2d, 0x0a, 0x07, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x67, 0x6f, 0x61, 0x73, 0x74, 0x2e, 0x50, 0x6f, 0x73, 0x69,
+	0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x07, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6e,
+	0x67, 0x12, 0x24, 0x0a, 0x04, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x10, 0x2e, 0x67, 0x6f, 0x61, 0x73, 0x74, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x4e, 0x6f, 0x64,
+	0x65, 0x52, 0x04, 0x6c, 0x69, 0x73, 0x74, 0x12, 0x2d, 0x0a, 0x07, 0x63, 0x6c, 0x6f, 0x73, 0x69,
+	0x6e, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x67, 0x6f, 0x61, 0x73, 0x74,
+	0x2e, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x07, 0x63,
+	0x6c, 0x6f, 0x73, 0x69, 0x6e, 0x67, 0x22, 0x72, 0x0a, 0x0b, 0x42, 0x61, 0x64, 0x45, 0x78, 0x70,
+	0x72, 0x4e, 0x6f, 0x64, 0x65, 0x12, 0x15, 0x0a, 0x06, 0x72, 0x65, 0x66, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x72, 0x65, 0x66, 0x49, 0x64, 0x12, 0x27, 0x0a, 0x04,
+	0x66, 0x72, 0x6f, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x67, 0x6f, 0x61,
+	0x73, 0x74, 0x2e, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x6f, 0x64, 0x65, 0x52,
+	0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x23, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x13, 0x2e, 0x67, 0x6f, 0x61, 0x73, 0x74, 0x2e, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69,
+	0x6f, 0x6e, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x02, 0x74, 0x6f, 0x22, 0x66, 0x0a, 0x09, 0x49, 0x64,
+	0x65, 0x6e, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x12, 0x15, 0x0a, 0x06, 0x72, 0x65, 0x66, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x72, 0x65, 0x66, 0x49, 0x64, 0x12, 0x2e,
+	0x0a, 0x08, 0x6e, 0x61, 0x6d, 0x65, 0x5f, 0x70, 0x6f, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x13, 0x2e, 0x67, 0x6f, 0x61, 0x73, 0x74, 0x2e, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f,
+	0x6e, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x07, 0x6e, 0x61, 0x6d, 0x65, 0x50, 0x6f, 0x73, 0x12, 0x12,
+	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x22, 0x75, 0x0a, 0x0c, 0x45, 0x6c, 0x6c, 0x69, 0x70, 0x73, 0x69, 0x73, 0x4e, 0x6f,
+	0x64, 0x65, 0x12, 0x15, 0x0a, 0x06, 0x72, 0x65, 0x66, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x05, 0x72, 0x65, 0x66, 0x49, 0x64, 0x12, 0x2f, 0x0a, 0x08, 0x65, 0x6c, 0x6c,
+	0x69, 0x70, 0x73, 0x69, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x67, 0x6f,
+	0x61, 0x73, 0x74, 0x2e, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x6f, 0x64, 0x65,
+	0x52, 0x08, 0x65, 0x6c, 0x6c, 0x69, 0x70, 0x73, 0x69, 0x73, 0x12, 0x1d, 0x0a, 0x03, 0x65, 0x6c,
+	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x67, 0x6f, 0x61, 0x73, 0x74, 0x2e,
+	0x45, 0x78, 0x70, 0x72, 0x52, 0x03, 0x65, 0x6c, 0x74, 0x22, 0x81, 0x01, 0x0a, 0x0c, 0x42, 0x61,
+	0x73, 0x69, 0x63, 0x4c, 0x69, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x12, 0x15, 0x0a, 0x06, 0x72, 0x65,
+	0x66, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x72, 0x65, 0x66, 0x49,
+	0x64, 0x12, 0x30, 0x0a, 0x09, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x5f, 0x70, 0x6f, 0x73, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x67, 0x6f, 0x61, 0x73, 0x74, 0x2e, 0x50, 0x6f, 0x73,
+	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x08, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x50, 0x6f, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x77, 0x0a,
+	0x0b, 0x46, 0x75, 0x6e, 0x63, 0x4c, 0x69, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x12, 0x15, 0x0a, 0x06,
+	0x72, 0x65, 0x66, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x72, 0x65,
+	0x66, 0x49, 0x64, 0x12, 0x27, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x13, 0x2e, 0x67, 0x6f, 0x61, 0x73, 0x74, 0x2e, 0x46, 0x75, 0x6e, 0x63, 0x54, 0x79,
+	0x70, 0x65, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x28, 0x0a, 0x04,
+	0x62, 0x6f, 0x64, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x61,
+	0x73, 0x74, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x53, 0x74, 0x6d, 0x74, 0x4e, 0x6f, 0x64, 0x65,
+	0x52, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x22, 0xe5, 0x01, 0x0a, 0x10, 0x43, 0x6f, 0x6d, 0x70, 0x6f,
+	0x73, 0x69, 0x74, 0x65, 0x4c, 0x69, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x12, 0x15, 0x0a, 0x06, 0x72,
+	0x65, 0x66, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x72, 0x65, 0x66,
+	0x49, 0x64, 0x12, 0x1f, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x0b, 0x2e, 0x67, 0x6f, 0x61, 0x73, 0x74, 0x2e, 0x45, 0x78, 0x70, 0x72, 0x52, 0x04, 0x74,
+	0x79, 0x70, 0x65, 0x12, 0x2b, 0x0a, 0x06, 0x6c, 0x62, 0x72, 0x61, 0x63, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x67, 0x6f, 0x61, 0x73, 0x74, 0x2e, 0x50, 0x6f, 0x73, 0x69,
+	0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x06, 0x6c, 0x62, 0x72, 0x61, 0x63, 0x65,
+	0x12, 0x1f, 0x0a, 0x04, 0x65, 0x6c, 0x74, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0b,
+	0x2e, 0x67, 0x6f, 0x61, 0x73, 0x74, 0x2e, 0x45, 0x78, 0x70, 0x72, 0x52, 0x04, 0x65, 0x6c, 0x74,
+	0x73, 0x12, 0x2b, 0x0a, 0x06, 0x72, 0x62, 0x72, 0x61, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x13, 0x2e, 0x67, 0x6f, 0x61, 0x73, 0x74, 0x2e, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69,
+	0x6f, 0x6e, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x06, 0x72, 0x62, 0x72, 0x61, 0x63, 0x65, 0x12, 0x1e,
+	0x0a, 0x0a, 0x69, 0x6e, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x0a, 0x69, 0x6e, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x22, 0x9b,
+	0x01, 0x0a, 0x0d, 0x50, 0x61, 0x72, 0x65, 0x6e, 0x45, 0x78, 0x70, 0x72, 0x4e, 0x6f, 0x64, 0x65,
+	0x12, 0x15, 0x0a, 0x06, 0x72, 0x65, 0x66, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x05, 0x72, 0x65, 0x66, 0x49, 0x64, 0x12, 0x2b, 0x0a, 0x06, 0x6c, 0x70, 0x61, 0x72, 0x65,
+	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x67, 0x6f, 0x61, 0x73, 0x74, 0x2e,
+	0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x06, 0x6c, 0x70,
+	0x61, 0x72, 0x65, 0x6e, 0x12, 0x19, 0x0a, 0x01, 0x78, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0b, 0x2e, 0x67, 0x6f, 0x61, 0x73, 0x74, 0x2e, 0x45, 0x78, 0x70, 0x72, 0x52, 0x01, 0x78, 0x12,
+	0x2b, 0x0a, 0x06, 0x72, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x13, 0x2e, 0x67, 0x6f, 0x61, 0x73, 0x74, 0x2e, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e,
+	0x4e, 0x6f, 0x64, 0x65, 0x52, 0x06, 0x72, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x22, 0x68, 0x0a, 0x10,
+	0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x45, 0x78, 0x70, 0x72, 0x4e, 0x6f, 0x64, 0x65,
+	0x12, 0x15, 0x0a, 0x06, 0x72, 0x65, 0x66, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x05, 0x72, 0x65, 0x66, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x01, 0x78, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x67, 0x6f, 0x61, 0x73, 0x74, 0x2e, 0x45, 0x78, 0x70, 0x72, 0x52,
+	0x01, 0x78, 0x12, 0x22, 0x0a, 0x03, 0x73, 0x65, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x10, 0x2e, 0x67, 0x6f, 0x61, 0x73, 0x74, 0x2e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x4e, 0x6f, 0x64,
+	0x65, 0x52, 0x03, 0x73, 0x65, 0x6c, 0x22, 0xbe, 0x01, 0x0a, 0x0d, 0x49, 0x6e, 0x64, 0x65, 0x78,
+	0x45, 0x78, 0x70, 0x72, 0x4e, 0x6f, 0x64, 0x65, 0x12, 0x15, 0x0a, 0x06, 0x72, 0x65, 0x66, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x72, 0x65, 0x66, 0x49, 0x64, 0x12,
+	0x19, 0x0a, 0x01, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x67, 0x6f, 0x61,
+	0x73, 0x74, 0x2e, 0x45, 0x78, 0x70, 0x72, 0x52, 0x01, 0x78, 0x12, 0x2b, 0x0a, 0x06, 0x6c, 0x62,
+	0x72, 0x61, 0x63, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x67, 0x6f, 0x61,
+	0x73, 0x74, 0x2e, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x6f, 0x64, 0x65, 0x52,
+	0x06, 0x6c, 0x62, 0x72, 0x61, 0x63, 0x6b, 0x12, 0x21, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x67, 0x6f, 0x61, 0x73, 0x74, 0x2e, 0x45,
+	0x78, 0x70, 0x72, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x2b, 0x0a, 0x06, 0x72, 0x62,
+	0x72, 0x61, 0x63, 0x6b, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x67, 0x6f, 0x61,
+	0x73, 0x74, 0x2e, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x6f, 0x64, 0x65, 0x52,
+	0x06, 0x72, 0x62, 0x72, 0x61, 0x63, 0x6b, 0x22, 0xc6, 0x01, 0x0a, 0x11, 0x49, 0x6e, 0x64, 0x65,
+	0x78, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x78, 0x70, 0x72, 0x4e, 0x6f, 0x64, 0x65, 0x12, 0x15, 0x0a,
+	0x06, 0x72, 0x65, 0x66, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x72,
+	0x65, 0x66, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x01, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0b, 0x2e, 0x67, 0x6f, 0x61, 0x73, 0x74, 0x2e, 0x45, 0x78, 0x70, 0x72, 0x52, 0x01, 0x78, 0x12,
+	0x2b, 0x0a, 0x06, 0x6c, 0x62, 0x72, 0x61, 0x63, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x13, 0x2e, 0x67, 0x6f, 0x61, 0x73, 0x74, 0x2e, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e,
+	0x4e, 0x6f, 0x64, 0x65, 0x52, 0x06, 0x6c, 0x62, 0x72, 0x61, 0x63, 0x6b, 0x12, 0x25, 0x0a, 0x07,
+	0x69, 0x6e, 0x64, 0x69, 0x63, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0b, 0x2e,
+	0x67, 0x6f, 0x61, 0x73, 0x74, 0x2e, 0x45, 0x78, 0x70, 0x72, 0x52, 0x07, 0x69, 0x6e, 0x64, 0x69,
+	0x63, 0x65, 0x73, 0x12, 0x2b, 0x0a, 0x06, 0x72, 0x62, 0x72, 0x61, 0x63, 0x6b, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x67, 0x6f, 0x61, 0x73, 0x74, 0x2e, 0x50, 0x6f, 0x73, 0x69,
+	0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x06, 0x72, 0x62, 0x72, 0x61, 0x63, 0x6b,
+	0x22, 0x92, 0x02, 0x0a, 0x0d, 0x53, 0x6c, 0x69, 0x63, 0x65, 0x45, 0x78, 0x70, 0x72, 0x4e, 0x6f,
+	0x64, 0x65, 0x12, 0x15, 0x0a, 0x06, 0x72, 0x65, 0x66, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x05, 0x72, 0x65, 0x66, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x01, 0x78, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x67, 0x6f, 0x61, 0x73, 0x74, 0x2e, 0x45, 0x78, 0x70,
+	0x72, 0x52, 0x01, 0x78, 0x12, 0x2b, 0x0a, 0x06, 0x6c, 0x62, 0x72, 0x61, 0x63, 0x6b, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x67, 0x6f, 0x61, 0x73, 0x74, 0x2e, 0x50, 0x6f, 0x73,
+	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x06, 0x6c, 0x62, 0x72, 0x61, 0x63,
+	0x6b, 0x12, 0x1d, 0x0a, 0x03, 0x6c, 0x6f, 0x77, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b,
+	0x2e, 0x67, 0x6f, 0x61, 0x73, 0x74, 0x2e, 0x45, 0x78, 0x70, 0x72, 0x52, 0x03, 0x6c, 0x6f, 0x77,
+	0x12, 0x1f, 0x0a, 0x04, 0x68, 0x69, 0x67, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b,
+	0x2e, 0x67, 0x6f, 0x61, 0x73, 0x74, 0x2e, 0x45, 0x78, 0x70, 0x72, 0x52, 0x04, 0x68, 0x69, 0x67,
+	0x68, 0x12, 0x1d, 0x0a, 0x03, 0x6d, 0x61, 0x78, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b,
+	0x2e, 0x67, 0x6f, 0x61, 0x73, 0x74, 0x2e, 0x45, 0x78, 0x70, 0x72, 0x52, 0x03, 0x6d, 0x61, 0x78,
+	0x12, 0x16, 0x0a, 0x06, 0x73, 0x6c, 0x69, 0x63, 0x65, 0x33, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x06, 0x73, 0x6c, 0x69, 0x63, 0x65, 0x33, 0x12, 0x2b, 0x0a, 0x06, 0x72, 0x62, 0x72, 0x61,
+	0x63, 0x6b, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x67, 0x6f, 0x61, 0x73, 0x74,
+	0x2e, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x06, 0x72,
+	0x62, 0x72, 0x61, 0x63, 0x6b, 0x22, 0xc1, 0x01, 0x0a, 0x12, 0x54, 0x79, 0x70, 0x65, 0x41, 0x73,
+	0x73, 0x65, 0x72, 0x74, 0x45, 0x78, 0x70, 0x72, 0x4e, 0x6f, 0x64, 0x65, 0x12, 0x15, 0x0a, 0x06,
+	0x72, 0x65, 0x66, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x72, 0x65,
+	0x66, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x01, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b,
+	0x2e, 0x67, 0x6f, 0x61, 0x73, 0x74, 0x2e, 0x45, 0x78, 0x70, 0x72, 0x52, 0x01, 0x78, 0x12, 0x2b,
+	0x0a, 0x06, 0x6c, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13,
+	0x2e, 0x67, 0x6f, 0x61, 0x73, 0x74, 0x2e, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x4e,
+	0x6f, 0x64, 0x65, 0x52, 0x06, 0x6c, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x12, 0x1f, 0x0a, 0x04, 0x74,
+	0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x67, 0x6f, 0x61, 0x73,
+	0x74, 0x2e, 0x45, 0x78, 0x70, 0x72, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x2b, 0x0a, 0x06,
+	0x72, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x67,
+	0x6f, 0x61, 0x73, 0x74, 0x2e, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x6f, 0x64,
+	0x65, 0x52, 0x06, 0x72, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x22, 0xf0, 0x01, 0x0a, 0x0c, 0x43, 0x61,
+	0x6c, 0x6c, 0x45, 0x78, 0x70, 0x72, 0x4e, 0x6f, 0x64, 0x65, 0x12, 0x15, 0x0a, 0x06, 0x72, 0x65,
+	0x66, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x72, 0x65, 0x66, 0x49,
+	0x64, 0x12, 0x1d, 0x0a, 0x03, 0x66, 0x75, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b,
+	0x2e, 0x67, 0x6f, 0x61, 0x73, 0x74, 0x2e, 0x45, 0x78, 0x70, 0x72, 0x52, 0x03, 0x66, 0x75, 0x6e,
+	0x12, 0x2b, 0x0a, 0x06, 0x6c, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x13, 0x2e, 0x67, 0x6f, 0x61, 0x73, 0x74, 0x2e, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f,
+	0x6e, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x06, 0x6c, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x12, 0x1f, 0x0a,
+	0x04, 0x61, 0x72, 0x67, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x67, 0x6f,
+	0x61, 0x73, 0x74, 0x2e, 0x45, 0x78, 0x70, 0x72, 0x52, 0x04, 0x61, 0x72, 0x67, 0x73, 0x12, 0x2f,
+	0x0a, 0x08, 0x65, 0x6c, 0x6c, 0x69, 0x70, 0x73, 0x69, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x13, 0x2e, 0x67, 0x6f, 0x61, 0x73, 0x74, 0x2e, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f,
+	0x6e, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x08, 0x65, 0x6c, 0x6c, 0x69, 0x70, 0x73, 0x69, 0x73, 0x12,
+	0x2b, 0x0a, 0x06, 0x72, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x13, 0x2e, 0x67, 0x6f, 0x61, 0x73, 0x74, 0x2e, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e,
+	0x4e, 0x6f, 0x64, 0x65, 0x52, 0x06, 0x72, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x22, 0x69, 0x0a, 0x0c,
+	0x53, 0x74, 0x61, 0x72, 0x45, 0x78, 0x70, 0x72, 0x4e, 0x6f, 0x64, 0x65, 0x12, 0x15, 0x0a, 0x06,
+	0x72, 0x65, 0x66, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x72, 0x65,
+	0x66, 0x49, 0x64, 0x12, 0x27, 0x0a, 0x04, 0x73, 0x74, 0x61, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x13, 0x2e, 0x67, 0x6f, 0x61, 0x73, 0x74, 0x2e, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69,
+	0x6f, 0x6e, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x73, 0x74, 0x61, 0x72, 0x12, 0x19, 0x0a, 0x01,
+	0x78, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x67, 0x6f, 0x61, 0x73, 0x74, 0x2e,
+	0x45, 0x78, 0x70, 0x72, 0x52, 0x01, 0x78, 0x22, 0x7d, 0x0a, 0x0d, 0x55, 0x6e, 0x61, 0x72, 0x79,
+	0x45, 0x78, 0x70, 0x72, 0x4e, 0x6f, 0x64, 0x65, 0x12, 0x15, 0x0a, 0x06, 0x72, 0x65, 0x66, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x72, 0x65, 0x66, 0x49, 0x64, 0x12,
+	0x2a, 0x0a, 0x06, 0x6f, 0x70, 0x5f, 0x70, 0x6f, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x13, 0x2e, 0x67, 0x6f, 0x61, 0x73, 0x74, 0x2e, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e,
+	0x4e, 0x6f, 0x64, 0x65, 0x52, 0x05, 0x6f, 0x70, 0x50, 0x6f, 0x73, 0x12, 0x0e, 0x0a, 0x02, 0x6f,
+	0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x6f, 0x70, 0x12, 0x19, 0x0a, 0x01, 0x78,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x67, 0x6f, 0x61, 0x73, 0x74, 0x2e, 0x45,
+	0x78, 0x70, 0x72, 0x52, 0x01, 0x78, 0x22, 0x99, 0x01, 0x0a, 0x0e, 0x42, 0x69, 0x6e, 0x61, 0x72,
+	0x79, 0x45, 0x78, 0x70, 0x72, 0x4e, 0x6f, 0x64, 0x65, 0x12, 0x15, 0x0a, 0x06, 0x72, 0x65, 0x66,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x72, 0x65, 0x66, 0x49, 0x64,
+	0x12, 0x19, 0x0a, 0x01, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x67, 0x6f,
+	0x61, 0x73, 0x74, 0x2e, 0x45, 0x78, 0x70, 0x72, 0x52, 0x01, 0x78, 0x12, 0x2a, 0x0a, 0x06, 0x6f,
+	0x70, 0x5f, 0x70, 0x6f, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x67, 0x6f,
+	0x61, 0x73, 0x74, 0x2e, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x6f, 0x64, 0x65,
+	0x52, 0x05, 0x6f, 0x70, 0x50, 0x6f, 0x73, 0x12, 0x0e, 0x0a, 0x02, 0x6f, 0x70, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x02, 0x6f, 0x70, 0x12, 0x19, 0x0a, 0x01, 0x79, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x67, 0x6f, 0x61, 0x73, 0x74, 0x2e, 0x45, 0x78, 0x70, 0x72, 0x52,
+	0x01, 0x79, 0x22, 0x96, 0x01, 0x0a, 0x10, 0x4b, 0x65, 0x79, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x45,
+	0x78, 0x70, 0x72, 0x4e, 0x6f, 0x64, 0x65, 0x12, 0x15, 0x0a, 0x06, 0x72, 0x65, 0x66, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x72, 0x65, 0x66, 0x49, 0x64, 0x12, 0x1d,
+	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x67, 0x6f,
+	0x61, 0x73, 0x74, 0x2e, 0x45, 0x78, 0x70, 0x72, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x29, 0x0a,
+	0x05, 0x63, 0x6f, 0x6c, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x67,
+	0x6f, 0x61, 0x73, 0x74, 0x2e, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x6f, 0x64,
+	0x65, 0x52, 0x05, 0x63, 0x6f, 0x6c, 0x6f, 0x6e, 0x12, 0x21, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x67, 0x6f, 0x61, 0x73, 0x74, 0x2e,
+	0x45, 0x78, 0x70, 0x72, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x91, 0x01, 0x0a, 0x0d,
+	0x41, 0x72, 0x72, 0x61, 0x79, 0x54, 0x79, 0x70, 0x65, 0x4e, 0x6f, 0x64, 0x65, 0x12, 0x15, 0x0a,
+	0x06, 0x72, 0x65, 0x66, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x72,
+	0x65, 0x66, 0x49, 0x64, 0x12, 0x2b, 0x0a, 0x06, 0x6c, 0x62, 0x72, 0x61, 0x63, 0x6b, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x67, 0x6f, 0x61, 0x73, 0x74, 0x2e, 0x50, 0x6f, 0x73,
+	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x06, 0x6c, 0x62, 0x72, 0x61, 0x63,
+	0x6b, 0x12, 0x1d, 0x0a, 0x03, 0x6c, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b,
+	0x2e, 0x67, 0x6f, 0x61, 0x73, 0x74, 0x2e, 0x45, 0x78, 0x70, 0x72, 0x52, 0x03, 0x6c, 0x65, 0x6e,
+	0x12, 0x1d, 0x0a, 0x03, 0x65, 0x6c, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e,
+	0x67, 0x6f, 0x61, 0x73, 0x74, 0x2e, 0x45, 0x78, 0x70, 0x72, 0x52, 0x03, 0x65, 0x6c, 0x74, 0x22,
+	0xa2, 0x01, 0x0a, 0x0e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x54, 0x79, 0x70, 0x65, 0x4e, 0x6f,
+	0x64, 0x65, 0x12, 0x15, 0x0a, 0x06, 0x72, 0x65, 0x66, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x05, 0x72, 0x65, 0x66, 0x49, 0x64, 0x12, 0x2b, 0x0a, 0x06, 0x73, 0x74, 0x72,
+	0x75, 0x63, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x67, 0x6f, 0x61, 0x73,
+	0x74, 0x2e, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x06,
+	0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x12, 0x2c, 0x0a, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x61, 0x73, 0x74, 0x2e, 0x46,
+	0x69, 0x65, 0x6c, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x06, 0x66, 0x69,
+	0x65, 0x6c, 0x64, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x69, 0x6e, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65,
+	0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x69, 0x6e, 0x63, 0x6f, 0x6d, 0x70,
+	0x6c, 0x65, 0x74, 0x65, 0x22, 0xe3, 0x01, 0x0a, 0x0c, 0x46, 0x75, 0x6e, 0x63, 0x54, 0x79, 0x70,
+	0x65, 0x4e, 0x6f, 0x64, 0x65, 0x12, 0x15, 0x0a, 0x06, 0x72, 0x65, 0x66, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x72, 0x65, 0x66, 0x49, 0x64, 0x12, 0x27, 0x0a, 0x04,
+	0x66, 0x75, 0x6e, 0x63, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x67, 0x6f, 0x61,
+	0x73, 0x74, 0x2e, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x6f, 0x64, 0x65, 0x52,
+	0x04, 0x66, 0x75, 0x6e, 0x63, 0x12, 0x35, 0x0a, 0x0b, 0x74, 0x79, 0x70, 0x65, 0x5f, 0x70, 0x61,
+	0x72, 0x61, 0x6d, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x61,
+	0x73, 0x74, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x4e, 0x6f, 0x64, 0x65,
+	0x52, 0x0a, 0x74, 0x79, 0x70, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x2c, 0x0a, 0x06,
+	0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67,
+	0x6f, 0x61, 0x73, 0x74, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x4e, 0x6f,
+	0x64, 0x65, 0x52, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x2e, 0x0a, 0x07, 0x72, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f,
+	0x61, 0x73, 0x74, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x4e, 0x6f, 0x64,
+	0x65, 0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x22, 0xad, 0x01, 0x0a, 0x11, 0x49,
+	0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x4e, 0x6f, 0x64, 0x65,
+	0x12, 0x15, 0x0a, 0x06, 0x72, 0x65, 0x66, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x05, 0x72, 0x65, 0x66, 0x49, 0x64, 0x12, 0x31, 0x0a, 0x09, 0x69, 0x6e, 0x74, 0x65, 0x72,
+	0x66, 0x61, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x67, 0x6f, 0x61,
+	0x73, 0x74, 0x2e, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x6f, 0x64, 0x65, 0x52,
+	0x09, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x12, 0x2e, 0x0a, 0x07, 0x6d, 0x65,
+	0x74, 0x68, 0x6f, 0x64, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f,
+	0x61, 0x73, 0x74, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x4e, 0x6f, 0x64,
+	0x65, 0x52, 0x07, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x69, 0x6e,
+	0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a,
+	0x69, 0x6e, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x22, 0x8d, 0x01, 0x0a, 0x0b, 0x4d,
+	0x61, 0x70, 0x54, 0x79, 0x70, 0x65, 0x4e, 0x6f, 0x64, 0x65, 0x12, 0x15, 0x0a, 0x06, 0x72, 0x65,
+	0x66, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x72, 0x65, 0x66, 0x49,
+	0x64, 0x12, 0x25, 0x0a, 0x03, 0x6d, 0x61, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13,
+	0x2e, 0x67, 0x6f, 0x61, 0x73, 0x74, 0x2e, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x4e,
+	0x6f, 0x64, 0x65, 0x52, 0x03, 0x6d, 0x61, 0x70, 0x12, 0x1d, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x67, 0x6f, 0x61, 0x73, 0x74, 0x2e, 0x45, 0x78,
+	0x70, 0x72, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x21, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x67, 0x6f, 0x61, 0x73, 0x74, 0x2e, 0x45,
+	0x78, 0x70, 0x72, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0xb0, 0x01, 0x0a, 0x0c, 0x43,
+	0x68, 0x61, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x4e, 0x6f, 0x64, 0x65, 0x12, 0x15, 0x0a, 0x06, 0x72,
+	0x65, 0x66, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x72, 0x65, 0x66,
+	0x49, 0x64, 0x12, 0x29, 0x0a, 0x05, 0x62, 0x65, 0x67, 0x69, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x13, 0x2e, 0x67, 0x6f, 0x61, 0x73, 0x74, 0x2e, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69,
+	0x6f, 0x6e, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x05, 0x62, 0x65, 0x67, 0x69, 0x6e, 0x12, 0x29, 0x0a,
+	0x05, 0x61, 0x72, 0x72, 0x6f, 0x77, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x67,
+	0x6f, 0x61, 0x73, 0x74, 0x2e, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x6f, 0x64,
+	0x65, 0x52, 0x05, 0x61, 0x72, 0x72, 0x6f, 0x77, 0x12, 0x10, 0x0a, 0x03, 0x64, 0x69, 0x72, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x64, 0x69, 0x72, 0x12, 0x21, 0x0a, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x67, 0x6f, 0x61, 0x73,
+	0x74, 0x2e, 0x45, 0x78, 0x70, 0x72, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x72, 0x0a,
+	0x0b, 0x42, 0x61, 0x64, 0x53, 0x74, 0x6d, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x12, 0x15, 0x0a, 0x06,
+	0x72, 0x65, 0x66, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x72, 0x65,
+	0x66, 0x49, 0x64, 0x12, 0x27, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x13, 0x2e, 0x67, 0x6f, 0x61, 0x73, 0x74, 0x2e, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69,
+	0x6f, 0x6e, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x23, 0x0a, 0x02,
+	0x74, 0x6f, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x67, 0x6f, 0x61, 0x73, 0x74,
+	0x2e, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x02, 0x74,
+	0x6f, 0x22, 0x46, 0x0a, 0x0c, 0x44, 0x65, 0x63, 0x6c, 0x53, 0x74, 0x6d, 0x74, 0x4e, 0x6f, 0x64,
+	0x65, 0x12, 0x15, 0x0a, 0x06, 0x72, 0x65, 0x66, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x05, 0x72, 0x65, 0x66, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x04, 0x64, 0x65, 0x63, 0x6c,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x67, 0x6f, 0x61, 0x73, 0x74, 0x2e, 0x44,
+	0x65, 0x63, 0x6c, 0x52, 0x04, 0x64, 0x65, 0x63, 0x6c, 0x22, 0x75, 0x0a, 0x0d, 0x45, 0x6d, 0x70,
+	0x74, 0x79, 0x53, 0x74, 0x6d, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x12, 0x15, 0x0a, 0x06, 0x72, 0x65,
+	0x66, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x72, 0x65, 0x66, 0x49,
+	0x64, 0x12, 0x31, 0x0a, 0x09, 0x73, 0x65, 0x6d, 0x69, 0x63, 0x6f, 0x6c, 0x6f, 0x6e, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x67, 0x6f, 0x61, 0x73, 0x74, 0x2e, 0x50, 0x6f, 0x73,
+	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x09, 0x73, 0x65, 0x6d, 0x69, 0x63,
+	0x6f, 0x6c, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x6d, 0x70, 0x6c, 0x69, 0x63, 0x69, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x69, 0x6d, 0x70, 0x6c, 0x69, 0x63, 0x69, 0x74,
+	0x22, 0x9c, 0x01, 0x0a, 0x0f, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x65, 0x64, 0x53, 0x74, 0x6d, 0x74,
+	0x4e, 0x6f, 0x64, 0x65, 0x12, 0x15, 0x0a, 0x06, 0x72, 0x65, 0x66, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x72, 0x65, 0x66, 0x49, 0x64, 0x12, 0x26, 0x0a, 0x05, 0x6c,
+	0x61, 0x62, 0x65, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x67, 0x6f, 0x61,
+	0x73, 0x74, 0x2e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x05, 0x6c, 0x61,
+	0x62, 0x65, 0x6c, 0x12, 0x29, 0x0a, 0x05, 0x63, 0x6f, 0x6c, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x13, 0x2e, 0x67, 0x6f, 0x61, 0x73, 0x74, 0x2e, 0x50, 0x6f, 0x73, 0x69, 0x74,
+	0x69, 0x6f, 0x6e, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x05, 0x63, 0x6f, 0x6c, 0x6f, 0x6e, 0x12, 0x1f,
+	0x0a, 0x04, 0x73, 0x74, 0x6d, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x67,
+	0x6f, 0x61, 0x73, 0x74, 0x2e, 0x53, 0x74, 0x6d, 0x74, 0x52, 0x04, 0x73, 0x74, 0x6d, 0x74, 0x22,
+	0x40, 0x0a, 0x0c, 0x45, 0x78, 0x70, 0x72, 0x53, 0x74, 0x6d, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x12,
+	0x15, 0x0a, 0x06, 0x72, 0x65, 0x66, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x05, 0x72, 0x65, 0x66, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x01, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0b, 0x2e, 0x67, 0x6f, 0x61, 0x73, 0x74, 0x2e, 0x45, 0x78, 0x70, 0x72, 0x52, 0x01,
+	0x78, 0x22, 0x94, 0x01, 0x0a, 0x0c, 0x53, 0x65, 0x6e, 0x64, 0x53, 0x74, 0x6d, 0x74, 0x4e, 0x6f,
+	0x64, 0x65, 0x12, 0x15, 0x0a, 0x06, 0x72, 0x65, 0x66, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x05, 0x72, 0x65, 0x66, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x04, 0x63, 0x68, 0x61,
+	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x67, 0x6f, 0x61, 0x73, 0x74, 0x2e,
+	0x45, 0x78, 0x70, 0x72, 0x52, 0x04, 0x63, 0x68, 0x61, 0x6e, 0x12, 0x29, 0x0a, 0x05, 0x61, 0x72,
+	0x72, 0x6f, 0x77, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x67, 0x6f, 0x61, 0x73,
+	0x74, 0x2e, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x05,
+	0x61, 0x72, 0x72, 0x6f, 0x77, 0x12, 0x21, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x67, 0x6f, 0x61, 0x73, 0x74, 0x2e, 0x45, 0x78, 0x70,
+	0x72, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x82, 0x01, 0x0a, 0x0e, 0x49, 0x6e, 0x63,
+	0x44, 0x65, 0x63, 0x53, 0x74, 0x6d, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x12, 0x15, 0x0a, 0x06, 0x72,
+	0x65, 0x66, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x72, 0x65, 0x66,
+	0x49, 0x64, 0x12, 0x19, 0x0a, 0x01, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e,
+	0x67, 0x6f, 0x61, 0x73, 0x74, 0x2e, 0x45, 0x78, 0x70, 0x72, 0x52, 0x01, 0x78, 0x12, 0x2c, 0x0a,
+	0x07, 0x74, 0x6f, 0x6b, 0x5f, 0x70, 0x6f, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13,
+	0x2e, 0x67, 0x6f, 0x61, 0x73, 0x74, 0x2e, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x4e,
+	0x6f, 0x64, 0x65, 0x52, 0x06, 0x74, 0x6f, 0x6b, 0x50, 0x6f, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x74,
+	0x6f, 0x6b, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x74, 0x6f, 0x6b, 0x22, 0xa5, 0x01,
+	0x0a, 0x0e, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x53, 0x74, 0x6d, 0x74, 0x4e, 0x6f, 0x64, 0x65,
+	0x12, 0x15, 0x0a, 0x06, 0x72, 0x65, 0x66, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x05, 0x72, 0x65, 0x66, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x03, 0x6c, 0x68, 0x73, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x67, 0x6f, 0x61, 0x73, 0x74, 0x2e, 0x45, 0x78, 0x70,
+	0x72, 0x52, 0x03, 0x6c, 0x68, 0x73, 0x12, 0x2c, 0x0a, 0x07, 0x74, 0x6f, 0x6b, 0x5f, 0x70, 0x6f,
+	0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x67, 0x6f, 0x61, 0x73, 0x74, 0x2e,
+	0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x06, 0x74, 0x6f,
+	0x6b, 0x50, 0x6f, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x74, 0x6f, 0x6b, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x74, 0x6f, 0x6b, 0x12, 0x1d, 0x0a, 0x03, 0x72, 0x68, 0x73, 0x18, 0x05, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x67, 0x6f, 0x61, 0x73, 0x74, 0x2e, 0x45, 0x78, 0x70, 0x72,
+	0x52, 0x03, 0x72, 0x68, 0x73, 0x22, 0x71, 0x0a, 0x0a, 0x47, 0x6f, 0x53, 0x74, 0x6d, 0x74, 0x4e,
+	0x6f, 0x64, 0x65, 0x12, 0x15, 0x0a, 0x06, 0x72, 0x65, 0x66, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x05, 0x72, 0x65, 0x66, 0x49, 0x64, 0x12, 0x23, 0x0a, 0x02, 0x67, 0x6f,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x67, 0x6f, 0x61, 0x73, 0x74, 0x2e, 0x50,
+	0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x02, 0x67, 0x6f, 0x12,
+	0x27, 0x0a, 0x04, 0x63, 0x61, 0x6c, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e,
+	0x67, 0x6f, 0x61, 0x73, 0x74, 0x2e, 0x43, 0x61, 0x6c, 0x6c, 0x45, 0x78, 0x70, 0x72, 0x4e, 0x6f,
+	0x64, 0x65, 0x52, 0x04, 0x63, 0x61, 0x6c, 0x6c, 0x22, 0x7a, 0x0a, 0x0d, 0x44, 0x65, 0x66, 0x65,
+	0x72, 0x53, 0x74, 0x6d, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x12, 0x15, 0x0a, 0x06, 0x72, 0x65, 0x66,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x72, 0x65, 0x66, 0x49, 0x64,
+	0x12, 0x29, 0x0a, 0x05, 0x64, 0x65, 0x66, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x13, 0x2e, 0x67, 0x6f, 0x61, 0x73, 0x74, 0x2e, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e,
+	0x4e, 0x6f, 0x64, 0x65, 0x52, 0x05, 0x64, 0x65, 0x66, 0x65, 0x72, 0x12, 0x27, 0x0a, 0x04, 0x63,
+	0x61, 0x6c, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x67, 0x6f, 0x61, 0x73,
+	0x74, 0x2e, 0x43, 0x61, 0x6c, 0x6c, 0x45, 0x78, 0x70, 0x72, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x04,
+	0x63, 0x61, 0x6c, 0x6c, 0x22, 0x7b, 0x0a, 0x0e, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x53, 0x74,
+	0x6d, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x12, 0x15, 0x0a, 0x06, 0x72, 0x65, 0x66, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x72, 0x65, 0x66, 0x49, 0x64, 0x12, 0x2b, 0x0a,
+	0x06, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e,
+	0x67, 0x6f, 0x61, 0x73, 0x74, 0x2e, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x6f,
+	0x64, 0x65, 0x52, 0x06, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x12, 0x25, 0x0a, 0x07, 0x72, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x67, 0x6f,
+	0x61, 0x73, 0x74, 0x2e, 0x45, 0x78, 0x70, 0x72, 0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x73, 0x22, 0x8f, 0x01, 0x0a, 0x0e, 0x42, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x53, 0x74, 0x6d, 0x74,
+	0x4e, 0x6f, 0x64, 0x65, 0x12, 0x15, 0x0a, 0x06, 0x72, 0x65, 0x66, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x72, 0x65, 0x66, 0x49, 0x64, 0x12, 0x2c, 0x0a, 0x07, 0x74,
+	0x6f, 0x6b, 0x5f, 0x70, 0x6f, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x67,
+	0x6f, 0x61, 0x73, 0x74, 0x2e, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x6f, 0x64,
+	0x65, 0x52, 0x06, 0x74, 0x6f, 0x6b, 0x50, 0x6f, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x74, 0x6f, 0x6b,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x74, 0x6f, 0x6b, 0x12, 0x26, 0x0a, 0x05, 0x6c,
+	0x61, 0x62, 0x65, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x67, 0x6f, 0x61,
+	0x73, 0x74, 0x2e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x05, 0x6c, 0x61,
+	0x62, 0x65, 0x6c, 0x22, 0xa1, 0x01, 0x0a, 0x0d, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x53, 0x74, 0x6d,
+	0x74, 0x4e, 0x6f, 0x64, 0x65, 0x12, 0x15, 0x0a, 0x06, 0x72, 0x65, 0x66, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x72, 0x65, 0x66, 0x49, 0x64, 0x12, 0x2b, 0x0a, 0x06,
+	0x6c, 0x62, 0x72, 0x61, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x67,
+	0x6f, 0x61, 0x73, 0x74, 0x2e, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x6f, 0x64,
+	0x65, 0x52, 0x06, 0x6c, 0x62, 0x72, 0x61, 0x63, 0x65, 0x12, 0x1f, 0x0a, 0x04, 0x6c, 0x69, 0x73,
+	0x74, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x67, 0x6f, 0x61, 0x73, 0x74, 0x2e,
+	0x53, 0x74, 0x6d, 0x74, 0x52, 0x04, 0x6c, 0x69, 0x73, 0x74, 0x12, 0x2b, 0x0a, 0x06, 0x72, 0x62,
+	0x72, 0x61, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x67, 0x6f, 0x61,
+	0x73, 0x74, 0x2e, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x6f, 0x64, 0x65, 0x52,
+	0x06, 0x72, 0x62, 0x72, 0x61, 0x63, 0x65, 0x22, 0xd5, 0x01, 0x0a, 0x0a, 0x49, 0x66, 0x53, 0x74,
+	0x6d, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x12, 0x15, 0x0a, 0x06, 0x72, 0x65, 0x66, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x72, 0x65, 0x66, 0x49, 0x64, 0x12, 0x23, 0x0a,
+	0x02, 0x69, 0x66, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x67, 0x6f, 0x61, 0x73,
+	0x74, 0x2e, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x02,
+	0x69, 0x66, 0x12, 0x1f, 0x0a, 0x04, 0x69, 0x6e, 0x69, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x0b, 0x2e, 0x67, 0x6f, 0x61, 0x73, 0x74, 0x2e, 0x53, 0x74, 0x6d, 0x74, 0x52, 0x04, 0x69,
+	0x6e, 0x69, 0x74, 0x12, 0x1f, 0x0a, 0x04, 0x63, 0x6f, 0x6e, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0b, 0x2e, 0x67, 0x6f, 0x61, 0x73, 0x74, 0x2e, 0x45, 0x78, 0x70, 0x72, 0x52, 0x04,
+	0x63, 0x6f, 0x6e, 0x64, 0x12, 0x28, 0x0a, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x61, 0x73, 0x74, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
+	0x53, 0x74, 0x6d, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x12, 0x1f,
+	0x0a, 0x04, 0x65, 0x6c, 0x73, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x67,
+	0x6f, 0x61, 0x73, 0x74, 0x2e, 0x53, 0x74, 0x6d, 0x74, 0x52, 0x04, 0x65, 0x6c, 0x73, 0x65, 0x22,
+	0xbd, 0x01, 0x0a, 0x0e, 0x43, 0x61, 0x73, 0x65, 0x43, 0x6c, 0x61, 0x75, 0x73, 0x65, 0x4e, 0x6f,
+	0x64, 0x65, 0x12, 0x15, 0x0a, 0x06, 0x72, 0x65, 0x66, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x05, 0x72, 0x65, 0x66, 0x49, 0x64, 0x12, 0x27, 0x0a, 0x04, 0x63, 0x61, 0x73,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x67, 0x6f, 0x61, 0x73, 0x74, 0x2e,
+	0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x63, 0x61,
+	0x73, 0x65, 0x12, 0x1f, 0x0a, 0x04, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x0b, 0x2e, 0x67, 0x6f, 0x61, 0x73, 0x74, 0x2e, 0x45, 0x78, 0x70, 0x72, 0x52, 0x04, 0x6c,
+	0x69, 0x73, 0x74, 0x12, 0x29, 0x0a, 0x05, 0x63, 0x6f, 0x6c, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x13, 0x2e, 0x67, 0x6f, 0x61, 0x73, 0x74, 0x2e, 0x50, 0x6f, 0x73, 0x69, 0x74,
+	0x69, 0x6f, 0x6e, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x05, 0x63, 0x6f, 0x6c, 0x6f, 0x6e, 0x12, 0x1f,
+	0x0a, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x67,
+	0x6f, 0x61, 0x73, 0x74, 0x2e, 0x53, 0x74, 0x6d, 0x74, 0x52, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x22,
+	0xbe, 0x01, 0x0a, 0x0e, 0x53, 0x77, 0x69, 0x74, 0x63, 0x68, 0x53, 0x74, 0x6d, 0x74, 0x4e, 0x6f,
+	0x64, 0x65, 0x12, 0x15, 0x0a, 0x06, 0x72, 0x65, 0x66, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x05, 0x72, 0x65, 0x66, 0x49, 0x64, 0x12, 0x2b, 0x0a, 0x06, 0x73, 0x77, 0x69,
+	0x74, 0x63, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x67, 0x6f, 0x61, 0x73,
+	0x74, 0x2e, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x06,
+	0x73, 0x77, 0x69, 0x74, 0x63, 0x68, 0x12, 0x1f, 0x0a, 0x04, 0x69, 0x6e, 0x69, 0x74, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x67, 0x6f, 0x61, 0x73, 0x74, 0x2e, 0x53, 0x74, 0x6d,
+	0x74, 0x52, 0x04, 0x69, 0x6e, 0x69, 0x74, 0x12, 0x1d, 0x0a, 0x03, 0x74, 0x61, 0x67, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x67, 0x6f, 0x61, 0x73, 0x74, 0x2e, 0x45, 0x78, 0x70,
+	0x72, 0x52, 0x03, 0x74, 0x61, 0x67, 0x12, 0x28, 0x0a, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x61, 0x73, 0x74, 0x2e, 0x42, 0x6c, 0x6f,
+	0x63, 0x6b, 0x53, 0x74, 0x6d, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x62, 0x6f, 0x64, 0x79,
+	0x22, 0xc8, 0x01, 0x0a, 0x12, 0x54, 0x79, 0x70, 0x65, 0x53, 0x77, 0x69, 0x74, 0x63, 0x68, 0x53,
+	0x74, 0x6d, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x12, 0x15, 0x0a, 0x06, 0x72, 0x65, 0x66, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x72, 0x65, 0x66, 0x49, 0x64, 0x12, 0x2b,
+	0x0a, 0x06, 0x73, 0x77, 0x69, 0x74, 0x63, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13,
+	0x2e, 0x67, 0x6f, 0x61, 0x73, 0x74, 0x2e, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x4e,
+	0x6f, 0x64, 0x65, 0x52, 0x06, 0x73, 0x77, 0x69, 0x74, 0x63, 0x68, 0x12, 0x1f, 0x0a, 0x04, 0x69,
+	0x6e, 0x69, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x67, 0x6f, 0x61, 0x73,
+	0x74, 0x2e, 0x53, 0x74, 0x6d, 0x74, 0x52, 0x04, 0x69, 0x6e, 0x69, 0x74, 0x12, 0x23, 0x0a, 0x06,
+	0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x67,
+	0x6f, 0x61, 0x73, 0x74, 0x2e, 0x53, 0x74, 0x6d, 0x74, 0x52, 0x06, 0x61, 0x73, 0x73, 0x69, 0x67,
+	0x6e, 0x12, 0x28, 0x0a, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x14, 0x2e, 0x67, 0x6f, 0x61, 0x73, 0x74, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x53, 0x74, 0x6d,
+	0x74, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x22, 0xbd, 0x01, 0x0a, 0x0e,
+	0x43, 0x6f, 0x6d, 0x6d, 0x43, 0x6c, 0x61, 0x75, 0x73, 0x65, 0x4e, 0x6f, 0x64, 0x65, 0x12, 0x15,
+	0x0a, 0x06, 0x72, 0x65, 0x66, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05,
+	0x72, 0x65, 0x66, 0x49, 0x64, 0x12, 0x27, 0x0a, 0x04, 0x63, 0x61, 0x73, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x67, 0x6f, 0x61, 0x73, 0x74, 0x2e, 0x50, 0x6f, 0x73, 0x69,
+	0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x63, 0x61, 0x73, 0x65, 0x12, 0x1f,
+	0x0a, 0x04, 0x63, 0x6f, 0x6d, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x67,
+	0x6f, 0x61, 0x73, 0x74, 0x2e, 0x53, 0x74, 0x6d, 0x74, 0x52, 0x04, 0x63, 0x6f, 0x6d, 0x6d, 0x12,
+	0x29, 0x0a, 0x05, 0x63, 0x6f, 0x6c, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13,
+	0x2e, 0x67, 0x6f, 0x61, 0x73, 0x74, 0x2e, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x4e,
+	0x6f, 0x64, 0x65, 0x52, 0x05, 0x63, 0x6f, 0x6c, 0x6f, 0x6e, 0x12, 0x1f, 0x0a, 0x04, 0x62, 0x6f,
+	0x64, 0x79, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x67, 0x6f, 0x61, 0x73, 0x74,
+	0x2e, 0x53, 0x74, 0x6d, 0x74, 0x52, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x22, 0x7e, 0x0a, 0x0e, 0x53,
+	0x65, 0x6c, 0x65, 0x63, 0x74, 0x53, 0x74, 0x6d, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x12, 0x15, 0x0a,
+	0x06, 0x72, 0x65, 0x66, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x72,
+	0x65, 0x66, 0x49, 0x64, 0x12, 0x2b, 0x0a, 0x06, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x67, 0x6f, 0x61, 0x73, 0x74, 0x2e, 0x50, 0x6f, 0x73,
+	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x06, 0x73, 0x65, 0x6c, 0x65, 0x63,
+	0x74, 0x12, 0x28, 0x0a, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x14, 0x2e, 0x67, 0x6f, 0x61, 0x73, 0x74, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x53, 0x74, 0x6d,
+	0x74, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x22, 0xd8, 0x01, 0x0a, 0x0b,
+	0x46, 0x6f, 0x72, 0x53, 0x74, 0x6d, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x12, 0x15, 0x0a, 0x06, 0x72,
+	0x65, 0x66, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x72, 0x65, 0x66,
+	0x49, 0x64, 0x12, 0x25, 0x0a, 0x03, 0x66, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x13, 0x2e, 0x67, 0x6f, 0x61, 0x73, 0x74, 0x2e, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e,
+	0x4e, 0x6f, 0x64, 0x65, 0x52, 0x03, 0x66, 0x6f, 0x72, 0x12, 0x1f, 0x0a, 0x04, 0x69, 0x6e, 0x69,
+	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x67, 0x6f, 0x61, 0x73, 0x74, 0x2e,
+	0x53, 0x74, 0x6d, 0x74, 0x52, 0x04, 0x69, 0x6e, 0x69, 0x74, 0x12, 0x1f, 0x0a, 0x04, 0x63, 0x6f,
+	0x6e, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x67, 0x6f, 0x61, 0x73, 0x74,
+	0x2e, 0x45, 0x78, 0x70, 0x72, 0x52, 0x04, 0x63, 0x6f, 0x6e, 0x64, 0x12, 0x1f, 0x0a, 0x04, 0x70,
+	0x6f, 0x73, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x67, 0x6f, 0x61, 0x73,
+	0x74, 0x2e, 0x53, 0x74, 0x6d, 0x74, 0x52, 0x04, 0x70, 0x6f, 0x73, 0x74, 0x12, 0x28, 0x0a, 0x04,
+	0x62, 0x6f, 0x64, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x61,
+	0x73, 0x74, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x53, 0x74, 0x6d, 0x74, 0x4e, 0x6f, 0x64, 0x65,
+	0x52, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x22, 0x94, 0x02, 0x0a, 0x0d, 0x52, 0x61, 0x6e, 0x67, 0x65,
+	0x53, 0x74, 0x6d, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x12, 0x15, 0x0a, 0x06, 0x72, 0x65, 0x66, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x72, 0x65, 0x66, 0x49, 0x64, 0x12,
+	0x25, 0x0a, 0x03, 0x66, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x67,
+	0x6f, 0x61, 0x73, 0x74, 0x2e, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x6f, 0x64,
+	0x65, 0x52, 0x03, 0x66, 0x6f, 0x72, 0x12, 0x1d, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x67, 0x6f, 0x61, 0x73, 0x74, 0x2e, 0x45, 0x78, 0x70, 0x72,
+	0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x21, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x67, 0x6f, 0x61, 0x73, 0x74, 0x2e, 0x45, 0x78, 0x70,
+	0x72, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x2c, 0x0a, 0x07, 0x74, 0x6f, 0x6b, 0x5f,
+	0x70, 0x6f, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x67, 0x6f, 0x61, 0x73,
+	0x74, 0x2e, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x06,
+	0x74, 0x6f, 0x6b, 0x50, 0x6f, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x74, 0x6f, 0x6b, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x74, 0x6f, 0x6b, 0x12, 0x19, 0x0a, 0x01, 0x78, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x67, 0x6f, 0x61, 0x73, 0x74, 0x2e, 0x45, 0x78, 0x70, 0x72,
+	0x52, 0x01, 0x78, 0x12, 0x28, 0x0a, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x61, 0x73, 0x74, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x53,
+	0x74, 0x6d, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x22, 0x82, 0x02,
+	0x0a, 0x0e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x53, 0x70, 0x65, 0x63, 0x4e, 0x6f, 0x64, 0x65,
+	0x12, 0x15, 0x0a, 0x06, 0x72, 0x65, 0x66, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x05, 0x72, 0x65, 0x66, 0x49, 0x64, 0x12, 0x29, 0x0a, 0x03, 0x64, 0x6f, 0x63, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x61, 0x73, 0x74, 0x2e, 0x43, 0x6f, 0x6d,
+	0x6d, 0x65, 0x6e, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x03, 0x64,
+	0x6f, 0x63, 0x12, 0x24, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x10, 0x2e, 0x67, 0x6f, 0x61, 0x73, 0x74, 0x2e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x4e, 0x6f,
+	0x64, 0x65, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x27, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x67, 0x6f, 0x61, 0x73, 0x74, 0x2e, 0x42,
+	0x61, 0x73, 0x69, 0x63, 0x4c, 0x69, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x70, 0x61, 0x74,
+	0x68, 0x12, 0x31, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x61, 0x73, 0x74, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x65,
+	0x6e, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x07, 0x63, 0x6f, 0x6d,
+	0x6d, 0x65, 0x6e, 0x74, 0x12, 0x2c, 0x0a, 0x07, 0x65, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x67, 0x6f, 0x61, 0x73, 0x74, 0x2e, 0x50, 0x6f,
+	0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x06, 0x65, 0x6e, 0x64, 0x50,
+	0x6f, 0x73, 0x22, 0xf2, 0x01, 0x0a, 0x0d, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x53, 0x70, 0x65, 0x63,
+	0x4e, 0x6f, 0x64, 0x65, 0x12, 0x15, 0x0a, 0x06, 0x72, 0x65, 0x66, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x72, 0x65, 0x66, 0x49, 0x64, 0x12, 0x29, 0x0a, 0x03, 0x64,
+	0x6f, 0x63, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x61, 0x73, 0x74,
+	0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4e, 0x6f, 0x64,
+	0x65, 0x52, 0x03, 0x64, 0x6f, 0x63, 0x12, 0x26, 0x0a, 0x05, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x18,
+	0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x67, 0x6f, 0x61, 0x73, 0x74, 0x2e, 0x49, 0x64,
+	0x65, 0x6e, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x05, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x12, 0x1f,
+	0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x67,
+	0x6f, 0x61, 0x73, 0x74, 0x2e, 0x45, 0x78, 0x70, 0x72, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12,
+	0x23, 0x0a, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x0b, 0x2e, 0x67, 0x6f, 0x61, 0x73, 0x74, 0x2e, 0x45, 0x78, 0x70, 0x72, 0x52, 0x06, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x73, 0x12, 0x31, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x61, 0x73, 0x74, 0x2e, 0x43, 0x6f,
+	0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x07,
+	0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0xae, 0x02, 0x0a, 0x0c, 0x54, 0x79, 0x70, 0x65,
+	0x53, 0x70, 0x65, 0x63, 0x4e, 0x6f, 0x64, 0x65, 0x12, 0x15, 0x0a, 0x06, 0x72, 0x65, 0x66, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x72, 0x65, 0x66, 0x49, 0x64, 0x12,
+	0x29, 0x0a, 0x03, 0x64, 0x6f, 0x63, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67,
+	0x6f, 0x61, 0x73, 0x74, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x47, 0x72, 0x6f, 0x75,
+	0x70, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x03, 0x64, 0x6f, 0x63, 0x12, 0x24, 0x0a, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x67, 0x6f, 0x61, 0x73, 0x74,
+	0x2e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x35, 0x0a, 0x0b, 0x74, 0x79, 0x70, 0x65, 0x5f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x61, 0x73, 0x74, 0x2e, 0x46, 0x69,
+	0x65, 0x6c, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x0a, 0x74, 0x79, 0x70,
+	0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x2b, 0x0a, 0x06, 0x61, 0x73, 0x73, 0x69, 0x67,
+	0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x67, 0x6f, 0x61, 0x73, 0x74, 0x2e,
+	0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x06, 0x61, 0x73,
+	0x73, 0x69, 0x67, 0x6e, 0x12, 0x1f, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x67, 0x6f, 0x61, 0x73, 0x74, 0x2e, 0x45, 0x78, 0x70, 0x72, 0x52,
+	0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x31, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x61, 0x73, 0x74, 0x2e, 0x43,
+	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4e, 0x6f, 0x64, 0x65, 0x52,
+	0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0x72, 0x0a, 0x0b, 0x42, 0x61, 0x64, 0x44,
+	0x65, 0x63, 0x6c, 0x4e, 0x6f, 0x64, 0x65, 0x12, 0x15, 0x0a, 0x06, 0x72, 0x65, 0x66, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x72, 0x65, 0x66, 0x49, 0x64, 0x12, 0x27,
+	0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x67,
+	0x6f, 0x61, 0x73, 0x74, 0x2e, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x6f, 0x64,
+	0x65, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x23, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x67, 0x6f, 0x61, 0x73, 0x74, 0x2e, 0x50, 0x6f, 0x73, 0x69,
+	0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x02, 0x74, 0x6f, 0x22, 0x8c, 0x02, 0x0a,
+	0x0b, 0x47, 0x65, 0x6e, 0x44, 0x65, 0x63, 0x6c, 0x4e, 0x6f, 0x64, 0x65, 0x12, 0x15, 0x0a, 0x06,
+	0x72, 0x65, 0x66, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x72, 0x65,
+	0x66, 0x49, 0x64, 0x12, 0x29, 0x0a, 0x03, 0x64, 0x6f, 0x63, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x17, 0x2e, 0x67, 0x6f, 0x61, 0x73, 0x74, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74,
+	0x47, 0x72, 0x6f, 0x75, 0x70, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x03, 0x64, 0x6f, 0x63, 0x12, 0x2c,
+	0x0a, 0x07, 0x74, 0x6f, 0x6b, 0x5f, 0x70, 0x6f, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x13, 0x2e, 0x67, 0x6f, 0x61, 0x73, 0x74, 0x2e, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e,
+	0x4e, 0x6f, 0x64, 0x65, 0x52, 0x06, 0x74, 0x6f, 0x6b, 0x50, 0x6f, 0x73, 0x12, 0x10, 0x0a, 0x03,
+	0x74, 0x6f, 0x6b, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x74, 0x6f, 0x6b, 0x12, 0x2b,
+	0x0a, 0x06, 0x6c, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13,
+	0x2e, 0x67, 0x6f, 0x61, 0x73, 0x74, 0x2e, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x4e,
+	0x6f, 0x64, 0x65, 0x52, 0x06, 0x6c, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x12, 0x21, 0x0a, 0x05, 0x73,
+	0x70, 0x65, 0x63, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x67, 0x6f, 0x61,
+	0x73, 0x74, 0x2e, 0x53, 0x70, 0x65, 0x63, 0x52, 0x05, 0x73, 0x70, 0x65, 0x63, 0x73, 0x12, 0x2b,
+	0x0a, 0x06, 0x72, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13,
+	0x2e, 0x67, 0x6f, 0x61, 0x73, 0x74, 0x2e, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x4e,
+	0x6f, 0x64, 0x65, 0x52, 0x06, 0x72, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x22, 0xf3, 0x01, 0x0a, 0x0c,
+	0x46, 0x75, 0x6e, 0x63, 0x44, 0x65, 0x63, 0x6c, 0x4e, 0x6f, 0x64, 0x65, 0x12, 0x15, 0x0a, 0x06,
+	0x72, 0x65, 0x66, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x72, 0x65,
+	0x66, 0x49, 0x64, 0x12, 0x29, 0x0a, 0x03, 0x64, 0x6f, 0x63, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x17, 0x2e, 0x67, 0x6f, 0x61, 0x73, 0x74, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74,
+	0x47, 0x72, 0x6f, 0x75, 0x70, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x03, 0x64, 0x6f, 0x63, 0x12, 0x28,
+	0x0a, 0x04, 0x72, 0x65, 0x63, 0x76, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67,
+	0x6f, 0x61, 0x73, 0x74, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x4e, 0x6f,
+	0x64, 0x65, 0x52, 0x04, 0x72, 0x65, 0x63, 0x76, 0x12, 0x24, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x67, 0x6f, 0x61, 0x73, 0x74, 0x2e, 0x49,
+	0x64, 0x65, 0x6e, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x27,
+	0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x67,
+	0x6f, 0x61, 0x73, 0x74, 0x2e, 0x46, 0x75, 0x6e, 0x63, 0x54, 0x79, 0x70, 0x65, 0x4e, 0x6f, 0x64,
+	0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x28, 0x0a, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x61, 0x73, 0x74, 0x2e, 0x42, 0x6c,
+	0x6f, 0x63, 0x6b, 0x53, 0x74, 0x6d, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x62, 0x6f, 0x64,
+	0x79, 0x22, 0x5e, 0x0a, 0x0c, 0x46, 0x69, 0x6c, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x4e, 0x6f, 0x64,
+	0x65, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72,
+	0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72,
+	0x79, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e,
+	0x74, 0x22, 0xb0, 0x03, 0x0a, 0x08, 0x46, 0x69, 0x6c, 0x65, 0x4e, 0x6f, 0x64, 0x65, 0x12, 0x15,
+	0x0a, 0x06, 0x72, 0x65, 0x66, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05,
+	0x72, 0x65, 0x66, 0x49, 0x64, 0x12, 0x27, 0x0a, 0x04, 0x6d, 0x65, 0x74, 0x61, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x67, 0x6f, 0x61, 0x73, 0x74, 0x2e, 0x46, 0x69, 0x6c, 0x65,
+	0x4d, 0x65, 0x74, 0x61, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x6d, 0x65, 0x74, 0x61, 0x12, 0x29,
+	0x0a, 0x03, 0x64, 0x6f, 0x63, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f,
+	0x61, 0x73, 0x74, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70,
+	0x4e, 0x6f, 0x64, 0x65, 0x52, 0x03, 0x64, 0x6f, 0x63, 0x12, 0x2d, 0x0a, 0x07, 0x70, 0x61, 0x63,
+	0x6b, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x67, 0x6f, 0x61,
+	0x73, 0x74, 0x2e, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x6f, 0x64, 0x65, 0x52,
+	0x07, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x12, 0x24, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x67, 0x6f, 0x61, 0x73, 0x74, 0x2e, 0x49,
+	0x64, 0x65, 0x6e, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x21,
+	0x0a, 0x05, 0x64, 0x65, 0x63, 0x6c, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0b, 0x2e,
+	0x67, 0x6f, 0x61, 0x73, 0x74, 0x2e, 0x44, 0x65, 0x63, 0x6c, 0x52, 0x05, 0x64, 0x65, 0x63, 0x6c,
+	0x73, 0x12, 0x2f, 0x0a, 0x07, 0x69, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x18, 0x07, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x15, 0x2e, 0x67, 0x6f, 0x61, 0x73, 0x74, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72,
+	0x74, 0x53, 0x70, 0x65, 0x63, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x07, 0x69, 0x6d, 0x70, 0x6f, 0x72,
+	0x74, 0x73, 0x12, 0x30, 0x0a, 0x0a, 0x75, 0x6e, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x64,
+	0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x67, 0x6f, 0x61, 0x73, 0x74, 0x2e, 0x49,
+	0x64, 0x65, 0x6e, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x0a, 0x75, 0x6e, 0x72, 0x65, 0x73, 0x6f,
+	0x6c, 0x76, 0x65, 0x64, 0x12, 0x33, 0x0a, 0x08, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73,
+	0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x61, 0x73, 0x74, 0x2e, 0x43,
+	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4e, 0x6f, 0x64, 0x65, 0x52,
+	0x08, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x29, 0x0a, 0x08, 0x66, 0x69, 0x6c,
+	0x65, 0x5f, 0x73, 0x65, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x67, 0x6f,
+	0x61, 0x73, 0x74, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x53, 0x65, 0x74, 0x52, 0x07, 0x66, 0x69, 0x6c,
+	0x65, 0x53, 0x65, 0x74, 0x22, 0x47, 0x0a, 0x07, 0x46, 0x69, 0x6c, 0x65, 0x53, 0x65, 0x74, 0x12,
+	0x12, 0x0a, 0x04, 0x62, 0x61, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x62,
+	0x61, 0x73, 0x65, 0x12, 0x28, 0x0a, 0x05, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x12, 0x2e, 0x67, 0x6f, 0x61, 0x73, 0x74, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x53,
+	0x65, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x05, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x22, 0x8d, 0x01,
+	0x0a, 0x0b, 0x46, 0x69, 0x6c, 0x65, 0x53, 0x65, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x12, 0x0a,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x12, 0x0a, 0x04, 0x62, 0x61, 0x73, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x04, 0x62, 0x61, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6e,
+	0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x69, 0x6e, 0x65, 0x73, 0x12,
+	0x2c, 0x0a, 0x05, 0x69, 0x6e, 0x66, 0x6f, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16,
+	0x2e, 0x67, 0x6f, 0x61, 0x73, 0x74, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x53, 0x65, 0x74, 0x4c, 0x69,
+	0x6e, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x05, 0x69, 0x6e, 0x66, 0x6f, 0x73, 0x22, 0x71, 0x0a,
+	0x0f, 0x46, 0x69, 0x6c, 0x65, 0x53, 0x65, 0x74, 0x4c, 0x69, 0x6e, 0x65, 0x49, 0x6e, 0x66, 0x6f,
+	0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x69, 0x6c, 0x65,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x69, 0x6c, 0x65,
+	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x04, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x6f, 0x6c, 0x75,
+	0x6d, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e,
+	0x42, 0x15, 0x5a, 0x13, 0x47, 0x6f, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x41, 0x53,
+	0x54, 0x2f, 0x61, 0x73, 0x74, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_ast_proto_rawDescOnce sync.Once
+	file_ast_proto_rawDescData = file_ast_proto_rawDesc
+)
+
+func file_ast_proto_rawDescGZIP() []byte {
+	file_ast_proto_rawDescOnce.Do(func() {
+		file_ast_proto_rawDescData = protoimpl.X.CompressGZIP(file_ast_proto_rawDescData)
+	})
+	return file_ast_proto_rawDescData
+}
+
+var file_ast_proto_msgTypes = make([]protoimpl.MessageInfo, 64)
+var file_ast_proto_goTypes = []any{
+	(*Expr)(nil),               // 0: goast.Expr
+	(*Stmt)(nil),               // 1: goast.Stmt
+	(*Spec)(nil),               // 2: goast.Spec
+	(*Decl)(nil),               // 3: goast.Decl
+	(*PositionNode)(nil),       // 4: goast.PositionNode
+	(*CommentNode)(nil),        // 5: goast.CommentNode
+	(*CommentGroupNode)(nil),   // 6: goast.CommentGroupNode
+	(*FieldNode)(nil),          // 7: goast.FieldNode
+	(*FieldListNode)(nil),      // 8: goast.FieldListNode
+	(*BadExprNode)(nil),        // 9: goast.BadExprNode
+	(*IdentNode)(nil),          // 10: goast.IdentNode
+	(*EllipsisNode)(nil),       // 11: goast.EllipsisNode
+	(*BasicLitNode)(nil),       // 12: goast.BasicLitNode
+	(*FuncLitNode)(nil),        // 13: goast.FuncLitNode
+	(*CompositeLitNode)(nil),   // 14: goast.CompositeLitNode
+	(*ParenExprNode)(nil),      // 15: goast.ParenExprNode
+	(*SelectorExprNode)(nil),   // 16: goast.SelectorExprNode
+	(*IndexExprNode)(nil),      // 17: goast.IndexExprNode
+	(*IndexListExprNode)(nil),  // 18: goast.IndexListExprNode
+	(*SliceExprNode)(nil),      // 19: goast.SliceExprNode
+	(*TypeAssertExprNode)(nil), // 20: goast.TypeAssertExprNode
+	(*CallExprNode)(nil),       // 21: goast.CallExprNode
+	(*StarExprNode)(nil),       // 22: goast.StarExprNode
+	(*UnaryExprNode)(nil),      // 23: goast.UnaryExprNode
+	(*BinaryExprNode)(nil),     // 24: goast.BinaryExprNode
+	(*KeyValueExprNode)(nil),   // 25: goast.KeyValueExprNode
+	(*ArrayTypeNode)(nil),      // 26: goast.ArrayTypeNode
+	(*StructTypeNode)(nil),     // 27: goast.StructTypeNode
+	(*FuncTypeNode)(nil),       // 28: goast.FuncTypeNode
+	(*InterfaceTypeNode)(nil),  // 29: goast.InterfaceTypeNode
+	(*MapTypeNode)(nil),        // 30: goast.MapTypeNode
+	(*ChanTypeNode)(nil),       // 31: goast.ChanTypeNode
+	(*BadStmtNode)(nil),        // 32: goast.BadStmtNode
+	(*DeclStmtNode)(nil),       // 33: goast.DeclStmtNode
+	(*EmptyStmtNode)(nil),      // 34: goast.EmptyStmtNode
+	(*LabeledStmtNode)(nil),    // 35: goast.LabeledStmtNode
+	(*ExprStmtNode)(nil),       // 36: goast.ExprStmtNode
+	(*SendStmtNode)(nil),       // 37: goast.SendStmtNode
+	(*IncDecStmtNode)(nil),     // 38: goast.IncDecStmtNode
+	(*AssignStmtNode)(nil),     // 39: goast.AssignStmtNode
+	(*GoStmtNode)(nil),         // 40: goast.GoStmtNode
+	(*DeferStmtNode)(nil),      // 41: goast.DeferStmtNode
+	(*ReturnStmtNode)(nil),     // 42: goast.ReturnStmtNode
+	(*BranchStmtNode)(nil),     // 43: goast.BranchStmtNode
+	(*BlockStmtNode)(nil),      // 44: goast.BlockStmtNode
+	(*IfStmtNode)(nil),         // 45: goast.IfStmtNode
+	(*CaseClauseNode)(nil),     // 46: goast.CaseClauseNode
+	(*SwitchStmtNode)(nil),     // 47: goast.SwitchStmtNode
+	(*TypeSwitchStmtNode)(nil), // 48: goast.TypeSwitchStmtNode
+	(*CommClauseNode)(nil),     // 49: goast.CommClauseNode
+	(*SelectStmtNode)(nil),     // 50: goast.SelectStmtNode
+	(*ForStmtNode)(nil),        // 51: goast.ForStmtNode
+	(*RangeStmtNode)(nil),      // 52: goast.RangeStmtNode
+	(*ImportSpecNode)(nil),     // 53: goast.ImportSpecNode
+	(*ValueSpecNode)(nil),      // 54: goast.ValueSpecNode
+	(*TypeSpecNode)(nil),       // 55: goast.TypeSpecNode
+	(*BadDeclNode)(nil),        // 56: goast.BadDeclNode
+	(*GenDeclNode)(nil),        // 57: goast.GenDeclNode
+	(*FuncDeclNode)(nil),       // 58: goast.FuncDeclNode
+	(*FileMetaNode)(nil),       // 59: goast.FileMetaNode
+	(*FileNode)(nil),           // 60: goast.FileNode
+	(*FileSet)(nil),            // 61: goast.FileSet
+	(*FileSetFile)(nil),        // 62: goast.FileSetFile
+	(*FileSetLineInfo)(nil),    // 63: goast.FileSetLineInfo
+}
+var file_ast_proto_depIdxs = []int32{
+	9,   // 0: goast.Expr.bad_expr:type_name -> goast.BadExprNode
+	10,  // 1: goast.Expr.ident:type_name -> goast.IdentNode
+	11,  // 2: goast.Expr.ellipsis:type_name -> goast.EllipsisNode
+	12,  // 3: goast.Expr.basic_lit:type_name -> goast.BasicLitNode
+	13,  // 4: goast.Expr.func_lit:type_name -> goast.FuncLitNode
+	14,  // 5: goast.Expr.composite_lit:type_name -> goast.CompositeLitNode
+	15,  // 6: goast.Expr.paren_expr:type_name -> goast.ParenExprNode
+	16,  // 7: goast.Expr.selector_expr:type_name -> goast.SelectorExprNode
+	17,  // 8: goast.Expr.index_expr:type_name -> goast.IndexExprNode
+	18,  // 9: goast.Expr.index_list_expr:type_name -> goast.IndexListExprNode
+	19,  // 10: goast.Expr.slice_expr:type_name -> goast.SliceExprNode
+	20,  // 11: goast.Expr.type_assert_expr:type_name -> goast.TypeAssertExprNode
+	21,  // 12: goast.Expr.call_expr:type_name -> goast.CallExprNode
+	22,  // 13: goast.Expr.star_expr:type_name -> goast.StarExprNode
+	23,  // 14: goast.Expr.unary_expr:type_name -> goast.UnaryExprNode
+	24,  // 15: goast.Expr.binary_expr:type_name -> goast.BinaryExprNode
+	25,  // 16: goast.Expr.key_value_expr:type_name -> goast.KeyValueExprNode
+	26,  // 17: goast.Expr.array_type:type_name -> goast.ArrayTypeNode
+	27,  // 18: goast.Expr.struct_type:type_name -> goast.StructTypeNode
+	28,  // 19: goast.Expr.func_type:type_name -> goast.FuncTypeNode
+	29,  // 20: goast.Expr.interface_type:type_name -> goast.InterfaceTypeNode
+	30,  // 21: goast.Expr.map_type:type_name -> goast.MapTypeNode
+	31,  // 22: goast.Expr.chan_type:type_name -> goast.ChanTypeNode
+	32,  // 23: goast.Stmt.bad_stmt:type_name -> goast.BadStmtNode
+	33,  // 24: goast.Stmt.decl_stmt:type_name -> goast.DeclStmtNode
+	34,  // 25: goast.Stmt.empty_stmt:type_name -> goast.EmptyStmtNode
+	35,  // 26: goast.Stmt.labeled_stmt:type_name -> goast.LabeledStmtNode
+	36,  // 27: goast.Stmt.expr_stmt:type_name -> goast.ExprStmtNode
+	37,  // 28: goast.Stmt.send_stmt:type_name -> goast.SendStmtNode
+	38,  // 29: goast.Stmt.inc_dec_stmt:type_name -> goast.IncDecStmtNode
+	39,  // 30: goast.Stmt.assign_stmt:type_name -> goast.AssignStmtNode
+	40,  // 31: goast.Stmt.go_stmt:type_name -> goast.GoStmtNode
+	41,  // 32: goast.Stmt.defer_stmt:type_name -> goast.DeferStmtNode
+	42,  // 33: goast.Stmt.return_stmt:type_name -> goast.ReturnStmtNode
+	43,  // 34: goast.Stmt.branch_stmt:type_name -> goast.BranchStmtNode
+	44,  // 35: goast.Stmt.block_stmt:type_name -> goast.BlockStmtNode
+	45,  // 36: goast.Stmt.if_stmt:type_name -> goast.IfStmtNode
+	46,  // 37: goast.Stmt.case_clause:type_name -> goast.CaseClauseNode
+	47,  // 38: goast.Stmt.switch_stmt:type_name -> goast.SwitchStmtNode
+	48,  // 39: goast.Stmt.type_switch_stmt:type_name -> goast.TypeSwitchStmtNode
+	49,  // 40: goast.Stmt.comm_clause:type_name -> goast.CommClauseNode
+	50,  // 41: goast.Stmt.select_stmt:type_name -> goast.SelectStmtNode
+	51,  // 42: goast.Stmt.for_stmt:type_name -> goast.ForStmtNode
+	52,  // 43: goast.Stmt.range_stmt:type_name -> goast.RangeStmtNode
+	53,  // 44: goast.Spec.import_spec:type_name -> goast.ImportSpecNode
+	54,  // 45: goast.Spec.value_spec:type_name -> goast.ValueSpecNode
+	55,  // 46: goast.Spec.type_spec:type_name -> goast.TypeSpecNode
+	56,  // 47: goast.Decl.bad_decl:type_name -> goast.BadDeclNode
+	57,  // 48: goast.Decl.gen_decl:type_name -> goast.GenDeclNode
+	58,  // 49: goast.Decl.func_decl:type_name -> goast.FuncDeclNode
+	4,   // 50: goast.CommentNode.slash:type_name -> goast.PositionNode
+	5,   // 51: goast.CommentGroupNode.list:type_name -> goast.CommentNode
+	6,   // 52: goast.FieldNode.doc:type_name -> goast.CommentGroupNode
+	10,  // 53: goast.FieldNode.names:type_name -> goast.IdentNode
+	0,   // 54: goast.FieldNode.type:type_name -> goast.Expr
+	12,  // 55: goast.FieldNode.tag:type_name -> goast.BasicLitNode
+	6,   // 56: goast.FieldNode.comment:type_name -> goast.CommentGroupNode
+	4,   // 57: goast.FieldListNode.opening:type_name -> goast.PositionNode
+	7,   // 58: goast.FieldListNode.list:type_name -> goast.FieldNode
+	4,   // 59: goast.FieldListNode.closing:type_name -> goast.PositionNode
+	4,   // 60: goast.BadExprNode.from:type_name -> goast.PositionNode
+	4,   // 61: goast.BadExprNode.to:type_name -> goast.PositionNode
+	4,   // 62: goast.IdentNode.name_pos:type_name -> goast.PositionNode
+	4,   // 63: goast.EllipsisNode.ellipsis:type_name -> goast.PositionNode
+	0,   // 64: goast.EllipsisNode.elt:type_name -> goast.Expr
+	4,   // 65: goast.BasicLitNode.value_pos:type_name -> goast.PositionNode
+	28,  // 66: goast.FuncLitNode.type:type_name -> goast.FuncTypeNode
+	44,  // 67: goast.FuncLitNode.body:type_name -> goast.BlockStmtNode
+	0,   // 68: goast.CompositeLitNode.type:type_name -> goast.Expr
+	4,   // 69: goast.CompositeLitNode.lbrace:type_name -> goast.PositionNode
+	0,   // 70: goast.CompositeLitNode.elts:type_name -> goast.Expr
+	4,   // 71: goast.CompositeLitNode.rbrace:type_name -> goast.PositionNode
+	4,   // 72: goast.ParenExprNode.lparen:type_name -> goast.PositionNode
+	0,   // 73: goast.ParenExprNode.x:type_name -> goast.Expr
+	4,   // 74: goast.ParenExprNode.rparen:type_name -> goast.PositionNode
+	0,   // 75: goast.SelectorExprNode.x:type_name -> goast.Expr
+	10,  // 76: goast.SelectorExprNode.sel:type_name -> goast.IdentNode
+	0,   // 77: goast.IndexExprNode.x:type_name -> goast.Expr
+	4,   // 78: goast.IndexExprNode.lbrack:type_name -> goast.PositionNode
+	0,   // 79: goast.IndexExprNode.index:type_name -> goast.Expr
+	4,   // 80: goast.IndexExprNode.rbrack:type_name -> goast.PositionNode
+	0,   // 81: goast.IndexListExprNode.x:type_name -> goast.Expr
+	4,   // 82: goast.IndexListExprNode.lbrack:type_name -> goast.PositionNode
+	0,   // 83: goast.IndexListExprNode.indices:type_name -> goast.Expr
+	4,   // 84: goast.IndexListExprNode.rbrack:type_name -> goast.PositionNode
+	0,   // 85: goast.SliceExprNode.x:type_name -> goast.Expr
+	4,   // 86: goast.SliceExprNode.lbrack:type_name -> goast.PositionNode
+	0,   // 87: goast.SliceExprNode.low:type_name -> goast.Expr
+	0,   // 88: goast.SliceExprNode.high:type_name -> goast.Expr
+	0,   // 89: goast.SliceExprNode.max:type_name -> goast.Expr
+	4,   // 90: goast.SliceExprNode.rbrack:type_name -> goast.PositionNode
+	0,   // 91: goast.TypeAssertExprNode.x:type_name -> goast.Expr
+	4,   // 92: goast.TypeAssertExprNode.lparen:type_name -> goast.PositionNode
+	0,   // 93: goast.TypeAssertExprNode.type:type_name -> goast.Expr
+	4,   // 94: goast.TypeAssertExprNode.rparen:type_name -> goast.PositionNode
+	0,   // 95: goast.CallExprNode.fun:type_name -> goast.Expr
+	4,   // 96: goast.CallExprNode.lparen:type_name -> goast.PositionNode
+	0,   // 97: goast.CallExprNode.args:type_name -> goast.Expr
+	4,   // 98: goast.CallExprNode.ellipsis:type_name -> goast.PositionNode
+	4,   // 99: goast.CallExprNode.rparen:type_name -> goast.PositionNode
+	4,   // 100: goast.StarExprNode.star:type_name -> goast.PositionNode
+	0,   // 101: goast.StarExprNode.x:type_name -> goast.Expr
+	4,   // 102: goast.UnaryExprNode.op_pos:type_name -> goast.PositionNode
+	0,   // 103: goast.UnaryExprNode.x:type_name -> goast.Expr
+	0,   // 104: goast.BinaryExprNode.x:type_name -> goast.Expr
+	4,   // 105: goast.BinaryExprNode.op_pos:type_name -> goast.PositionNode
+	0,   // 106: goast.BinaryExprNode.y:type_name -> goast.Expr
+	0,   // 107: goast.KeyValueExprNode.key:type_name -> goast.Expr
+	4,   // 108: goast.KeyValueExprNode.colon:type_name -> goast.PositionNode
+	0,   // 109: goast.KeyValueExprNode.value:type_name -> goast.Expr
+	4,   // 110: goast.ArrayTypeNode.lbrack:type_name -> goast.PositionNode
+	0,   // 111: goast.ArrayTypeNode.len:type_name -> goast.Expr
+	0,   // 112: goast.ArrayTypeNode.elt:type_name -> goast.Expr
+	4,   // 113: goast.StructTypeNode.struct:type_name -> goast.PositionNode
+	8,   // 114: goast.StructTypeNode.fields:type_name -> goast.FieldListNode
+	4,   // 115: goast.FuncTypeNode.func:type_name -> goast.PositionNode
+	8,   // 116: goast.FuncTypeNode.type_params:type_name -> goast.FieldListNode
+	8,   // 117: goast.FuncTypeNode.params:type_name -> goast.FieldListNode
+	8,   // 118: goast.FuncTypeNode.results:type_name -> goast.FieldListNode
+	4,   // 119: goast.InterfaceTypeNode.interface:type_name -> goast.PositionNode
+	8,   // 120: goast.InterfaceTypeNode.methods:type_name -> goast.FieldListNode
+	4,   // 121: goast.MapTypeNode.map:type_name -> goast.PositionNode
+	0,   // 122: goast.MapTypeNode.key:type_name -> goast.Expr
+	0,   // 123: goast.MapTypeNode.value:type_name -> goast.Expr
+	4,   // 124: goast.ChanTypeNode.begin:type_name -> goast.PositionNode
+	4,   // 125: goast.ChanTypeNode.arrow:type_name -> goast.PositionNode
+	0,   // 126: goast.ChanTypeNode.value:type_name -> goast.Expr
+	4,   // 127: goast.BadStmtNode.from:type_name -> goast.PositionNode
+	4,   // 128: goast.BadStmtNode.to:type_name -> goast.PositionNode
+	3,   // 129: goast.DeclStmtNode.decl:type_name -> goast.Decl
+	4,   // 130: goast.EmptyStmtNode.semicolon:type_name -> goast.PositionNode
+	10,  // 131: goast.LabeledStmtNode.label:type_name -> goast.IdentNode
+	4,   // 132: goast.LabeledStmtNode.colon:type_name -> goast.PositionNode
+	1,   // 133: goast.LabeledStmtNode.stmt:type_name -> goast.Stmt
+	0,   // 134: goast.ExprStmtNode.x:type_name -> goast.Expr
+	0,   // 135: goast.SendStmtNode.chan:type_name -> goast.Expr
+	4,   // 136: goast.SendStmtNode.arrow:type_name -> goast.PositionNode
+	0,   // 137: goast.SendStmtNode.value:type_name -> goast.Expr
+	0,   // 138: goast.IncDecStmtNode.x:type_name -> goast.Expr
+	4,   // 139: goast.IncDecStmtNode.tok_pos:type_name -> goast.PositionNode
+	0,   // 140: goast.AssignStmtNode.lhs:type_name -> goast.Expr
+	4,   // 141: goast.AssignStmtNode.tok_pos:type_name -> goast.PositionNode
+	0,   // 142: goast.AssignStmtNode.rhs:type_name -> goast.Expr
+	4,   // 143: goast.GoStmtNode.go:type_name -> goast.PositionNode
+	21,  // 144: goast.GoStmtNode.call:type_name -> goast.CallExprNode
+	4,   // 145: goast.DeferStmtNode.defer:type_name -> goast.PositionNode
+	21,  // 146: goast.DeferStmtNode.call:type_name -> goast.CallExprNode
+	4,   // 147: goast.ReturnStmtNode.return:type_name -> goast.PositionNode
+	0,   // 148: goast.ReturnStmtNode.results:type_name -> goast.Expr
+	4,   // 149: goast.BranchStmtNode.tok_pos:type_name -> goast.PositionNode
+	10,  // 150: goast.BranchStmtNode.label:type_name -> goast.IdentNode
+	4,   // 151: goast.BlockStmtNode.lbrace:type_name -> goast.PositionNode
+	1,   // 152: goast.BlockStmtNode.list:type_name -> goast.Stmt
+	4,   // 153: goast.BlockStmtNode.rbrace:type_name -> goast.PositionNode
+	4,   // 154: goast.IfStmtNode.if:type_name -> goast.PositionNode
+	1,   // 155: goast.IfStmtNode.init:type_name -> goast.Stmt
+	0,   // 156: goast.IfStmtNode.cond:type_name -> goast.Expr
+	44,  // 157: goast.IfStmtNode.body:type_name -> goast.BlockStmtNode
+	1,   // 158: goast.IfStmtNode.else:type_name -> goast.Stmt
+	4,   // 159: goast.CaseClauseNode.case:type_name -> goast.PositionNode
+	0,   // 160: goast.CaseClauseNode.list:type_name -> goast.Expr
+	4,   // 161: goast.CaseClauseNode.colon:type_name -> goast.PositionNode
+	1,   // 162: goast.CaseClauseNode.body:type_name -> goast.Stmt
+	4,   // 163: goast.SwitchStmtNode.switch:type_name -> goast.PositionNode
+	1,   // 164: goast.SwitchStmtNode.init:type_name -> goast.Stmt
+	0,   // 165: goast.SwitchStmtNode.tag:type_name -> goast.Expr
+	44,  // 166: goast.SwitchStmtNode.body:type_name -> goast.BlockStmtNode
+	4,   // 167: goast.TypeSwitchStmtNode.switch:type_name -> goast.PositionNode
+	1,   // 168: goast.TypeSwitchStmtNode.init:type_name -> goast.Stmt
+	1,   // 169: goast.TypeSwitchStmtNode.assign:type_name -> goast.Stmt
+	44,  // 170: goast.TypeSwitchStmtNode.body:type_name -> goast.BlockStmtNode
+	4,   // 171: goast.CommClauseNode.case:type_name -> goast.PositionNode
+	1,   // 172: goast.CommClauseNode.comm:type_name -> goast.Stmt
+	4,   // 173: goast.CommClauseNode.colon:type_name -> goast.PositionNode
+	1,   // 174: goast.CommClauseNode.body:type_name -> goast.Stmt
+	4,   // 175: goast.SelectStmtNode.select:type_name -> goast.PositionNode
+	44,  // 176: goast.SelectStmtNode.body:type_name -> goast.BlockStmtNode
+	4,   // 177: goast.ForStmtNode.for:type_name -> goast.PositionNode
+	1,   // 178: goast.ForStmtNode.init:type_name -> goast.Stmt
+	0,   // 179: goast.ForStmtNode.cond:type_name -> goast.Expr
+	1,   // 180: goast.ForStmtNode.post:type_name -> goast.Stmt
+	44,  // 181: goast.ForStmtNode.body:type_name -> goast.BlockStmtNode
+	4,   // 182: goast.RangeStmtNode.for:type_name -> goast.PositionNode
+	0,   // 183: goast.RangeStmtNode.key:type_name -> goast.Expr
+	0,   // 184: goast.RangeStmtNode.value:type_name -> goast.Expr
+	4,   // 185: goast.RangeStmtNode.tok_pos:type_name -> goast.PositionNode
+	0,   // 186: goast.RangeStmtNode.x:type_name -> goast.Expr
+	44,  // 187: goast.RangeStmtNode.body:type_name -> goast.BlockStmtNode
+	6,   // 188: goast.ImportSpecNode.doc:type_name -> goast.CommentGroupNode
+	10,  // 189: goast.ImportSpecNode.name:type_name -> goast.IdentNode
+	12,  // 190: goast.ImportSpecNode.path:type_name -> goast.BasicLitNode
+	6,   // 191: goast.ImportSpecNode.comment:type_name -> goast.CommentGroupNode
+	4,   // 192: goast.ImportSpecNode.end_pos:type_name -> goast.PositionNode
+	6,   // 193: goast.ValueSpecNode.doc:type_name -> goast.CommentGroupNode
+	10,  // 194: goast.ValueSpecNode.names:type_name -> goast.IdentNode
+	0,   // 195: goast.ValueSpecNode.type:type_name -> goast.Expr
+	0,   // 196: goast.ValueSpecNode.values:type_name -> goast.Expr
+	6,   // 197: goast.ValueSpecNode.comment:type_name -> goast.CommentGroupNode
+	6,   // 198: goast.TypeSpecNode.doc:type_name -> goast.CommentGroupNode
+	10,  // 199: goast.TypeSpecNode.name:type_name -> goast.IdentNode
+	8,   // 200: goast.TypeSpecNode.type_params:type_name -> goast.FieldListNode
+	4,   // 201: goast.TypeSpecNode.assign:type_name -> goast.PositionNode
+	0,   // 202: goast.TypeSpecNode.type:type_name -> goast.Expr
+	6,   // 203: goast.TypeSpecNode.comment:type_name -> goast.CommentGroupNode
+	4,   // 204: goast.BadDeclNode.from:type_name -> goast.PositionNode
+	4,   // 205: goast.BadDeclNode.to:type_name -> goast.PositionNode
+	6,   // 206: goast.GenDeclNode.doc:type_name -> goast.CommentGroupNode
+	4,   // 207: goast.GenDeclNode.tok_pos:type_name -> goast.PositionNode
+	4,   // 208: goast.GenDeclNode.lparen:type_name -> goast.PositionNode
+	2,   // 209: goast.GenDeclNode.specs:type_name -> goast.Spec
+	4,   // 210: goast.GenDeclNode.rparen:type_name -> goast.PositionNode
+	6,   // 211: goast.FuncDeclNode.doc:type_name -> goast.CommentGroupNode
+	8,   // 212: goast.FuncDeclNode.recv:type_name -> goast.FieldListNode
+	10,  // 213: goast.FuncDeclNode.name:type_name -> goast.IdentNode
+	28,  // 214: goast.FuncDeclNode.type:type_name -> goast.FuncTypeNode
+	44,  // 215: goast.FuncDeclNode.body:type_name -> goast.BlockStmtNode
+	59,  // 216: goast.FileNode.meta:type_name -> goast.FileMetaNode
+	6,   // 217: goast.FileNode.doc:type_name -> goast.CommentGroupNode
+	4,   // 218: goast.FileNode.package:type_name -> goast.PositionNode
+	10,  // 219: goast.FileNode.name:type_name -> goast.IdentNode
+	3,   // 220: goast.FileNode.decls:type_name -> goast.Decl
+	53,  // 221: goast.FileNode.imports:type_name -> goast.ImportSpecNode
+	10,  // 222: goast.FileNode.unresolved:type_name -> goast.IdentNode
+	6,   // 223: goast.FileNode.comments:type_name -> goast.CommentGroupNode
+	61,  // 224: goast.FileNode.file_set:type_name -> goast.FileSet
+	62,  // 225: goast.FileSet.files:type_name -> goast.FileSetFile
+	63,  // 226: goast.FileSetFile.infos:type_name -> goast.FileSetLineInfo
+	227, // [227:227] is the sub-list for method output_type
+	227, // [227:227] is the sub-list for method input_type
+	227, // [227:227] is the sub-list for extension type_name
+	227, // [227:227] is the sub-list for extension extendee
+	0,   // [0:227] is the sub-list for field type_name
+}
+
+func init() { file_ast_proto_init() }
+func file_ast_proto_init() {
+	if File_ast_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_ast_proto_msgTypes[0].Exporter = func(v any, i int) any {
+			switch v := v.(*Expr); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ast_proto_msgTypes[1].Exporter = func(v any, i int) any {
+			switch v := v.(*Stmt); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ast_proto_msgTypes[2].Exporter = func(v any, i int) any {
+			switch v := v.(*Spec); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ast_proto_msgTypes[3].Exporter = func(v any, i int) any {
+			switch v := v.(*Decl); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ast_proto_msgTypes[4].Exporter = func(v any, i int) any {
+			switch v := v.(*PositionNode); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ast_proto_msgTypes[5].Exporter = func(v any, i int) any {
+			switch v := v.(*CommentNode); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ast_proto_msgTypes[6].Exporter = func(v any, i int) any {
+			switch v := v.(*CommentGroupNode); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ast_proto_msgTypes[7].Exporter = func(v any, i int) any {
+			switch v := v.(*FieldNode); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ast_proto_msgTypes[8].Exporter = func(v any, i int) any {
+			switch v := v.(*FieldListNode); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ast_proto_msgTypes[9].Exporter = func(v any, i int) any {
+			switch v := v.(*BadExprNode); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ast_proto_msgTypes[10].Exporter = func(v any, i int) any {
+			switch v := v.(*IdentNode); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ast_proto_msgTypes[11].Exporter = func(v any, i int) any {
+			switch v := v.(*EllipsisNode); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ast_proto_msgTypes[12].Exporter = func(v any, i int) any {
+			switch v := v.(*BasicLitNode); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ast_proto_msgTypes[13].Exporter = func(v any, i int) any {
+			switch v := v.(*FuncLitNode); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ast_proto_msgTypes[14].Exporter = func(v any, i int) any {
+			switch v := v.(*CompositeLitNode); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ast_proto_msgTypes[15].Exporter = func(v any, i int) any {
+			switch v := v.(*ParenExprNode); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ast_proto_msgTypes[16].Exporter = func(v any, i int) any {
+			switch v := v.(*SelectorExprNode); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ast_proto_msgTypes[17].Exporter = func(v any, i int) any {
+			switch v := v.(*IndexExprNode); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ast_proto_msgTypes[18].Exporter = func(v any, i int) any {
+			switch v := v.(*IndexListExprNode); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ast_proto_msgTypes[19].Exporter = func(v any, i int) any {
+			switch v := v.(*SliceExprNode); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ast_proto_msgTypes[20].Exporter = func(v any, i int) any {
+			switch v := v.(*TypeAssertExprNode); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ast_proto_msgTypes[21].Exporter = func(v any, i int) any {
+			switch v := v.(*CallExprNode); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ast_proto_msgTypes[22].Exporter = func(v any, i int) any {
+			switch v := v.(*StarExprNode); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ast_proto_msgTypes[23].Exporter = func(v any, i int) any {
+			switch v := v.(*UnaryExprNode); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ast_proto_msgTypes[24].Exporter = func(v any, i int) any {
+			switch v := v.(*BinaryExprNode); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ast_proto_msgTypes[25].Exporter = func(v any, i int) any {
+			switch v := v.(*KeyValueExprNode); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ast_proto_msgTypes[26].Exporter = func(v any, i int) any {
+			switch v := v.(*ArrayTypeNode); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ast_proto_msgTypes[27].Exporter = func(v any, i int) any {
+			switch v := v.(*StructTypeNode); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ast_proto_msgTypes[28].Exporter = func(v any, i int) any {
+			switch v := v.(*FuncTypeNode); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ast_proto_msgTypes[29].Exporter = func(v any, i int) any {
+			switch v := v.(*InterfaceTypeNode); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ast_proto_msgTypes[30].Exporter = func(v any, i int) any {
+			switch v := v.(*MapTypeNode); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ast_proto_msgTypes[31].Exporter = func(v any, i int) any {
+			switch v := v.(*ChanTypeNode); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ast_proto_msgTypes[32].Exporter = func(v any, i int) any {
+			switch v := v.(*BadStmtNode); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ast_proto_msgTypes[33].Exporter = func(v any, i int) any {
+			switch v := v.(*DeclStmtNode); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ast_proto_msgTypes[34].Exporter = func(v any, i int) any {
+			switch v := v.(*EmptyStmtNode); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ast_proto_msgTypes[35].Exporter = func(v any, i int) any {
+			switch v := v.(*LabeledStmtNode); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ast_proto_msgTypes[36].Exporter = func(v any, i int) any {
+			switch v := v.(*ExprStmtNode); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ast_proto_msgTypes[37].Exporter = func(v any, i int) any {
+			switch v := v.(*SendStmtNode); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ast_proto_msgTypes[38].Exporter = func(v any, i int) any {
+			switch v := v.(*IncDecStmtNode); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ast_proto_msgTypes[39].Exporter = func(v any, i int) any {
+			switch v := v.(*AssignStmtNode); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ast_proto_msgTypes[40].Exporter = func(v any, i int) any {
+			switch v := v.(*GoStmtNode); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ast_proto_msgTypes[41].Exporter = func(v any, i int) any {
+			switch v := v.(*DeferStmtNode); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ast_proto_msgTypes[42].Exporter = func(v any, i int) any {
+			switch v := v.(*ReturnStmtNode); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ast_proto_msgTypes[43].Exporter = func(v any, i int) any {
+			switch v := v.(*BranchStmtNode); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ast_proto_msgTypes[44].Exporter = func(v any, i int) any {
+			switch v := v.(*BlockStmtNode); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ast_proto_msgTypes[45].Exporter = func(v any, i int) any {
+			switch v := v.(*IfStmtNode); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ast_proto_msgTypes[46].Exporter = func(v any, i int) any {
+			switch v := v.(*CaseClauseNode); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ast_proto_msgTypes[47].Exporter = func(v any, i int) any {
+			switch v := v.(*SwitchStmtNode); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ast_proto_msgTypes[48].Exporter = func(v any, i int) any {
+			switch v := v.(*TypeSwitchStmtNode); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ast_proto_msgTypes[49].Exporter = func(v any, i int) any {
+			switch v := v.(*CommClauseNode); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ast_proto_msgTypes[50].Exporter = func(v any, i int) any {
+			switch v := v.(*SelectStmtNode); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ast_proto_msgTypes[51].Exporter = func(v any, i int) any {
+			switch v := v.(*ForStmtNode); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ast_proto_msgTypes[52].Exporter = func(v any, i int) any {
+			switch v := v.(*RangeStmtNode); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ast_proto_msgTypes[53].Exporter = func(v any, i int) any {
+			switch v := v.(*ImportSpecNode); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ast_proto_msgTypes[54].Exporter = func(v any, i int) any {
+			switch v := v.(*ValueSpecNode); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ast_proto_msgTypes[55].Exporter = func(v any, i int) any {
+			switch v := v.(*TypeSpecNode); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ast_proto_msgTypes[56].Exporter = func(v any, i int) any {
+			switch v := v.(*BadDeclNode); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ast_proto_msgTypes[57].Exporter = func(v any, i int) any {
+			switch v := v.(*GenDeclNode); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ast_proto_msgTypes[58].Exporter = func(v any, i int) any {
+			switch v := v.(*FuncDeclNode); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ast_proto_msgTypes[59].Exporter = func(v any, i int) any {
+			switch v := v.(*FileMetaNode); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ast_proto_msgTypes[60].Exporter = func(v any, i int) any {
+			switch v := v.(*FileNode); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ast_proto_msgTypes[61].Exporter = func(v any, i int) any {
+			switch v := v.(*FileSet); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ast_proto_msgTypes[62].Exporter = func(v any, i int) any {
+			switch v := v.(*FileSetFile); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ast_proto_msgTypes[63].Exporter = func(v any, i int) any {
+			switch v := v.(*FileSetLineInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_ast_proto_msgTypes[0].OneofWrappers = []any{
+		(*Expr_BadExpr)(nil),
+		(*Expr_Ident)(nil),
+		(*Expr_Ellipsis)(nil),
+		(*Expr_BasicLit)(nil),
+		(*Expr_FuncLit)(nil),
+		(*Expr_CompositeLit)(nil),
+		(*Expr_ParenExpr)(nil),
+		(*Expr_SelectorExpr)(nil),
+		(*Expr_IndexExpr)(nil),
+		(*Expr_IndexListExpr)(nil),
+		(*Expr_SliceExpr)(nil),
+		(*Expr_TypeAssertExpr)(nil),
+		(*Expr_CallExpr)(nil),
+		(*Expr_StarExpr)(nil),
+		(*Expr_UnaryExpr)(nil),
+		(*Expr_BinaryExpr)(nil),
+		(*Expr_KeyValueExpr)(nil),
+		(*Expr_ArrayType)(nil),
+		(*Expr_StructType)(nil),
+		(*Expr_FuncType)(nil),
+		(*Expr_InterfaceType)(nil),
+		(*Expr_MapType)(nil),
+		(*Expr_ChanType)(nil),
+	}
+	file_ast_proto_msgTypes[1].OneofWrappers = []any{
+		(*Stmt_BadStmt)(nil),
+		(*Stmt_DeclStmt)(nil),
+		(*Stmt_EmptyStmt)(nil),
+		(*Stmt_LabeledStmt)(nil),
+		(*Stmt_ExprStmt)(nil),
+		(*Stmt_SendStmt)(nil),
+		(*Stmt_IncDecStmt)(nil),
+		(*Stmt_AssignStmt)(nil),
+		(*Stmt_GoStmt)(nil),
+		(*Stmt_DeferStmt)(nil),
+		(*Stmt_ReturnStmt)(nil),
+		(*Stmt_BranchStmt)(nil),
+		(*Stmt_BlockStmt)(nil),
+		(*Stmt_IfStmt)(nil),
+		(*Stmt_CaseClause)(nil),
+		(*Stmt_SwitchStmt)(nil),
+		(*Stmt_TypeSwitchStmt)(nil),
+		(*Stmt_CommClause)(nil),
+		(*Stmt_SelectStmt)(nil),
+		(*Stmt_ForStmt)(nil),
+		(*Stmt_RangeStmt)(nil),
+	}
+	file_ast_proto_msgTypes[2].OneofWrappers = []any{
+		(*Spec_ImportSpec)(nil),
+		(*Spec_ValueSpec)(nil),
+		(*Spec_TypeSpec)(nil),
+	}
+	file_ast_proto_msgTypes[3].OneofWrappers = []any{
+		(*Decl_BadDecl)(nil),
+		(*Decl_GenDecl)(nil),
+		(*Decl_FuncDecl)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_ast_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   64,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_ast_proto_goTypes,
+		DependencyIndexes: file_ast_proto_depIdxs,
+		MessageInfos:      file_ast_proto_msgTypes,
+	}.Build()
+	File_ast_proto = out.File
+	file_ast_proto_rawDesc = nil
+	file_ast_proto_goTypes = nil
+	file_ast_proto_depIdxs = nil
+}
@@ -0,0 +1,414 @@
+package main
+
+import (
+	astjson "GoOperatorAST/ast_json"
+	"GoOperatorAST/processors"
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"github.com/BurntSushi/toml"
+	"go.uber.org/zap"
+	"gopkg.in/yaml.v3"
+	"net/http"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"sync"
+)
+
+// batchConfig lists the repositories processed by the batch command
+type batchConfig struct {
+	// Output is the directory receiving one subtree per repository
+	Output     string `yaml:"output" toml:"output"`
+	CacheDir   string `yaml:"cacheDir" toml:"cacheDir"`
+	CacheMaxMB int64  `yaml:"cacheMaxMB" toml:"cacheMaxMB"`
+	// Parallel is the number of pairs processed at once, they share the file processor pool
+	Parallel int          `yaml:"parallel" toml:"parallel"`
+	Repos    []repoConfig `yaml:"repos" toml:"repos"`
+}
+
+// repoConfig describes one repository of a batch and the ref pairs to process
+type repoConfig struct {
+	// Name of the output subtree, defaults to owner/repo, the module path or the git directory name
+	Name    string `yaml:"name" toml:"name"`
+	Forge   string `yaml:"forge" toml:"forge"`
+	BaseURL string `yaml:"baseURL" toml:"baseURL"`
+	Owner   string `yaml:"owner" toml:"owner"`
+	Repo    string `yaml:"repo" toml:"repo"`
+	// TokenEnv names the environment variable holding the access token,
+	// defaults to GITHUB_TOKEN, GITLAB_TOKEN or GITEA_TOKEN depending on the forge,
+	// required for GitHub Enterprise
+	TokenEnv string `yaml:"tokenEnv" toml:"tokenEnv"`
+	Tarball  bool   `yaml:"tarball" toml:"tarball"`
+	GitDir   string `yaml:"gitDir" toml:"gitDir"`
+	Module   string `yaml:"module" toml:"module"`
+	GOPROXY  string `yaml:"goproxy" toml:"goproxy"`
+
+	Pairs []pairConfig `yaml:"pairs" toml:"pairs"`
+
+	Root        string   `yaml:"root" toml:"root"`
+	Include     []string `yaml:"include" toml:"include"`
+	Exclude     []string `yaml:"exclude" toml:"exclude"`
+	Tests       bool     `yaml:"tests" toml:"tests"`
+	Vendor      bool     `yaml:"vendor" toml:"vendor"`
+	Testdata    bool     `yaml:"testdata" toml:"testdata"`
+	Generated   bool     `yaml:"generated" toml:"generated"`
+	ChangedOnly bool     `yaml:"changedOnly" toml:"changedOnly"`
+	Targets     string   `yaml:"targets" toml:"targets"`
+}
+
+// pairConfig is a pair of refs to compare
+type pairConfig struct {
+	Old string `yaml:"old" toml:"old"`
+	New string `yaml:"new" toml:"new"`
+}
+
+// pairStatus is the outcome of one pair in the batch summary
+type pairStatus struct {
+	Repository string `json:"Repository"`
+	Old        string `json:"Old"`
+	New        string `json:"New"`
+	// Output is the subtree of the pair below the batch output directory, name/old...new
+	Output string `json:"Output"`
+	// OldCommit and NewCommit are the commits the refs resolved to
+	OldCommit string `json:"OldCommit,omitempty"`
+	NewCommit string `json:"NewCommit,omitempty"`
+	// Status is complete, incomplete when files were skipped, or failed
+	Status  string   `json:"Status"`
+	Errors  []string `json:"Errors,omitempty"`
+	Skipped []string `json:"Skipped,omitempty"`
+}
+
+// Load a batch config from a .yaml, .yml or .toml file
+// @param file: path of the config file
+func loadBatchConfig(file string) (*batchConfig, error) {
+	data, err := os.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+
+	config := &batchConfig{}
+	switch strings.ToLower(filepath.Ext(file)) {
+	case ".yaml", ".yml":
+		err = yaml.Unmarshal(data, config)
+	case ".toml":
+		err = toml.Unmarshal(data, config)
+	default:
+		err = fmt.Errorf("unknown config format %q, use .yaml or .toml", filepath.Ext(file))
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if config.Output == "" {
+		config.Output = "./output_temp"
+	}
+	if config.CacheMaxMB == 0 {
+		config.CacheMaxMB = 1024
+	}
+	if config.Parallel <= 0 {
+		config.Parallel = 4
+	}
+	names := map[string]bool{}
+	for i, repo := range config.Repos {
+		name := repo.label()
+		if name == "" {
+			return nil, fmt.Errorf("repository %d: no repo, gitDir or module", i+1)
+		}
+		if names[name] {
+			return nil, fmt.Errorf("repository %s is listed twice, give one of them a name", name)
+		}
+		names[name] = true
+		if len(repo.Pairs) == 0 {
+			return nil, fmt.Errorf("repository %s: no pairs", name)
+		}
+		for _, pair := range repo.Pairs {
+			if pair.Old == "" || pair.New == "" || pair.Old == pair.New {
+				return nil, fmt.Errorf("repository %s: pairs need two different refs, got %q and %q", name, pair.Old, pair.New)
+			}
+		}
+		if repo.GitDir == "" && repo.Module == "" && repo.TokenEnv == "" && isGitHubEnterprise(repo.Forge, repo.BaseURL) {
+			return nil, fmt.Errorf("repository %s: a GitHub Enterprise baseURL needs a tokenEnv", name)
+		}
+		if _, err := repo.filter(); err != nil {
+			return nil, fmt.Errorf("repository %s: %w", name, err)
+		}
+		if _, err := processors.ParseTargets(repo.Targets); err != nil {
+			return nil, fmt.Errorf("repository %s: %w", name, err)
+		}
+	}
+	return config, nil
+}
+
+// Name of the output subtree of a repository
+func (r repoConfig) label() string {
+	switch {
+	case r.Name != "":
+		return r.Name
+	case r.Repo != "":
+		return path.Join(r.Owner, r.Repo)
+	case r.Module != "":
+		return r.Module
+	case r.GitDir != "":
+		return filepath.Base(filepath.Clean(r.GitDir))
+	}
+	return ""
+}
+
+// File filter of a repository
+func (r repoConfig) filter() (*processors.FileFilter, error) {
+	filter := &processors.FileFilter{
+		Include:   r.Include,
+		Exclude:   r.Exclude,
+		Tests:     r.Tests,
+		Vendor:    r.Vendor,
+		Testdata:  r.Testdata,
+		Generated: r.Generated,
+	}
+	return filter, filter.Validate()
+}
+
+// Source reading a repository
+// @param ctx: context of the run
+// @param httpClient: client shared by every HTTP based source
+func (r repoConfig) source(ctx context.Context, httpClient *http.Client) (processors.Source, error) {
+	switch {
+	case r.GitDir != "":
+		return processors.NewGitSource(r.GitDir), nil
+	case r.Module != "":
+		proxies := r.GOPROXY
+		if proxies == "" {
+			proxies = os.Getenv("GOPROXY")
+		}
+		if proxies == "" {
+			proxies = "https://proxy.golang.org"
+		}
+		return processors.NewModuleProxySource(r.Module, proxies, httpClient)
+	}
+
+	return processors.NewForgeSource(ctx, r.forgeOptions(httpClient))
+}
+
+// Source resolving the refs of a repository whose archives cannot, nil when the source itself can
+// @param ctx: context of the run
+// @param httpClient: client shared by every HTTP based source
+func (r repoConfig) refSource(ctx context.Context, httpClient *http.Client) (processors.Source, error) {
+	if r.GitDir != "" || r.Module != "" || !r.Tarball {
+		return nil, nil
+	}
+	opts := r.forgeOptions(httpClient)
+	opts.Tarball = false
+	return processors.NewForgeSource(ctx, opts)
+}
+
+// Forge options of a repository
+// @param httpClient: client shared by every HTTP based source
+func (r repoConfig) forgeOptions(httpClient *http.Client) processors.ForgeOptions {
+	token := forgeToken(r.Forge, r.BaseURL)
+	if r.TokenEnv != "" {
+		token = os.Getenv(r.TokenEnv)
+	}
+	return processors.ForgeOptions{
+		Forge:      r.Forge,
+		BaseURL:    r.BaseURL,
+		Token:      token,
+		Owner:      r.Owner,
+		Repo:       r.Repo,
+		Tarball:    r.Tarball,
+		HTTPClient: httpClient,
+	}
+}
+
+// Run the batch command, processing every pair of every repository of a config file
+// @param args: command line arguments following batch
+// @return true when every pair is complete
+func runBatch(args []string) bool {
+	flags := flag.NewFlagSet("batch", flag.ExitOnError)
+	configFile := flags.String("config", "", "Required: YAML or TOML file listing the repositories to process")
+	resume := flags.Bool("resume", false, "Optional: Skip the files the manifest of each tag records as done and retry the failed ones")
+	var compression astjson.Compression
+	flags.Var(&compression, "compress", "Optional: Compress the JSON files with gzip or zstd, their names then end in .gz or .zst")
+	poolSizes := poolFlags(flags)
+	progressOutputs := progressFlags(flags)
+	flags.Parse(args)
+
+	if *configFile == "" {
+		logger.Info("Please provide the config argument.")
+		return false
+	}
+	config, err := loadBatchConfig(*configFile)
+	if err != nil {
+		logger.Error("Invalid batch config", zap.Error(err))
+		return false
+	}
+
+	ctx, stop := signalContext()
+	defer stop()
+	httpClient := &http.Client{Transport: processors.NewRetryTransport(nil, logger)}
+	createDir(config.Output)
+	var cache *processors.BlobCache
+	if config.CacheDir != "" {
+		cache, err = processors.NewBlobCache(config.CacheDir, config.CacheMaxMB<<20)
+		if err != nil {
+			logger.Error("Failed to open blob cache", zap.Error(err))
+			return false
+		}
+	}
+
+	progress, metrics, closeProgress, err := progressOutputs.open()
+	if err != nil {
+		logger.Error("Failed to open the progress outputs", zap.Error(err))
+		return false
+	}
+	defer closeProgress()
+
+	// Every repository shares the pipeline, their filters are passed with each ref
+	proc, err := processors.NewProcessor(processors.Options{
+		OutputDir:   config.Output,
+		Logger:      logger,
+		Pools:       *poolSizes,
+		Cache:       cache,
+		Progress:    progress,
+		Resume:      *resume,
+		Compression: compression,
+	})
+	if err != nil {
+		logger.Error("Failed to create processor", zap.Error(err))
+		return false
+	}
+	defer proc.Close()
+	if metrics != nil {
+		metrics.WatchStages(proc.Stats)
+	}
+
+	worker_ch := startWorkerReporter(proc)
+
+	var mu sync.Mutex
+	var statuses []pairStatus
+	var wg sync.WaitGroup
+	slots := make(chan struct{}, config.Parallel)
+	for _, repo := range config.Repos {
+		name := repo.label()
+		src, err := repo.source(ctx, httpClient)
+		var refSrc processors.Source
+		if err == nil {
+			refSrc, err = repo.refSource(ctx, httpClient)
+		}
+		if err != nil {
+			for _, pair := range repo.Pairs {
+				statuses = append(statuses, pairStatus{Repository: name, Old: pair.Old, New: pair.New, Status: "failed", Errors: []string{err.Error()}})
+			}
+			continue
+		}
+		filter, _ := repo.filter()
+		targets, _ := processors.ParseTargets(repo.Targets)
+
+		for _, pair := range repo.Pairs {
+			pair := pair
+			job := pairJob{
+				proc:        proc,
+				name:        name,
+				outputDir:   filepath.Join(config.Output, filepath.FromSlash(pairOutput(name, pair))),
+				oldSource:   src,
+				newSource:   src,
+				refSource:   refSrc,
+				oldTag:      pair.Old,
+				newTag:      pair.New,
+				root:        repo.Root,
+				filter:      filter,
+				changedOnly: repo.ChangedOnly,
+				targets:     targets,
+			}
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				slots <- struct{}{}
+				defer func() { <-slots }()
+
+				logger.Info("Processing pair", zap.String("repository", job.name), zap.String("old", job.oldTag), zap.String("new", job.newTag))
+				status := pairStatusOf(job, runPair(ctx, job))
+				status.Output = pairOutput(job.name, pair)
+				mu.Lock()
+				statuses = append(statuses, status)
+				mu.Unlock()
+			}()
+		}
+	}
+	wg.Wait()
+	worker_ch <- true
+
+	finishRun(proc, cache)
+
+	complete := true
+	for _, status := range statuses {
+		if status.Status != "complete" {
+			complete = false
+			logger.Error("Pair not complete", zap.String("repository", status.Repository), zap.String("old", status.Old), zap.String("new", status.New), zap.String("status", status.Status), zap.Strings("errors", status.Errors))
+		}
+	}
+	err = writeBatchSummary(config.Output, statuses)
+	if err != nil {
+		logger.Error("Unable to write batch summary", zap.Error(err))
+		return false
+	}
+	logger.Info("Batch finished", zap.Int("pairs", len(statuses)), zap.Bool("complete", complete))
+	return complete
+}
+
+// Subtree of a pair below the batch output directory, pairs of a repository
+// get their own directory so that they can share tags and run at the same time
+// @param name: name of the repository
+// @param pair: refs of the pair
+func pairOutput(name string, pair pairConfig) string {
+	return path.Join(name, strings.ReplaceAll(pair.Old+"..."+pair.New, "/", "_"))
+}
+
+// Summarise the result of a pair
+// @param job: pair that was processed
+// @param result: outcome of runPair
+func pairStatusOf(job pairJob, result pairResult) pairStatus {
+	status := pairStatus{
+		Repository: job.name,
+		Old:        job.oldTag,
+		New:        job.newTag,
+		OldCommit:  result.oldRef.Commit,
+		NewCommit:  result.newRef.Commit,
+		Status:     "complete",
+	}
+	for _, tagErr := range []error{result.oldErr, result.newErr} {
+		if tagErr != nil {
+			status.Status = "failed"
+			status.Errors = append(status.Errors, tagErr.Error())
+		}
+	}
+	for _, tag := range []struct {
+		name    string
+		skipped []processors.SkippedFile
+	}{{job.oldTag, result.oldSkipped}, {job.newTag, result.newSkipped}} {
+		for _, file := range tag.skipped {
+			status.Skipped = append(status.Skipped, tag.name+": "+file.Path)
+		}
+	}
+	if status.Status == "complete" && len(status.Skipped) > 0 {
+		status.Status = "incomplete"
+	}
+	return status
+}
+
+// Write the summary of a batch to summary.json in its output directory
+// @param dir: output directory of the batch
+// @param statuses: outcome of every pair
+func writeBatchSummary(dir string, statuses []pairStatus) error {
+	data, err := json.MarshalIndent(map[string][]pairStatus{"Pairs": statuses}, "", "  ")
+	if err != nil {
+		return err
+	}
+	err = os.WriteFile(filepath.Join(dir, "summary.json"), data, 0644)
+	if err != nil {
+		return errors.Join(fmt.Errorf("writing %s", filepath.Join(dir, "summary.json")), err)
+	}
+	return nil
+}
@@ -0,0 +1,38 @@
+// Code generated by the FlatBuffers compiler. DO NOT EDIT.
+
+package fileinfo
+
+import "strconv"
+
+type DeclKind int8
+
+const (
+	DeclKindFunc   DeclKind = 0
+	DeclKindMethod DeclKind = 1
+	DeclKindType   DeclKind = 2
+	DeclKindVar    DeclKind = 3
+	DeclKindConst  DeclKind = 4
+)
+
+var EnumNamesDeclKind = map[DeclKind]string{
+	DeclKindFunc:   "Func",
+	DeclKindMethod: "Method",
+	DeclKindType:   "Type",
+	DeclKindVar:    "Var",
+	DeclKindConst:  "Const",
+}
+
+var EnumValuesDeclKind = map[string]DeclKind{
+	"Func":   DeclKindFunc,
+	"Method": DeclKindMethod,
+	"Type":   DeclKindType,
+	"Var":    DeclKindVar,
+	"Const":  DeclKindConst,
+}
+
+func (v DeclKind) String() string {
+	if s, ok := EnumNamesDeclKind[v]; ok {
+		return s
+	}
+	return "DeclKind(" + strconv.FormatInt(int64(v), 10) + ")"
+}
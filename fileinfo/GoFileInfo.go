@@ -3,6 +3,7 @@
 package fileinfo
 
 import (
+	"bytes"
 	flatbuffers "github.com/google/flatbuffers/go"
 )
 
@@ -49,6 +50,38 @@ func (rcv *GoFileInfo) Filename() []byte {
 	return nil
 }
 
+func GoFileInfoKeyCompare(o1, o2 flatbuffers.UOffsetT, buf []byte) bool {
+	obj1 := &GoFileInfo{}
+	obj2 := &GoFileInfo{}
+	obj1.Init(buf, flatbuffers.UOffsetT(len(buf))-o1)
+	obj2.Init(buf, flatbuffers.UOffsetT(len(buf))-o2)
+	return string(obj1.Filename()) < string(obj2.Filename())
+}
+
+func (rcv *GoFileInfo) LookupByKey(key string, vectorLocation flatbuffers.UOffsetT, buf []byte) bool {
+	span := flatbuffers.GetUOffsetT(buf[vectorLocation-4:])
+	start := flatbuffers.UOffsetT(0)
+	bKey := []byte(key)
+	for span != 0 {
+		middle := span / 2
+		tableOffset := flatbuffers.GetIndirectOffset(buf, vectorLocation+4*(start+middle))
+		obj := &GoFileInfo{}
+		obj.Init(buf, tableOffset)
+		comp := bytes.Compare(obj.Filename(), bKey)
+		if comp > 0 {
+			span = middle
+		} else if comp < 0 {
+			middle += 1
+			start += middle
+			span -= middle
+		} else {
+			rcv.Init(buf, tableOffset)
+			return true
+		}
+	}
+	return false
+}
+
 func (rcv *GoFileInfo) NumImports() int32 {
 	o := flatbuffers.UOffsetT(rcv._tab.Offset(6))
 	if o != 0 {
@@ -61,8 +94,141 @@ func (rcv *GoFileInfo) MutateNumImports(n int32) bool {
 	return rcv._tab.MutateInt32Slot(6, n)
 }
 
+func (rcv *GoFileInfo) Package() []byte {
+	o := flatbuffers.UOffsetT(rcv._tab.Offset(8))
+	if o != 0 {
+		return rcv._tab.ByteVector(o + rcv._tab.Pos)
+	}
+	return nil
+}
+
+func (rcv *GoFileInfo) Imports(j int) []byte {
+	o := flatbuffers.UOffsetT(rcv._tab.Offset(10))
+	if o != 0 {
+		a := rcv._tab.Vector(o)
+		return rcv._tab.ByteVector(a + flatbuffers.UOffsetT(j*4))
+	}
+	return nil
+}
+
+func (rcv *GoFileInfo) ImportsLength() int {
+	o := flatbuffers.UOffsetT(rcv._tab.Offset(10))
+	if o != 0 {
+		return rcv._tab.VectorLen(o)
+	}
+	return 0
+}
+
+func (rcv *GoFileInfo) Constraint() []byte {
+	o := flatbuffers.UOffsetT(rcv._tab.Offset(12))
+	if o != 0 {
+		return rcv._tab.ByteVector(o + rcv._tab.Pos)
+	}
+	return nil
+}
+
+func (rcv *GoFileInfo) Funcs() int32 {
+	o := flatbuffers.UOffsetT(rcv._tab.Offset(14))
+	if o != 0 {
+		return rcv._tab.GetInt32(o + rcv._tab.Pos)
+	}
+	return 0
+}
+
+func (rcv *GoFileInfo) MutateFuncs(n int32) bool {
+	return rcv._tab.MutateInt32Slot(14, n)
+}
+
+func (rcv *GoFileInfo) Methods() int32 {
+	o := flatbuffers.UOffsetT(rcv._tab.Offset(16))
+	if o != 0 {
+		return rcv._tab.GetInt32(o + rcv._tab.Pos)
+	}
+	return 0
+}
+
+func (rcv *GoFileInfo) MutateMethods(n int32) bool {
+	return rcv._tab.MutateInt32Slot(16, n)
+}
+
+func (rcv *GoFileInfo) Types() int32 {
+	o := flatbuffers.UOffsetT(rcv._tab.Offset(18))
+	if o != 0 {
+		return rcv._tab.GetInt32(o + rcv._tab.Pos)
+	}
+	return 0
+}
+
+func (rcv *GoFileInfo) MutateTypes(n int32) bool {
+	return rcv._tab.MutateInt32Slot(18, n)
+}
+
+func (rcv *GoFileInfo) Vars() int32 {
+	o := flatbuffers.UOffsetT(rcv._tab.Offset(20))
+	if o != 0 {
+		return rcv._tab.GetInt32(o + rcv._tab.Pos)
+	}
+	return 0
+}
+
+func (rcv *GoFileInfo) MutateVars(n int32) bool {
+	return rcv._tab.MutateInt32Slot(20, n)
+}
+
+func (rcv *GoFileInfo) Consts() int32 {
+	o := flatbuffers.UOffsetT(rcv._tab.Offset(22))
+	if o != 0 {
+		return rcv._tab.GetInt32(o + rcv._tab.Pos)
+	}
+	return 0
+}
+
+func (rcv *GoFileInfo) MutateConsts(n int32) bool {
+	return rcv._tab.MutateInt32Slot(22, n)
+}
+
+func (rcv *GoFileInfo) Exported(obj *Symbol, j int) bool {
+	o := flatbuffers.UOffsetT(rcv._tab.Offset(24))
+	if o != 0 {
+		x := rcv._tab.Vector(o)
+		x += flatbuffers.UOffsetT(j) * 4
+		x = rcv._tab.Indirect(x)
+		obj.Init(rcv._tab.Bytes, x)
+		return true
+	}
+	return false
+}
+
+func (rcv *GoFileInfo) ExportedLength() int {
+	o := flatbuffers.UOffsetT(rcv._tab.Offset(24))
+	if o != 0 {
+		return rcv._tab.VectorLen(o)
+	}
+	return 0
+}
+
+func (rcv *GoFileInfo) Sha() []byte {
+	o := flatbuffers.UOffsetT(rcv._tab.Offset(26))
+	if o != 0 {
+		return rcv._tab.ByteVector(o + rcv._tab.Pos)
+	}
+	return nil
+}
+
+func (rcv *GoFileInfo) Size() int64 {
+	o := flatbuffers.UOffsetT(rcv._tab.Offset(28))
+	if o != 0 {
+		return rcv._tab.GetInt64(o + rcv._tab.Pos)
+	}
+	return 0
+}
+
+func (rcv *GoFileInfo) MutateSize(n int64) bool {
+	return rcv._tab.MutateInt64Slot(28, n)
+}
+
 func GoFileInfoStart(builder *flatbuffers.Builder) {
-	builder.StartObject(2)
+	builder.StartObject(13)
 }
 func GoFileInfoAddFilename(builder *flatbuffers.Builder, filename flatbuffers.UOffsetT) {
 	builder.PrependUOffsetTSlot(0, flatbuffers.UOffsetT(filename), 0)
@@ -70,6 +236,45 @@ func GoFileInfoAddFilename(builder *flatbuffers.Builder, filename flatbuffers.UO
 func GoFileInfoAddNumImports(builder *flatbuffers.Builder, numImports int32) {
 	builder.PrependInt32Slot(1, numImports, 0)
 }
+func GoFileInfoAddPackage(builder *flatbuffers.Builder, package_ flatbuffers.UOffsetT) {
+	builder.PrependUOffsetTSlot(2, flatbuffers.UOffsetT(package_), 0)
+}
+func GoFileInfoAddImports(builder *flatbuffers.Builder, imports flatbuffers.UOffsetT) {
+	builder.PrependUOffsetTSlot(3, flatbuffers.UOffsetT(imports), 0)
+}
+func GoFileInfoStartImportsVector(builder *flatbuffers.Builder, numElems int) flatbuffers.UOffsetT {
+	return builder.StartVector(4, numElems, 4)
+}
+func GoFileInfoAddConstraint(builder *flatbuffers.Builder, constraint flatbuffers.UOffsetT) {
+	builder.PrependUOffsetTSlot(4, flatbuffers.UOffsetT(constraint), 0)
+}
+func GoFileInfoAddFuncs(builder *flatbuffers.Builder, funcs int32) {
+	builder.PrependInt32Slot(5, funcs, 0)
+}
+func GoFileInfoAddMethods(builder *flatbuffers.Builder, methods int32) {
+	builder.PrependInt32Slot(6, methods, 0)
+}
+func GoFileInfoAddTypes(builder *flatbuffers.Builder, types int32) {
+	builder.PrependInt32Slot(7, types, 0)
+}
+func GoFileInfoAddVars(builder *flatbuffers.Builder, vars int32) {
+	builder.PrependInt32Slot(8, vars, 0)
+}
+func GoFileInfoAddConsts(builder *flatbuffers.Builder, consts int32) {
+	builder.PrependInt32Slot(9, consts, 0)
+}
+func GoFileInfoAddExported(builder *flatbuffers.Builder, exported flatbuffers.UOffsetT) {
+	builder.PrependUOffsetTSlot(10, flatbuffers.UOffsetT(exported), 0)
+}
+func GoFileInfoStartExportedVector(builder *flatbuffers.Builder, numElems int) flatbuffers.UOffsetT {
+	return builder.StartVector(4, numElems, 4)
+}
+func GoFileInfoAddSha(builder *flatbuffers.Builder, sha flatbuffers.UOffsetT) {
+	builder.PrependUOffsetTSlot(11, flatbuffers.UOffsetT(sha), 0)
+}
+func GoFileInfoAddSize(builder *flatbuffers.Builder, size int64) {
+	builder.PrependInt64Slot(12, size, 0)
+}
 func GoFileInfoEnd(builder *flatbuffers.Builder) flatbuffers.UOffsetT {
 	return builder.EndObject()
 }
@@ -0,0 +1,107 @@
+// Code generated by the FlatBuffers compiler. DO NOT EDIT.
+
+package fileinfo
+
+import (
+	flatbuffers "github.com/google/flatbuffers/go"
+)
+
+type GoFileInfos struct {
+	_tab flatbuffers.Table
+}
+
+const GoFileInfosIdentifier = "GOFI"
+
+func GetRootAsGoFileInfos(buf []byte, offset flatbuffers.UOffsetT) *GoFileInfos {
+	n := flatbuffers.GetUOffsetT(buf[offset:])
+	x := &GoFileInfos{}
+	x.Init(buf, n+offset)
+	return x
+}
+
+func FinishGoFileInfosBuffer(builder *flatbuffers.Builder, offset flatbuffers.UOffsetT) {
+	identifierBytes := []byte(GoFileInfosIdentifier)
+	builder.FinishWithFileIdentifier(offset, identifierBytes)
+}
+
+func GoFileInfosBufferHasIdentifier(buf []byte) bool {
+	return flatbuffers.BufferHasIdentifier(buf, GoFileInfosIdentifier)
+}
+
+func GetSizePrefixedRootAsGoFileInfos(buf []byte, offset flatbuffers.UOffsetT) *GoFileInfos {
+	n := flatbuffers.GetUOffsetT(buf[offset+flatbuffers.SizeUint32:])
+	x := &GoFileInfos{}
+	x.Init(buf, n+offset+flatbuffers.SizeUint32)
+	return x
+}
+
+func FinishSizePrefixedGoFileInfosBuffer(builder *flatbuffers.Builder, offset flatbuffers.UOffsetT) {
+	identifierBytes := []byte(GoFileInfosIdentifier)
+	builder.FinishSizePrefixedWithFileIdentifier(offset, identifierBytes)
+}
+
+func SizePrefixedGoFileInfosBufferHasIdentifier(buf []byte) bool {
+	return flatbuffers.SizePrefixedBufferHasIdentifier(buf, GoFileInfosIdentifier)
+}
+
+func (rcv *GoFileInfos) Init(buf []byte, i flatbuffers.UOffsetT) {
+	rcv._tab.Bytes = buf
+	rcv._tab.Pos = i
+}
+
+func (rcv *GoFileInfos) Table() flatbuffers.Table {
+	return rcv._tab
+}
+
+func (rcv *GoFileInfos) Ref() []byte {
+	o := flatbuffers.UOffsetT(rcv._tab.Offset(4))
+	if o != 0 {
+		return rcv._tab.ByteVector(o + rcv._tab.Pos)
+	}
+	return nil
+}
+
+func (rcv *GoFileInfos) Files(obj *GoFileInfo, j int) bool {
+	o := flatbuffers.UOffsetT(rcv._tab.Offset(6))
+	if o != 0 {
+		x := rcv._tab.Vector(o)
+		x += flatbuffers.UOffsetT(j) * 4
+		x = rcv._tab.Indirect(x)
+		obj.Init(rcv._tab.Bytes, x)
+		return true
+	}
+	return false
+}
+
+func (rcv *GoFileInfos) FilesByKey(obj *GoFileInfo, key string) bool {
+	o := flatbuffers.UOffsetT(rcv._tab.Offset(6))
+	if o != 0 {
+		x := rcv._tab.Vector(o)
+		return obj.LookupByKey(key, x, rcv._tab.Bytes)
+	}
+	return false
+}
+
+func (rcv *GoFileInfos) FilesLength() int {
+	o := flatbuffers.UOffsetT(rcv._tab.Offset(6))
+	if o != 0 {
+		return rcv._tab.VectorLen(o)
+	}
+	return 0
+}
+
+func GoFileInfosStart(builder *flatbuffers.Builder) {
+	builder.StartObject(2)
+}
+func GoFileInfosAddRef(builder *flatbuffers.Builder, ref flatbuffers.UOffsetT) {
+	builder.PrependUOffsetTSlot(0, flatbuffers.UOffsetT(ref), 0)
+}
+func GoFileInfosAddFiles(builder *flatbuffers.Builder, files flatbuffers.UOffsetT) {
+	builder.PrependUOffsetTSlot(1, flatbuffers.UOffsetT(files), 0)
+}
+func GoFileInfosStartFilesVector(builder *flatbuffers.Builder, numElems int) flatbuffers.UOffsetT {
+	return builder.StartVector(4, numElems, 4)
+}
+func GoFileInfosEnd(builder *flatbuffers.Builder) flatbuffers.UOffsetT {
+	return builder.EndObject()
+}
@@ -0,0 +1,97 @@
+// Code generated by the FlatBuffers compiler. DO NOT EDIT.
+
+package fileinfo
+
+import (
+	flatbuffers "github.com/google/flatbuffers/go"
+)
+
+type Symbol struct {
+	_tab flatbuffers.Table
+}
+
+func GetRootAsSymbol(buf []byte, offset flatbuffers.UOffsetT) *Symbol {
+	n := flatbuffers.GetUOffsetT(buf[offset:])
+	x := &Symbol{}
+	x.Init(buf, n+offset)
+	return x
+}
+
+func FinishSymbolBuffer(builder *flatbuffers.Builder, offset flatbuffers.UOffsetT) {
+	builder.Finish(offset)
+}
+
+func GetSizePrefixedRootAsSymbol(buf []byte, offset flatbuffers.UOffsetT) *Symbol {
+	n := flatbuffers.GetUOffsetT(buf[offset+flatbuffers.SizeUint32:])
+	x := &Symbol{}
+	x.Init(buf, n+offset+flatbuffers.SizeUint32)
+	return x
+}
+
+func FinishSizePrefixedSymbolBuffer(builder *flatbuffers.Builder, offset flatbuffers.UOffsetT) {
+	builder.FinishSizePrefixed(offset)
+}
+
+func (rcv *Symbol) Init(buf []byte, i flatbuffers.UOffsetT) {
+	rcv._tab.Bytes = buf
+	rcv._tab.Pos = i
+}
+
+func (rcv *Symbol) Table() flatbuffers.Table {
+	return rcv._tab
+}
+
+func (rcv *Symbol) Name() []byte {
+	o := flatbuffers.UOffsetT(rcv._tab.Offset(4))
+	if o != 0 {
+		return rcv._tab.ByteVector(o + rcv._tab.Pos)
+	}
+	return nil
+}
+
+func (rcv *Symbol) Kind() DeclKind {
+	o := flatbuffers.UOffsetT(rcv._tab.Offset(6))
+	if o != 0 {
+		return DeclKind(rcv._tab.GetInt8(o + rcv._tab.Pos))
+	}
+	return 0
+}
+
+func (rcv *Symbol) MutateKind(n DeclKind) bool {
+	return rcv._tab.MutateInt8Slot(6, int8(n))
+}
+
+func (rcv *Symbol) Hash() []byte {
+	o := flatbuffers.UOffsetT(rcv._tab.Offset(8))
+	if o != 0 {
+		return rcv._tab.ByteVector(o + rcv._tab.Pos)
+	}
+	return nil
+}
+
+func (rcv *Symbol) Signature() []byte {
+	o := flatbuffers.UOffsetT(rcv._tab.Offset(10))
+	if o != 0 {
+		return rcv._tab.ByteVector(o + rcv._tab.Pos)
+	}
+	return nil
+}
+
+func SymbolStart(builder *flatbuffers.Builder) {
+	builder.StartObject(4)
+}
+func SymbolAddName(builder *flatbuffers.Builder, name flatbuffers.UOffsetT) {
+	builder.PrependUOffsetTSlot(0, flatbuffers.UOffsetT(name), 0)
+}
+func SymbolAddKind(builder *flatbuffers.Builder, kind DeclKind) {
+	builder.PrependInt8Slot(1, int8(kind), 0)
+}
+func SymbolAddHash(builder *flatbuffers.Builder, hash flatbuffers.UOffsetT) {
+	builder.PrependUOffsetTSlot(2, flatbuffers.UOffsetT(hash), 0)
+}
+func SymbolAddSignature(builder *flatbuffers.Builder, signature flatbuffers.UOffsetT) {
+	builder.PrependUOffsetTSlot(3, flatbuffers.UOffsetT(signature), 0)
+}
+func SymbolEnd(builder *flatbuffers.Builder) flatbuffers.UOffsetT {
+	return builder.EndObject()
+}
@@ -1,22 +1,26 @@
 module GoOperatorAST
 
-go 1.21
+go 1.22
 
 require (
+	github.com/BurntSushi/toml v1.5.0
 	github.com/google/flatbuffers v23.5.26+incompatible
 	github.com/google/go-github v17.0.0+incompatible
-	github.com/panjf2000/ants/v2 v2.8.2
+	github.com/klauspost/compress v1.18.0
 	github.com/sergi/go-diff v1.2.0
 	github.com/thedevsaddam/gojsonq v2.3.0+incompatible
 	go.uber.org/zap v1.26.0
+	golang.org/x/mod v0.14.0
 	golang.org/x/oauth2 v0.13.0
+	google.golang.org/protobuf v1.34.2
+	gopkg.in/yaml.v3 v3.0.1
 )
 
 require (
 	github.com/golang/protobuf v1.5.3 // indirect
 	github.com/google/go-querystring v1.1.0 // indirect
+	github.com/stretchr/testify v1.8.2 // indirect
 	go.uber.org/multierr v1.10.0 // indirect
 	golang.org/x/net v0.16.0 // indirect
 	google.golang.org/appengine v1.6.7 // indirect
-	google.golang.org/protobuf v1.31.0 // indirect
 )
@@ -1,27 +1,25 @@
 package main
 
 import (
+	astjson "GoOperatorAST/ast_json"
 	"GoOperatorAST/processors"
 	"context"
 	"flag"
-	"fmt"
-	"github.com/google/go-github/github"
-	"github.com/panjf2000/ants/v2"
 	"go.uber.org/zap"
-	"golang.org/x/oauth2"
+	"net/http"
 	"os"
-	"sync"
+	"os/signal"
+	"strings"
+	"syscall"
 	"time"
 )
 
 var (
-	GITHUB_TOKEN  = os.Getenv("GITHUB_TOKEN")
-	GITHUB_OWNER  = os.Getenv("GITHUB_OWNER")
-	OUTPUT_DIR    = os.Getenv("OUTPUT_DIR")
-	REPO_OLD_TAG  = ""
-	REPO_NEW_TAG  = ""
-	logger        *zap.Logger
-	fileProcessor *ants.PoolWithFunc
+	GITHUB_OWNER = os.Getenv("GITHUB_OWNER")
+	OUTPUT_DIR   = os.Getenv("OUTPUT_DIR")
+	REPO_OLD_TAG = ""
+	REPO_NEW_TAG = ""
+	logger       *zap.Logger
 )
 
 func init() {
@@ -37,41 +35,122 @@ func init() {
 	if err != nil {
 		logger.Fatal("Failed to generate random bytes for secret key", zap.Error(err))
 	}
-
-	fp, err := processors.CreateProcessFilePool(200)
-	if err != nil {
-		logger.Fatal("Failed to create file processor pool", zap.Error(err))
-	}
-	fileProcessor = fp
 }
 
 // Main function
 func main() {
-	defer fileProcessor.Release()
+	// The gc command trims the blob cache and exits
+	if len(os.Args) > 1 && os.Args[1] == "gc" {
+		runCacheGC(os.Args[2:])
+		return
+	}
+
+	// The batch command processes every repository of a config file
+	if len(os.Args) > 1 && os.Args[1] == "batch" {
+		if !runBatch(os.Args[2:]) {
+			os.Exit(1)
+		}
+		return
+	}
 
 	// Define flags for repository and token
 	repo := flag.String("repo", "", "Required: Repository name")
 	repoOldTag := flag.String("tagOld", "", "Required: Tag name for previous version")
 	repoNewTag := flag.String("tagNew", "", "Option: Tag name for new version, empty new tag will use main")
-	githubToken := flag.String("token", "", "Optional: Access token")
+	githubToken := flag.String("token", "", "Optional: Access token, defaults to GITHUB_TOKEN, GITLAB_TOKEN or GITEA_TOKEN depending on -forge, required for GitHub Enterprise")
 	githubOwner := flag.String("owner", "", "Optional: Repo owner name")
+	gitDir := flag.String("gitDir", "", "Optional: Local clone or bare repository to read instead of GitHub")
+	dirOld := flag.String("dirOld", "", "Optional: Local directory holding the previous version, requires -dirNew")
+	dirNew := flag.String("dirNew", "", "Optional: Local directory holding the new version, requires -dirOld")
+	archiveOld := flag.String("archiveOld", "", "Optional: .tar.gz or .zip file or URL holding the previous version, requires -archiveNew")
+	archiveNew := flag.String("archiveNew", "", "Optional: .tar.gz or .zip file or URL holding the new version, requires -archiveOld")
+	module := flag.String("module", "", "Optional: Go module path to fetch through GOPROXY, -tagOld and -tagNew are module versions")
+	goproxy := flag.String("goproxy", os.Getenv("GOPROXY"), "Optional: Module proxy list used with -module, defaults to $GOPROXY")
+	modCache := flag.Bool("modcache", false, "Optional: Look up modules in the local module cache before the proxies")
+	cacheDir := flag.String("cacheDir", os.Getenv("AST_CACHE_DIR"), "Optional: Directory of the blob cache shared across tags and runs, defaults to $AST_CACHE_DIR")
+	cacheMaxMB := flag.Int64("cacheMaxMB", 1024, "Optional: Size cap of the blob cache in megabytes, enforced after each run and by the gc command")
+	changedOnly := flag.Bool("changedOnly", false, "Optional: Only process the .go files added, modified, renamed or removed between the two tags")
+	tarball := flag.Bool("tarball", false, "Optional: Download one archive per tag from the forge instead of fetching files one by one")
+	forge := flag.String("forge", processors.ForgeGitHub, "Optional: Forge hosting the repository, one of github, gitlab or gitea")
+	baseURL := flag.String("baseURL", "", "Optional: Address of a GitHub Enterprise, GitLab or Gitea instance, empty uses the public service")
+	root := flag.String("root", "", "Optional: Subdirectory of the repository to process, empty processes the whole repository")
+	var include, exclude globList
+	flag.Var(&include, "include", "Optional: Glob of the files to process, repeatable, ** matches any number of directories")
+	flag.Var(&exclude, "exclude", "Optional: Glob of the files to skip, repeatable, ** matches any number of directories")
+	withTests := flag.Bool("tests", false, "Optional: Process _test.go files")
+	withVendor := flag.Bool("vendor", false, "Optional: Process files below vendor directories")
+	withTestdata := flag.Bool("testdata", false, "Optional: Process files below testdata directories")
+	withGenerated := flag.Bool("generated", false, "Optional: Process files marked as generated code")
+	resume := flag.Bool("resume", false, "Optional: Skip the files the manifest of each tag records as done and retry the failed ones")
+	var compression astjson.Compression
+	flag.Var(&compression, "compress", "Optional: Compress the JSON files with gzip or zstd, their names then end in .gz or .zst")
+	releaseRange := flag.String("range", "", "Optional: Semver range of the releases to process instead of -tagOld and -tagNew, such as v1.18.0..v1.21.x")
+	lastReleases := flag.Int("last", 0, "Optional: Process the last N releases, within -range when given")
+	tagPrefix := flag.String("tagPrefix", "", "Optional: Prefix stripped from tags before reading their version with -range or -last, such as go")
+	prereleases := flag.Bool("prereleases", false, "Optional: Include prereleases such as v1.2.0-rc.1 with -range or -last")
+	targetList := flag.String("targets", "", "Optional: Comma separated GOOS/GOARCH pairs to compute the package contents for, such as linux/amd64,darwin/arm64")
+	poolSizes := poolFlags(flag.CommandLine)
+	progressOutputs := progressFlags(flag.CommandLine)
 
 	// Parse the command-line arguments
 	flag.Parse()
 
+	// Plain directory mode compares two local directories and needs no repository
+	dirMode := *dirOld != "" || *dirNew != ""
+	if dirMode && (*dirOld == "" || *dirNew == "") {
+		logger.Info("Please provide both the -dirOld and -dirNew arguments.")
+		return
+	}
+
+	// Archive mode compares two archives and needs no repository
+	archiveMode := *archiveOld != "" || *archiveNew != ""
+	if archiveMode && (*archiveOld == "" || *archiveNew == "") {
+		logger.Info("Please provide both the -archiveOld and -archiveNew arguments.")
+		return
+	}
+
+	if dirMode && archiveMode {
+		logger.Info("Please provide either directories or archives, not both.")
+		return
+	}
+	pairMode := dirMode || archiveMode
+
+	// Series mode processes every release of a range and needs tags to list
+	seriesMode := *releaseRange != "" || *lastReleases > 0
+	if seriesMode && pairMode {
+		logger.Info("Please provide a release range for a repository, not directories or archives.")
+		return
+	}
+	if seriesMode && *changedOnly {
+		logger.Info("Please process whole releases with -range or -last, -changedOnly compares two tags.")
+		return
+	}
+
 	// Check if the required repository argument is provided
-	if *repo == "" {
+	if *repo == "" && *gitDir == "" && *module == "" && !pairMode {
 		logger.Info("Please provide the repository argument.")
 		return
 	}
 
-	if *repoOldTag == "" {
+	if *repoOldTag == "" && !pairMode && !seriesMode {
 		logger.Info("Please provide the previous tag version argument.")
 		return
 	}
 
+	// Directories and archives have no tags, label their output old and new unless told otherwise
+	if pairMode {
+		if *repoOldTag == "" {
+			*repoOldTag = "old"
+		}
+		if *repoNewTag == "" {
+			*repoNewTag = "new"
+		}
+	}
+
+	// Tokens from the environment are only sent to the forge they belong to
+	token := forgeToken(*forge, *baseURL)
 	if *githubToken != "" {
-		GITHUB_TOKEN = *githubToken
+		token = *githubToken
 	}
 
 	if *githubOwner != "" {
@@ -84,40 +163,249 @@ func main() {
 
 	if *repoNewTag != "" {
 		REPO_NEW_TAG = *repoNewTag
-	} else {
-		logger.Info("Using main as the new tag")
+	} else if !seriesMode {
+		logger.Info("Using the default branch as the new tag")
 	}
 
 	if OUTPUT_DIR == "" {
 		OUTPUT_DIR = "./output_temp"
 	}
 
-	// Initialize GitHub client
-	ctx := context.Background()
-	ts := oauth2.StaticTokenSource(
-		&oauth2.Token{AccessToken: GITHUB_TOKEN},
-	)
-	tc := oauth2.NewClient(ctx, ts)
-	client := github.NewClient(tc)
-	//_ = github.NewClient(tc)
+	ctx, stop := signalContext()
+	defer stop()
 
-	// Check and Create the output directory
-	createDir(OUTPUT_DIR)
-	createDir(OUTPUT_DIR + "/" + REPO_OLD_TAG)
+	// Every HTTP based source waits for rate limits and retries transient failures
+	httpClient := &http.Client{Transport: processors.NewRetryTransport(nil, logger)}
 
-	if REPO_NEW_TAG == "" {
-		createDir(OUTPUT_DIR + "/" + "main")
+	// Select where the source files are read from
+	var oldSource, newSource processors.Source
+	var tagSource processors.Source
+	if dirMode {
+		oldSource = processors.NewDirSource(*dirOld)
+		newSource = processors.NewDirSource(*dirNew)
+	} else if archiveMode {
+		oldSource = processors.NewFileArchiveSource(map[string]string{*repoOldTag: *archiveOld}, httpClient)
+		newSource = processors.NewFileArchiveSource(map[string]string{*repoNewTag: *archiveNew}, httpClient)
+	} else if *module != "" {
+		proxies := *goproxy
+		if proxies == "" {
+			proxies = "https://proxy.golang.org"
+		}
+		if *modCache {
+			proxies = processors.ModCacheProxy() + "," + proxies
+		}
+		src, err := processors.NewModuleProxySource(*module, proxies, httpClient)
+		if err != nil {
+			logger.Fatal("Failed to create module proxy source", zap.Error(err))
+		}
+		oldSource = src
+		newSource = src
+		tagSource = src
+	} else if *gitDir != "" {
+		oldSource = processors.NewGitSource(*gitDir)
+		newSource = oldSource
+		tagSource = oldSource
 	} else {
-		createDir(OUTPUT_DIR + "/" + REPO_NEW_TAG)
+		if token == "" && isGitHubEnterprise(*forge, *baseURL) {
+			logger.Fatal("A GitHub Enterprise server needs an access token, pass -token")
+		}
+		// Initialize the forge client
+		opts := processors.ForgeOptions{
+			Forge:      *forge,
+			BaseURL:    *baseURL,
+			Token:      token,
+			Owner:      GITHUB_OWNER,
+			Repo:       *repo,
+			Tarball:    *tarball,
+			HTTPClient: httpClient,
+		}
+		src, err := processors.NewForgeSource(ctx, opts)
+		if err != nil {
+			logger.Fatal("Failed to create forge source", zap.Error(err))
+		}
+		oldSource = src
+		newSource = src
+
+		// Archives have no tag list, the API of the forge has
+		opts.Tarball = false
+		tagSource, err = processors.NewForgeSource(ctx, opts)
+		if err != nil {
+			logger.Fatal("Failed to create forge source", zap.Error(err))
+		}
 	}
 
-	processors.SetupProcessing(OUTPUT_DIR, logger)
+	filter := &processors.FileFilter{
+		Include:   include,
+		Exclude:   exclude,
+		Tests:     *withTests,
+		Vendor:    *withVendor,
+		Testdata:  *withTestdata,
+		Generated: *withGenerated,
+	}
+	targets, err := processors.ParseTargets(*targetList)
+	if err != nil {
+		logger.Fatal("Invalid target list", zap.Error(err))
+	}
+
+	var cache *processors.BlobCache
+	if *cacheDir != "" {
+		c, err := processors.NewBlobCache(*cacheDir, *cacheMaxMB<<20)
+		if err != nil {
+			logger.Fatal("Failed to open blob cache", zap.Error(err))
+		}
+		cache = c
+	}
+
+	progress, metrics, closeProgress, err := progressOutputs.open()
+	if err != nil {
+		logger.Fatal("Failed to open the progress outputs", zap.Error(err))
+	}
+	// os.Exit skips the deferred calls, failed runs close the processor and the outputs first
+	defer closeProgress()
+
+	proc := newProcessor(processors.Options{
+		OutputDir:   OUTPUT_DIR,
+		Logger:      logger,
+		Pools:       *poolSizes,
+		Cache:       cache,
+		Filter:      filter,
+		Progress:    progress,
+		Resume:      *resume,
+		Compression: compression,
+	})
+	defer proc.Close()
+	if metrics != nil {
+		metrics.WatchStages(proc.Stats)
+	}
+
+	job := pairJob{
+		proc:        proc,
+		name:        *repo,
+		outputDir:   OUTPUT_DIR,
+		oldSource:   oldSource,
+		newSource:   newSource,
+		refSource:   tagSource,
+		oldTag:      REPO_OLD_TAG,
+		newTag:      REPO_NEW_TAG,
+		root:        *root,
+		filter:      filter,
+		changedOnly: *changedOnly,
+		targets:     targets,
+	}
+
+	if seriesMode {
+		if !processSeries(ctx, job, tagSource, processors.ReleaseQuery{Range: *releaseRange, Last: *lastReleases, Prefix: *tagPrefix, Prereleases: *prereleases}, cache) {
+			proc.Close()
+			closeProgress()
+			os.Exit(1)
+		}
+		return
+	}
 
 	// Start the worker reporter
+	worker_ch := startWorkerReporter(proc)
+
+	logger.Info("Waiting for all file processing to finish...")
+	result := runPair(ctx, job)
+
+	worker_ch <- true
+	logger.Info("All file processing has completed...")
+	finishRun(proc, cache)
+
+	oldComplete := reportMissing(tagLabel(REPO_OLD_TAG, result.oldRef), result.oldSkipped, result.oldErr)
+	newComplete := reportMissing(tagLabel(REPO_NEW_TAG, result.newRef), result.newSkipped, result.newErr)
+	if !oldComplete || !newComplete {
+		proc.Close()
+		closeProgress()
+		os.Exit(1)
+	}
+}
+
+// Context cancelled by SIGINT or SIGTERM, a second signal terminates the process at once
+// @return the context and the function releasing its signal handler
+func signalContext() (context.Context, context.CancelFunc) {
+	ctx, cancel := context.WithCancel(context.Background())
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, os.Interrupt, syscall.SIGTERM)
+	go func() {
+		select {
+		case <-signals:
+			logger.Warn("Interrupted, finishing the files in progress, interrupt again to exit immediately")
+			// Without a handler the next signal terminates the process
+			signal.Stop(signals)
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+	return ctx, func() {
+		signal.Stop(signals)
+		cancel()
+	}
+}
+
+// Environment variable holding the access token of each forge
+var forgeTokenEnv = map[string]string{
+	"":                     "GITHUB_TOKEN",
+	processors.ForgeGitHub: "GITHUB_TOKEN",
+	processors.ForgeGitLab: "GITLAB_TOKEN",
+	processors.ForgeGitea:  "GITEA_TOKEN",
+}
+
+// Access token of a forge read from its own environment variable, so that a
+// GitHub token is never sent to a GitLab or Gitea instance. GITHUB_TOKEN
+// belongs to github.com, GitHub Enterprise servers get no token from it.
+// @param forge: name of the forge, empty means GitHub
+// @param baseURL: address of a self-hosted instance, empty means the public service
+// @return the token, empty for unknown forges and GitHub Enterprise
+func forgeToken(forge, baseURL string) string {
+	env, ok := forgeTokenEnv[forge]
+	if !ok || isGitHubEnterprise(forge, baseURL) {
+		return ""
+	}
+	return os.Getenv(env)
+}
+
+// Whether a forge and base URL name a GitHub Enterprise server, which needs an explicit token
+// @param forge: name of the forge, empty means GitHub
+// @param baseURL: address of a self-hosted instance, empty means the public service
+func isGitHubEnterprise(forge, baseURL string) bool {
+	return baseURL != "" && (forge == "" || forge == processors.ForgeGitHub)
+}
+
+// Register the flags sizing the stages of the processing pipeline
+// @param flags: flag set to register the flags with
+// @return the pool sizes, filled in once the flags are parsed
+func poolFlags(flags *flag.FlagSet) *processors.PoolSizes {
+	sizes := processors.DefaultPoolSizes()
+	flags.IntVar(&sizes.Fetch, "fetchWorkers", sizes.Fetch, "Optional: Number of files fetched from the source at once")
+	flags.IntVar(&sizes.Parse, "parseWorkers", sizes.Parse, "Optional: Number of files parsed and marshalled at once, defaults to the number of CPUs")
+	flags.IntVar(&sizes.Write, "writeWorkers", sizes.Write, "Optional: Number of JSON files encoded and written at once")
+	flags.IntVar(&sizes.Analysis, "analysisWorkers", sizes.Analysis, "Optional: Number of JSON files analysed at once, defaults to the number of CPUs")
+	flags.IntVar(&sizes.Queue, "queueSize", sizes.Queue, "Optional: Number of files waiting in front of each stage, bounds the memory held by the pipeline")
+	return &sizes
+}
+
+// Create the processor converting and analysing the files, exiting on invalid options
+// @param opts: output directory, pool sizes, cache and filter of the processor
+// @return the processor, to be closed once processing is over
+func newProcessor(opts processors.Options) *processors.Processor {
+	proc, err := processors.NewProcessor(opts)
+	if err != nil {
+		logger.Fatal("Failed to create processor", zap.Error(err))
+	}
+	return proc
+}
+
+// Log the statistics of the pipeline stages every five seconds
+// @param proc: processor whose pipeline is reported
+// @return channel stopping the reporter when sent to
+func startWorkerReporter(proc *processors.Processor) chan bool {
 	worker_ch := make(chan bool)
 	go func() {
 		for {
-			logger.Debug("file processing worker threads stats", zap.Int("Running", fileProcessor.Running()), zap.Int("Waiting", fileProcessor.Waiting()), zap.Int("Free", fileProcessor.Free()))
+			for _, stats := range proc.Stats() {
+				logger.Debug("pipeline stage stats", zap.String("stage", stats.Name), zap.Int("Workers", stats.Workers), zap.Int("Busy", stats.Busy), zap.Int("Queued", stats.Queued), zap.Int64("Done", stats.Done), zap.Duration("Waited", stats.Waited), zap.Duration("Worked", stats.Worked))
+			}
 
 			// Wait for and print values received from channels
 			select {
@@ -129,31 +417,91 @@ func main() {
 			time.Sleep(5 * time.Second)
 		}
 	}()
+	return worker_ch
+}
 
-	var wg sync.WaitGroup
-
-	// Process the repository
-	wg.Add(1)
-	go func(wg1 *sync.WaitGroup) {
-		defer wg1.Done()
-		logger.Debug(fmt.Sprintf("Processing old repository %s with tag", *repo, REPO_OLD_TAG))
-		processors.ProcessRepo(ctx, client, GITHUB_OWNER, *repo, "", REPO_OLD_TAG)
-		processors.ReadFiles(OUTPUT_DIR + "/" + REPO_OLD_TAG)
-	}(&wg)
-
-	wg.Add(1)
-	go func(wg1 *sync.WaitGroup) {
-		defer wg1.Done()
-		logger.Debug(fmt.Sprintf("Processing new repository %s with tag", *repo, REPO_NEW_TAG))
-		processors.ProcessRepo(ctx, client, GITHUB_OWNER, *repo, "", REPO_NEW_TAG)
-		processors.ReadFiles(OUTPUT_DIR + "/" + REPO_NEW_TAG)
-	}(&wg)
+// globList collects the values of a repeatable glob flag
+type globList []string
 
-	logger.Info("Waiting for all file processing to finish...")
-	wg.Wait()
+func (g *globList) String() string {
+	return strings.Join(*g, ",")
+}
 
-	worker_ch <- true
-	logger.Info("All file processing has completed...")
+func (g *globList) Set(value string) error {
+	*g = append(*g, value)
+	return nil
+}
+
+// Run the gc command, trimming the blob cache to its size cap
+// @param args: command line arguments following gc
+func runCacheGC(args []string) {
+	flags := flag.NewFlagSet("gc", flag.ExitOnError)
+	cacheDir := flags.String("cacheDir", os.Getenv("AST_CACHE_DIR"), "Required: Directory of the blob cache, defaults to $AST_CACHE_DIR")
+	cacheMaxMB := flags.Int64("cacheMaxMB", 1024, "Optional: Size cap of the blob cache in megabytes")
+	flags.Parse(args)
+
+	if *cacheDir == "" {
+		logger.Info("Please provide the cache directory argument.")
+		return
+	}
+	cache, err := processors.NewBlobCache(*cacheDir, *cacheMaxMB<<20)
+	if err != nil {
+		logger.Fatal("Failed to open blob cache", zap.Error(err))
+	}
+	collectCache(cache)
+}
+
+// Trim the blob cache to its size cap
+// @param cache: blob cache
+func collectCache(cache *processors.BlobCache) {
+	removed, freed, err := cache.GC()
+	if err != nil {
+		logger.Error("Blob cache garbage collection failed", zap.Error(err))
+		return
+	}
+	logger.Info("Blob cache garbage collection finished", zap.Int("removed", removed), zap.Int64("freed bytes", freed))
+}
+
+// Save the manifests and trim the blob cache once processing is over
+// @param proc: processor that wrote the manifests
+// @param cache: blob cache, may be nil
+func finishRun(proc *processors.Processor, cache *processors.BlobCache) {
+	err := proc.SaveManifests()
+	if err != nil {
+		logger.Error("Unable to save manifests", zap.Error(err))
+	}
+	if cache != nil {
+		collectCache(cache)
+	}
+}
+
+// Name of a tag in the logs, the name of its output directory once resolved
+// @param tag: tag as requested
+// @param ref: tag once resolved, empty when resolution failed
+func tagLabel(tag string, ref processors.ResolvedRef) string {
+	if ref.Name == "" && ref.Commit == "" {
+		return tag
+	}
+	return ref.DirName()
+}
+
+// Log the files missing from the output of a tag
+// @param tag: tag that was processed
+// @param skipped: files that could not be fetched or converted
+// @param err: error listing the files of the tag
+// @return true when nothing is missing
+func reportMissing(tag string, skipped []processors.SkippedFile, err error) bool {
+	if err != nil {
+		logger.Error("Unable to process tag", zap.String("tag", tag), zap.Error(err))
+		return false
+	}
+	for _, file := range skipped {
+		logger.Error("File permanently skipped", zap.String("tag", tag), zap.String("path", file.Path), zap.Error(file.Err))
+	}
+	if len(skipped) > 0 {
+		logger.Error("Tag incomplete", zap.String("tag", tag), zap.Int("failed files", len(skipped)))
+	}
+	return len(skipped) == 0
 }
 
 // Create directory if it does not exist
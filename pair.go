@@ -0,0 +1,156 @@
+package main
+
+import (
+	"GoOperatorAST/processors"
+	"context"
+	"fmt"
+	"go.uber.org/zap"
+	"sync"
+)
+
+// pairJob describes the processing of two refs of one repository
+type pairJob struct {
+	proc      *processors.Processor
+	name      string
+	outputDir string
+	oldSource processors.Source
+	newSource processors.Source
+	// refSource resolves the refs when the sources cannot, such as forge archives, nil uses the sources
+	refSource   processors.Source
+	oldTag      string
+	newTag      string
+	root        string
+	filter      *processors.FileFilter
+	changedOnly bool
+	targets     []processors.Target
+}
+
+// pairResult holds the outcome of a pairJob
+type pairResult struct {
+	oldRef     processors.ResolvedRef
+	newRef     processors.ResolvedRef
+	oldSkipped []processors.SkippedFile
+	newSkipped []processors.SkippedFile
+	oldErr     error
+	newErr     error
+}
+
+// Process both refs of a pair into their tag directories below the output directory of the job
+// @param ctx: context of the run
+// @param job: pair to process
+// @return the files missing from each tag, or the error preventing a tag from being processed
+func runPair(ctx context.Context, job pairJob) pairResult {
+	var result pairResult
+
+	// Pin both refs so that a branch moving during the run is read at one commit
+	result.oldRef, result.oldErr = resolveTag(ctx, job, job.oldSource, job.oldTag)
+	result.newRef, result.newErr = resolveTag(ctx, job, job.newSource, job.newTag)
+	if result.oldErr != nil || result.newErr != nil {
+		return result
+	}
+
+	// Check and Create the output directories
+	createDir(job.outputDir)
+	createDir(job.outputDir + "/" + result.oldRef.DirName())
+	createDir(job.outputDir + "/" + result.newRef.DirName())
+
+	// Restrict processing to the changed files when asked to
+	var oldPaths, newPaths map[string]bool
+	if job.changedOnly {
+		changes, err := job.proc.DiffRefs(ctx, job.oldSource, job.newSource, result.oldRef.ReadRef(), result.newRef.ReadRef())
+		if err == nil {
+			changes = processors.GoChanges(changes)
+			err = processors.WriteChangeManifest(job.outputDir, processors.ChangeManifest{
+				Old:     result.oldRef.DirName(),
+				New:     result.newRef.DirName(),
+				OldRef:  &result.oldRef,
+				NewRef:  &result.newRef,
+				Changes: changes,
+			})
+		}
+		if err != nil {
+			err = fmt.Errorf("comparing tags: %w", err)
+			result.oldErr, result.newErr = err, err
+			return result
+		}
+		oldPaths, newPaths = processors.ChangedPaths(changes)
+		logger.Info("Processing changed files only", zap.String("repository", job.name), zap.Int("changes", len(changes)))
+	}
+
+	var wg sync.WaitGroup
+
+	// Process the repository
+	wg.Add(1)
+	go func(wg1 *sync.WaitGroup) {
+		defer wg1.Done()
+		logger.Debug(fmt.Sprintf("Processing old repository %s with tag %s", job.name, result.oldRef.DirName()))
+		result.oldSkipped, result.oldErr = processTag(ctx, job, job.oldSource, result.oldRef, oldPaths)
+	}(&wg)
+
+	wg.Add(1)
+	go func(wg1 *sync.WaitGroup) {
+		defer wg1.Done()
+		logger.Debug(fmt.Sprintf("Processing new repository %s with tag %s", job.name, result.newRef.DirName()))
+		result.newSkipped, result.newErr = processTag(ctx, job, job.newSource, result.newRef, newPaths)
+	}(&wg)
+
+	wg.Wait()
+	return result
+}
+
+// Resolve a tag of a pair to the commit it names
+// @param ctx: context of the run
+// @param job: pair the tag belongs to
+// @param src: source of the tag
+// @param tag: tag, branch or commit, empty for the default branch
+func resolveTag(ctx context.Context, job pairJob, src processors.Source, tag string) (processors.ResolvedRef, error) {
+	if job.refSource != nil {
+		src = job.refSource
+	}
+	ref, err := processors.ResolveRef(ctx, src, tag)
+	if err != nil {
+		return ref, fmt.Errorf("resolving %q: %w", tag, err)
+	}
+	logger.Info("Resolved ref", zap.String("repository", job.name), zap.String("ref", tag), zap.String("kind", ref.Kind), zap.String("name", ref.Name), zap.String("commit", ref.Commit))
+	return ref, nil
+}
+
+// Process one tag of a pair and run the analyses over its output
+// @param ctx: context of the run
+// @param job: pair the tag belongs to
+// @param src: source of the tag
+// @param ref: resolved tag to process
+// @param paths: files to process, nil processes every file
+func processTag(ctx context.Context, job pairJob, src processors.Source, ref processors.ResolvedRef, paths map[string]bool) ([]processors.SkippedFile, error) {
+	tagDir := job.outputDir + "/" + ref.DirName()
+	skipped, err := job.proc.ProcessRef(ctx, src, ref.ReadRef(), processors.ProcessOptions{
+		Dir:       job.root,
+		OutputDir: tagDir,
+		Paths:     paths,
+		Filter:    job.filter,
+		Ref:       &ref,
+	})
+	if ctx.Err() != nil {
+		// The output of an interrupted tag is incomplete, analysing it would mislead
+		return skipped, err
+	}
+	if err := job.proc.ReadFiles(tagDir); err != nil {
+		logger.Warn("Unable to analyse every file", zap.String("dir", tagDir), zap.Error(err))
+	}
+	writeTargets(job.proc, tagDir, job.targets)
+	return skipped, err
+}
+
+// Write the package contents per target of a tag directory
+// @param proc: processor that wrote the tag directory
+// @param tagDir: output directory of the tag
+// @param targets: GOOS/GOARCH pairs, nothing is written when empty
+func writeTargets(proc *processors.Processor, tagDir string, targets []processors.Target) {
+	if len(targets) == 0 {
+		return
+	}
+	err := proc.WriteTargets(tagDir, targets)
+	if err != nil {
+		logger.Error("Unable to compute the package contents per target", zap.String("dir", tagDir), zap.Error(err))
+	}
+}
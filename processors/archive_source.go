@@ -0,0 +1,309 @@
+package processors
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/google/go-github/github"
+)
+
+// ArchiveOpener returns the raw bytes of the archive holding ref.
+type ArchiveOpener func(ctx context.Context, ref string) ([]byte, error)
+
+// ArchiveSource reads files out of one .tar.gz or .zip archive per ref.
+// Each archive is downloaded or read once and its Go files are kept in memory.
+// A single top-level directory shared by every entry, as found in GitHub
+// tarballs and zipballs, is stripped from the paths.
+type ArchiveSource struct {
+	open ArchiveOpener
+
+	mu       sync.Mutex
+	archives map[string]*archiveLoad
+}
+
+// archiveLoad is the extraction of the archive of one ref, done is closed once it ends.
+type archiveLoad struct {
+	done    chan struct{}
+	entries map[string]string
+	err     error
+}
+
+// NewArchiveSource creates a Source from an opener returning the archive for a ref.
+// @param open ArchiveOpener
+func NewArchiveSource(open ArchiveOpener) *ArchiveSource {
+	return &ArchiveSource{
+		open:     open,
+		archives: map[string]*archiveLoad{},
+	}
+}
+
+// NewFileArchiveSource creates a Source reading local archive files or http(s) URLs.
+// @param locations map[string]string maps each ref to an archive path or URL
+// @param httpClient *http.Client used for URLs, nil means http.DefaultClient
+func NewFileArchiveSource(locations map[string]string, httpClient *http.Client) *ArchiveSource {
+	return NewArchiveSource(func(ctx context.Context, ref string) ([]byte, error) {
+		location, ok := locations[ref]
+		if !ok {
+			return nil, fmt.Errorf("no archive configured for ref %q", ref)
+		}
+		return readLocation(ctx, httpClient, location)
+	})
+}
+
+// NewGitHubArchiveSource creates a Source downloading one tarball per ref from GitHub.
+// @param client *github.Client
+// @param httpClient *http.Client used to download the archive, nil means http.DefaultClient
+// @param owner string
+// @param repo string
+func NewGitHubArchiveSource(client *github.Client, httpClient *http.Client, owner, repo string) *ArchiveSource {
+	return NewArchiveSource(func(ctx context.Context, ref string) ([]byte, error) {
+		link, _, err := client.Repositories.GetArchiveLink(ctx, owner, repo, github.Tarball, contentOptions(ref))
+		if err != nil {
+			return nil, err
+		}
+		return readLocation(ctx, httpClient, link.String())
+	})
+}
+
+// ListFiles returns the Go files of the archive for ref below dir.
+func (s *ArchiveSource) ListFiles(ctx context.Context, dir, ref string) ([]SourceFile, error) {
+	entries, err := s.load(ctx, ref)
+	if err != nil {
+		return nil, err
+	}
+
+	prefix := strings.Trim(dir, "/")
+	var files []SourceFile
+	for name, content := range entries {
+		if prefix != "" && name != prefix && !strings.HasPrefix(name, prefix+"/") {
+			continue
+		}
+		files = append(files, SourceFile{Path: name, SHA: gitBlobSHA([]byte(content))})
+	}
+	sort.Slice(files, func(i, j int) bool {
+		return files[i].Path < files[j].Path
+	})
+	return files, nil
+}
+
+// ReadFile returns the content of a file of the archive for ref.
+func (s *ArchiveSource) ReadFile(ctx context.Context, file SourceFile, ref string) (string, error) {
+	entries, err := s.load(ctx, ref)
+	if err != nil {
+		return "", err
+	}
+	content, ok := entries[file.Path]
+	if !ok {
+		return "", fmt.Errorf("%s: %w", file.Path, os.ErrNotExist)
+	}
+	return content, nil
+}
+
+// load opens and extracts the archive for ref once. Callers asking for a ref
+// being loaded wait for it, or for their own context, without holding up the
+// other refs. A failed load is forgotten so that the next call tries again,
+// and waiters whose context is still live retry one cancelled by its caller.
+func (s *ArchiveSource) load(ctx context.Context, ref string) (map[string]string, error) {
+	for {
+		s.mu.Lock()
+		load, ok := s.archives[ref]
+		if !ok {
+			load = &archiveLoad{done: make(chan struct{})}
+			s.archives[ref] = load
+		}
+		s.mu.Unlock()
+		if !ok {
+			return s.extract(ctx, ref, load)
+		}
+
+		select {
+		case <-load.done:
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+		cancelled := errors.Is(load.err, context.Canceled) || errors.Is(load.err, context.DeadlineExceeded)
+		if !cancelled || ctx.Err() != nil {
+			return load.entries, load.err
+		}
+	}
+}
+
+// extract opens and extracts the archive for ref into load, which is forgotten if it fails.
+func (s *ArchiveSource) extract(ctx context.Context, ref string, load *archiveLoad) (map[string]string, error) {
+	defer close(load.done)
+	data, err := s.open(ctx, ref)
+	if err == nil {
+		load.entries, err = ExtractGoFiles(data)
+	}
+	if err != nil {
+		load.err = err
+		s.mu.Lock()
+		delete(s.archives, ref)
+		s.mu.Unlock()
+	}
+	return load.entries, load.err
+}
+
+// ExtractGoFiles returns the .go entries of a .tar.gz or .zip archive keyed by
+// their path. The format is detected from the magic bytes of data.
+// Archives with entries outside of their root, such as ../x.go, are rejected.
+// @param data []byte
+func ExtractGoFiles(data []byte) (map[string]string, error) {
+	var entries map[string]string
+	var files []string
+	var err error
+	switch {
+	case bytes.HasPrefix(data, []byte{0x1f, 0x8b}):
+		entries, files, err = extractTarGz(data)
+	case bytes.HasPrefix(data, []byte("PK\x03\x04")), bytes.HasPrefix(data, []byte("PK\x05\x06")):
+		entries, files, err = extractZip(data)
+	default:
+		return nil, fmt.Errorf("unknown archive format")
+	}
+	if err != nil {
+		return nil, err
+	}
+	return stripCommonPrefix(entries, files), nil
+}
+
+// extractTarGz returns the .go entries of a tarball and the names of all of its files.
+func extractTarGz(data []byte) (map[string]string, []string, error) {
+	gz, err := gzip.NewReader(bytes.NewReader(data))
+	if err != nil {
+		return nil, nil, err
+	}
+	defer gz.Close()
+
+	entries := map[string]string{}
+	var files []string
+	reader := tar.NewReader(gz)
+	for {
+		header, err := reader.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, nil, err
+		}
+		// GitHub tarballs start with a global header holding the commit
+		if header.Typeflag == tar.TypeDir || header.Typeflag == tar.TypeXGlobalHeader {
+			continue
+		}
+		name, err := entryName(header.Name)
+		if err != nil {
+			return nil, nil, err
+		}
+		files = append(files, name)
+		if header.Typeflag != tar.TypeReg || !strings.HasSuffix(name, ".go") {
+			continue
+		}
+		content, err := io.ReadAll(reader)
+		if err != nil {
+			return nil, nil, err
+		}
+		entries[name] = string(content)
+	}
+	return entries, files, nil
+}
+
+// extractZip returns the .go entries of a zip archive and the names of all of its files.
+func extractZip(data []byte) (map[string]string, []string, error) {
+	reader, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	entries := map[string]string{}
+	var files []string
+	for _, file := range reader.File {
+		if file.FileInfo().IsDir() {
+			continue
+		}
+		name, err := entryName(file.Name)
+		if err != nil {
+			return nil, nil, err
+		}
+		files = append(files, name)
+		if !strings.HasSuffix(name, ".go") {
+			continue
+		}
+		rc, err := file.Open()
+		if err != nil {
+			return nil, nil, err
+		}
+		content, err := io.ReadAll(rc)
+		rc.Close()
+		if err != nil {
+			return nil, nil, err
+		}
+		entries[name] = string(content)
+	}
+	return entries, files, nil
+}
+
+// entryName returns the cleaned path of an archive entry, which must stay below the root of the archive.
+func entryName(name string) (string, error) {
+	clean := path.Clean(name)
+	if !filepath.IsLocal(filepath.FromSlash(clean)) {
+		return "", fmt.Errorf("archive entry %s is outside of the archive", name)
+	}
+	return clean, nil
+}
+
+// stripCommonPrefix removes the top-level directory of entries when every file
+// of the archive, not only its Go files, lives below the same one.
+func stripCommonPrefix(entries map[string]string, files []string) map[string]string {
+	prefix := ""
+	for _, name := range files {
+		top, _, ok := strings.Cut(name, "/")
+		if !ok || (prefix != "" && top != prefix) {
+			return entries
+		}
+		prefix = top
+	}
+	if prefix == "" {
+		return entries
+	}
+
+	stripped := make(map[string]string, len(entries))
+	for name, content := range entries {
+		stripped[strings.TrimPrefix(name, prefix+"/")] = content
+	}
+	return stripped
+}
+
+// readLocation reads a local file or downloads an http(s) URL.
+func readLocation(ctx context.Context, httpClient *http.Client, location string) ([]byte, error) {
+	if !strings.HasPrefix(location, "http://") && !strings.HasPrefix(location, "https://") {
+		return os.ReadFile(location)
+	}
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, location, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("GET %s: unexpected status %s", location, resp.Status)
+	}
+	return io.ReadAll(resp.Body)
+}
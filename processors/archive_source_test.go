@@ -0,0 +1,249 @@
+package processors
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+var archiveFiles = map[string]string{
+	"owner-repo-1234567/a.go":      "package a\n",
+	"owner-repo-1234567/sub/b.go":  "package sub\n",
+	"owner-repo-1234567/README.md": "readme\n",
+}
+
+func buildTarGz(t *testing.T, files map[string]string) []byte {
+	var buf bytes.Buffer
+	gz := gzip.NewWriter(&buf)
+	tw := tar.NewWriter(gz)
+	for name, content := range files {
+		err := tw.WriteHeader(&tar.Header{Name: name, Mode: 0644, Size: int64(len(content)), Typeflag: tar.TypeReg})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := tw.Write([]byte(content)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := tw.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if err := gz.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+func buildZip(t *testing.T, files map[string]string) []byte {
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+	for name, content := range files {
+		w, err := zw.Create(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := w.Write([]byte(content)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := zw.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+func TestArchiveSource(t *testing.T) {
+	tarPath := filepath.Join(t.TempDir(), "v1.tar.gz")
+	err := os.WriteFile(tarPath, buildTarGz(t, archiveFiles), 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	zipData := buildZip(t, archiveFiles)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write(zipData)
+	}))
+	defer server.Close()
+
+	src := NewFileArchiveSource(map[string]string{
+		"v1": tarPath,
+		"v2": server.URL + "/v2.zip",
+	}, server.Client())
+
+	ctx := context.Background()
+	for _, ref := range []string{"v1", "v2"} {
+		files, err := src.ListFiles(ctx, "", ref)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(files) != 2 || files[0].Path != "a.go" || files[1].Path != "sub/b.go" {
+			t.Fatalf("%s: unexpected files %v", ref, files)
+		}
+		if files[0].SHA != gitBlobSHA([]byte("package a\n")) {
+			t.Errorf("%s: unexpected blob sha %s", ref, files[0].SHA)
+		}
+
+		content, err := src.ReadFile(ctx, files[1], ref)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if content != "package sub\n" {
+			t.Errorf("%s: unexpected content %q", ref, content)
+		}
+	}
+
+	if _, err := src.ListFiles(ctx, "", "v3"); err == nil {
+		t.Error("expected an error for a ref without archive")
+	}
+	if _, err := ExtractGoFiles([]byte("not an archive")); err == nil {
+		t.Error("expected an error for an unknown format")
+	}
+}
+
+// escapingSource lists a file whose path leaves the repository.
+type escapingSource struct{}
+
+func (escapingSource) ListFiles(ctx context.Context, dir, ref string) ([]SourceFile, error) {
+	return []SourceFile{{Path: "../../x.go"}}, nil
+}
+
+func (escapingSource) ReadFile(ctx context.Context, file SourceFile, ref string) (string, error) {
+	return "package x\n", nil
+}
+
+func TestArchiveEntriesOutsideRoot(t *testing.T) {
+	for _, files := range []map[string]string{
+		{"repo/a.go": "package a\n", "../../x.go": "package x\n"},
+		{"repo/a.go": "package a\n", "repo/../../x.go": "package x\n"},
+		{"/tmp/x.go": "package x\n"},
+	} {
+		if _, err := ExtractGoFiles(buildZip(t, files)); err == nil {
+			t.Errorf("zip with entries %v accepted", files)
+		}
+		if _, err := ExtractGoFiles(buildTarGz(t, files)); err == nil {
+			t.Errorf("tarball with entries %v accepted", files)
+		}
+	}
+
+	// Paths leaving the output directory are skipped by the pipeline as well
+	p := newTestProcessor(t, Options{OutputDir: filepath.Join(t.TempDir(), "out", "deep")})
+	skipped, err := p.ProcessRepo(context.Background(), escapingSource{}, "", "v1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(skipped) != 1 || skipped[0].Path != "../../x.go" {
+		t.Errorf("unexpected skipped files %v", skipped)
+	}
+	if _, err := os.Stat(filepath.Join(p.OutputDir(), "..", "x.go.json")); !os.IsNotExist(err) {
+		t.Errorf("json written outside of the output directory: %v", err)
+	}
+}
+
+func TestArchiveWrapperDirectory(t *testing.T) {
+	// Go code living in a single directory of an archive without wrapper keeps its path
+	entries, err := ExtractGoFiles(buildZip(t, map[string]string{
+		"go.mod":   "module example.com/m\n",
+		"pkg/a.go": "package pkg\n",
+		"pkg/b.go": "package pkg\n",
+	}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := entries["pkg/a.go"]; !ok || len(entries) != 2 {
+		t.Errorf("unexpected entries %v", entries)
+	}
+
+	// The global header and directories of a GitHub tarball do not hide its wrapper
+	var buf bytes.Buffer
+	gz := gzip.NewWriter(&buf)
+	tw := tar.NewWriter(gz)
+	headers := []*tar.Header{
+		{Name: "pax_global_header", Typeflag: tar.TypeXGlobalHeader, PAXRecords: map[string]string{"comment": "1234567"}},
+		{Name: "owner-repo-1234567/", Typeflag: tar.TypeDir, Mode: 0755},
+		{Name: "owner-repo-1234567/go.mod", Typeflag: tar.TypeReg, Mode: 0644},
+		{Name: "owner-repo-1234567/pkg/a.go", Typeflag: tar.TypeReg, Mode: 0644},
+	}
+	for _, header := range headers {
+		if err := tw.WriteHeader(header); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := tw.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if err := gz.Close(); err != nil {
+		t.Fatal(err)
+	}
+	entries, err = ExtractGoFiles(buf.Bytes())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := entries["pkg/a.go"]; !ok || len(entries) != 1 {
+		t.Errorf("unexpected entries %v", entries)
+	}
+}
+
+func TestArchiveSourceLoadsRefsApart(t *testing.T) {
+	data := buildZip(t, archiveFiles)
+	started := make(chan struct{})
+	var slowCalls atomic.Int32
+	src := NewArchiveSource(func(ctx context.Context, ref string) ([]byte, error) {
+		// The first download of slow lasts until its caller gives up
+		if ref == "slow" && slowCalls.Add(1) == 1 {
+			close(started)
+			<-ctx.Done()
+			return nil, ctx.Err()
+		}
+		return data, nil
+	})
+
+	slowCtx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	cancelled := make(chan error)
+	go func() {
+		_, err := src.ListFiles(slowCtx, "", "slow")
+		cancelled <- err
+	}()
+	<-started
+	waiter := make(chan error)
+	go func() {
+		_, err := src.ListFiles(context.Background(), "", "slow")
+		waiter <- err
+	}()
+
+	// Other refs load while slow is downloaded
+	done := make(chan error)
+	go func() {
+		_, err := src.ListFiles(context.Background(), "", "fast")
+		done <- err
+	}()
+	select {
+	case err := <-done:
+		if err != nil {
+			t.Fatal(err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("fast waited for the download of slow")
+	}
+
+	// Cancelling the download fails its caller only, the waiter downloads slow again
+	cancel()
+	if err := <-cancelled; err != context.Canceled {
+		t.Errorf("cancelled ListFiles = %v", err)
+	}
+	if err := <-waiter; err != nil {
+		t.Errorf("waiting ListFiles = %v", err)
+	}
+	if calls := slowCalls.Load(); calls != 2 {
+		t.Errorf("slow downloaded %d times", calls)
+	}
+}
@@ -0,0 +1,217 @@
+package processors
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	astjson "GoOperatorAST/ast_json"
+)
+
+// cacheFormat is part of every JSON cache key, bump it when the JSON layout changes.
+const cacheFormat = 3
+
+// BlobCache is an on-disk cache shared across tags and runs. Raw sources are
+// keyed by their git blob SHA, marshalled JSON and its FileInfo summary by blob
+// SHA and path plus the astjson.Options used to produce it. Identical files in
+// different tags are therefore fetched and parsed only once.
+//
+// Layout:
+//
+//	<dir>/src/<sha[:2]>/<sha>
+//	<dir>/json/<options>/<key[:2]>/<key>.json
+//	<dir>/json/<options>/<key[:2]>/<key>.info.json
+type BlobCache struct {
+	dir      string
+	maxBytes int64
+}
+
+// NewBlobCache creates a cache below dir holding at most maxBytes after GC.
+// A maxBytes of zero or less disables the size cap.
+// @param dir string
+// @param maxBytes int64
+func NewBlobCache(dir string, maxBytes int64) (*BlobCache, error) {
+	err := os.MkdirAll(dir, os.ModePerm)
+	if err != nil {
+		return nil, err
+	}
+	return &BlobCache{dir: dir, maxBytes: maxBytes}, nil
+}
+
+// Source returns the cached raw source of a blob.
+func (c *BlobCache) Source(sha string) (string, bool) {
+	path := c.sourcePath(sha)
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return "", false
+	}
+	touch(path)
+	return string(content), true
+}
+
+// PutSource stores the raw source of a blob.
+func (c *BlobCache) PutSource(sha, content string) error {
+	return writeFileAtomic(c.sourcePath(sha), strings.NewReader(content))
+}
+
+// CopyJSON copies the cached JSON of a blob to output and reports whether it was cached.
+func (c *BlobCache) CopyJSON(sha string, options astjson.Options, output string) (bool, error) {
+	path := c.jsonPath(sha, options)
+	in, err := os.Open(path)
+	if err != nil {
+		return false, nil
+	}
+	defer in.Close()
+	touch(path)
+
+	err = writeFileAtomic(output, in)
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// PutJSON stores the marshalled JSON file of a blob.
+func (c *BlobCache) PutJSON(sha string, options astjson.Options, jsonFile string) error {
+	in, err := os.Open(jsonFile)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+	return writeFileAtomic(c.jsonPath(sha, options), in)
+}
+
+// FileInfo returns the cached summary of the JSON of a blob.
+func (c *BlobCache) FileInfo(sha string, options astjson.Options) (*FileInfo, bool) {
+	path := c.fileInfoPath(sha, options)
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, false
+	}
+	var info FileInfo
+	if json.Unmarshal(data, &info) != nil {
+		return nil, false
+	}
+	touch(path)
+	return &info, true
+}
+
+// PutFileInfo stores the summary of the JSON of a blob.
+func (c *BlobCache) PutFileInfo(sha string, options astjson.Options, info *FileInfo) error {
+	data, err := json.Marshal(info)
+	if err != nil {
+		return err
+	}
+	return writeFileAtomic(c.fileInfoPath(sha, options), bytes.NewReader(data))
+}
+
+// GC removes the least recently used entries until the cache fits its size cap.
+// It returns the number of removed entries and the bytes freed.
+func (c *BlobCache) GC() (int, int64, error) {
+	type entry struct {
+		path    string
+		size    int64
+		modTime time.Time
+	}
+	var entries []entry
+	var total int64
+	err := filepath.WalkDir(c.dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() {
+			return err
+		}
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+		entries = append(entries, entry{path: path, size: info.Size(), modTime: info.ModTime()})
+		total += info.Size()
+		return nil
+	})
+	if err != nil {
+		return 0, 0, err
+	}
+	if c.maxBytes <= 0 || total <= c.maxBytes {
+		return 0, 0, nil
+	}
+
+	// Oldest first, hits refresh the modification time
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].modTime.Before(entries[j].modTime)
+	})
+	removed := 0
+	var freed int64
+	for _, e := range entries {
+		if total-freed <= c.maxBytes {
+			break
+		}
+		err := os.Remove(e.path)
+		if err != nil && !os.IsNotExist(err) {
+			return removed, freed, err
+		}
+		removed++
+		freed += e.size
+	}
+	return removed, freed, nil
+}
+
+func (c *BlobCache) sourcePath(sha string) string {
+	return filepath.Join(c.dir, "src", shard(sha), sha)
+}
+
+func (c *BlobCache) jsonPath(sha string, options astjson.Options) string {
+	return filepath.Join(c.dir, "json", optionsKey(options), shard(sha), sha+".json")
+}
+
+// optionsKey encodes the cache format and marshalling options as a directory name.
+// Compressed JSON is cached as written, apart from the uncompressed one.
+func optionsKey(options astjson.Options) string {
+	key := fmt.Sprintf("v%d-c%d-p%d-r%d-i%d", cacheFormat,
+		bit(options.WithComments), bit(options.WithPositions), bit(options.WithReferences), bit(options.WithImports))
+	if options.Compression != astjson.CompressionNone {
+		key += "-" + options.Compression.String()
+	}
+	return key
+}
+
+func (c *BlobCache) fileInfoPath(sha string, options astjson.Options) string {
+	return filepath.Join(c.dir, "json", optionsKey(options), shard(sha), sha+".info.json")
+}
+
+func shard(sha string) string {
+	if len(sha) < 2 {
+		return "_"
+	}
+	return sha[:2]
+}
+
+func bit(b bool) int {
+	if b {
+		return 1
+	}
+	return 0
+}
+
+// touch marks a cache entry as recently used.
+func touch(path string) {
+	now := time.Now()
+	_ = os.Chtimes(path, now, now)
+}
+
+// writeFileAtomic writes r to a temporary file next to path and renames it into place.
+func writeFileAtomic(path string, r io.Reader) error {
+	err := os.MkdirAll(filepath.Dir(path), os.ModePerm)
+	if err != nil {
+		return err
+	}
+	return astjson.WriteFileAtomic(path, func(w io.Writer) error {
+		_, err := io.Copy(w, r)
+		return err
+	})
+}
@@ -0,0 +1,193 @@
+package processors
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	astjson "GoOperatorAST/ast_json"
+)
+
+func TestBlobCache(t *testing.T) {
+	cache, err := NewBlobCache(t.TempDir(), 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, ok := cache.Source("abc"); ok {
+		t.Fatal("empty cache returned a source")
+	}
+	err = cache.PutSource("abc", "package a\n")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if content, ok := cache.Source("abc"); !ok || content != "package a\n" {
+		t.Fatalf("Source = %q, %t", content, ok)
+	}
+
+	jsonFile := filepath.Join(t.TempDir(), "a.json")
+	err = os.WriteFile(jsonFile, []byte(`{"NodeType":"File"}`), 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = cache.PutJSON("abc", DefaultMarshalOptions, jsonFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	output := filepath.Join(t.TempDir(), "out.json")
+	hit, err := cache.CopyJSON("abc", astjson.Options{WithComments: true}, output)
+	if err != nil || hit {
+		t.Fatalf("CopyJSON with other options = %t, %v", hit, err)
+	}
+	hit, err = cache.CopyJSON("abc", DefaultMarshalOptions, output)
+	if err != nil || !hit {
+		t.Fatalf("CopyJSON = %t, %v", hit, err)
+	}
+	content, err := os.ReadFile(output)
+	if err != nil || string(content) != `{"NodeType":"File"}` {
+		t.Fatalf("copied json %q, %v", content, err)
+	}
+
+	// The summary of a JSON is served without decoding the JSON
+	if _, ok := cache.FileInfo("abc", DefaultMarshalOptions); ok {
+		t.Fatal("summary of a JSON cached without one")
+	}
+	info := &FileInfo{Path: "a.go", Package: "a", Imports: []string{"fmt"}, Exported: []Symbol{{Name: "A", Hash: "1234"}}}
+	err = cache.PutFileInfo("abc", DefaultMarshalOptions, info)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, ok := cache.FileInfo("abc", DefaultMarshalOptions); !ok || !reflect.DeepEqual(got, info) {
+		t.Fatalf("FileInfo = %+v, %t", got, ok)
+	}
+}
+
+func TestBlobCacheGC(t *testing.T) {
+	dir := t.TempDir()
+	cache, err := NewBlobCache(dir, 25)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	old := time.Now().Add(-time.Hour)
+	for i, sha := range []string{"aa01", "aa02", "aa03"} {
+		err = cache.PutSource(sha, "0123456789")
+		if err != nil {
+			t.Fatal(err)
+		}
+		stamp := old.Add(time.Duration(i) * time.Minute)
+		os.Chtimes(cache.sourcePath(sha), stamp, stamp)
+	}
+	// A hit makes the oldest entry the most recently used one
+	cache.Source("aa01")
+
+	removed, freed, err := cache.GC()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if removed != 1 || freed != 10 {
+		t.Errorf("GC removed %d entries and %d bytes", removed, freed)
+	}
+	if _, ok := cache.Source("aa02"); ok {
+		t.Error("least recently used entry survived GC")
+	}
+	if _, ok := cache.Source("aa01"); !ok {
+		t.Error("recently used entry was removed")
+	}
+}
+
+// countingSource counts ReadFile calls of the wrapped Source.
+type countingSource struct {
+	Source
+	reads int32
+}
+
+func (s *countingSource) ReadFile(ctx context.Context, file SourceFile, ref string) (string, error) {
+	atomic.AddInt32(&s.reads, 1)
+	return s.Source.ReadFile(ctx, file, ref)
+}
+
+func TestProcessRepoServesCachedJSON(t *testing.T) {
+	cache, err := NewBlobCache(t.TempDir(), 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	p := newTestProcessor(t, Options{Cache: cache})
+	outputDir := p.OutputDir()
+	err = os.MkdirAll(filepath.Join(outputDir, "v1"), os.ModePerm)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	repoDir := t.TempDir()
+	writeFile(t, repoDir, "sub/a.go", "package sub\n")
+	sha := gitBlobSHA([]byte("package sub\n"))
+	jsonFile := filepath.Join(t.TempDir(), "a.json")
+	err = os.WriteFile(jsonFile, []byte("{}"), 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = cache.PutJSON(jsonCacheKey(sha, "sub/a.go"), p.marshal, jsonFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	src := &countingSource{Source: NewArchiveSource(func(context.Context, string) ([]byte, error) {
+		return buildZip(t, map[string]string{"repo/sub/a.go": "package sub\n"}), nil
+	})}
+	skipped, err := p.ProcessRepo(context.Background(), src, "", "v1")
+	if err != nil || len(skipped) != 0 {
+		t.Fatalf("ProcessRepo = %v, %v", skipped, err)
+	}
+	if src.reads != 0 {
+		t.Errorf("cached file was fetched %d times", src.reads)
+	}
+	if _, err := os.Stat(filepath.Join(outputDir, "v1", "sub", "a.go.json")); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestProcessRepoCachesJSONPerPath(t *testing.T) {
+	cache, err := NewBlobCache(t.TempDir(), 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	p := newTestProcessor(t, Options{Cache: cache})
+	outputDir := p.OutputDir()
+
+	// The same blob found at two paths of two tags keeps the path of each copy
+	content := "package sub\n\nfunc F() {}\n"
+	v1 := t.TempDir()
+	writeFile(t, v1, "a/x.go", content)
+	v2 := t.TempDir()
+	writeFile(t, v2, "a/x.go", content)
+	writeFile(t, v2, "b/x.go", content)
+	for _, run := range []struct{ dir, tag string }{{v1, "v1"}, {v2, "v2"}} {
+		_, err = p.ProcessRepo(context.Background(), NewDirSource(run.dir), "", run.tag)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	for _, path := range []string{"v1/a/x.go", "v2/a/x.go", "v2/b/x.go"} {
+		in, err := astjson.OpenJSON(filepath.Join(outputDir, filepath.FromSlash(path)+".json"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		var node astjson.FileNode
+		err = json.NewDecoder(in).Decode(&node)
+		in.Close()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := path[len("v1/"):]; node.Meta == nil || node.Meta.Path != want {
+			t.Errorf("%s: unexpected meta %+v", path, node.Meta)
+		}
+	}
+}
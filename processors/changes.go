@@ -0,0 +1,178 @@
+package processors
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"go.uber.org/zap"
+)
+
+// Change kinds of a FileChange.
+const (
+	ChangeAdded    = "added"
+	ChangeModified = "modified"
+	ChangeRenamed  = "renamed"
+	ChangeRemoved  = "removed"
+)
+
+// errTooManyChanges is returned when a forge truncates a comparison.
+var errTooManyChanges = errors.New("comparison lists too many files")
+
+// errDivergedRefs is returned by forges comparing from the merge base when the
+// new ref does not descend from the old one.
+var errDivergedRefs = errors.New("refs have diverged")
+
+// FileChange describes a file that differs between two refs.
+type FileChange struct {
+	Status string `json:"Status"`
+	// Path is the path in the new ref, or the removed path.
+	Path string `json:"Path"`
+	// OldPath is the path in the old ref of a renamed file.
+	OldPath string `json:"OldPath,omitempty"`
+}
+
+// ChangeManifest records the changed files a changed-files-only run processed.
+type ChangeManifest struct {
+	Old string `json:"Old"`
+	New string `json:"New"`
+	// OldRef and NewRef pin Old and New to the commits that were compared.
+	OldRef  *ResolvedRef `json:"OldRef,omitempty"`
+	NewRef  *ResolvedRef `json:"NewRef,omitempty"`
+	Changes []FileChange `json:"Changes"`
+}
+
+// Differ is implemented by sources able to list the files changed between two refs
+// without listing both trees.
+type Differ interface {
+	Diff(ctx context.Context, oldRef, newRef string) ([]FileChange, error)
+}
+
+// DiffRefs returns the changes between oldRef of oldSrc and newRef of newSrc.
+// The Differ of a source is used when both refs come from it, otherwise both
+// trees are listed and compared by blob SHA, which finds no renames.
+// @param ctx context.Context
+// @param oldSrc Source
+// @param newSrc Source
+// @param oldRef string
+// @param newRef string
+func (p *Processor) DiffRefs(ctx context.Context, oldSrc, newSrc Source, oldRef, newRef string) ([]FileChange, error) {
+	if differ, ok := oldSrc.(Differ); ok && oldSrc == newSrc {
+		changes, err := differ.Diff(ctx, oldRef, newRef)
+		switch err {
+		case nil:
+			return changes, nil
+		case errTooManyChanges:
+			p.logger.Warn("Comparison truncated by the forge, comparing trees instead", zap.String("old", oldRef), zap.String("new", newRef))
+		case errDivergedRefs:
+			p.logger.Info("Refs have diverged, comparing trees instead", zap.String("old", oldRef), zap.String("new", newRef))
+		default:
+			return nil, err
+		}
+	}
+
+	oldFiles, err := blobsByPath(ctx, oldSrc, oldRef)
+	if err != nil {
+		return nil, err
+	}
+	newFiles, err := blobsByPath(ctx, newSrc, newRef)
+	if err != nil {
+		return nil, err
+	}
+
+	var changes []FileChange
+	for path, sha := range newFiles {
+		oldSHA, ok := oldFiles[path]
+		switch {
+		case !ok:
+			changes = append(changes, FileChange{Status: ChangeAdded, Path: path})
+		case oldSHA != sha:
+			changes = append(changes, FileChange{Status: ChangeModified, Path: path})
+		}
+	}
+	for path := range oldFiles {
+		if _, ok := newFiles[path]; !ok {
+			changes = append(changes, FileChange{Status: ChangeRemoved, Path: path})
+		}
+	}
+	sortChanges(changes)
+	return changes, nil
+}
+
+// ChangedPaths splits changes into the paths to process for the old and the new ref.
+// @param changes []FileChange
+func ChangedPaths(changes []FileChange) (oldPaths, newPaths map[string]bool) {
+	oldPaths = map[string]bool{}
+	newPaths = map[string]bool{}
+	for _, change := range changes {
+		switch change.Status {
+		case ChangeAdded:
+			newPaths[change.Path] = true
+		case ChangeModified:
+			oldPaths[change.Path] = true
+			newPaths[change.Path] = true
+		case ChangeRenamed:
+			oldPaths[change.OldPath] = true
+			newPaths[change.Path] = true
+		case ChangeRemoved:
+			oldPaths[change.Path] = true
+		}
+	}
+	return oldPaths, newPaths
+}
+
+// GoChanges keeps the changes touching .go files.
+// @param changes []FileChange
+func GoChanges(changes []FileChange) []FileChange {
+	var result []FileChange
+	for _, change := range changes {
+		if strings.HasSuffix(change.Path, ".go") || strings.HasSuffix(change.OldPath, ".go") {
+			result = append(result, change)
+		}
+	}
+	return result
+}
+
+// WriteChangeManifest writes the manifest of a changed-files-only run to changes.json in dir.
+// @param dir string output directory holding the tag directories
+// @param manifest ChangeManifest
+func WriteChangeManifest(dir string, manifest ChangeManifest) error {
+	data, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return err
+	}
+	return writeFileAtomic(filepath.Join(dir, "changes.json"), strings.NewReader(string(data)))
+}
+
+// blobsByPath lists the Go files of ref with their blob SHA, computing missing SHAs from the content.
+func blobsByPath(ctx context.Context, src Source, ref string) (map[string]string, error) {
+	files, err := src.ListFiles(ctx, "", ref)
+	if err != nil {
+		return nil, err
+	}
+	blobs := make(map[string]string, len(files))
+	for _, file := range files {
+		if !strings.HasSuffix(file.Path, ".go") {
+			continue
+		}
+		sha := file.SHA
+		if sha == "" {
+			content, err := src.ReadFile(ctx, file, ref)
+			if err != nil {
+				return nil, err
+			}
+			sha = gitBlobSHA([]byte(content))
+		}
+		blobs[file.Path] = sha
+	}
+	return blobs, nil
+}
+
+func sortChanges(changes []FileChange) {
+	sort.Slice(changes, func(i, j int) bool {
+		return changes[i].Path < changes[j].Path
+	})
+}
@@ -0,0 +1,123 @@
+package processors
+
+import (
+	"context"
+	"fmt"
+	"testing"
+)
+
+func formatChanges(changes []FileChange) string {
+	var result string
+	for _, change := range changes {
+		result += fmt.Sprintf("%s:%s:%s;", change.Status, change.OldPath, change.Path)
+	}
+	return result
+}
+
+func TestGitSourceDiff(t *testing.T) {
+	dir := createGitRepo(t)
+	writeFile(t, dir, "c.go", "package a\n\nfunc C() {}\n")
+	runGit(t, dir, "mv", "sub/b.go", "sub/renamed.go")
+	runGit(t, dir, "rm", "-q", "README.md")
+	runGit(t, dir, "add", "-A")
+	runGit(t, dir, "commit", "-q", "-m", "v3")
+	runGit(t, dir, "tag", "v3")
+
+	src := NewGitSource(dir)
+	changes, err := newTestProcessor(t, Options{}).DiffRefs(context.Background(), src, src, "v1", "v3")
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := "removed::README.md;modified::a.go;added::c.go;renamed:sub/b.go:sub/renamed.go;"
+	if got := formatChanges(changes); got != want {
+		t.Errorf("changes %s, want %s", got, want)
+	}
+
+	changes = GoChanges(changes)
+	oldPaths, newPaths := ChangedPaths(changes)
+	if len(oldPaths) != 2 || !oldPaths["a.go"] || !oldPaths["sub/b.go"] {
+		t.Errorf("unexpected old paths %v", oldPaths)
+	}
+	if len(newPaths) != 3 || !newPaths["a.go"] || !newPaths["c.go"] || !newPaths["sub/renamed.go"] {
+		t.Errorf("unexpected new paths %v", newPaths)
+	}
+}
+
+func TestDiffRefsComparesTrees(t *testing.T) {
+	oldDir, newDir := t.TempDir(), t.TempDir()
+	writeFile(t, oldDir, "same.go", "package a\n")
+	writeFile(t, newDir, "same.go", "package a\n")
+	writeFile(t, oldDir, "changed.go", "package a\n")
+	writeFile(t, newDir, "changed.go", "package a // changed\n")
+	writeFile(t, oldDir, "gone.go", "package a\n")
+	writeFile(t, newDir, "new.go", "package a\n")
+
+	changes, err := newTestProcessor(t, Options{}).DiffRefs(context.Background(), NewDirSource(oldDir), NewDirSource(newDir), "old", "new")
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := "modified::changed.go;removed::gone.go;added::new.go;"
+	if got := formatChanges(changes); got != want {
+		t.Errorf("changes %s, want %s", got, want)
+	}
+}
+
+func TestGitHubSourceDiff(t *testing.T) {
+	fake := newFakeGitHub(map[string]map[string]string{
+		"v1": {"a.go": "package a\n", "b.go": "package a\n"},
+		"v2": {"a.go": "package a // v2\n", "c.go": "package a\n"},
+	})
+	src := NewGitHubSource(fake.start(t), "owner", "repo")
+
+	changes, err := newTestProcessor(t, Options{}).DiffRefs(context.Background(), src, src, "v1", "v2")
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := "modified::a.go;removed::b.go;added::c.go;"
+	if got := formatChanges(changes); got != want {
+		t.Errorf("changes %s, want %s", got, want)
+	}
+	if n := fake.count("/repos/owner/repo/git/trees/v1"); n != 0 {
+		t.Errorf("trees were listed %d times", n)
+	}
+}
+
+func TestDiffRefsDiverged(t *testing.T) {
+	// v1.0.1 is released from a branch of v1.0.0, v2.0.0 from the main branch
+	dir := createGitRepo(t)
+	writeFile(t, dir, "a.go", "package a\n")
+	writeFile(t, dir, "b.go", "package a\n")
+	runGit(t, dir, "add", "-A")
+	runGit(t, dir, "commit", "-q", "-m", "v1.0.0")
+	runGit(t, dir, "tag", "v1.0.0")
+	runGit(t, dir, "checkout", "-q", "-b", "release-1")
+	writeFile(t, dir, "a.go", "package a // fix\n")
+	runGit(t, dir, "commit", "-q", "-a", "-m", "v1.0.1")
+	runGit(t, dir, "tag", "v1.0.1")
+	runGit(t, dir, "checkout", "-q", "-")
+	writeFile(t, dir, "b.go", "package a // v2\n")
+	writeFile(t, dir, "c.go", "package a\n")
+	runGit(t, dir, "add", "-A")
+	runGit(t, dir, "commit", "-q", "-m", "v2.0.0")
+	runGit(t, dir, "tag", "v2.0.0")
+
+	fake := newFakeGitHub(map[string]map[string]string{
+		"v1.0.0": {"a.go": "package a\n", "b.go": "package a\n"},
+		"v1.0.1": {"a.go": "package a // fix\n", "b.go": "package a\n"},
+		"v2.0.0": {"a.go": "package a\n", "b.go": "package a // v2\n", "c.go": "package a\n"},
+	})
+	fake.mergeBases = map[string]string{"v1.0.1...v2.0.0": "v1.0.0"}
+	gitHub := NewGitHubSource(fake.start(t), "owner", "repo")
+
+	// Both backends report the changes from v1.0.1, not from the merge base
+	want := "modified::a.go;modified::b.go;added::c.go;"
+	for _, src := range []Source{NewGitSource(dir), gitHub} {
+		changes, err := newTestProcessor(t, Options{}).DiffRefs(context.Background(), src, src, "v1.0.1", "v2.0.0")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := formatChanges(GoChanges(changes)); got != want {
+			t.Errorf("%T: changes %s, want %s", src, got, want)
+		}
+	}
+}
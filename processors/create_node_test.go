@@ -1,22 +1,54 @@
 package processors
 
 import (
+	astjson "GoOperatorAST/ast_json"
+	"encoding/json"
 	"go.uber.org/zap"
+	"go.uber.org/zap/zaptest/observer"
 	"io/ioutil"
 	"os"
+	"path/filepath"
+	"reflect"
 	"testing"
 )
 
+// writeTagFixture marshals the Go files of this package into a tag directory
+// the same way the file processor does and returns that directory.
+func writeTagFixture(t *testing.T) string {
+	tagDir := filepath.Join(t.TempDir(), "v1.21.0")
+	err := os.MkdirAll(tagDir, os.ModePerm)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	files, err := filepath.Glob("*.go")
+	if err != nil {
+		t.Fatal(err)
+	}
+	options := astjson.Options{
+		WithPositions:  true,
+		WithReferences: true,
+	}
+	for _, file := range files {
+		output := filepath.Join(tagDir, outputName(file, astjson.CompressionNone))
+		err = astjson.SourceToJSON(file, output, "  ", options)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	return tagDir
+}
+
 func TestReadFiles(t *testing.T) {
 
 	l, err := zap.NewDevelopment()
 	if err != nil {
 		panic("Failed to initialize Zap logger")
 	}
-	logger = l
-	defer logger.Sync() // Flushes buffer, if any
+	defer l.Sync() // Flushes buffer, if any
 
-	SetupProcessing("../output_temp", logger)
+	tagDir := writeTagFixture(t)
+	p := newTestProcessor(t, Options{OutputDir: filepath.Dir(tagDir), Logger: l})
 	currDir, err := os.Getwd()
 	if err != nil {
 		t.Errorf("ReadFiles error getting current directory: %v", err)
@@ -24,7 +56,7 @@ func TestReadFiles(t *testing.T) {
 	t.Log("Current Directory", currDir)
 
 	// Call the function being tested
-	err = ReadFiles("../output_temp/v1.21.0")
+	err = p.ReadFiles(tagDir)
 	if err != nil {
 		t.Errorf("ReadFiles returned an error: %v", err)
 	}
@@ -32,7 +64,7 @@ func TestReadFiles(t *testing.T) {
 	// Add more positive and negative test cases as needed
 
 	// Test case: Directory does not exist
-	err = ReadFiles("nonexistent")
+	err = p.ReadFiles("nonexistent")
 	if err == nil {
 		t.Error("ReadFiles(nonexistent) did not return an error")
 	}
@@ -44,8 +76,88 @@ func TestReadFiles(t *testing.T) {
 	}
 	defer os.RemoveAll(emptyDir)
 
-	err = ReadFiles(emptyDir)
+	err = p.ReadFiles(emptyDir)
 	if err != nil {
 		t.Errorf("ReadFiles(%s) returned an error: %v", emptyDir, err)
 	}
 }
+
+// genericDecls returns the declarations of source in the generic form of the
+// JSON the handlers walk, as ReadFiles does.
+func genericDecls(t *testing.T, source string) []interface{} {
+	t.Helper()
+	output := filepath.Join(t.TempDir(), "a.go.json")
+	err := astjson.SourceToJSONWithContent(&source, "a.go", output, "", astjson.Options{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := os.ReadFile(output)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var file map[string]interface{}
+	err = json.Unmarshal(data, &file)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return file["Decls"].([]interface{})
+}
+
+// observeHandlers returns a processor whose handlers record their debug entries in logs.
+func observeHandlers(t *testing.T) (*Processor, *observer.ObservedLogs) {
+	core, logs := observer.New(zap.DebugLevel)
+	return newTestProcessor(t, Options{Logger: zap.New(core)}), logs
+}
+
+// loggedFields returns the fields of the entries logged with message.
+func loggedFields(logs *observer.ObservedLogs, message string) []map[string]interface{} {
+	var fields []map[string]interface{}
+	for _, entry := range logs.FilterMessage(message).All() {
+		fields = append(fields, entry.ContextMap())
+	}
+	return fields
+}
+
+func TestMapTypeHandlerNestedMap(t *testing.T) {
+	decls := genericDecls(t, "package a\n\ntype T struct {\n\tRefs map[string]map[string]string\n}\n")
+	spec := decls[0].(map[string]interface{})["Specs"].([]interface{})[0].(map[string]interface{})
+	fields := spec["Type"].(map[string]interface{})["Fields"].(map[string]interface{})
+	field := fields["List"].([]interface{})[0].(map[string]interface{})
+
+	p, logs := observeHandlers(t)
+	p.MapTypeHandler(field["Type"].(map[string]interface{}), field)
+
+	// The outer map records its key type, the inner one its key and value types
+	want := []map[string]interface{}{
+		{"key type": "string", "names": []interface{}{"Refs"}},
+		{"key type": "string", "value type": "string", "names": []interface{}{"Refs"}},
+	}
+	if got := loggedFields(logs, "TypeSpec->TypeSpec->StructType->MapType"); !reflect.DeepEqual(got, want) {
+		t.Errorf("recorded %v, want %v", got, want)
+	}
+}
+
+func TestHandlersUnnamedFields(t *testing.T) {
+	// Embedded fields and unnamed parameters have no Names
+	decls := genericDecls(t, "package a\n\ntype T struct {\n\tio.Reader\n\t*sync.Mutex\n}\n\nfunc F(int, []string) {}\n")
+	spec := decls[0].(map[string]interface{})["Specs"].([]interface{})[0].(map[string]interface{})
+	fields := spec["Type"].(map[string]interface{})["Fields"].(map[string]interface{})
+
+	p, logs := observeHandlers(t)
+	p.FieldListHandler(fields)
+	want := []map[string]interface{}{{"type": "io"}, {"type": "sync"}}
+	if got := loggedFields(logs, "SelectorExpr->Ident"); !reflect.DeepEqual(got, want) {
+		t.Errorf("embedded fields recorded %v, want %v", got, want)
+	}
+
+	p.FuncDeclHandler(decls[1].(map[string]interface{}))
+	// IdentTypeHandler records the type, FuncDeclHandler the parameter kind
+	want = []map[string]interface{}{{"name": []interface{}{}, "type": "int"}, {}}
+	if got := loggedFields(logs, "FuncDeclHandler->Ident"); !reflect.DeepEqual(got, want) {
+		t.Errorf("unnamed int parameter recorded %v, want %v", got, want)
+	}
+	want = []map[string]interface{}{{"names": []interface{}{}, "type": "string"}}
+	if got := loggedFields(logs, "ArrayType"); !reflect.DeepEqual(got, want) {
+		t.Errorf("unnamed slice parameter recorded %v, want %v", got, want)
+	}
+}
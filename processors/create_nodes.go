@@ -1,52 +1,103 @@
 package processors
 
 import (
+	astjson "GoOperatorAST/ast_json"
+	"errors"
+	"fmt"
 	"github.com/thedevsaddam/gojsonq"
 	"go.uber.org/zap"
-	"io/ioutil"
-	"log"
+	"io/fs"
 	"os"
+	"path/filepath"
+	"sync"
 )
 
-func ReadFiles(dir string) error {
-	files, err := ioutil.ReadDir(dir)
+// ReadFiles analyses the JSON files of a tag directory. The files marshalled
+// according to its manifest are read, or every AST JSON file of a directory
+// without manifest. The files are analysed by the analysis stage of the
+// pipeline and the files that could not be analysed are returned as an error.
+func (p *Processor) ReadFiles(dir string) error {
+	fileNames, err := listOutputFiles(dir)
 	if err != nil {
-		log.Fatal(err)
+		return err
 	}
 
-	//createDir(dir + "/funcs")
+	var wg sync.WaitGroup
+	jobs := make([]*analysisJob, len(fileNames))
+	for i, fileName := range fileNames {
+		jobs[i] = &analysisJob{proc: p, dir: dir, fileName: fileName, wg: &wg}
+		wg.Add(1)
+		p.stages.analysis.submit(jobs[i])
+	}
+	wg.Wait()
 
-	for _, file := range files {
-		fileName := file.Name()
-		//err := GetFunctions(dir, fileName, "funcs")
-		createNodeMap(dir, fileName)
-		if err != nil {
-			logger.Fatal(err.Error())
+	var errs []error
+	for _, job := range jobs {
+		if job.err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", job.fileName, job.err))
 		}
-
 	}
-	return nil
+	return errors.Join(errs...)
 }
 
-var packageArray map[string][]*Node = map[string][]*Node{}
+// listOutputFiles returns the names of the AST JSON files of a tag directory
+// relative to it, from its index, its manifest when it has no index, or the
+// files found below it otherwise.
+func listOutputFiles(dir string) ([]string, error) {
+	index, err := LoadIndex(dir)
+	if err != nil {
+		return nil, err
+	}
+	if len(index.Files) > 0 {
+		return index.Outputs(), nil
+	}
+
+	manifest, err := LoadManifest(dir)
+	if err != nil {
+		return nil, err
+	}
+	var fileNames []string
+	if entries := manifest.Entries(); len(entries) > 0 {
+		for _, entry := range entries {
+			if entry.State == StateMarshalled {
+				fileNames = append(fileNames, entry.Output)
+			}
+		}
+		return fileNames, nil
+	}
 
-type Node struct {
-	parent   *Node
-	children []*Node
+	err = filepath.WalkDir(dir, func(path string, entry fs.DirEntry, err error) error {
+		if err != nil || entry.IsDir() || !isASTFile(entry.Name()) {
+			return err
+		}
+		rel, err := filepath.Rel(dir, path)
+		if err != nil {
+			return err
+		}
+		fileNames = append(fileNames, filepath.ToSlash(rel))
+		return nil
+	})
+	return fileNames, err
 }
 
-func createNodeMap(dir string, filename string) error {
+// createNodeMap runs the handlers over the declarations of a JSON file.
+func (p *Processor) createNodeMap(dir string, filename string) error {
 
 	var packageName map[string]interface{}
 	var decls []interface{}
 
-	jq := gojsonq.New().File(dir + "/" + filename)
-	p := jq.From("Name").Get()
-	packageName = p.(map[string]interface{})
+	in, err := astjson.OpenJSON(filepath.Join(dir, filepath.FromSlash(filename)))
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+	jq := gojsonq.New().Reader(in)
+	name := jq.From("Name").Get()
+	packageName = name.(map[string]interface{})
 	jq.Reset()
 	d := jq.From("Decls").Get()
 	decls = d.([]interface{})
-	logger.Debug("Package name", zap.Any("name", packageName["Name"]))
+	p.logger.Debug("Package name", zap.Any("name", packageName["Name"]))
 
 	for _, decl := range decls {
 		declMap := decl.(map[string]interface{})
@@ -55,20 +106,20 @@ func createNodeMap(dir string, filename string) error {
 
 		switch declType {
 		case "FuncDecl":
-			FuncDeclHandler(declMap)
+			p.FuncDeclHandler(declMap)
 		case "GenDecl":
-			GenDeclHandler(declMap)
+			p.GenDeclHandler(declMap)
 		case "ImportSpec":
-			logger.Debug("ImportSpec", zap.Any("name", declMap["Name"]))
+			p.logger.Debug("ImportSpec", zap.Any("name", declMap["Name"]))
 		case "TypeSpec":
-			logger.Debug("TypeSpec", zap.Any("name", declMap["Name"]))
+			p.logger.Debug("TypeSpec", zap.Any("name", declMap["Name"]))
 		case "ValueSpec":
 			spec := declMap["Specs"].([]interface{})
-			ValueSpecHandler(spec)
+			p.ValueSpecHandler(spec)
 
-			logger.Debug("ValueSpec", zap.Any("name", declMap["Name"]))
+			p.logger.Debug("ValueSpec", zap.Any("name", declMap["Name"]))
 		default:
-			logger.Debug("Unknown", zap.Any("name", declMap["Name"]))
+			p.logger.Debug("Unknown", zap.Any("name", declMap["Name"]))
 		}
 
 	}
@@ -76,8 +127,13 @@ func createNodeMap(dir string, filename string) error {
 	return nil
 }
 
-func GetFunctions(dir string, filename string, tempLocation string) error {
-	jq := gojsonq.New().File(dir + "/" + filename)
+func (p *Processor) GetFunctions(dir string, filename string, tempLocation string) error {
+	in, err := astjson.OpenJSON(dir + "/" + filename)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+	jq := gojsonq.New().Reader(in)
 
 	outFile, err := os.Create(dir + "/" + tempLocation + "/" + filename + "_functions.json")
 	if err != nil {
@@ -89,23 +145,8 @@ func GetFunctions(dir string, filename string, tempLocation string) error {
 	if resjq.Count() > 0 {
 		resjq.Writer(outFile)
 	} else {
-		logger.Info("No functions found in file", zap.String("file", filename))
+		p.logger.Info("No functions found in file", zap.String("file", filename))
 	}
 
 	return nil
 }
-
-func createDir(directory string) {
-	// Check if the directory already exists
-	if _, err := os.Stat(directory); os.IsNotExist(err) {
-		// Create the directory recursively
-		err := os.MkdirAll(directory, os.ModePerm)
-		if err != nil {
-			logger.Error("Error creating directory", zap.Error(err))
-			return
-		}
-		logger.Debug("Directory created successfully.")
-	} else {
-		logger.Debug("Directory already exists.")
-	}
-}
@@ -0,0 +1,73 @@
+package processors
+
+import (
+	"context"
+	"fmt"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+)
+
+// DirSource reads files from a plain directory on disk, such as a working
+// tree or a vendored copy of a module. The ref is ignored since a directory
+// holds a single version.
+type DirSource struct {
+	root string
+}
+
+// NewDirSource creates a Source backed by the directory root.
+// @param root string
+func NewDirSource(root string) *DirSource {
+	return &DirSource{root: root}
+}
+
+// ListFiles walks the directory below dir and returns every regular file,
+// stamped with its size and modification time.
+// Version control metadata directories are skipped.
+func (s *DirSource) ListFiles(ctx context.Context, dir, _ string) ([]SourceFile, error) {
+	var files []SourceFile
+	start := filepath.Join(s.root, filepath.FromSlash(dir))
+	err := filepath.WalkDir(start, func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if d.IsDir() {
+			switch d.Name() {
+			case ".git", ".hg", ".svn":
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if !d.Type().IsRegular() {
+			return nil
+		}
+		rel, err := filepath.Rel(s.root, p)
+		if err != nil {
+			return err
+		}
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+		stamp := fmt.Sprintf("%d-%d", info.Size(), info.ModTime().UnixNano())
+		files = append(files, SourceFile{Path: filepath.ToSlash(rel), Stamp: stamp})
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return files, nil
+}
+
+// ReadFile reads the file from disk.
+func (s *DirSource) ReadFile(_ context.Context, file SourceFile, _ string) (string, error) {
+	content, err := os.ReadFile(filepath.Join(s.root, filepath.FromSlash(path.Clean(file.Path))))
+	if err != nil {
+		return "", err
+	}
+	return string(content), nil
+}
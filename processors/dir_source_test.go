@@ -0,0 +1,38 @@
+package processors
+
+import (
+	"context"
+	"sort"
+	"testing"
+)
+
+func TestDirSource(t *testing.T) {
+	dir := t.TempDir()
+	writeFile(t, dir, "a.go", "package a\n")
+	writeFile(t, dir, "sub/b.go", "package sub\n")
+	writeFile(t, dir, ".git/config", "[core]\n")
+
+	ctx := context.Background()
+	src := NewDirSource(dir)
+
+	files, err := src.ListFiles(ctx, "", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var paths []string
+	for _, file := range files {
+		paths = append(paths, file.Path)
+	}
+	sort.Strings(paths)
+	if len(paths) != 2 || paths[0] != "a.go" || paths[1] != "sub/b.go" {
+		t.Fatalf("unexpected files %v", paths)
+	}
+
+	content, err := src.ReadFile(ctx, SourceFile{Path: "sub/b.go"}, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if content != "package sub\n" {
+		t.Errorf("unexpected content %q", content)
+	}
+}
@@ -2,18 +2,18 @@ package processors
 
 import "go.uber.org/zap"
 
-func FieldListHandler(fieldsMap map[string]interface{}) {
+func (p *Processor) FieldListHandler(fieldsMap map[string]interface{}) {
 	var fields []interface{}
 	if fieldsMap["List"] != nil {
 		fields = fieldsMap["List"].([]interface{})
 	} else {
-		logger.Debug("FieldListHandler->List is nil")
+		p.logger.Debug("FieldListHandler->List is nil")
 		return
 	}
 	for _, field := range fields {
 		fieldMap := field.(map[string]interface{})
 		fieldType := fieldMap["Type"].(map[string]interface{})
-		fieldNames := fieldMap["Names"].([]interface{})
+		fieldNames, _ := fieldMap["Names"].([]interface{})
 		nameList := []string{}
 		if fieldNames != nil {
 			for _, fieldName := range fieldNames {
@@ -22,29 +22,29 @@ func FieldListHandler(fieldsMap map[string]interface{}) {
 		}
 		switch fieldType["NodeType"].(string) {
 		case "Ident":
-			paramNameList, paramTypeList := IdentTypeHandler(fieldMap, fieldType)
-			logger.Debug("FieldListHandler->Ident", zap.Any("names", paramNameList), zap.Any("types", paramTypeList))
+			paramNameList, paramTypeList := p.IdentTypeHandler(fieldMap, fieldType)
+			p.logger.Debug("FieldListHandler->Ident", zap.Any("names", paramNameList), zap.Any("types", paramTypeList))
 		case "SelectorExpr":
-			SelectorExprHandler(fieldType)
+			p.SelectorExprHandler(fieldType)
 		case "StarExpr":
-			StarExprHandler(fieldType, fieldMap)
+			p.StarExprHandler(fieldType, fieldMap)
 		case "ArrayType":
-			ArrayTypeHandler(fieldType, fieldMap)
+			p.ArrayTypeHandler(fieldType, fieldMap)
 		case "MapType":
-			MapTypeHandler(fieldType, fieldMap)
+			p.MapTypeHandler(fieldType, fieldMap)
 		case "FuncType":
-			logger.Debug("FieldListHandler->FuncType", zap.Any("names", nameList))
+			p.logger.Debug("FieldListHandler->FuncType", zap.Any("names", nameList))
 		case "InterfaceType":
-			logger.Debug("FieldListHandler->InterfaceType", zap.Any("names", nameList))
+			p.logger.Debug("FieldListHandler->InterfaceType", zap.Any("names", nameList))
 		case "Ellipsis":
-			logger.Debug("FieldListHandler->Ellipsis", zap.Any("names", nameList))
+			p.logger.Debug("FieldListHandler->Ellipsis", zap.Any("names", nameList))
 		case "ChanType":
-			logger.Debug("FieldListHandler->ChanType", zap.Any("names", nameList))
+			p.logger.Debug("FieldListHandler->ChanType", zap.Any("names", nameList))
 		case "StructType":
-			//FieldListHandler(fieldType)
-			logger.Debug("FieldListHandler->StructType", zap.Any("names", nameList))
+			//p.FieldListHandler(fieldType)
+			p.logger.Debug("FieldListHandler->StructType", zap.Any("names", nameList))
 		default:
-			logger.Debug("FieldListHandler Unknown", zap.Any("type", fieldType["NodeType"]))
+			p.logger.Debug("FieldListHandler Unknown", zap.Any("type", fieldType["NodeType"]))
 		}
 	}
 }
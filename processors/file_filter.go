@@ -0,0 +1,239 @@
+package processors
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"path"
+	"regexp"
+	"strings"
+
+	astjson "GoOperatorAST/ast_json"
+)
+
+// File categories recorded in the Meta of every output JSON.
+const (
+	CategorySource    = "source"
+	CategoryTest      = "test"
+	CategoryVendor    = "vendor"
+	CategoryTestdata  = "testdata"
+	CategoryGenerated = "generated"
+)
+
+// errExcluded is returned for a file the FileFilter rejects once its content is known.
+var errExcluded = errors.New("file excluded by filter")
+
+// generatedPattern is the comment marking generated files, see https://go.dev/s/generatedcode.
+var generatedPattern = regexp.MustCompile(`^// Code generated .* DO NOT EDIT\.$`)
+
+// FileFilter selects the Go files to process. The zero value keeps every Go
+// file except tests, vendored packages, testdata and generated code.
+type FileFilter struct {
+	// Include keeps only the files matching one of the globs, empty keeps every file.
+	Include []string
+	// Exclude drops the files matching one of the globs.
+	Exclude []string
+
+	Tests     bool
+	Vendor    bool
+	Testdata  bool
+	Generated bool
+}
+
+// Validate reports the first malformed glob.
+func (f *FileFilter) Validate() error {
+	for _, pattern := range append(append([]string{}, f.Include...), f.Exclude...) {
+		for _, segment := range strings.Split(pattern, "/") {
+			if _, err := path.Match(segment, ""); err != nil {
+				return fmt.Errorf("invalid glob %q: %w", pattern, err)
+			}
+		}
+	}
+	return nil
+}
+
+// MatchPath reports whether the file at p may be processed, judging by its path only.
+// Globs are matched against the slash separated path from the repository
+// root, "**" matches any number of directories and a glob without a slash
+// matches the file name alone.
+func (f *FileFilter) MatchPath(p string) bool {
+	if !strings.HasSuffix(p, ".go") {
+		return false
+	}
+	if len(f.Include) > 0 && !matchAny(f.Include, p) {
+		return false
+	}
+	if matchAny(f.Exclude, p) {
+		return false
+	}
+	return f.allows(pathCategory(p))
+}
+
+// allows reports whether files of category are processed.
+func (f *FileFilter) allows(category string) bool {
+	switch category {
+	case CategoryTest:
+		return f.Tests
+	case CategoryVendor:
+		return f.Vendor
+	case CategoryTestdata:
+		return f.Testdata
+	case CategoryGenerated:
+		return f.Generated
+	}
+	return true
+}
+
+// pathCategory returns the category of a file as far as its path tells.
+func pathCategory(p string) string {
+	dirs := strings.Split(path.Dir(p), "/")
+	for _, dir := range dirs {
+		if dir == "vendor" {
+			return CategoryVendor
+		}
+	}
+	for _, dir := range dirs {
+		if dir == "testdata" {
+			return CategoryTestdata
+		}
+	}
+	if strings.HasSuffix(p, "_test.go") {
+		return CategoryTest
+	}
+	return CategorySource
+}
+
+// fileCategory returns the category of the file at p holding content.
+func fileCategory(p, content string) string {
+	category := pathCategory(p)
+	if category == CategorySource && isGenerated(content) {
+		return CategoryGenerated
+	}
+	return category
+}
+
+// isGenerated looks for the generated code comment before the package clause.
+// Line and block comments before it are skipped, the comment must be a line of its own.
+func isGenerated(content string) bool {
+	inComment := false
+	scanner := bufio.NewScanner(strings.NewReader(content))
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		for line != "" {
+			if inComment {
+				end := strings.Index(line, "*/")
+				if end < 0 {
+					break
+				}
+				inComment = false
+				line = strings.TrimSpace(line[end+2:])
+				continue
+			}
+			if strings.HasPrefix(line, "/*") {
+				inComment = true
+				line = line[2:]
+				continue
+			}
+			if generatedPattern.MatchString(line) {
+				return true
+			}
+			if !strings.HasPrefix(line, "//") {
+				return false
+			}
+			break
+		}
+	}
+	return false
+}
+
+// readFileMeta returns the Meta recorded in a JSON file, nil when it has none.
+func readFileMeta(jsonFile string) (*astjson.FileMetaNode, error) {
+	header, err := readFileHeader(jsonFile)
+	if err != nil {
+		return nil, err
+	}
+	return header.meta, nil
+}
+
+// fileHeader holds the fields of a JSON file needed without its declarations.
+type fileHeader struct {
+	meta *astjson.FileMetaNode
+	pkg  string
+}
+
+// readFileHeader decodes the Meta and the package name of a JSON file, skipping its other fields.
+func readFileHeader(jsonFile string) (fileHeader, error) {
+	var header fileHeader
+	in, err := astjson.OpenJSON(jsonFile)
+	if err != nil {
+		return header, err
+	}
+	defer in.Close()
+
+	decoder := json.NewDecoder(in)
+	if _, err := decoder.Token(); err != nil {
+		return header, err
+	}
+	for decoder.More() {
+		key, err := decoder.Token()
+		if err != nil {
+			return header, err
+		}
+		switch key {
+		case "Meta":
+			header.meta = &astjson.FileMetaNode{}
+			err = decoder.Decode(header.meta)
+		case "Name":
+			var name struct{ Name string }
+			err = decoder.Decode(&name)
+			header.pkg = name.Name
+			// Meta is written before Name, nothing else is needed
+			return header, err
+		default:
+			var skip json.RawMessage
+			err = decoder.Decode(&skip)
+		}
+		if err != nil {
+			return header, err
+		}
+	}
+	return header, nil
+}
+
+func matchAny(patterns []string, p string) bool {
+	for _, pattern := range patterns {
+		if !strings.Contains(pattern, "/") {
+			if ok, _ := path.Match(pattern, path.Base(p)); ok {
+				return true
+			}
+			continue
+		}
+		if matchSegments(strings.Split(pattern, "/"), strings.Split(p, "/")) {
+			return true
+		}
+	}
+	return false
+}
+
+// matchSegments matches path segments against glob segments, "**" matching zero or more of them.
+func matchSegments(pattern, name []string) bool {
+	for len(pattern) > 0 {
+		if pattern[0] == "**" {
+			for i := 0; i <= len(name); i++ {
+				if matchSegments(pattern[1:], name[i:]) {
+					return true
+				}
+			}
+			return false
+		}
+		if len(name) == 0 {
+			return false
+		}
+		if ok, _ := path.Match(pattern[0], name[0]); !ok {
+			return false
+		}
+		pattern, name = pattern[1:], name[1:]
+	}
+	return len(name) == 0
+}
@@ -0,0 +1,80 @@
+package processors
+
+import (
+	"path/filepath"
+	"testing"
+
+	astjson "GoOperatorAST/ast_json"
+)
+
+func TestFileFilterMatchPath(t *testing.T) {
+	tests := []struct {
+		filter FileFilter
+		path   string
+		want   bool
+	}{
+		{FileFilter{}, "a.go", true},
+		{FileFilter{}, "README.md", false},
+		{FileFilter{}, "a_test.go", false},
+		{FileFilter{Tests: true}, "a_test.go", true},
+		{FileFilter{}, "vendor/x/a.go", false},
+		{FileFilter{Vendor: true}, "vendor/x/a.go", true},
+		{FileFilter{Tests: true}, "vendor/x/a_test.go", false},
+		{FileFilter{}, "pkg/testdata/a.go", false},
+		{FileFilter{Testdata: true}, "pkg/testdata/a.go", true},
+		{FileFilter{Include: []string{"pkg/**"}}, "pkg/sub/a.go", true},
+		{FileFilter{Include: []string{"pkg/**"}}, "cmd/a.go", false},
+		{FileFilter{Include: []string{"pkg/*.go"}}, "pkg/sub/a.go", false},
+		{FileFilter{Exclude: []string{"*_mock.go"}}, "pkg/a_mock.go", false},
+		{FileFilter{Exclude: []string{"**/internal/**"}}, "internal/a.go", false},
+		{FileFilter{Exclude: []string{"**/internal/**"}}, "pkg/internal/x/a.go", false},
+		{FileFilter{Exclude: []string{"**/internal/**"}}, "pkg/a.go", true},
+	}
+	for _, test := range tests {
+		if got := test.filter.MatchPath(test.path); got != test.want {
+			t.Errorf("%+v.MatchPath(%s) = %t, want %t", test.filter, test.path, got, test.want)
+		}
+	}
+
+	if err := (&FileFilter{Exclude: []string{"pkg/[a"}}).Validate(); err == nil {
+		t.Error("malformed glob was accepted")
+	}
+}
+
+func TestFileCategory(t *testing.T) {
+	generated := "// Copyright 2024\n\n// Code generated by protoc-gen-go. DO NOT EDIT.\n\npackage a\n"
+	tests := []struct {
+		path, content, want string
+	}{
+		{"a.go", "package a\n", CategorySource},
+		{"a.go", generated, CategoryGenerated},
+		{"a.go", "package a\n\n// Code generated by hand. DO NOT EDIT.\n", CategorySource},
+		{"a.go", "/*\nCopyright 2024\n*/\n\n// Code generated by controller-gen. DO NOT EDIT.\n\npackage a\n", CategoryGenerated},
+		{"a.go", "/* Copyright 2024 */ /* license */\n// Code generated by controller-gen. DO NOT EDIT.\npackage a\n", CategoryGenerated},
+		{"a.go", "/*\n// Code generated by controller-gen. DO NOT EDIT.\n*/\npackage a\n", CategorySource},
+		{"a.go", "/* license */ package a\n\n// Code generated by hand. DO NOT EDIT.\n", CategorySource},
+		{"a_test.go", generated, CategoryTest},
+		{"vendor/a/a.go", generated, CategoryVendor},
+	}
+	for _, test := range tests {
+		if got := fileCategory(test.path, test.content); got != test.want {
+			t.Errorf("fileCategory(%s) = %s, want %s", test.path, got, test.want)
+		}
+	}
+}
+
+func TestReadFileMeta(t *testing.T) {
+	content := "package a\n\nfunc A() {}\n"
+	output := filepath.Join(t.TempDir(), "a.go.json")
+	err := astjson.SourceToJSONWithMeta(&content, "a.go", output, "  ", DefaultMarshalOptions, &astjson.FileMetaNode{Category: CategoryGenerated})
+	if err != nil {
+		t.Fatal(err)
+	}
+	meta, err := readFileMeta(output)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if meta == nil || meta.Category != CategoryGenerated {
+		t.Errorf("unexpected meta %+v", meta)
+	}
+}
@@ -0,0 +1,509 @@
+package processors
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"go/ast"
+	"go/printer"
+	"go/token"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	astjson "GoOperatorAST/ast_json"
+	"GoOperatorAST/fileinfo"
+	flatbuffers "github.com/google/flatbuffers/go"
+	"go.uber.org/zap"
+)
+
+// FileInfoFile is the name of the file summaries of a tag directory, a
+// GoFileInfos buffer of the fileinfo.fbs schema.
+const FileInfoFile = "fileinfo.fb"
+
+// Symbol is an exported top level name of a file.
+type Symbol struct {
+	// Name is the name of the symbol, Type.Method for methods.
+	Name string
+	Kind fileinfo.DeclKind
+	// Hash changes when the printed declaration does, not when it only moves.
+	Hash string
+	// Signature is the declaration without body on a single line, struct
+	// types keep their exported and embedded fields only.
+	Signature string
+}
+
+// FileInfo summarises a source file, so that its imports and exported names are known without reading its JSON.
+type FileInfo struct {
+	// Path is the slash separated path of the file in its repository.
+	Path       string
+	Package    string
+	Imports    []string
+	Constraint string
+	// Funcs, Methods, Types, Vars and Consts count the names declared by kind.
+	Funcs    int
+	Methods  int
+	Types    int
+	Vars     int
+	Consts   int
+	Exported []Symbol
+	// SHA is the git blob SHA of the content, Size its length in bytes.
+	SHA  string
+	Size int64
+}
+
+// describeFile summarises the marshalled file node of path.
+// The declarations are unmarshalled with options and printed to hash them.
+func describeFile(node *astjson.FileNode, path, sha string, options astjson.Options) (info *FileInfo, err error) {
+	info = &FileInfo{Path: path, SHA: sha}
+	if node.Name != nil {
+		info.Package = node.Name.Name
+	}
+	if node.Meta != nil {
+		info.Constraint = node.Meta.Constraint
+	}
+	if node.FileSet != nil {
+		node.FileSet.Iterate(func(file *token.File) bool {
+			info.Size = int64(file.Size())
+			return false
+		})
+	}
+
+	// The Unmarshaller panics on nodes it cannot convert
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("unable to unmarshal %s: %v", path, r)
+		}
+	}()
+	unmarshaller := astjson.NewUnmarshaller(options)
+	file := unmarshaller.UnmarshalFileNode(node)
+	hash := func(node ast.Node) string {
+		var buf bytes.Buffer
+		if err := printer.Fprint(&buf, unmarshaller.FileSet(), node); err != nil {
+			return ""
+		}
+		return gitBlobSHA(buf.Bytes())[:16]
+	}
+	exported := func(ident *ast.Ident, name string, kind fileinfo.DeclKind, decl ast.Node, signature func() string) {
+		if ident.IsExported() {
+			info.Exported = append(info.Exported, Symbol{Name: name, Kind: kind, Hash: hash(decl), Signature: signature()})
+		}
+	}
+
+	for _, decl := range file.Decls {
+		switch decl := decl.(type) {
+		case *ast.FuncDecl:
+			signature := func() string { return funcSignature(decl) }
+			if decl.Recv != nil && len(decl.Recv.List) > 0 {
+				info.Methods++
+				// Methods of unexported types are not part of the exported API
+				if receiver := receiverName(decl.Recv.List[0].Type); ast.IsExported(receiver) {
+					exported(decl.Name, receiver+"."+decl.Name.Name, fileinfo.DeclKindMethod, decl, signature)
+				}
+			} else {
+				info.Funcs++
+				exported(decl.Name, decl.Name.Name, fileinfo.DeclKindFunc, decl, signature)
+			}
+		case *ast.GenDecl:
+			for _, spec := range decl.Specs {
+				switch spec := spec.(type) {
+				case *ast.ImportSpec:
+					importPath, err := strconv.Unquote(spec.Path.Value)
+					if err != nil {
+						importPath = spec.Path.Value
+					}
+					info.Imports = append(info.Imports, importPath)
+				case *ast.TypeSpec:
+					info.Types++
+					exported(spec.Name, spec.Name.Name, fileinfo.DeclKindType, spec, func() string { return typeSignature(spec) })
+				case *ast.ValueSpec:
+					kind := fileinfo.DeclKindVar
+					if decl.Tok == token.CONST {
+						kind = fileinfo.DeclKindConst
+						info.Consts += len(spec.Names)
+					} else {
+						info.Vars += len(spec.Names)
+					}
+					signature := func() string {
+						if spec.Type == nil {
+							return decl.Tok.String()
+						}
+						return decl.Tok.String() + " " + formatNode(spec.Type)
+					}
+					for _, name := range spec.Names {
+						exported(name, name.Name, kind, spec, signature)
+					}
+				}
+			}
+		}
+	}
+	return info, nil
+}
+
+// funcSignature returns the signature of a function or method.
+func funcSignature(decl *ast.FuncDecl) string {
+	signature := decl.Name.Name + strings.TrimPrefix(formatNode(decl.Type), "func")
+	if decl.Recv == nil || len(decl.Recv.List) == 0 {
+		return "func " + signature
+	}
+	return "func (" + formatNode(decl.Recv.List[0].Type) + ") " + signature
+}
+
+// typeSignature returns the signature of a type, leaving out the unexported fields of structs.
+func typeSignature(spec *ast.TypeSpec) string {
+	signature := "type"
+	if spec.TypeParams != nil {
+		signature += formatNode(spec.TypeParams)
+	}
+	if spec.Assign.IsValid() {
+		signature += " ="
+	}
+	return signature + " " + formatNode(exportedType(spec.Type))
+}
+
+// exportedType returns a struct type reduced to its exported and embedded fields, other types unchanged.
+func exportedType(expr ast.Expr) ast.Expr {
+	structType, ok := expr.(*ast.StructType)
+	if !ok || structType.Fields == nil {
+		return expr
+	}
+	fields := &ast.FieldList{}
+	for _, field := range structType.Fields.List {
+		if len(field.Names) == 0 {
+			fields.List = append(fields.List, &ast.Field{Type: field.Type})
+			continue
+		}
+		var names []*ast.Ident
+		for _, name := range field.Names {
+			if ast.IsExported(name.Name) {
+				names = append(names, ast.NewIdent(name.Name))
+			}
+		}
+		if len(names) > 0 {
+			fields.List = append(fields.List, &ast.Field{Names: names, Type: field.Type, Tag: field.Tag})
+		}
+	}
+	return &ast.StructType{Fields: fields}
+}
+
+// formatNode prints a node on a single line.
+func formatNode(node ast.Node) string {
+	var buf bytes.Buffer
+	err := printer.Fprint(&buf, token.NewFileSet(), node)
+	if err != nil {
+		return ""
+	}
+	return strings.Join(strings.Fields(buf.String()), " ")
+}
+
+// readFileInfo summarises the file of path from its JSON file.
+func readFileInfo(jsonFile, path, sha string, options astjson.Options) (*FileInfo, error) {
+	in, err := astjson.OpenJSON(jsonFile)
+	if err != nil {
+		return nil, err
+	}
+	defer in.Close()
+	var node astjson.FileNode
+	err = json.NewDecoder(in).Decode(&node)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", jsonFile, err)
+	}
+	return describeFile(&node, path, sha, options)
+}
+
+// writeFileInfos writes the summaries of the files of index to the tag directory dir.
+// Summaries come from described, the files of this run, then from the previous
+// summaries when the blob is unchanged, and are read from the JSON otherwise.
+func (p *Processor) writeFileInfos(dir, ref string, index *Index, described map[string]*FileInfo) error {
+	previous, err := LoadFileInfos(dir)
+	if err != nil {
+		p.logger.Warn("Ignoring unreadable file summaries", zap.String("dir", dir), zap.Error(err))
+		previous = &FileInfos{}
+	}
+
+	paths := make([]string, 0, len(index.Files))
+	for path := range index.Files {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+	infos := make([]*FileInfo, 0, len(paths))
+	for _, path := range paths {
+		entry := index.Files[path]
+		info := described[path]
+		if info == nil {
+			if old, ok := previous.File(path); ok && entry.SHA != "" && old.SHA == entry.SHA {
+				info = &old
+			}
+		}
+		if info == nil {
+			info, err = readFileInfo(filepath.Join(dir, filepath.FromSlash(entry.Output)), path, entry.SHA, p.marshal)
+			if err != nil {
+				p.logger.Warn("Unable to summarise file", zap.String("path", path), zap.Error(err))
+				info = &FileInfo{Path: path, Package: entry.Package, SHA: entry.SHA}
+			}
+		}
+		infos = append(infos, info)
+	}
+	return writeFileAtomic(filepath.Join(dir, FileInfoFile), bytes.NewReader(encodeFileInfos(ref, infos)))
+}
+
+// encodeFileInfos encodes the summaries of a tag, which must be sorted by path.
+func encodeFileInfos(ref string, infos []*FileInfo) []byte {
+	builder := flatbuffers.NewBuilder(1024)
+	files := make([]flatbuffers.UOffsetT, len(infos))
+	for i, info := range infos {
+		files[i] = encodeFileInfo(builder, info)
+	}
+	refOffset := builder.CreateString(ref)
+	filesOffset := encodeOffsets(builder, files)
+	fileinfo.GoFileInfosStart(builder)
+	fileinfo.GoFileInfosAddRef(builder, refOffset)
+	fileinfo.GoFileInfosAddFiles(builder, filesOffset)
+	fileinfo.FinishGoFileInfosBuffer(builder, fileinfo.GoFileInfosEnd(builder))
+	return builder.FinishedBytes()
+}
+
+func encodeFileInfo(builder *flatbuffers.Builder, info *FileInfo) flatbuffers.UOffsetT {
+	imports := make([]flatbuffers.UOffsetT, len(info.Imports))
+	for i, importPath := range info.Imports {
+		imports[i] = builder.CreateSharedString(importPath)
+	}
+	symbols := make([]flatbuffers.UOffsetT, len(info.Exported))
+	for i, symbol := range info.Exported {
+		name := builder.CreateString(symbol.Name)
+		hash := builder.CreateString(symbol.Hash)
+		signature := builder.CreateString(symbol.Signature)
+		fileinfo.SymbolStart(builder)
+		fileinfo.SymbolAddName(builder, name)
+		fileinfo.SymbolAddKind(builder, symbol.Kind)
+		fileinfo.SymbolAddHash(builder, hash)
+		fileinfo.SymbolAddSignature(builder, signature)
+		symbols[i] = fileinfo.SymbolEnd(builder)
+	}
+	filename := builder.CreateString(info.Path)
+	pkg := builder.CreateSharedString(info.Package)
+	importsOffset := encodeOffsets(builder, imports)
+	constraint := builder.CreateSharedString(info.Constraint)
+	exported := encodeOffsets(builder, symbols)
+	sha := builder.CreateString(info.SHA)
+
+	fileinfo.GoFileInfoStart(builder)
+	fileinfo.GoFileInfoAddFilename(builder, filename)
+	fileinfo.GoFileInfoAddNumImports(builder, int32(len(info.Imports)))
+	fileinfo.GoFileInfoAddPackage(builder, pkg)
+	fileinfo.GoFileInfoAddImports(builder, importsOffset)
+	fileinfo.GoFileInfoAddConstraint(builder, constraint)
+	fileinfo.GoFileInfoAddFuncs(builder, int32(info.Funcs))
+	fileinfo.GoFileInfoAddMethods(builder, int32(info.Methods))
+	fileinfo.GoFileInfoAddTypes(builder, int32(info.Types))
+	fileinfo.GoFileInfoAddVars(builder, int32(info.Vars))
+	fileinfo.GoFileInfoAddConsts(builder, int32(info.Consts))
+	fileinfo.GoFileInfoAddExported(builder, exported)
+	fileinfo.GoFileInfoAddSha(builder, sha)
+	fileinfo.GoFileInfoAddSize(builder, info.Size)
+	return fileinfo.GoFileInfoEnd(builder)
+}
+
+// encodeOffsets encodes a vector of strings or tables.
+func encodeOffsets(builder *flatbuffers.Builder, offsets []flatbuffers.UOffsetT) flatbuffers.UOffsetT {
+	builder.StartVector(flatbuffers.SizeUOffsetT, len(offsets), flatbuffers.SizeUOffsetT)
+	for i := len(offsets) - 1; i >= 0; i-- {
+		builder.PrependUOffsetT(offsets[i])
+	}
+	return builder.EndVector(len(offsets))
+}
+
+// FileInfos reads the summaries of the files of a tag in place.
+type FileInfos struct {
+	root *fileinfo.GoFileInfos
+}
+
+// LoadFileInfos reads the summaries of the tag directory dir.
+// It returns empty summaries when the directory has none.
+// @param dir string
+func LoadFileInfos(dir string) (*FileInfos, error) {
+	buf, err := os.ReadFile(filepath.Join(dir, FileInfoFile))
+	if errors.Is(err, os.ErrNotExist) {
+		return &FileInfos{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	infos, err := ReadFileInfos(buf)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", filepath.Join(dir, FileInfoFile), err)
+	}
+	return infos, nil
+}
+
+// ReadFileInfos reads the summaries held by buf.
+// @param buf []byte
+func ReadFileInfos(buf []byte) (infos *FileInfos, err error) {
+	// The root offset is followed by the 4 bytes of the file identifier
+	if len(buf) < flatbuffers.SizeUOffsetT+4 || !fileinfo.GoFileInfosBufferHasIdentifier(buf) {
+		return nil, errors.New("not a file summaries buffer")
+	}
+
+	// Accessors panic when reading past a truncated buffer, check every file once
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("malformed file summaries: %v", r)
+		}
+	}()
+	root := fileinfo.GetRootAsGoFileInfos(buf, 0)
+	var file fileinfo.GoFileInfo
+	for i := 0; i < root.FilesLength(); i++ {
+		root.Files(&file, i)
+		decodeFileInfo(&file)
+	}
+	return &FileInfos{root: root}, nil
+}
+
+// Ref returns the ref the summaries were written for.
+func (f *FileInfos) Ref() string {
+	if f.root == nil {
+		return ""
+	}
+	return string(f.root.Ref())
+}
+
+// Paths returns the paths of the summarised files, sorted.
+func (f *FileInfos) Paths() []string {
+	var paths []string
+	f.each(func(file *fileinfo.GoFileInfo) {
+		paths = append(paths, string(file.Filename()))
+	})
+	return paths
+}
+
+// File returns the summary of the file of path.
+// @param path string slash separated path of the file in its repository
+func (f *FileInfos) File(path string) (FileInfo, bool) {
+	var file fileinfo.GoFileInfo
+	if f.root == nil || !f.root.FilesByKey(&file, path) {
+		return FileInfo{}, false
+	}
+	return decodeFileInfo(&file), true
+}
+
+// Importers returns the paths of the files importing importPath, sorted.
+// @param importPath string
+func (f *FileInfos) Importers(importPath string) []string {
+	want := []byte(importPath)
+	var paths []string
+	f.each(func(file *fileinfo.GoFileInfo) {
+		for i := 0; i < file.ImportsLength(); i++ {
+			if bytes.Equal(file.Imports(i), want) {
+				paths = append(paths, string(file.Filename()))
+				return
+			}
+		}
+	})
+	return paths
+}
+
+func (f *FileInfos) each(visit func(file *fileinfo.GoFileInfo)) {
+	if f.root == nil {
+		return
+	}
+	var file fileinfo.GoFileInfo
+	for i := 0; i < f.root.FilesLength(); i++ {
+		f.root.Files(&file, i)
+		visit(&file)
+	}
+}
+
+func decodeFileInfo(file *fileinfo.GoFileInfo) FileInfo {
+	info := FileInfo{
+		Path:       string(file.Filename()),
+		Package:    string(file.Package()),
+		Constraint: string(file.Constraint()),
+		Funcs:      int(file.Funcs()),
+		Methods:    int(file.Methods()),
+		Types:      int(file.Types()),
+		Vars:       int(file.Vars()),
+		Consts:     int(file.Consts()),
+		SHA:        string(file.Sha()),
+		Size:       file.Size(),
+	}
+	for i := 0; i < file.ImportsLength(); i++ {
+		info.Imports = append(info.Imports, string(file.Imports(i)))
+	}
+	var symbol fileinfo.Symbol
+	for i := 0; i < file.ExportedLength(); i++ {
+		file.Exported(&symbol, i)
+		info.Exported = append(info.Exported, Symbol{
+			Name:      string(symbol.Name()),
+			Kind:      symbol.Kind(),
+			Hash:      string(symbol.Hash()),
+			Signature: string(symbol.Signature()),
+		})
+	}
+	return info
+}
+
+// SymbolChange describes an exported symbol that differs between two tags.
+type SymbolChange struct {
+	// Status is ChangeAdded, ChangeModified or ChangeRemoved.
+	Status string
+	Path   string
+	Symbol Symbol
+}
+
+// CompareExported returns the exported symbols added, modified or removed from the tag of from to the one of to,
+// sorted by path and name. Files with the same blob SHA in both are skipped unread.
+// @param from *FileInfos
+// @param to *FileInfos
+func CompareExported(from, to *FileInfos) []SymbolChange {
+	var changes []SymbolChange
+	compare := func(path string, before, after []Symbol) {
+		previous := map[string]Symbol{}
+		for _, symbol := range before {
+			previous[symbol.Name] = symbol
+		}
+		for _, symbol := range after {
+			was, ok := previous[symbol.Name]
+			delete(previous, symbol.Name)
+			switch {
+			case !ok:
+				changes = append(changes, SymbolChange{Status: ChangeAdded, Path: path, Symbol: symbol})
+			case was.Hash != symbol.Hash || was.Kind != symbol.Kind:
+				changes = append(changes, SymbolChange{Status: ChangeModified, Path: path, Symbol: symbol})
+			}
+		}
+		for _, symbol := range previous {
+			changes = append(changes, SymbolChange{Status: ChangeRemoved, Path: path, Symbol: symbol})
+		}
+	}
+
+	var before fileinfo.GoFileInfo
+	to.each(func(after *fileinfo.GoFileInfo) {
+		path := string(after.Filename())
+		if from.root == nil || !from.root.FilesByKey(&before, path) {
+			compare(path, nil, decodeFileInfo(after).Exported)
+			return
+		}
+		if len(after.Sha()) > 0 && bytes.Equal(before.Sha(), after.Sha()) {
+			return
+		}
+		compare(path, decodeFileInfo(&before).Exported, decodeFileInfo(after).Exported)
+	})
+	var gone fileinfo.GoFileInfo
+	from.each(func(before *fileinfo.GoFileInfo) {
+		path := string(before.Filename())
+		if to.root == nil || !to.root.FilesByKey(&gone, path) {
+			compare(path, decodeFileInfo(before).Exported, nil)
+		}
+	})
+
+	sort.Slice(changes, func(i, j int) bool {
+		if changes[i].Path != changes[j].Path {
+			return changes[i].Path < changes[j].Path
+		}
+		return changes[i].Symbol.Name < changes[j].Symbol.Name
+	})
+	return changes
+}
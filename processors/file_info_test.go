@@ -0,0 +1,84 @@
+package processors
+
+import (
+	"context"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"GoOperatorAST/fileinfo"
+)
+
+func TestProcessRepoWritesFileInfos(t *testing.T) {
+	cache, err := NewBlobCache(t.TempDir(), 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	p := newTestProcessor(t, Options{Cache: cache})
+	outputDir := p.OutputDir()
+
+	a := "package a\n\nimport \"fmt\"\n\nconst C, D = 1, 2\n\nvar V int\n\ntype T struct{}\n\nfunc (t *T) M() {}\n\nfunc Exported() { fmt.Println() }\n\nfunc hidden() {}\n"
+	v1 := t.TempDir()
+	writeFile(t, v1, "a/a.go", a)
+	writeFile(t, v1, "b.go", "package main\n\nimport _ \"example.com/a\"\n\nfunc main() {}\n")
+	_, err = p.ProcessRepo(context.Background(), NewDirSource(v1), "", "v1")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// v2 moves T, changes Exported, removes T.M and adds New and c.go
+	v2 := t.TempDir()
+	writeFile(t, v2, "a/a.go", "package a\n\nimport \"fmt\"\n\nfunc New() {}\n\nconst C, D = 1, 2\n\nvar V int\n\ntype T struct{}\n\nfunc Exported() { fmt.Print() }\n\nfunc hidden() {}\n")
+	writeFile(t, v2, "b.go", "package main\n\nimport _ \"example.com/a\"\n\nfunc main() {}\n")
+	writeFile(t, v2, "c.go", "package main\n\nimport \"fmt\"\n\nfunc Hello() { fmt.Println() }\n")
+	_, err = p.ProcessRepo(context.Background(), NewDirSource(v2), "", "v2")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	old, err := LoadFileInfos(filepath.Join(outputDir, "v1"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	info, ok := old.File("a/a.go")
+	if !ok {
+		t.Fatal("a/a.go is not summarised")
+	}
+	if info.Package != "a" || !reflect.DeepEqual(info.Imports, []string{"fmt"}) || info.Size != int64(len(a)) || info.SHA == "" {
+		t.Errorf("unexpected summary %+v", info)
+	}
+	if info.Funcs != 2 || info.Methods != 1 || info.Types != 1 || info.Vars != 1 || info.Consts != 2 {
+		t.Errorf("unexpected declaration counts %+v", info)
+	}
+	if len(info.Exported) != 6 || info.Exported[4].Name != "T.M" || info.Exported[4].Kind != fileinfo.DeclKindMethod || info.Exported[4].Signature != "func (*T) M()" {
+		t.Errorf("unexpected exported symbols %+v", info.Exported)
+	}
+
+	infos, err := LoadFileInfos(filepath.Join(outputDir, "v2"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if infos.Ref() != "v2" || !reflect.DeepEqual(infos.Paths(), []string{"a/a.go", "b.go", "c.go"}) {
+		t.Errorf("unexpected files %s %v", infos.Ref(), infos.Paths())
+	}
+	if paths := infos.Importers("fmt"); !reflect.DeepEqual(paths, []string{"a/a.go", "c.go"}) {
+		t.Errorf("unexpected importers %v", paths)
+	}
+	// b.go is served from the cache
+	if paths := infos.Importers("example.com/a"); !reflect.DeepEqual(paths, []string{"b.go"}) {
+		t.Errorf("unexpected importers %v", paths)
+	}
+
+	var got []string
+	for _, change := range CompareExported(old, infos) {
+		got = append(got, change.Status+" "+change.Path+" "+change.Symbol.Name)
+	}
+	want := []string{"modified a/a.go Exported", "added a/a.go New", "removed a/a.go T.M", "added c.go Hello"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("unexpected changes %v", got)
+	}
+
+	if _, err := ReadFileInfos([]byte("{}")); err == nil {
+		t.Error("malformed buffer accepted")
+	}
+}
@@ -0,0 +1,153 @@
+package processors
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/google/go-github/github"
+	"golang.org/x/oauth2"
+)
+
+// Forges accepted by NewForgeSource.
+const (
+	ForgeGitHub = "github"
+	ForgeGitLab = "gitlab"
+	ForgeGitea  = "gitea"
+)
+
+// ForgeOptions selects a hosted repository and how to reach it.
+type ForgeOptions struct {
+	// Forge is one of ForgeGitHub, ForgeGitLab or ForgeGitea, empty means GitHub.
+	Forge string
+	// BaseURL is the address of a self-hosted instance, empty means the public service.
+	BaseURL string
+	Token   string
+	// Owner is the user, organisation or, on GitLab, the group path of the repository.
+	Owner string
+	Repo  string
+	// Tarball downloads one archive per ref instead of fetching files one by one.
+	Tarball bool
+	// HTTPClient is used for every request, nil means http.DefaultClient.
+	HTTPClient *http.Client
+}
+
+// NewForgeSource creates a Source reading the repository described by opts.
+// @param ctx context.Context
+// @param opts ForgeOptions
+func NewForgeSource(ctx context.Context, opts ForgeOptions) (Source, error) {
+	switch opts.Forge {
+	case "", ForgeGitHub:
+		client, err := newGitHubClient(ctx, opts)
+		if err != nil {
+			return nil, err
+		}
+		if opts.Tarball {
+			return NewGitHubArchiveSource(client, opts.HTTPClient, opts.Owner, opts.Repo), nil
+		}
+		return NewGitHubSource(client, opts.Owner, opts.Repo), nil
+	case ForgeGitLab:
+		src := NewGitLabSource(opts.BaseURL, opts.Token, opts.Owner+"/"+opts.Repo, opts.HTTPClient)
+		if opts.Tarball {
+			return NewArchiveSource(src.Archive), nil
+		}
+		return src, nil
+	case ForgeGitea:
+		src := NewGiteaSource(opts.BaseURL, opts.Token, opts.Owner, opts.Repo, opts.HTTPClient)
+		if opts.Tarball {
+			return NewArchiveSource(src.Archive), nil
+		}
+		return src, nil
+	default:
+		return nil, fmt.Errorf("unknown forge %q", opts.Forge)
+	}
+}
+
+// newGitHubClient creates a client for github.com, or for the GitHub Enterprise
+// server at opts.BaseURL, authenticated with opts.Token.
+func newGitHubClient(ctx context.Context, opts ForgeOptions) (*github.Client, error) {
+	httpClient := opts.HTTPClient
+	if opts.Token != "" {
+		if httpClient != nil {
+			ctx = context.WithValue(ctx, oauth2.HTTPClient, httpClient)
+		}
+		httpClient = oauth2.NewClient(ctx, oauth2.StaticTokenSource(&oauth2.Token{AccessToken: opts.Token}))
+	}
+	if opts.BaseURL == "" {
+		return github.NewClient(httpClient), nil
+	}
+
+	// GitHub Enterprise serves the REST API below /api/v3 and uploads below /api/uploads
+	base := strings.TrimSuffix(strings.TrimSuffix(opts.BaseURL, "/"), "/api/v3")
+	return github.NewEnterpriseClient(base+"/api/v3/", base+"/api/uploads/", httpClient)
+}
+
+// forgeAPI performs authenticated GET requests against a REST API.
+type forgeAPI struct {
+	root       string
+	authHeader string
+	authValue  string
+	httpClient *http.Client
+}
+
+// newForgeAPI returns an API rooted at baseURL+apiPath, baseURL defaulting to
+// fallback. A baseURL already ending in apiPath is accepted as well.
+func newForgeAPI(baseURL, fallback, apiPath string, httpClient *http.Client) *forgeAPI {
+	if baseURL == "" {
+		baseURL = fallback
+	}
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+	base := strings.TrimSuffix(strings.TrimSuffix(baseURL, "/"), apiPath)
+	return &forgeAPI{root: base + apiPath, httpClient: httpClient}
+}
+
+// get requests the API path, which must already be escaped, with query.
+// The caller closes the body of the response.
+func (a *forgeAPI) get(ctx context.Context, path string, query url.Values) (*http.Response, error) {
+	location := a.root + "/" + path
+	if len(query) > 0 {
+		location += "?" + query.Encode()
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, location, nil)
+	if err != nil {
+		return nil, err
+	}
+	if a.authValue != "" {
+		req.Header.Set(a.authHeader, a.authValue)
+	}
+	resp, err := a.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		resp.Body.Close()
+		return nil, fmt.Errorf("GET %s: unexpected status %s", location, resp.Status)
+	}
+	return resp, nil
+}
+
+// getJSON decodes the answer for path into v and returns the response headers.
+func (a *forgeAPI) getJSON(ctx context.Context, path string, query url.Values, v any) (http.Header, error) {
+	resp, err := a.get(ctx, path, query)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	return resp.Header, json.NewDecoder(resp.Body).Decode(v)
+}
+
+// getRaw returns the body of the answer for path.
+func (a *forgeAPI) getRaw(ctx context.Context, path string, query url.Values) ([]byte, error) {
+	resp, err := a.get(ctx, path, query)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	return io.ReadAll(resp.Body)
+}
@@ -0,0 +1,194 @@
+package processors
+
+import (
+	"context"
+	"encoding/base64"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+var forgeFiles = map[string]map[string]string{
+	"v1": {"a.go": "package a\n", "sub/b.go": "package sub\n", "sub/c.go": "package sub\n"},
+	"v2": {"a.go": "package a // v2\n", "sub/b.go": "package sub\n", "sub/d.go": "package sub\n"},
+}
+
+// blobContent looks up a blob of forgeFiles by SHA.
+func blobContent(sha string) (string, bool) {
+	for _, files := range forgeFiles {
+		for _, content := range files {
+			if gitBlobSHA([]byte(content)) == sha {
+				return content, true
+			}
+		}
+	}
+	return "", false
+}
+
+// sortedEntries returns the paths of files in order, so that pages are stable.
+func sortedEntries(files map[string]string) []string {
+	var paths []string
+	for name := range files {
+		paths = append(paths, name)
+	}
+	sort.Strings(paths)
+	return paths
+}
+
+// fakeGitLab serves project group/sub/repo with one tree entry per page.
+func fakeGitLab(t *testing.T) string {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("PRIVATE-TOKEN") != "secret" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		rest, ok := strings.CutPrefix(r.URL.EscapedPath(), "/api/v4/projects/group%2Fsub%2Frepo")
+		if !ok {
+			http.NotFound(w, r)
+			return
+		}
+		query := r.URL.Query()
+		switch {
+		case rest == "":
+			writeJSON(w, map[string]string{"default_branch": "v2"})
+		case rest == "/repository/tree":
+			paths := sortedEntries(forgeFiles[query.Get("ref")])
+			page, _ := strconv.Atoi(query.Get("page"))
+			if page < 1 || page > len(paths) {
+				http.NotFound(w, r)
+				return
+			}
+			if page < len(paths) {
+				w.Header().Set("X-Next-Page", strconv.Itoa(page+1))
+			}
+			path := paths[page-1]
+			writeJSON(w, []map[string]string{
+				{"id": "0000", "type": "tree", "path": "sub"},
+				{"id": gitBlobSHA([]byte(forgeFiles[query.Get("ref")][path])), "type": "blob", "path": path},
+			})
+		case strings.HasPrefix(rest, "/repository/blobs/"):
+			content, ok := blobContent(strings.TrimSuffix(strings.TrimPrefix(rest, "/repository/blobs/"), "/raw"))
+			if !ok {
+				http.NotFound(w, r)
+				return
+			}
+			w.Write([]byte(content))
+		case rest == "/repository/compare":
+			writeJSON(w, map[string]any{"diffs": []map[string]any{
+				{"old_path": "a.go", "new_path": "a.go"},
+				{"old_path": "sub/c.go", "new_path": "sub/d.go", "renamed_file": true},
+			}})
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	t.Cleanup(server.Close)
+	return server.URL
+}
+
+func TestGitLabSource(t *testing.T) {
+	src := NewGitLabSource(fakeGitLab(t), "secret", "group/sub/repo", nil)
+	ctx := context.Background()
+
+	files, err := src.ListFiles(ctx, "", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(files) != 3 || files[0].Path != "a.go" || files[2].Path != "sub/d.go" {
+		t.Fatalf("unexpected files %v", files)
+	}
+	content, err := src.ReadFile(ctx, files[0], "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if content != "package a // v2\n" {
+		t.Errorf("unexpected content %q", content)
+	}
+
+	changes, err := newTestProcessor(t, Options{}).DiffRefs(ctx, src, src, "v1", "v2")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := formatChanges(changes), "modified::a.go;renamed:sub/c.go:sub/d.go;"; got != want {
+		t.Errorf("changes %s, want %s", got, want)
+	}
+}
+
+// fakeGitea serves repository owner/repo with two tree entries per page.
+func fakeGitea(t *testing.T) string {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		rest, ok := strings.CutPrefix(r.URL.Path, "/api/v1/repos/owner/repo")
+		if !ok {
+			http.NotFound(w, r)
+			return
+		}
+		switch {
+		case rest == "":
+			writeJSON(w, map[string]string{"default_branch": "v2"})
+		case strings.HasPrefix(rest, "/git/trees/"):
+			paths := sortedEntries(forgeFiles[strings.TrimPrefix(rest, "/git/trees/")])
+			start := 0
+			if r.URL.Query().Get("page") == "2" {
+				start = 2
+			}
+			var entries []map[string]string
+			for _, path := range paths[start:min(start+2, len(paths))] {
+				entries = append(entries, map[string]string{"path": path, "type": "blob", "sha": gitBlobSHA([]byte(forgeFiles["v1"][path]))})
+			}
+			writeJSON(w, map[string]any{"tree": entries, "truncated": start+2 < len(paths)})
+		case strings.HasPrefix(rest, "/git/blobs/"):
+			content, ok := blobContent(strings.TrimPrefix(rest, "/git/blobs/"))
+			if !ok {
+				http.NotFound(w, r)
+				return
+			}
+			writeJSON(w, map[string]string{"content": base64.StdEncoding.EncodeToString([]byte(content)), "encoding": "base64"})
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	t.Cleanup(server.Close)
+	return server.URL
+}
+
+func TestGiteaSource(t *testing.T) {
+	src := NewGiteaSource(fakeGitea(t)+"/api/v1", "", "owner", "repo", nil)
+	ctx := context.Background()
+
+	files, err := src.ListFiles(ctx, "sub", "v1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(files) != 2 || files[0].Path != "sub/b.go" || files[1].Path != "sub/c.go" {
+		t.Fatalf("unexpected files %v", files)
+	}
+	content, err := src.ReadFile(ctx, files[1], "v1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if content != "package sub\n" {
+		t.Errorf("unexpected content %q", content)
+	}
+}
+
+func TestNewForgeSource(t *testing.T) {
+	ctx := context.Background()
+	src, err := NewForgeSource(ctx, ForgeOptions{Forge: ForgeGitHub, BaseURL: "https://github.example.com/", Owner: "owner", Repo: "repo"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	gh, ok := src.(*GitHubSource)
+	if !ok {
+		t.Fatalf("unexpected source %T", src)
+	}
+	if got := gh.client.BaseURL.String(); got != "https://github.example.com/api/v3/" {
+		t.Errorf("enterprise base URL %s", got)
+	}
+
+	if _, err := NewForgeSource(ctx, ForgeOptions{Forge: "bitbucket"}); err == nil {
+		t.Error("unknown forge was accepted")
+	}
+}
@@ -0,0 +1,168 @@
+package processors
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os/exec"
+	"strings"
+)
+
+// GitSource reads repository content from a local clone or bare repository
+// using the git command line tool. No network access is needed.
+type GitSource struct {
+	dir string
+}
+
+// NewGitSource creates a Source backed by the git repository at dir.
+// dir may point to a working tree or to a bare repository.
+// @param dir string
+func NewGitSource(dir string) *GitSource {
+	return &GitSource{dir: dir}
+}
+
+// ListFiles lists every blob below dir in the tree of ref.
+func (s *GitSource) ListFiles(ctx context.Context, dir, ref string) ([]SourceFile, error) {
+	args := []string{"ls-tree", "-r", "-z", "--full-tree", gitRef(ref)}
+	if dir != "" {
+		args = append(args, "--", dir)
+	}
+	out, err := s.git(ctx, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	var files []SourceFile
+	for _, line := range strings.Split(string(out), "\x00") {
+		if line == "" {
+			continue
+		}
+		// Each entry has the form "<mode> <type> <sha>\t<path>"
+		meta, path, ok := strings.Cut(line, "\t")
+		if !ok {
+			return nil, fmt.Errorf("unexpected ls-tree output %q", line)
+		}
+		fields := strings.Fields(meta)
+		if len(fields) != 3 || fields[1] != "blob" {
+			continue
+		}
+		files = append(files, SourceFile{Path: path, SHA: fields[2]})
+	}
+	return files, nil
+}
+
+// ReadFile returns the content of the blob, looked up by SHA when known.
+func (s *GitSource) ReadFile(ctx context.Context, file SourceFile, ref string) (string, error) {
+	object := file.SHA
+	if object == "" {
+		object = gitRef(ref) + ":" + file.Path
+	}
+	out, err := s.git(ctx, "cat-file", "blob", object)
+	if err != nil {
+		return "", err
+	}
+	return string(out), nil
+}
+
+// Diff lists the files changed between two refs with git diff --name-status.
+func (s *GitSource) Diff(ctx context.Context, oldRef, newRef string) ([]FileChange, error) {
+	out, err := s.git(ctx, "diff", "--name-status", "-M", "-z", gitRef(oldRef), gitRef(newRef))
+	if err != nil {
+		return nil, err
+	}
+	return parseNameStatus(string(out))
+}
+
+// parseNameStatus reads the output of git diff --name-status -z.
+func parseNameStatus(out string) ([]FileChange, error) {
+	if len(out) == 0 {
+		return nil, nil
+	}
+
+	// Each entry is "<status>\x00<path>\x00", renames and copies carry a second path
+	fields := strings.Split(strings.TrimSuffix(out, "\x00"), "\x00")
+	var changes []FileChange
+	for i := 0; i+1 < len(fields); i += 2 {
+		status := fields[i]
+		switch status[0] {
+		case 'A':
+			changes = append(changes, FileChange{Status: ChangeAdded, Path: fields[i+1]})
+		case 'D':
+			changes = append(changes, FileChange{Status: ChangeRemoved, Path: fields[i+1]})
+		case 'R', 'C':
+			if i+2 >= len(fields) {
+				return nil, fmt.Errorf("unexpected diff output for %q", fields[i+1])
+			}
+			if status[0] == 'R' {
+				changes = append(changes, FileChange{Status: ChangeRenamed, OldPath: fields[i+1], Path: fields[i+2]})
+			} else {
+				// The copied file is left as it was, its copy is new
+				changes = append(changes, FileChange{Status: ChangeAdded, Path: fields[i+2]})
+			}
+			i++
+		default:
+			changes = append(changes, FileChange{Status: ChangeModified, Path: fields[i+1]})
+		}
+	}
+	sortChanges(changes)
+	return changes, nil
+}
+
+// Tags lists the tags of the repository with git tag.
+func (s *GitSource) Tags(ctx context.Context) ([]string, error) {
+	out, err := s.git(ctx, "tag", "--list")
+	if err != nil {
+		return nil, err
+	}
+	return strings.Fields(string(out)), nil
+}
+
+// ResolveRef pins ref to a commit, an empty ref resolves to the branch checked out.
+// Refs that are neither tags nor local or remote branches, such as HEAD~1, resolve to commits.
+func (s *GitSource) ResolveRef(ctx context.Context, ref string) (ResolvedRef, error) {
+	name := ref
+	if name == "" {
+		// A detached HEAD has no branch name and resolves to its commit
+		out, err := s.git(ctx, "symbolic-ref", "-q", "--short", "HEAD")
+		if err == nil {
+			name = strings.TrimSpace(string(out))
+		}
+	}
+	out, err := s.git(ctx, "rev-parse", "--verify", "-q", gitRef(name)+"^{commit}")
+	if err != nil {
+		return ResolvedRef{}, fmt.Errorf("resolving %q: %w", gitRef(ref), err)
+	}
+	commit := strings.TrimSpace(string(out))
+
+	_, err = s.git(ctx, "show-ref", "--verify", "-q", "refs/tags/"+name)
+	isTag := name != "" && err == nil
+	resolved := commitRef(ref, name, commit, isTag)
+	if resolved.Kind == RefBranch {
+		_, headErr := s.git(ctx, "show-ref", "--verify", "-q", "refs/heads/"+name)
+		_, remoteErr := s.git(ctx, "show-ref", "--verify", "-q", "refs/remotes/"+name)
+		if headErr != nil && remoteErr != nil {
+			resolved.Kind, resolved.Name = RefCommit, commit
+		}
+	}
+	return resolved, nil
+}
+
+// git runs a git command against the repository and returns its standard output.
+func (s *GitSource) git(ctx context.Context, args ...string) ([]byte, error) {
+	cmd := exec.CommandContext(ctx, "git", append([]string{"-C", s.dir}, args...)...)
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+	out, err := cmd.Output()
+	if err != nil {
+		return nil, fmt.Errorf("git %s: %w: %s", args[0], err, strings.TrimSpace(stderr.String()))
+	}
+	return out, nil
+}
+
+// gitRef maps an empty ref to HEAD.
+func gitRef(ref string) string {
+	if ref == "" {
+		return "HEAD"
+	}
+	return ref
+}
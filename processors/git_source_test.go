@@ -0,0 +1,123 @@
+package processors
+
+import (
+	"context"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+// runGit runs a git command in dir and fails the test on error.
+func runGit(t *testing.T, dir string, args ...string) {
+	t.Helper()
+	cmd := exec.Command("git", append([]string{"-C", dir, "-c", "user.name=test", "-c", "user.email=test@example.com"}, args...)...)
+	out, err := cmd.CombinedOutput()
+	if err != nil {
+		t.Fatalf("git %v: %v: %s", args, err, out)
+	}
+}
+
+// writeFile writes content to dir/name, creating parent directories.
+func writeFile(t *testing.T, dir, name, content string) {
+	t.Helper()
+	path := filepath.Join(dir, filepath.FromSlash(name))
+	err := os.MkdirAll(filepath.Dir(path), os.ModePerm)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = os.WriteFile(path, []byte(content), 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+// createGitRepo creates a repository with a v1 and a v2 tag.
+func createGitRepo(t *testing.T) string {
+	t.Helper()
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git is not installed")
+	}
+	dir := t.TempDir()
+	runGit(t, dir, "init", "-q")
+	writeFile(t, dir, "a.go", "package a\n\nfunc A() {}\n")
+	writeFile(t, dir, "sub/b.go", "package sub\n\nfunc B() {}\n")
+	writeFile(t, dir, "README.md", "readme\n")
+	runGit(t, dir, "add", "-A")
+	runGit(t, dir, "commit", "-q", "-m", "v1")
+	runGit(t, dir, "tag", "v1")
+	writeFile(t, dir, "a.go", "package a\n\nfunc A(x int) {}\n")
+	runGit(t, dir, "commit", "-q", "-a", "-m", "v2")
+	runGit(t, dir, "tag", "v2")
+	return dir
+}
+
+func TestGitSource(t *testing.T) {
+	dir := createGitRepo(t)
+	bare := filepath.Join(t.TempDir(), "bare.git")
+	runGit(t, dir, "clone", "-q", "--bare", dir, bare)
+
+	for name, repoDir := range map[string]string{"worktree": dir, "bare": bare} {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+			src := NewGitSource(repoDir)
+
+			files, err := src.ListFiles(ctx, "", "v1")
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(files) != 3 {
+				t.Fatalf("expected 3 files, got %v", files)
+			}
+
+			files, err = src.ListFiles(ctx, "sub", "v1")
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(files) != 1 || files[0].Path != "sub/b.go" || files[0].SHA == "" {
+				t.Fatalf("unexpected files below sub: %v", files)
+			}
+
+			for ref, want := range map[string]string{
+				"v1": "package a\n\nfunc A() {}\n",
+				"v2": "package a\n\nfunc A(x int) {}\n",
+			} {
+				content, err := src.ReadFile(ctx, SourceFile{Path: "a.go"}, ref)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if content != want {
+					t.Errorf("ReadFile(a.go, %s) = %q, want %q", ref, content, want)
+				}
+			}
+
+			_, err = src.ListFiles(ctx, "", "does-not-exist")
+			if err == nil {
+				t.Error("expected an error for an unknown ref")
+			}
+		})
+	}
+}
+
+func TestParseNameStatus(t *testing.T) {
+	out := "M\x00a.go\x00R095\x00old.go\x00new.go\x00C080\x00src.go\x00copy.go\x00D\x00gone.go\x00A\x00added.go\x00"
+	changes, err := parseNameStatus(out)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []FileChange{
+		{Status: ChangeModified, Path: "a.go"},
+		{Status: ChangeAdded, Path: "added.go"},
+		{Status: ChangeAdded, Path: "copy.go"},
+		{Status: ChangeRemoved, Path: "gone.go"},
+		{Status: ChangeRenamed, OldPath: "old.go", Path: "new.go"},
+	}
+	if !reflect.DeepEqual(changes, want) {
+		t.Errorf("parseNameStatus = %+v, want %+v", changes, want)
+	}
+
+	if _, err := parseNameStatus("C100\x00src.go\x00"); err == nil {
+		t.Error("a copy without destination was accepted")
+	}
+}
@@ -0,0 +1,178 @@
+package processors
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// giteaPageSize is the number of tree entries requested per page, Gitea caps
+// it to the MAX_RESPONSE_ITEMS setting of the instance.
+const giteaPageSize = 1000
+
+// GiteaSource reads repository content through the v1 API of Gitea, Forgejo
+// or Codeberg. It has no Diff, changed files are found by comparing trees.
+type GiteaSource struct {
+	api  *forgeAPI
+	repo string
+}
+
+// NewGiteaSource creates a Source backed by the Gitea repository owner/repo.
+// @param baseURL string address of the instance, empty means https://gitea.com
+// @param token string access token, may be empty
+// @param owner string
+// @param repo string
+// @param httpClient *http.Client nil means http.DefaultClient
+func NewGiteaSource(baseURL, token, owner, repo string, httpClient *http.Client) *GiteaSource {
+	api := newForgeAPI(baseURL, "https://gitea.com", "/api/v1", httpClient)
+	api.authHeader = "Authorization"
+	if token != "" {
+		api.authValue = "token " + token
+	}
+	return &GiteaSource{
+		api:  api,
+		repo: "repos/" + url.PathEscape(owner) + "/" + url.PathEscape(repo),
+	}
+}
+
+type giteaTree struct {
+	Tree []struct {
+		Path string `json:"path"`
+		Type string `json:"type"`
+		SHA  string `json:"sha"`
+	} `json:"tree"`
+	Truncated bool `json:"truncated"`
+}
+
+// ListFiles returns every blob of the tree of ref below dir, requesting pages until the tree is complete.
+func (s *GiteaSource) ListFiles(ctx context.Context, dir, ref string) ([]SourceFile, error) {
+	ref, err := s.refOrDefault(ctx, ref)
+	if err != nil {
+		return nil, err
+	}
+
+	prefix := strings.Trim(dir, "/")
+	var files []SourceFile
+	for page := 1; ; page++ {
+		query := url.Values{
+			"recursive": {"true"},
+			"per_page":  {strconv.Itoa(giteaPageSize)},
+			"page":      {strconv.Itoa(page)},
+		}
+		var tree giteaTree
+		_, err := s.api.getJSON(ctx, s.repo+"/git/trees/"+url.PathEscape(ref), query, &tree)
+		if err != nil {
+			return nil, err
+		}
+		for _, entry := range tree.Tree {
+			if entry.Type != "blob" {
+				continue
+			}
+			if prefix == "" || strings.HasPrefix(entry.Path, prefix+"/") {
+				files = append(files, SourceFile{Path: entry.Path, SHA: entry.SHA})
+			}
+		}
+		if !tree.Truncated || len(tree.Tree) == 0 {
+			return files, nil
+		}
+	}
+}
+
+// ReadFile downloads the blob of file, falling back to the raw file API when its SHA is unknown.
+func (s *GiteaSource) ReadFile(ctx context.Context, file SourceFile, ref string) (string, error) {
+	if file.SHA == "" {
+		query := url.Values{}
+		if ref != "" {
+			query.Set("ref", ref)
+		}
+		content, err := s.api.getRaw(ctx, s.repo+"/raw/"+escapePath(file.Path), query)
+		return string(content), err
+	}
+
+	var blob struct {
+		Content  string `json:"content"`
+		Encoding string `json:"encoding"`
+	}
+	_, err := s.api.getJSON(ctx, s.repo+"/git/blobs/"+file.SHA, nil, &blob)
+	if err != nil {
+		return "", err
+	}
+	if blob.Encoding != "base64" {
+		return "", fmt.Errorf("blob %s has unsupported encoding %q", file.SHA, blob.Encoding)
+	}
+	content, err := base64.StdEncoding.DecodeString(blob.Content)
+	return string(content), err
+}
+
+// Archive downloads the tar.gz archive of ref.
+func (s *GiteaSource) Archive(ctx context.Context, ref string) ([]byte, error) {
+	ref, err := s.refOrDefault(ctx, ref)
+	if err != nil {
+		return nil, err
+	}
+	return s.api.getRaw(ctx, s.repo+"/archive/"+url.PathEscape(ref)+".tar.gz", nil)
+}
+
+// Tags lists the tags of the repository, requesting pages until one comes back empty.
+func (s *GiteaSource) Tags(ctx context.Context) ([]string, error) {
+	var tags []string
+	for page := 1; ; page++ {
+		var entries []struct {
+			Name string `json:"name"`
+		}
+		query := url.Values{"page": {strconv.Itoa(page)}, "limit": {"50"}}
+		_, err := s.api.getJSON(ctx, s.repo+"/tags", query, &entries)
+		if err != nil {
+			return nil, err
+		}
+		if len(entries) == 0 {
+			return tags, nil
+		}
+		for _, entry := range entries {
+			tags = append(tags, entry.Name)
+		}
+	}
+}
+
+// ResolveRef pins ref to a commit, an empty ref resolves to the default branch.
+func (s *GiteaSource) ResolveRef(ctx context.Context, ref string) (ResolvedRef, error) {
+	name, err := s.refOrDefault(ctx, ref)
+	if err != nil {
+		return ResolvedRef{}, err
+	}
+	var commit struct {
+		SHA string `json:"sha"`
+	}
+	_, err = s.api.getJSON(ctx, s.repo+"/git/commits/"+url.PathEscape(name), nil, &commit)
+	if err != nil {
+		return ResolvedRef{}, fmt.Errorf("resolving %q: %w", name, err)
+	}
+	var tag struct{}
+	_, err = s.api.getJSON(ctx, s.repo+"/tags/"+url.PathEscape(name), nil, &tag)
+	return commitRef(ref, name, commit.SHA, err == nil), nil
+}
+
+// refOrDefault returns ref, or the default branch of the repository when ref is empty.
+func (s *GiteaSource) refOrDefault(ctx context.Context, ref string) (string, error) {
+	if ref != "" {
+		return ref, nil
+	}
+	var repository struct {
+		DefaultBranch string `json:"default_branch"`
+	}
+	_, err := s.api.getJSON(ctx, s.repo, nil, &repository)
+	return repository.DefaultBranch, err
+}
+
+// escapePath escapes each element of a slash separated path.
+func escapePath(p string) string {
+	parts := strings.Split(p, "/")
+	for i, part := range parts {
+		parts[i] = url.PathEscape(part)
+	}
+	return strings.Join(parts, "/")
+}
@@ -0,0 +1,231 @@
+package processors
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/google/go-github/github"
+)
+
+// GitHubSource reads repository content through the GitHub git data API.
+// The whole tree of a ref is listed with one recursive Git.GetTree call and
+// files are downloaded by blob SHA, which is not subject to the 1 MB limit
+// of the contents API.
+type GitHubSource struct {
+	client *github.Client
+	owner  string
+	repo   string
+}
+
+// NewGitHubSource creates a Source backed by the GitHub repository owner/repo.
+// @param client *github.Client
+// @param owner string
+// @param repo string
+func NewGitHubSource(client *github.Client, owner, repo string) *GitHubSource {
+	return &GitHubSource{
+		client: client,
+		owner:  owner,
+		repo:   repo,
+	}
+}
+
+// ListFiles returns every blob of the tree of ref below dir.
+func (s *GitHubSource) ListFiles(ctx context.Context, dir, ref string) ([]SourceFile, error) {
+	ref, err := s.refOrDefault(ctx, ref)
+	if err != nil {
+		return nil, err
+	}
+
+	tree, _, err := s.client.Git.GetTree(ctx, s.owner, s.repo, ref, true)
+	if err != nil {
+		return nil, err
+	}
+
+	var files []SourceFile
+	if tree.GetTruncated() {
+		// The tree is too large for a single response, walk it one level at a time
+		files, err = s.walkTree(ctx, tree.GetSHA(), "")
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		files = treeBlobs(tree, "")
+	}
+
+	prefix := strings.Trim(dir, "/")
+	if prefix == "" {
+		return files, nil
+	}
+	var filtered []SourceFile
+	for _, file := range files {
+		if strings.HasPrefix(file.Path, prefix+"/") {
+			filtered = append(filtered, file)
+		}
+	}
+	return filtered, nil
+}
+
+// ReadFile downloads the blob of file, falling back to the contents API when its SHA is unknown.
+func (s *GitHubSource) ReadFile(ctx context.Context, file SourceFile, ref string) (string, error) {
+	if file.SHA == "" {
+		fileContent, _, _, err := s.client.Repositories.GetContents(ctx, s.owner, s.repo, file.Path, contentOptions(ref))
+		if err != nil {
+			return "", err
+		}
+		return fileContent.GetContent()
+	}
+
+	content, _, err := s.client.Git.GetBlobRaw(ctx, s.owner, s.repo, file.SHA)
+	if err != nil {
+		return "", err
+	}
+	return string(content), nil
+}
+
+// maxCompareFiles is the number of files after which GitHub truncates a comparison.
+const maxCompareFiles = 300
+
+// compareResponse is the part of a compare API answer used by Diff. It is
+// decoded here because github.CommitFile lacks the previous file name.
+type compareResponse struct {
+	// Status is ahead when the head descends from the base.
+	Status string `json:"status"`
+	Files  []struct {
+		Filename         string `json:"filename"`
+		PreviousFilename string `json:"previous_filename"`
+		Status           string `json:"status"`
+	} `json:"files"`
+}
+
+// Diff lists the files changed between two refs with the compare API.
+func (s *GitHubSource) Diff(ctx context.Context, oldRef, newRef string) ([]FileChange, error) {
+	oldRef, err := s.refOrDefault(ctx, oldRef)
+	if err != nil {
+		return nil, err
+	}
+	newRef, err = s.refOrDefault(ctx, newRef)
+	if err != nil {
+		return nil, err
+	}
+
+	u := fmt.Sprintf("repos/%s/%s/compare/%s...%s", s.owner, s.repo, oldRef, newRef)
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, err
+	}
+	var comparison compareResponse
+	_, err = s.client.Do(ctx, req, &comparison)
+	if err != nil {
+		return nil, err
+	}
+	// The files are compared to the merge base, which is oldRef only when newRef descends from it
+	if comparison.Status != "ahead" && comparison.Status != "identical" {
+		return nil, errDivergedRefs
+	}
+	if len(comparison.Files) >= maxCompareFiles {
+		return nil, errTooManyChanges
+	}
+
+	changes := make([]FileChange, 0, len(comparison.Files))
+	for _, file := range comparison.Files {
+		switch file.Status {
+		case "added", "copied":
+			changes = append(changes, FileChange{Status: ChangeAdded, Path: file.Filename})
+		case "removed":
+			changes = append(changes, FileChange{Status: ChangeRemoved, Path: file.Filename})
+		case "renamed":
+			changes = append(changes, FileChange{Status: ChangeRenamed, Path: file.Filename, OldPath: file.PreviousFilename})
+		case "unchanged":
+		default:
+			changes = append(changes, FileChange{Status: ChangeModified, Path: file.Filename})
+		}
+	}
+	sortChanges(changes)
+	return changes, nil
+}
+
+// Tags lists the tags of the repository, following the pagination of the tags API.
+func (s *GitHubSource) Tags(ctx context.Context) ([]string, error) {
+	var tags []string
+	opts := &github.ListOptions{PerPage: 100}
+	for {
+		page, resp, err := s.client.Repositories.ListTags(ctx, s.owner, s.repo, opts)
+		if err != nil {
+			return nil, err
+		}
+		for _, tag := range page {
+			tags = append(tags, tag.GetName())
+		}
+		if resp.NextPage == 0 {
+			return tags, nil
+		}
+		opts.Page = resp.NextPage
+	}
+}
+
+// ResolveRef pins ref to a commit, an empty ref resolves to the default branch.
+func (s *GitHubSource) ResolveRef(ctx context.Context, ref string) (ResolvedRef, error) {
+	name, err := s.refOrDefault(ctx, ref)
+	if err != nil {
+		return ResolvedRef{}, err
+	}
+	commit, _, err := s.client.Repositories.GetCommitSHA1(ctx, s.owner, s.repo, name, "")
+	if err != nil {
+		return ResolvedRef{}, fmt.Errorf("resolving %q: %w", name, err)
+	}
+	_, _, err = s.client.Git.GetRef(ctx, s.owner, s.repo, "tags/"+name)
+	return commitRef(ref, name, commit, err == nil), nil
+}
+
+// refOrDefault returns ref, or the default branch of the repository when ref is empty.
+func (s *GitHubSource) refOrDefault(ctx context.Context, ref string) (string, error) {
+	if ref != "" {
+		return ref, nil
+	}
+	repository, _, err := s.client.Repositories.Get(ctx, s.owner, s.repo)
+	if err != nil {
+		return "", err
+	}
+	return repository.GetDefaultBranch(), nil
+}
+
+// walkTree lists the blobs of a tree with one non-recursive GetTree call per directory.
+func (s *GitHubSource) walkTree(ctx context.Context, sha, base string) ([]SourceFile, error) {
+	tree, _, err := s.client.Git.GetTree(ctx, s.owner, s.repo, sha, false)
+	if err != nil {
+		return nil, err
+	}
+
+	files := treeBlobs(tree, base)
+	for _, entry := range tree.Entries {
+		if entry.GetType() != "tree" {
+			continue
+		}
+		sub, err := s.walkTree(ctx, entry.GetSHA(), base+entry.GetPath()+"/")
+		if err != nil {
+			return nil, err
+		}
+		files = append(files, sub...)
+	}
+	return files, nil
+}
+
+// treeBlobs returns the blob entries of tree, with base prepended to their path.
+func treeBlobs(tree *github.Tree, base string) []SourceFile {
+	var files []SourceFile
+	for _, entry := range tree.Entries {
+		if entry.GetType() == "blob" {
+			files = append(files, SourceFile{Path: base + entry.GetPath(), SHA: entry.GetSHA()})
+		}
+	}
+	return files
+}
+
+// contentOptions returns the options selecting ref, or nil for the default branch.
+func contentOptions(ref string) *github.RepositoryContentGetOptions {
+	if ref == "" {
+		return nil
+	}
+	return &github.RepositoryContentGetOptions{Ref: ref}
+}
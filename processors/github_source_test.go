@@ -0,0 +1,212 @@
+package processors
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"path"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/google/go-github/github"
+)
+
+// fakeGitHub serves the parts of the GitHub API used by GitHubSource for the
+// repository owner/repo, with one file set per ref.
+type fakeGitHub struct {
+	refs          map[string]map[string]string
+	defaultBranch string
+	// truncated makes recursive tree listings report a truncated result.
+	truncated bool
+	// mergeBases maps "base...head" comparisons of diverged refs to their merge base.
+	mergeBases map[string]string
+
+	mu       sync.Mutex
+	requests map[string]int
+}
+
+func newFakeGitHub(refs map[string]map[string]string) *fakeGitHub {
+	return &fakeGitHub{
+		refs:          refs,
+		defaultBranch: "main",
+		requests:      map[string]int{},
+	}
+}
+
+// start serves the fake API and returns a client talking to it.
+func (f *fakeGitHub) start(t *testing.T) *github.Client {
+	server := httptest.NewServer(f)
+	t.Cleanup(server.Close)
+	client := github.NewClient(server.Client())
+	client.BaseURL, _ = url.Parse(server.URL + "/")
+	return client
+}
+
+// count returns how many requests were made for the API path.
+func (f *fakeGitHub) count(apiPath string) int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.requests[apiPath]
+}
+
+func (f *fakeGitHub) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	f.requests[r.URL.Path]++
+	f.mu.Unlock()
+
+	rest, ok := strings.CutPrefix(r.URL.Path, "/repos/owner/repo")
+	if !ok {
+		http.NotFound(w, r)
+		return
+	}
+	switch {
+	case rest == "":
+		writeJSON(w, map[string]string{"default_branch": f.defaultBranch})
+	case strings.HasPrefix(rest, "/git/trees/"):
+		f.serveTree(w, r, strings.TrimPrefix(rest, "/git/trees/"))
+	case strings.HasPrefix(rest, "/compare/"):
+		f.serveCompare(w, r, strings.TrimPrefix(rest, "/compare/"))
+	case strings.HasPrefix(rest, "/git/blobs/"):
+		sha := strings.TrimPrefix(rest, "/git/blobs/")
+		for _, files := range f.refs {
+			for _, content := range files {
+				if gitBlobSHA([]byte(content)) == sha {
+					w.Write([]byte(content))
+					return
+				}
+			}
+		}
+		http.NotFound(w, r)
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+// serveTree answers recursive listings by ref and non recursive listings by
+// the fake tree SHAs, which encode the ref and directory in hex.
+func (f *fakeGitHub) serveTree(w http.ResponseWriter, r *http.Request, sha string) {
+	ref, dir := sha, ""
+	if decoded, err := hex.DecodeString(sha); err == nil {
+		ref, dir, _ = strings.Cut(string(decoded), ":")
+	}
+	files, ok := f.refs[ref]
+	if !ok {
+		http.NotFound(w, r)
+		return
+	}
+
+	var entries []map[string]string
+	if r.URL.Query().Get("recursive") != "" {
+		for name, content := range files {
+			entries = append(entries, map[string]string{"path": name, "type": "blob", "sha": gitBlobSHA([]byte(content))})
+		}
+	} else {
+		dirs := map[string]bool{}
+		for name, content := range files {
+			rel, ok := strings.CutPrefix(name, dir)
+			if !ok {
+				continue
+			}
+			if top, _, nested := strings.Cut(rel, "/"); nested {
+				dirs[top] = true
+				continue
+			}
+			entries = append(entries, map[string]string{"path": rel, "type": "blob", "sha": gitBlobSHA([]byte(content))})
+		}
+		for top := range dirs {
+			entries = append(entries, map[string]string{"path": top, "type": "tree", "sha": hex.EncodeToString([]byte(ref + ":" + dir + top + "/"))})
+		}
+	}
+	writeJSON(w, map[string]any{
+		"sha":       hex.EncodeToString([]byte(ref + ":")),
+		"tree":      entries,
+		"truncated": f.truncated && r.URL.Query().Get("recursive") != "",
+	})
+}
+
+// serveCompare compares the files of two refs by path, it reports no renames.
+// Like GitHub, diverged refs are compared from their merge base.
+func (f *fakeGitHub) serveCompare(w http.ResponseWriter, r *http.Request, refs string) {
+	base, head, _ := strings.Cut(refs, "...")
+	status := "ahead"
+	if mergeBase, ok := f.mergeBases[refs]; ok {
+		base, status = mergeBase, "diverged"
+	}
+	oldFiles, okOld := f.refs[base]
+	newFiles, okNew := f.refs[head]
+	if !okOld || !okNew {
+		http.NotFound(w, r)
+		return
+	}
+	var files []map[string]string
+	for name, content := range newFiles {
+		if old, ok := oldFiles[name]; !ok {
+			files = append(files, map[string]string{"filename": name, "status": "added"})
+		} else if old != content {
+			files = append(files, map[string]string{"filename": name, "status": "modified"})
+		}
+	}
+	for name := range oldFiles {
+		if _, ok := newFiles[name]; !ok {
+			files = append(files, map[string]string{"filename": name, "status": "removed"})
+		}
+	}
+	writeJSON(w, map[string]any{"status": status, "files": files})
+}
+
+func writeJSON(w http.ResponseWriter, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(v)
+}
+
+func TestGitHubSource(t *testing.T) {
+	fake := newFakeGitHub(map[string]map[string]string{
+		"main": {"a.go": "package a\n", "sub/b.go": "package sub\n", "sub/deep/c.go": "package deep\n"},
+		"v1":   {"a.go": "package a // v1\n"},
+	})
+
+	for _, truncated := range []bool{false, true} {
+		fake.truncated = truncated
+		src := NewGitHubSource(fake.start(t), "owner", "repo")
+		ctx := context.Background()
+
+		files, err := src.ListFiles(ctx, "", "")
+		if err != nil {
+			t.Fatal(err)
+		}
+		var paths []string
+		for _, file := range files {
+			paths = append(paths, file.Path)
+		}
+		sort.Strings(paths)
+		if strings.Join(paths, ",") != "a.go,sub/b.go,sub/deep/c.go" {
+			t.Fatalf("truncated=%t: unexpected files %v", truncated, paths)
+		}
+
+		files, err = src.ListFiles(ctx, "sub", "main")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(files) != 2 {
+			t.Fatalf("truncated=%t: unexpected files below sub %v", truncated, files)
+		}
+		for _, file := range files {
+			content, err := src.ReadFile(ctx, file, "main")
+			if err != nil {
+				t.Fatal(err)
+			}
+			if want := "package " + path.Base(path.Dir(file.Path)) + "\n"; content != want {
+				t.Errorf("ReadFile(%s) = %q, want %q", file.Path, content, want)
+			}
+		}
+	}
+
+	if n := fake.count("/repos/owner/repo/contents/"); n != 0 {
+		t.Errorf("contents API was called %d times", n)
+	}
+}
@@ -0,0 +1,201 @@
+package processors
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// gitlabPageSize is the largest page size accepted by the GitLab API.
+const gitlabPageSize = 100
+
+// GitLabSource reads repository content through the GitLab v4 API of
+// gitlab.com or of a self-hosted instance. Trees are listed recursively and
+// files are downloaded by blob SHA.
+type GitLabSource struct {
+	api     *forgeAPI
+	project string
+}
+
+// NewGitLabSource creates a Source backed by a GitLab project.
+// @param baseURL string address of the instance, empty means https://gitlab.com
+// @param token string personal, project or group access token, may be empty
+// @param project string full path of the project, such as group/subgroup/repo
+// @param httpClient *http.Client nil means http.DefaultClient
+func NewGitLabSource(baseURL, token, project string, httpClient *http.Client) *GitLabSource {
+	api := newForgeAPI(baseURL, "https://gitlab.com", "/api/v4", httpClient)
+	api.authHeader = "PRIVATE-TOKEN"
+	api.authValue = token
+	return &GitLabSource{
+		api:     api,
+		project: "projects/" + url.PathEscape(strings.Trim(project, "/")),
+	}
+}
+
+type gitlabTreeEntry struct {
+	ID   string `json:"id"`
+	Type string `json:"type"`
+	Path string `json:"path"`
+}
+
+// ListFiles returns every blob of the tree of ref below dir, following the pagination of the tree API.
+func (s *GitLabSource) ListFiles(ctx context.Context, dir, ref string) ([]SourceFile, error) {
+	ref, err := s.refOrDefault(ctx, ref)
+	if err != nil {
+		return nil, err
+	}
+
+	query := url.Values{
+		"ref":       {ref},
+		"recursive": {"true"},
+		"per_page":  {strconv.Itoa(gitlabPageSize)},
+	}
+	if prefix := strings.Trim(dir, "/"); prefix != "" {
+		query.Set("path", prefix)
+	}
+
+	var files []SourceFile
+	for page := "1"; page != ""; {
+		query.Set("page", page)
+		var entries []gitlabTreeEntry
+		header, err := s.api.getJSON(ctx, s.project+"/repository/tree", query, &entries)
+		if err != nil {
+			return nil, err
+		}
+		for _, entry := range entries {
+			if entry.Type == "blob" {
+				files = append(files, SourceFile{Path: entry.Path, SHA: entry.ID})
+			}
+		}
+		page = header.Get("X-Next-Page")
+	}
+	return files, nil
+}
+
+// ReadFile downloads the blob of file, falling back to the raw file API when its SHA is unknown.
+func (s *GitLabSource) ReadFile(ctx context.Context, file SourceFile, ref string) (string, error) {
+	if file.SHA != "" {
+		content, err := s.api.getRaw(ctx, s.project+"/repository/blobs/"+file.SHA+"/raw", nil)
+		return string(content), err
+	}
+
+	ref, err := s.refOrDefault(ctx, ref)
+	if err != nil {
+		return "", err
+	}
+	content, err := s.api.getRaw(ctx, s.project+"/repository/files/"+url.PathEscape(file.Path)+"/raw", url.Values{"ref": {ref}})
+	return string(content), err
+}
+
+type gitlabComparison struct {
+	Diffs []struct {
+		OldPath     string `json:"old_path"`
+		NewPath     string `json:"new_path"`
+		NewFile     bool   `json:"new_file"`
+		RenamedFile bool   `json:"renamed_file"`
+		DeletedFile bool   `json:"deleted_file"`
+	} `json:"diffs"`
+	CompareTimeout bool `json:"compare_timeout"`
+}
+
+// Diff lists the files changed between two refs with the compare API.
+func (s *GitLabSource) Diff(ctx context.Context, oldRef, newRef string) ([]FileChange, error) {
+	oldRef, err := s.refOrDefault(ctx, oldRef)
+	if err != nil {
+		return nil, err
+	}
+	newRef, err = s.refOrDefault(ctx, newRef)
+	if err != nil {
+		return nil, err
+	}
+
+	// A straight comparison diffs the two trees as git diff does, not from their merge base
+	var comparison gitlabComparison
+	query := url.Values{"from": {oldRef}, "to": {newRef}, "straight": {"true"}}
+	_, err = s.api.getJSON(ctx, s.project+"/repository/compare", query, &comparison)
+	if err != nil {
+		return nil, err
+	}
+	if comparison.CompareTimeout {
+		return nil, errTooManyChanges
+	}
+
+	changes := make([]FileChange, 0, len(comparison.Diffs))
+	for _, diff := range comparison.Diffs {
+		switch {
+		case diff.NewFile:
+			changes = append(changes, FileChange{Status: ChangeAdded, Path: diff.NewPath})
+		case diff.DeletedFile:
+			changes = append(changes, FileChange{Status: ChangeRemoved, Path: diff.OldPath})
+		case diff.RenamedFile:
+			changes = append(changes, FileChange{Status: ChangeRenamed, Path: diff.NewPath, OldPath: diff.OldPath})
+		default:
+			changes = append(changes, FileChange{Status: ChangeModified, Path: diff.NewPath})
+		}
+	}
+	sortChanges(changes)
+	return changes, nil
+}
+
+// Archive downloads the tar.gz archive of ref.
+func (s *GitLabSource) Archive(ctx context.Context, ref string) ([]byte, error) {
+	query := url.Values{}
+	if ref != "" {
+		query.Set("sha", ref)
+	}
+	return s.api.getRaw(ctx, s.project+"/repository/archive.tar.gz", query)
+}
+
+// Tags lists the tags of the project, following the pagination of the tags API.
+func (s *GitLabSource) Tags(ctx context.Context) ([]string, error) {
+	query := url.Values{"per_page": {strconv.Itoa(gitlabPageSize)}}
+	var tags []string
+	for page := "1"; page != ""; {
+		query.Set("page", page)
+		var entries []struct {
+			Name string `json:"name"`
+		}
+		header, err := s.api.getJSON(ctx, s.project+"/repository/tags", query, &entries)
+		if err != nil {
+			return nil, err
+		}
+		for _, entry := range entries {
+			tags = append(tags, entry.Name)
+		}
+		page = header.Get("X-Next-Page")
+	}
+	return tags, nil
+}
+
+// ResolveRef pins ref to a commit, an empty ref resolves to the default branch.
+func (s *GitLabSource) ResolveRef(ctx context.Context, ref string) (ResolvedRef, error) {
+	name, err := s.refOrDefault(ctx, ref)
+	if err != nil {
+		return ResolvedRef{}, err
+	}
+	var commit struct {
+		ID string `json:"id"`
+	}
+	_, err = s.api.getJSON(ctx, s.project+"/repository/commits/"+url.PathEscape(name), nil, &commit)
+	if err != nil {
+		return ResolvedRef{}, fmt.Errorf("resolving %q: %w", name, err)
+	}
+	var tag struct{}
+	_, err = s.api.getJSON(ctx, s.project+"/repository/tags/"+url.PathEscape(name), nil, &tag)
+	return commitRef(ref, name, commit.ID, err == nil), nil
+}
+
+// refOrDefault returns ref, or the default branch of the project when ref is empty.
+func (s *GitLabSource) refOrDefault(ctx context.Context, ref string) (string, error) {
+	if ref != "" {
+		return ref, nil
+	}
+	var project struct {
+		DefaultBranch string `json:"default_branch"`
+	}
+	_, err := s.api.getJSON(ctx, s.project, nil, &project)
+	return project.DefaultBranch, err
+}
@@ -0,0 +1,128 @@
+package processors
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+
+	"go.uber.org/zap"
+)
+
+// IndexFile is the name of the index of a tag directory.
+const IndexFile = "index.json"
+
+// IndexEntry locates the JSON of a source file and the package it belongs to.
+type IndexEntry struct {
+	// Output is the JSON file relative to the tag directory, slash separated.
+	Output string `json:"Output"`
+	// Dir is the package directory relative to the repository root, "." for the root.
+	Dir     string `json:"Dir"`
+	Package string `json:"Package"`
+	SHA     string `json:"SHA,omitempty"`
+}
+
+// Index maps the source path of every marshalled file of a tag directory to its JSON file.
+// The JSON files mirror the tree of the repository below the tag directory,
+// so the index is the way to find the files of a package without walking it.
+type Index struct {
+	Ref   *ResolvedRef          `json:"Ref,omitempty"`
+	Files map[string]IndexEntry `json:"Files"`
+}
+
+// LoadIndex reads the index of the tag directory dir.
+// It returns an empty index when the directory has none.
+// @param dir string
+func LoadIndex(dir string) (*Index, error) {
+	index := &Index{Files: map[string]IndexEntry{}}
+	data, err := os.ReadFile(filepath.Join(dir, IndexFile))
+	if errors.Is(err, os.ErrNotExist) {
+		return index, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	err = json.Unmarshal(data, index)
+	if err != nil {
+		return nil, err
+	}
+	if index.Files == nil {
+		index.Files = map[string]IndexEntry{}
+	}
+	return index, nil
+}
+
+// Outputs returns the JSON files of the index sorted by source path.
+func (index *Index) Outputs() []string {
+	paths := make([]string, 0, len(index.Files))
+	for p := range index.Files {
+		paths = append(paths, p)
+	}
+	sort.Strings(paths)
+	outputs := make([]string, len(paths))
+	for i, p := range paths {
+		outputs[i] = index.Files[p].Output
+	}
+	return outputs
+}
+
+// Package returns the source paths of the files of the package directory dir, sorted.
+// @param dir string package directory relative to the repository root
+func (index *Index) Package(dir string) []string {
+	var paths []string
+	for p, entry := range index.Files {
+		if entry.Dir == dir {
+			paths = append(paths, p)
+		}
+	}
+	sort.Strings(paths)
+	return paths
+}
+
+// writeIndex writes the index of the files the manifest of dir records as
+// marshalled. Package names and SHAs come from written, the files of this run,
+// then from the previous index when the blob is unchanged, and the package
+// names are read from the JSON otherwise.
+func (p *Processor) writeIndex(dir string, manifest *Manifest, written map[string]IndexEntry) (*Index, error) {
+	previous, err := LoadIndex(dir)
+	if err != nil {
+		p.logger.Warn("Ignoring unreadable index", zap.String("dir", dir), zap.Error(err))
+		previous = &Index{Files: map[string]IndexEntry{}}
+	}
+
+	index := &Index{Ref: manifest.Ref(), Files: map[string]IndexEntry{}}
+	for _, entry := range manifest.Entries() {
+		if entry.State != StateMarshalled {
+			continue
+		}
+		file, ok := written[entry.Path]
+		if !ok {
+			file = IndexEntry{SHA: entry.SHA}
+			// Sources without blob SHAs leave the files resumed unchanged
+			if old, found := previous.Files[entry.Path]; found && old.Output == entry.Output && (entry.SHA == "" || old.SHA == entry.SHA) {
+				file, ok = old, true
+			}
+		}
+		if !ok || file.Package == "" {
+			header, err := readFileHeader(filepath.Join(dir, filepath.FromSlash(entry.Output)))
+			if err != nil {
+				return nil, err
+			}
+			file.Package = header.pkg
+		}
+		if file.SHA == "" {
+			file.SHA = entry.SHA
+		}
+		file.Output, file.Dir = entry.Output, path.Dir(entry.Path)
+		index.Files[entry.Path] = file
+	}
+
+	data, err := json.MarshalIndent(index, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return index, writeFileAtomic(filepath.Join(dir, IndexFile), bytes.NewReader(data))
+}
@@ -0,0 +1,79 @@
+package processors
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestProcessRepoWritesIndex(t *testing.T) {
+	p := newTestProcessor(t, Options{})
+	outputDir := p.OutputDir()
+
+	// Both paths were flattened to a_b_c.go.json by the previous layout
+	dir := t.TempDir()
+	writeFile(t, dir, "a/b_c.go", "package a\n\nfunc A() {}\n")
+	writeFile(t, dir, "a_b/c.go", "package ab\n\nfunc B() {}\n")
+	writeFile(t, dir, "main.go", "package main\n\nfunc main() {}\n")
+
+	_, err := p.ProcessRepo(context.Background(), NewDirSource(dir), "", "v1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	tagDir := filepath.Join(outputDir, "v1")
+	index, err := LoadIndex(tagDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := map[string]IndexEntry{
+		"a/b_c.go": {Output: "a/b_c.go.json", Dir: "a", Package: "a"},
+		"a_b/c.go": {Output: "a_b/c.go.json", Dir: "a_b", Package: "ab"},
+		"main.go":  {Output: "main.go.json", Dir: ".", Package: "main"},
+	}
+	for path, entry := range index.Files {
+		if entry.SHA == "" {
+			t.Errorf("%s has no SHA", path)
+		}
+		entry.SHA = ""
+		index.Files[path] = entry
+	}
+	if !reflect.DeepEqual(index.Files, want) {
+		t.Errorf("unexpected index %+v", index.Files)
+	}
+	if paths := index.Package("a"); !reflect.DeepEqual(paths, []string{"a/b_c.go"}) {
+		t.Errorf("unexpected package files %v", paths)
+	}
+	for _, entry := range want {
+		if _, err := os.Stat(filepath.Join(tagDir, filepath.FromSlash(entry.Output))); err != nil {
+			t.Error(err)
+		}
+	}
+
+	// Resumed files keep their entries
+	resumed := newTestProcessor(t, Options{OutputDir: outputDir, Resume: true})
+	_, err = resumed.ProcessRepo(context.Background(), NewDirSource(dir), "", "v1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	again, err := LoadIndex(tagDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for path, entry := range again.Files {
+		if entry.SHA == "" || entry.Package != want[path].Package {
+			t.Errorf("unexpected resumed entry %s %+v", path, entry)
+		}
+	}
+
+	// ReadFiles reads the files of the index, not whatever lies in the directory
+	err = os.WriteFile(filepath.Join(tagDir, "stale.go.json"), []byte("{}"), 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = p.ReadFiles(tagDir)
+	if err != nil {
+		t.Error(err)
+	}
+}
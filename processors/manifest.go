@@ -0,0 +1,227 @@
+package processors
+
+import (
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// States of a ManifestEntry.
+const (
+	StatePending    = "pending"
+	StateFetched    = "fetched"
+	StateMarshalled = "marshalled"
+	StateFailed     = "failed"
+	// StateInterrupted marks the files left unprocessed when the run was cancelled.
+	StateInterrupted = "interrupted"
+)
+
+// ManifestFile is the name of the manifest inside a tag directory.
+const ManifestFile = "manifest.json"
+
+// manifestSaveInterval throttles the writes of a manifest while files are processed.
+const manifestSaveInterval = time.Second
+
+// ManifestEntry records the progress of one file of a tag.
+type ManifestEntry struct {
+	Path string `json:"Path"`
+	SHA  string `json:"SHA,omitempty"`
+	// Stamp is the SourceFile stamp of files listed without a SHA.
+	Stamp string `json:"Stamp,omitempty"`
+	// Output is the name of the JSON file inside the tag directory.
+	Output string `json:"Output"`
+	State  string `json:"State"`
+	Error  string `json:"Error,omitempty"`
+}
+
+// Manifest is the persistent list of the files of a tag directory and their
+// state. It is written to <output dir>/<tag>/manifest.json while files are
+// processed, so that an interrupted run can be resumed.
+type Manifest struct {
+	path   string
+	logger *zap.Logger
+
+	mu       sync.Mutex
+	ref      *ResolvedRef
+	entries  map[string]*ManifestEntry
+	dirty    bool
+	lastSave time.Time
+}
+
+// manifestJSON is the on-disk form of a Manifest.
+type manifestJSON struct {
+	// Ref is the ref the files were read at and the commit it resolved to.
+	Ref     *ResolvedRef     `json:"Ref,omitempty"`
+	Entries []*ManifestEntry `json:"Entries"`
+}
+
+// NewManifest returns an empty manifest for the tag directory dir.
+// @param dir string
+func NewManifest(dir string) *Manifest {
+	return &Manifest{
+		path:    filepath.Join(dir, ManifestFile),
+		logger:  zap.NewNop(),
+		entries: map[string]*ManifestEntry{},
+	}
+}
+
+// LoadManifest reads the manifest of the tag directory dir.
+// It returns an empty manifest when the directory has none.
+// @param dir string
+func LoadManifest(dir string) (*Manifest, error) {
+	m := NewManifest(dir)
+	data, err := os.ReadFile(m.path)
+	if errors.Is(err, os.ErrNotExist) {
+		return m, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var stored manifestJSON
+	err = json.Unmarshal(data, &stored)
+	if err != nil {
+		return nil, err
+	}
+	m.ref = stored.Ref
+	for _, entry := range stored.Entries {
+		m.entries[entry.Path] = entry
+	}
+	return m, nil
+}
+
+// Ref returns the ref recorded by SetRef, nil when none was.
+func (m *Manifest) Ref() *ResolvedRef {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.ref == nil {
+		return nil
+	}
+	ref := *m.ref
+	return &ref
+}
+
+// SetRef records the ref the files of the tag directory are read at.
+func (m *Manifest) SetRef(ref ResolvedRef) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.ref == nil || *m.ref != ref {
+		m.ref = &ref
+		m.dirty = true
+	}
+}
+
+// Entry returns a copy of the entry of path.
+func (m *Manifest) Entry(path string) (ManifestEntry, bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	entry, ok := m.entries[path]
+	if !ok {
+		return ManifestEntry{}, false
+	}
+	return *entry, true
+}
+
+// Entries returns a copy of every entry, sorted by path.
+func (m *Manifest) Entries() []ManifestEntry {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	entries := make([]ManifestEntry, 0, len(m.entries))
+	for _, entry := range m.entries {
+		entries = append(entries, *entry)
+	}
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].Path < entries[j].Path
+	})
+	return entries
+}
+
+// Done reports whether file was marshalled to output and its output still exists.
+// The file must have the recorded SHA, or stamp when it has none, files with
+// neither cannot be told apart from edited ones and are never done.
+func (m *Manifest) Done(file SourceFile, output string) bool {
+	if file.SHA == "" && file.Stamp == "" {
+		return false
+	}
+	entry, ok := m.Entry(file.Path)
+	// Files written with another layout or compression are converted again
+	if !ok || entry.State != StateMarshalled || entry.SHA != file.SHA || entry.Stamp != file.Stamp || entry.Output != output {
+		return false
+	}
+	_, err := os.Stat(filepath.Join(filepath.Dir(m.path), filepath.FromSlash(entry.Output)))
+	return err == nil
+}
+
+// Set records the state of a file, err is kept as the error of failed files.
+// The manifest is saved when it was last written more than a second ago.
+func (m *Manifest) Set(file SourceFile, output, state string, err error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	entry := &ManifestEntry{Path: file.Path, SHA: file.SHA, Stamp: file.Stamp, Output: output, State: state}
+	if err != nil {
+		entry.Error = err.Error()
+	}
+	m.entries[file.Path] = entry
+	m.dirty = true
+	if time.Since(m.lastSave) > manifestSaveInterval {
+		m.saveLocked()
+	}
+}
+
+// Remove drops the entry of path.
+func (m *Manifest) Remove(path string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	delete(m.entries, path)
+	m.dirty = true
+}
+
+// Retain drops the entries whose path is not in paths.
+func (m *Manifest) Retain(paths map[string]bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for path := range m.entries {
+		if !paths[path] {
+			delete(m.entries, path)
+			m.dirty = true
+		}
+	}
+}
+
+// Save writes the manifest if it changed since it was last written.
+func (m *Manifest) Save() error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if !m.dirty {
+		return nil
+	}
+	return m.saveLocked()
+}
+
+func (m *Manifest) saveLocked() error {
+	stored := manifestJSON{Ref: m.ref, Entries: make([]*ManifestEntry, 0, len(m.entries))}
+	for _, entry := range m.entries {
+		stored.Entries = append(stored.Entries, entry)
+	}
+	sort.Slice(stored.Entries, func(i, j int) bool {
+		return stored.Entries[i].Path < stored.Entries[j].Path
+	})
+	data, err := json.MarshalIndent(stored, "", "  ")
+	if err != nil {
+		return err
+	}
+	err = writeFileAtomic(m.path, strings.NewReader(string(data)))
+	if err != nil {
+		m.logger.Warn("Unable to save manifest", zap.String("path", m.path), zap.Error(err))
+		return err
+	}
+	m.dirty = false
+	m.lastSave = time.Now()
+	return nil
+}
@@ -0,0 +1,120 @@
+package processors
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestManifest(t *testing.T) {
+	dir := t.TempDir()
+	m := NewManifest(dir)
+	m.Set(SourceFile{Path: "a.go", SHA: "aaa"}, "a.go.json", StateMarshalled, nil)
+	m.Set(SourceFile{Path: "b.go", SHA: "bbb"}, "b.go.json", StateFailed, os.ErrNotExist)
+	m.Set(SourceFile{Path: "c.go", SHA: "ccc"}, "c.go.json", StatePending, nil)
+	m.Remove("c.go")
+	err := m.Save()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	loaded, err := LoadManifest(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	entries := loaded.Entries()
+	if len(entries) != 2 || entries[1].State != StateFailed || entries[1].Error != os.ErrNotExist.Error() {
+		t.Fatalf("unexpected entries %+v", entries)
+	}
+
+	// Done needs the output file and the same blob
+	a := SourceFile{Path: "a.go", SHA: "aaa"}
+	if loaded.Done(a, "a.go.json") {
+		t.Error("file without output is done")
+	}
+	err = os.WriteFile(filepath.Join(dir, "a.go.json"), []byte("{}"), 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !loaded.Done(a, "a.go.json") || loaded.Done(SourceFile{Path: "a.go"}, "a.go.json") || loaded.Done(SourceFile{Path: "a.go", SHA: "abc"}, "a.go.json") || loaded.Done(SourceFile{Path: "b.go", SHA: "bbb"}, "b.go.json") || loaded.Done(a, "a.go.json.gz") {
+		t.Error("unexpected Done results")
+	}
+
+	// Files without a SHA are done while their stamp is unchanged
+	stamped := SourceFile{Path: "d.go", Stamp: "10-1"}
+	loaded.Set(stamped, "a.go.json", StateMarshalled, nil)
+	if !loaded.Done(stamped, "a.go.json") || loaded.Done(SourceFile{Path: "d.go", Stamp: "11-2"}, "a.go.json") || loaded.Done(SourceFile{Path: "d.go"}, "a.go.json") {
+		t.Error("unexpected Done results without SHA")
+	}
+}
+
+func TestProcessRepoResume(t *testing.T) {
+	p := newTestProcessor(t, Options{Resume: true})
+	outputDir := p.OutputDir()
+
+	tagDir := filepath.Join(outputDir, "v1")
+	m := NewManifest(tagDir)
+	m.Set(SourceFile{Path: "a.go", SHA: "aaa"}, "a.go.json", StateMarshalled, nil)
+	m.Set(SourceFile{Path: "b.go", SHA: "bbb"}, "b.go.json", StateFailed, os.ErrNotExist)
+	m.Set(SourceFile{Path: "gone.go", SHA: "ccc"}, "gone.go.json", StateMarshalled, nil)
+	err := m.Save()
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = os.WriteFile(filepath.Join(tagDir, "a.go.json"), []byte("{}"), 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	src := failingSource{files: []SourceFile{{Path: "a.go", SHA: "aaa"}, {Path: "b.go", SHA: "bbb"}}}
+	skipped, err := p.ProcessRepo(context.Background(), src, "", "v1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(skipped) != 1 || skipped[0].Path != "b.go" {
+		t.Errorf("unexpected skipped files %v", skipped)
+	}
+
+	loaded, err := LoadManifest(tagDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	entries := loaded.Entries()
+	if len(entries) != 2 || entries[0].State != StateMarshalled || entries[1].State != StateFailed || entries[1].Error != "unavailable" {
+		t.Errorf("unexpected entries %+v", entries)
+	}
+
+	fileNames, err := listOutputFiles(tagDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(fileNames) != 1 || fileNames[0] != "a.go.json" {
+		t.Errorf("unexpected output files %v", fileNames)
+	}
+}
+
+func TestProcessRepoResumeEditedDir(t *testing.T) {
+	p := newTestProcessor(t, Options{Resume: true})
+	repoDir := t.TempDir()
+	writeFile(t, repoDir, "a.go", "package a\n")
+	_, err := p.ProcessRepo(context.Background(), NewDirSource(repoDir), "", "v1")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// A directory lists no SHA, the edit is noticed from the size and modification time
+	writeFile(t, repoDir, "a.go", "package edited\n")
+	_, err = p.ProcessRepo(context.Background(), NewDirSource(repoDir), "", "v1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := os.ReadFile(filepath.Join(p.OutputDir(), "v1", "a.go.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(data), `"edited"`) {
+		t.Error("edited file was not converted again")
+	}
+}
@@ -0,0 +1,173 @@
+package processors
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// durationBuckets are the upper bounds in seconds of the stage latency histograms.
+var durationBuckets = []float64{0.001, 0.005, 0.01, 0.05, 0.1, 0.5, 1, 5, 10, 30}
+
+// Metrics counts the events of one or more processors and serves them in the
+// Prometheus text format.
+type Metrics struct {
+	mu         sync.Mutex
+	discovered int64
+	files      map[[2]string]int64
+	bytes      map[string]int64
+	durations  map[string]*histogram
+	refs       int64
+	stages     []func() []StageStats
+}
+
+// histogram is a Prometheus histogram, counts holds one cumulative count per bucket.
+type histogram struct {
+	counts []int64
+	count  int64
+	sum    float64
+}
+
+// NewMetrics returns empty metrics, pass them as the Progress of a processor to fill them.
+func NewMetrics() *Metrics {
+	return &Metrics{
+		files:     map[[2]string]int64{},
+		bytes:     map[string]int64{},
+		durations: map[string]*histogram{},
+	}
+}
+
+// WatchStages adds the queues and workers reported by stats, such as Processor.Stats, to the metrics.
+// @param stats func() []StageStats
+func (m *Metrics) WatchStages(stats func() []StageStats) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.stages = append(m.stages, stats)
+}
+
+// Event counts event.
+func (m *Metrics) Event(event ProgressEvent) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	switch event.Event {
+	case EventDiscovered:
+		m.discovered += int64(event.Files)
+		return
+	case EventRefDone:
+		m.refs++
+		return
+	}
+	m.files[[2]string{event.Event, event.Stage}]++
+	if event.Bytes > 0 {
+		m.bytes[event.Event] += event.Bytes
+	}
+	if event.Stage != "" && event.Seconds > 0 {
+		h, ok := m.durations[event.Stage]
+		if !ok {
+			h = &histogram{counts: make([]int64, len(durationBuckets))}
+			m.durations[event.Stage] = h
+		}
+		for i, bound := range durationBuckets {
+			if event.Seconds <= bound {
+				h.counts[i]++
+			}
+		}
+		h.count++
+		h.sum += event.Seconds
+	}
+}
+
+// ServeHTTP writes the metrics in the Prometheus text format.
+func (m *Metrics) ServeHTTP(w http.ResponseWriter, _ *http.Request) {
+	w.Header().Set("Content-Type", "text/plain; version=0.0.4")
+	m.WriteTo(w)
+}
+
+// WriteTo writes the metrics in the Prometheus text format to w.
+// @param w io.Writer
+func (m *Metrics) WriteTo(w io.Writer) (int64, error) {
+	m.mu.Lock()
+	var b strings.Builder
+	writeHeader(&b, "goast_files_discovered_total", "counter", "Files selected for processing.")
+	fmt.Fprintf(&b, "goast_files_discovered_total %d\n", m.discovered)
+	writeHeader(&b, "goast_refs_done_total", "counter", "Refs processed.")
+	fmt.Fprintf(&b, "goast_refs_done_total %d\n", m.refs)
+
+	writeHeader(&b, "goast_files_total", "counter", "Files by event and pipeline stage.")
+	keys := make([][2]string, 0, len(m.files))
+	for key := range m.files {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		return keys[i][0]+"/"+keys[i][1] < keys[j][0]+"/"+keys[j][1]
+	})
+	for _, key := range keys {
+		fmt.Fprintf(&b, "goast_files_total{event=%q,stage=%q} %d\n", key[0], key[1], m.files[key])
+	}
+
+	writeHeader(&b, "goast_bytes_total", "counter", "Bytes of source fetched and of JSON written.")
+	for _, event := range sortedKeys(m.bytes) {
+		fmt.Fprintf(&b, "goast_bytes_total{event=%q} %d\n", event, m.bytes[event])
+	}
+
+	writeHeader(&b, "goast_stage_duration_seconds", "histogram", "Time a pipeline stage spent on a file.")
+	for _, stage := range sortedKeys(m.durations) {
+		h := m.durations[stage]
+		for i, bound := range durationBuckets {
+			fmt.Fprintf(&b, "goast_stage_duration_seconds_bucket{stage=%q,le=\"%g\"} %d\n", stage, bound, h.counts[i])
+		}
+		fmt.Fprintf(&b, "goast_stage_duration_seconds_bucket{stage=%q,le=\"+Inf\"} %d\n", stage, h.count)
+		fmt.Fprintf(&b, "goast_stage_duration_seconds_sum{stage=%q} %g\n", stage, h.sum)
+		fmt.Fprintf(&b, "goast_stage_duration_seconds_count{stage=%q} %d\n", stage, h.count)
+	}
+	watched := m.stages
+	m.mu.Unlock()
+
+	// Stages are sampled outside the lock, the pipeline label tells the watched processors apart
+	var stats [][]StageStats
+	for _, stageStats := range watched {
+		stats = append(stats, stageStats())
+	}
+	if len(stats) > 0 {
+		writeHeader(&b, "goast_stage_workers", "gauge", "Workers of a pipeline stage.")
+		writeStages(&b, "goast_stage_workers", stats, func(s StageStats) string { return fmt.Sprint(s.Workers) })
+		writeHeader(&b, "goast_stage_busy", "gauge", "Workers of a pipeline stage handling an item.")
+		writeStages(&b, "goast_stage_busy", stats, func(s StageStats) string { return fmt.Sprint(s.Busy) })
+		writeHeader(&b, "goast_stage_queued", "gauge", "Items waiting in front of a pipeline stage.")
+		writeStages(&b, "goast_stage_queued", stats, func(s StageStats) string { return fmt.Sprint(s.Queued) })
+		writeHeader(&b, "goast_stage_wait_seconds_total", "counter", "Time items spent waiting for a pipeline stage.")
+		writeStages(&b, "goast_stage_wait_seconds_total", stats, func(s StageStats) string { return fmt.Sprintf("%g", s.Waited.Seconds()) })
+		writeHeader(&b, "goast_stage_work_seconds_total", "counter", "Time the workers of a pipeline stage spent on items.")
+		writeStages(&b, "goast_stage_work_seconds_total", stats, func(s StageStats) string { return fmt.Sprintf("%g", s.Worked.Seconds()) })
+	}
+
+	n, err := io.WriteString(w, b.String())
+	return int64(n), err
+}
+
+// writeHeader writes the HELP and TYPE lines of a metric.
+func writeHeader(b *strings.Builder, name, kind, help string) {
+	fmt.Fprintf(b, "# HELP %s %s\n# TYPE %s %s\n", name, help, name, kind)
+}
+
+// writeStages writes one sample per stage of each watched pipeline.
+func writeStages(b *strings.Builder, name string, stats [][]StageStats, value func(StageStats) string) {
+	for i, pipeline := range stats {
+		for _, s := range pipeline {
+			fmt.Fprintf(b, "%s{stage=%q,pipeline=\"%d\"} %s\n", name, s.Name, i, value(s))
+		}
+	}
+}
+
+// sortedKeys returns the keys of m in order.
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
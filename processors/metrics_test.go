@@ -0,0 +1,40 @@
+package processors
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestMetrics(t *testing.T) {
+	m := NewMetrics()
+	m.WatchStages(func() []StageStats {
+		return []StageStats{{Name: "fetch", Workers: 4, Queued: 2}}
+	})
+	m.Event(ProgressEvent{Event: EventDiscovered, Files: 3})
+	m.Event(ProgressEvent{Event: EventFetched, Stage: "fetch", Bytes: 100, Seconds: 0.002})
+	m.Event(ProgressEvent{Event: EventFetched, Stage: "fetch", Bytes: 50, Seconds: 2})
+	m.Event(ProgressEvent{Event: EventFailed, Stage: "parse", Error: "broken"})
+	m.Event(ProgressEvent{Event: EventRefDone})
+
+	var out strings.Builder
+	if _, err := m.WriteTo(&out); err != nil {
+		t.Fatal(err)
+	}
+	for _, line := range []string{
+		"goast_files_discovered_total 3",
+		"goast_refs_done_total 1",
+		`goast_files_total{event="fetched",stage="fetch"} 2`,
+		`goast_files_total{event="failed",stage="parse"} 1`,
+		`goast_bytes_total{event="fetched"} 150`,
+		`goast_stage_duration_seconds_bucket{stage="fetch",le="0.001"} 0`,
+		`goast_stage_duration_seconds_bucket{stage="fetch",le="0.005"} 1`,
+		`goast_stage_duration_seconds_bucket{stage="fetch",le="+Inf"} 2`,
+		`goast_stage_duration_seconds_count{stage="fetch"} 2`,
+		`goast_stage_queued{stage="fetch",pipeline="0"} 2`,
+		"# TYPE goast_stage_duration_seconds histogram",
+	} {
+		if !strings.Contains(out.String(), line+"\n") {
+			t.Errorf("missing %q in\n%s", line, out.String())
+		}
+	}
+}
@@ -0,0 +1,304 @@
+package processors
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"unicode"
+)
+
+// errModuleNotFound is returned by a proxy that does not know the module or version.
+var errModuleNotFound = errors.New("module not found")
+
+// ModuleInfo is the content of a @v/<version>.info proxy response.
+type ModuleInfo struct {
+	Version string
+	Time    string
+	// Origin is reported by proxies that know where the version was fetched from.
+	Origin *ModuleOrigin `json:",omitempty"`
+}
+
+// ModuleOrigin is the version control origin of a module version.
+type ModuleOrigin struct {
+	VCS  string `json:",omitempty"`
+	URL  string `json:",omitempty"`
+	Ref  string `json:",omitempty"`
+	Hash string `json:",omitempty"`
+}
+
+// ModuleProxySource reads a Go module through the GOPROXY protocol. The refs
+// passed to it are module versions, an empty ref or "latest" selects the
+// latest version known to the proxy. Both http(s) and file:// proxies are
+// supported, the local module cache can be used as a file:// proxy.
+type ModuleProxySource struct {
+	module     string
+	proxies    []moduleProxy
+	httpClient *http.Client
+
+	mu       sync.Mutex
+	versions map[string]map[string]string
+}
+
+// moduleProxy is one entry of a GOPROXY list.
+type moduleProxy struct {
+	url string
+	// anyError is set when the entry is followed by "|", so any failure
+	// falls through to the next proxy rather than only a not found answer.
+	anyError bool
+}
+
+// NewModuleProxySource creates a Source for module served by the given GOPROXY list.
+// direct entries are skipped since modules are not fetched from version control,
+// and off ends the list as it does for the go command.
+// @param module string module path, for example golang.org/x/mod
+// @param goproxy string comma or pipe separated proxy list in GOPROXY syntax
+// @param httpClient *http.Client used for http(s) proxies, nil means http.DefaultClient
+func NewModuleProxySource(module, goproxy string, httpClient *http.Client) (*ModuleProxySource, error) {
+	var proxies []moduleProxy
+	direct, off := false, false
+	for goproxy != "" && !off {
+		entry, rest := goproxy, ""
+		anyError := false
+		if i := strings.IndexAny(goproxy, ",|"); i >= 0 {
+			entry, rest = goproxy[:i], goproxy[i+1:]
+			anyError = goproxy[i] == '|'
+		}
+		goproxy = rest
+
+		entry = strings.TrimSpace(entry)
+		switch entry {
+		case "":
+		case "direct":
+			direct = true
+		case "off":
+			off = true
+		default:
+			proxies = append(proxies, moduleProxy{url: strings.TrimSuffix(entry, "/"), anyError: anyError})
+		}
+	}
+	if len(proxies) == 0 {
+		switch {
+		case direct:
+			return nil, fmt.Errorf("GOPROXY lists direct only, fetching modules from version control is not supported")
+		case off:
+			return nil, fmt.Errorf("module downloads are disabled by GOPROXY=off")
+		}
+		return nil, fmt.Errorf("no usable module proxy in GOPROXY list")
+	}
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+	return &ModuleProxySource{
+		module:     module,
+		proxies:    proxies,
+		httpClient: httpClient,
+		versions:   map[string]map[string]string{},
+	}, nil
+}
+
+// ModCacheProxy returns the file:// proxy URL of the local module download cache.
+func ModCacheProxy() string {
+	modCache := os.Getenv("GOMODCACHE")
+	if modCache == "" {
+		gopath := os.Getenv("GOPATH")
+		if gopath == "" {
+			home, _ := os.UserHomeDir()
+			gopath = filepath.Join(home, "go")
+		}
+		modCache = filepath.Join(gopath, "pkg", "mod")
+	}
+	return "file://" + filepath.ToSlash(filepath.Join(modCache, "cache", "download"))
+}
+
+// Versions returns the tagged versions listed by @v/list, in proxy order.
+func (s *ModuleProxySource) Versions(ctx context.Context) ([]string, error) {
+	data, err := s.fetch(ctx, "@v/list")
+	if err != nil {
+		return nil, err
+	}
+	var versions []string
+	for _, line := range strings.Split(string(data), "\n") {
+		if version := strings.TrimSpace(line); version != "" {
+			versions = append(versions, version)
+		}
+	}
+	return versions, nil
+}
+
+// Tags returns the versions of the module, so that a release series can be listed like the tags of a repository.
+func (s *ModuleProxySource) Tags(ctx context.Context) ([]string, error) {
+	return s.Versions(ctx)
+}
+
+// Resolve returns the canonical version for a version query using @v/<version>.info.
+func (s *ModuleProxySource) Resolve(ctx context.Context, version string) (*ModuleInfo, error) {
+	endpoint := "@latest"
+	if version != "" && version != "latest" {
+		endpoint = "@v/" + escapeModulePath(version) + ".info"
+	}
+	data, err := s.fetch(ctx, endpoint)
+	if err != nil {
+		return nil, err
+	}
+	var info ModuleInfo
+	err = json.Unmarshal(data, &info)
+	if err != nil {
+		return nil, err
+	}
+	return &info, nil
+}
+
+// ResolveRef resolves a version query such as latest or a branch name to the
+// canonical version, with the commit recorded by the proxy when it has one.
+func (s *ModuleProxySource) ResolveRef(ctx context.Context, ref string) (ResolvedRef, error) {
+	info, err := s.Resolve(ctx, ref)
+	if err != nil {
+		return ResolvedRef{}, err
+	}
+	resolved := ResolvedRef{Ref: ref, Name: info.Version, Kind: RefVersion}
+	if info.Origin != nil {
+		resolved.Commit = info.Origin.Hash
+	}
+	return resolved, nil
+}
+
+// ListFiles returns the Go files of the module zip for the version ref below dir.
+func (s *ModuleProxySource) ListFiles(ctx context.Context, dir, ref string) ([]SourceFile, error) {
+	entries, err := s.load(ctx, ref)
+	if err != nil {
+		return nil, err
+	}
+
+	prefix := strings.Trim(dir, "/")
+	var files []SourceFile
+	for name, content := range entries {
+		if prefix != "" && !strings.HasPrefix(name, prefix+"/") {
+			continue
+		}
+		files = append(files, SourceFile{Path: name, SHA: gitBlobSHA([]byte(content))})
+	}
+	sort.Slice(files, func(i, j int) bool {
+		return files[i].Path < files[j].Path
+	})
+	return files, nil
+}
+
+// ReadFile returns the content of a file of the module zip for the version ref.
+func (s *ModuleProxySource) ReadFile(ctx context.Context, file SourceFile, ref string) (string, error) {
+	entries, err := s.load(ctx, ref)
+	if err != nil {
+		return "", err
+	}
+	content, ok := entries[file.Path]
+	if !ok {
+		return "", fmt.Errorf("%s: %w", file.Path, os.ErrNotExist)
+	}
+	return content, nil
+}
+
+// load resolves the version, downloads its zip once and strips the module@version/ prefix.
+func (s *ModuleProxySource) load(ctx context.Context, ref string) (map[string]string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if entries, ok := s.versions[ref]; ok {
+		return entries, nil
+	}
+	info, err := s.Resolve(ctx, ref)
+	if err != nil {
+		return nil, err
+	}
+	data, err := s.fetch(ctx, "@v/"+escapeModulePath(info.Version)+".zip")
+	if err != nil {
+		return nil, err
+	}
+	raw, _, err := extractZip(data)
+	if err != nil {
+		return nil, err
+	}
+
+	prefix := s.module + "@" + info.Version + "/"
+	entries := make(map[string]string, len(raw))
+	for name, content := range raw {
+		if !strings.HasPrefix(name, prefix) {
+			return nil, fmt.Errorf("unexpected entry %s in module zip", name)
+		}
+		entries[strings.TrimPrefix(name, prefix)] = content
+	}
+	s.versions[ref] = entries
+	return entries, nil
+}
+
+// fetch requests endpoint for the module from each proxy in turn, following
+// the GOPROXY fallback rules.
+func (s *ModuleProxySource) fetch(ctx context.Context, endpoint string) ([]byte, error) {
+	var errs []error
+	for _, proxy := range s.proxies {
+		location := proxy.url + "/" + escapeModulePath(s.module) + "/" + endpoint
+		data, err := s.fetchURL(ctx, location)
+		if err == nil {
+			return data, nil
+		}
+		errs = append(errs, err)
+		if !proxy.anyError && !errors.Is(err, errModuleNotFound) {
+			break
+		}
+	}
+	return nil, errors.Join(errs...)
+}
+
+func (s *ModuleProxySource) fetchURL(ctx context.Context, location string) ([]byte, error) {
+	if strings.HasPrefix(location, "file://") {
+		u, err := url.Parse(location)
+		if err != nil {
+			return nil, err
+		}
+		data, err := os.ReadFile(filepath.FromSlash(u.Path))
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, fmt.Errorf("%s: %w", location, errModuleNotFound)
+		}
+		return data, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, location, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := s.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	switch resp.StatusCode {
+	case http.StatusOK:
+		return io.ReadAll(resp.Body)
+	case http.StatusNotFound, http.StatusGone:
+		return nil, fmt.Errorf("%s: %w", location, errModuleNotFound)
+	default:
+		return nil, fmt.Errorf("GET %s: unexpected status %s", location, resp.Status)
+	}
+}
+
+// escapeModulePath applies the module proxy case encoding, replacing every
+// upper case letter with an exclamation mark followed by its lower case.
+func escapeModulePath(path string) string {
+	var b strings.Builder
+	for _, r := range path {
+		if unicode.IsUpper(r) {
+			b.WriteByte('!')
+			b.WriteRune(unicode.ToLower(r))
+		} else {
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
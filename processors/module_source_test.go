@@ -0,0 +1,125 @@
+package processors
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// writeModuleProxy lays out a file:// proxy serving example.com/Mod at v1.0.0.
+func writeModuleProxy(t *testing.T) string {
+	root := t.TempDir()
+	versionDir := filepath.Join(root, "example.com", "!mod", "@v")
+	err := os.MkdirAll(versionDir, os.ModePerm)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	files := map[string]string{
+		"example.com/Mod@v1.0.0/go.mod":      "module example.com/Mod\n",
+		"example.com/Mod@v1.0.0/mod.go":      "package mod\n",
+		"example.com/Mod@v1.0.0/sub/sub.go":  "package sub\n",
+		"example.com/Mod@v1.0.0/sub/doc.txt": "doc\n",
+	}
+	for name, content := range map[string][]byte{
+		"list":        []byte("v1.0.0\n"),
+		"v1.0.0.info": []byte(`{"Version":"v1.0.0","Time":"2023-01-01T00:00:00Z"}`),
+		"v1.0.0.zip":  buildZip(t, files),
+	} {
+		err = os.WriteFile(filepath.Join(versionDir, name), content, 0644)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	return "file://" + filepath.ToSlash(root)
+}
+
+func TestModuleProxySource(t *testing.T) {
+	notFound := httptest.NewServer(http.NotFoundHandler())
+	defer notFound.Close()
+
+	src, err := NewModuleProxySource("example.com/Mod", notFound.URL+",direct,"+writeModuleProxy(t), notFound.Client())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx := context.Background()
+	versions, err := src.Versions(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(versions) != 1 || versions[0] != "v1.0.0" {
+		t.Fatalf("unexpected versions %v", versions)
+	}
+
+	files, err := src.ListFiles(ctx, "", "v1.0.0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(files) != 2 || files[0].Path != "mod.go" || files[1].Path != "sub/sub.go" {
+		t.Fatalf("unexpected files %v", files)
+	}
+
+	content, err := src.ReadFile(ctx, files[1], "v1.0.0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if content != "package sub\n" {
+		t.Errorf("unexpected content %q", content)
+	}
+
+	if _, err := src.ListFiles(ctx, "", "v2.0.0"); err == nil {
+		t.Error("expected an error for an unknown version")
+	}
+}
+
+func TestModuleProxySourceStopsOnServerError(t *testing.T) {
+	broken := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer broken.Close()
+
+	for goproxy, ok := range map[string]bool{
+		broken.URL + "," + writeModuleProxy(t): false,
+		broken.URL + "|" + writeModuleProxy(t): true,
+	} {
+		src, err := NewModuleProxySource("example.com/Mod", goproxy, broken.Client())
+		if err != nil {
+			t.Fatal(err)
+		}
+		_, err = src.Resolve(context.Background(), "v1.0.0")
+		if (err == nil) != ok {
+			t.Errorf("Resolve with GOPROXY=%s returned %v", goproxy, err)
+		}
+	}
+}
+
+func TestModuleProxySourceList(t *testing.T) {
+	proxy := writeModuleProxy(t)
+	// Proxies after off are never queried
+	src, err := NewModuleProxySource("example.com/Mod", "http://127.0.0.1:0,off,"+proxy, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(src.proxies) != 1 || src.proxies[0].url != "http://127.0.0.1:0" {
+		t.Errorf("unexpected proxies %+v", src.proxies)
+	}
+
+	for goproxy, want := range map[string]string{
+		"direct":          "direct",
+		"off," + proxy:    "off",
+		"direct,off":      "direct",
+		" , ":             "no usable module proxy",
+		"direct|" + proxy: "",
+		proxy + ",direct": "",
+	} {
+		_, err := NewModuleProxySource("example.com/Mod", goproxy, nil)
+		if (err == nil) != (want == "") || (err != nil && !strings.Contains(err.Error(), want)) {
+			t.Errorf("GOPROXY=%s returned %v, want an error mentioning %q", goproxy, err, want)
+		}
+	}
+}
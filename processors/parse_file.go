@@ -2,179 +2,291 @@ package processors
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"os"
-	"strings"
+	"sort"
 	"sync"
+	"time"
 
 	astjson "GoOperatorAST/ast_json"
-	"github.com/google/go-github/github"
-	"github.com/panjf2000/ants/v2"
 	"go.uber.org/zap"
 )
 
-var logger *zap.Logger
-var OUTPUT_DIR = os.Getenv("OUTPUT_DIR")
-var fileProcessor *ants.PoolWithFunc
-
-// SetupProcessing sets up the output directory and logger for processing.
-// @param outputDir string
-// @param logger *zap.Logger
-func SetupProcessing(outputDir string, l *zap.Logger) {
-	OUTPUT_DIR = outputDir
-	logger = l
+// SkippedFile records a file that could not be fetched or converted and is missing from the output.
+type SkippedFile struct {
+	Path string
+	Err  error
 }
 
-type FileInfo struct {
-	Content  *string
-	Path     *string
-	FileName *string
+// outputName returns the name of the JSON file of path inside a tag directory.
+// The JSON files mirror the tree of the repository, so that no two paths share one.
+func outputName(path string, compression astjson.Compression) string {
+	return path + ".json" + compression.Extension()
 }
 
-type FileProcessor struct {
-	FileInfo *FileInfo
-	Wg       *sync.WaitGroup
-	Logger   *zap.Logger
-	Err      error
+// readSource returns the content of file, from the blob cache when possible.
+func (p *Processor) readSource(ctx context.Context, src Source, file SourceFile, ref string) (string, error) {
+	if p.cache != nil && file.SHA != "" {
+		if content, ok := p.cache.Source(file.SHA); ok {
+			return content, nil
+		}
+	}
+	content, err := src.ReadFile(ctx, file, ref)
+	if err != nil {
+		return "", err
+	}
+	if p.cache != nil && file.SHA != "" {
+		err = p.cache.PutSource(file.SHA, content)
+		if err != nil {
+			p.logger.Warn("Unable to cache source", zap.String("path", file.Path), zap.Error(err))
+		}
+	}
+	return content, nil
 }
 
-// CreateProcessFilePool creates a pool of goroutines to process files.
-// It takes the pool size as an argument and returns a pointer to the ants.PoolWithFunc and an error (if any).
-func CreateProcessFilePool(poolsize int) (*ants.PoolWithFunc, error) {
-	p, err := ants.NewPoolWithFunc(poolsize, func(i interface{}) {
-		// Type assert the input as FileProcessor
-		pf := i.(FileProcessor)
-		// Defer the Done() method of the WaitGroup to mark the completion of the goroutine
-		defer pf.Wg.Done()
-		// Call the parseFile method of the FileProcessor and assign the error to the Err field
-		pf.Err = pf.parseFile()
-		// Return to exit the goroutine
-		return
-	})
+// fileMeta describes the file at path holding content.
+func (p *Processor) fileMeta(path, content string) *astjson.FileMetaNode {
+	expr, err := fileConstraint(path, content)
 	if err != nil {
-		return nil, err
+		p.logger.Warn("Ignoring malformed build constraint", zap.String("path", path), zap.Error(err))
+	}
+	return &astjson.FileMetaNode{
+		Path:       path,
+		Category:   fileCategory(path, content),
+		Constraint: expr,
 	}
-	// Assign the created pool to the global variable fileProcessor
-	fileProcessor = p
-	// Return the created pool and nil error
-	return p, nil
 }
 
-// parseFile parses the file and converts it to JSON format.
-func (pf *FileProcessor) parseFile() error {
-	// Specify options for converting the file to JSON.
-	options := astjson.Options{
-		WithImports:    false,
-		WithComments:   false,
-		WithPositions:  true,
-		WithReferences: true,
+// jsonCacheKey returns the cache key of the JSON of the blob sha found at path.
+// The Meta recorded in the JSON depends on the path, so the same blob is
+// cached once per path it is found at.
+func jsonCacheKey(sha, path string) string {
+	if sha == "" {
+		return ""
 	}
+	return sha + "-" + gitBlobSHA([]byte(path))[:16]
+}
 
-	// Set the indentation string.
-	indentStr := strings.Repeat(" ", 2)
-
-	// Convert the file to JSON with the specified options and indentation.
-	err := astjson.SourceToJSONWithContent(pf.FileInfo.Content, *pf.FileInfo.Path, *pf.FileInfo.FileName, indentStr, options)
+// finishCachedFile records the file of a job served from the cache as marshalled, unless it is excluded generated code.
+func (job *fileJob) finishCachedFile() error {
+	path := job.file.Path
+	err := checkCachedCategory(job.opts.Filter, job.jsonFile)
+	if err == errExcluded {
+		job.manifest.Remove(path)
+		return err
+	}
 	if err != nil {
-		pf.Logger.Error("unable to convert file to json", zap.Error(err))
+		job.manifest.Set(job.file, job.output(), StateFailed, err)
 		return err
 	}
-
+	job.manifest.Set(job.file, job.output(), StateMarshalled, nil)
 	return nil
 }
 
-// fetchAndParseFile fetches and parses a Go file from a GitHub repository
-// @param ctx context.Context
-// @param client *github.Client
-// @param owner string
-// @param repo string
-// @param path string
-// @param tag string
-func fetchAndParseFile(ctx context.Context, client *github.Client, owner, repo, path string, tag string) {
-	// Fetch Golang code from GitHub repository
-	fileContent, _, _, err := client.Repositories.GetContents(ctx, owner, repo, path, nil)
+// checkCachedCategory removes a JSON copied from the cache when it turns out to describe generated code that is excluded.
+func checkCachedCategory(filter *FileFilter, output string) error {
+	if filter.Generated {
+		return nil
+	}
+	meta, err := readFileMeta(output)
 	if err != nil {
-		logger.Error("Error fetching repository content", zap.Error(err))
-		return
+		return err
 	}
-
-	// Get the content of the file
-	content, err := fileContent.GetContent()
+	if meta == nil || meta.Category != CategoryGenerated {
+		return nil
+	}
+	err = os.Remove(output)
 	if err != nil {
-		logger.Error("Error getting file content", zap.Error(err))
-		return
+		return err
 	}
+	return errExcluded
+}
 
-	// Generate the file name
-	fileName := strings.ReplaceAll(path, "/", "_") + ".json"
+// copyCachedJSON writes the cached JSON of the blob sha to output and reports whether it was cached.
+func (p *Processor) copyCachedJSON(sha, output string) (bool, error) {
+	if p.cache == nil || sha == "" {
+		return false, nil
+	}
+	hit, err := p.cache.CopyJSON(sha, p.marshal, output)
+	if err != nil {
+		p.logger.Error("Unable to copy cached json", zap.String("output", output), zap.Error(err))
+		return false, err
+	}
+	if hit {
+		p.logger.Debug("Served file from cache", zap.String("output", output))
+	}
+	return hit, nil
+}
 
-	// Generate the fully qualified file name
-	fqfn := OUTPUT_DIR + "/" + tag + "/" + fileName
+// ProcessRepo fetches and parses the Go files below dir from a Source that pass the file filter.
+// It returns the files that could not be fetched, transient failures are
+// expected to be retried by the Source. An error is returned when the file
+// list itself cannot be obtained. The tag is resolved first and the files go
+// to <output dir>/<ResolvedRef.DirName()>, so an empty tag for the default branch
+// writes to <output dir>/<branch>@<commit>.
+// @param ctx context.Context
+// @param src Source
+// @param dir string
+// @param tag string
+func (p *Processor) ProcessRepo(ctx context.Context, src Source, dir string, tag string) ([]SkippedFile, error) {
+	return p.ProcessPaths(ctx, src, dir, tag, nil)
+}
 
-	// Create a FileInfo struct
-	fileInfo := FileInfo{
-		Content:  &content,
-		Path:     &path,
-		FileName: &fqfn,
+// ProcessPaths is ProcessRepo restricted to the files whose path is in paths.
+// A nil paths processes every file.
+// @param ctx context.Context
+// @param src Source
+// @param dir string
+// @param tag string
+// @param paths map[string]bool
+func (p *Processor) ProcessPaths(ctx context.Context, src Source, dir string, tag string, paths map[string]bool) ([]SkippedFile, error) {
+	resolved, err := ResolveRef(ctx, src, tag)
+	if err != nil {
+		p.logger.Error("Unable to resolve ref", zap.String("ref", tag), zap.Error(err))
+		return nil, err
 	}
+	return p.ProcessRef(ctx, src, resolved.ReadRef(), ProcessOptions{
+		Dir:       dir,
+		OutputDir: p.outputDir + "/" + resolved.DirName(),
+		Paths:     paths,
+		Ref:       &resolved,
+	})
+}
 
-	var wg sync.WaitGroup
+// ProcessOptions selects the files of a ref to process and where their JSON goes.
+type ProcessOptions struct {
+	// Dir restricts processing to a subdirectory of the repository.
+	Dir string
+	// OutputDir receives the JSON files and the manifest.
+	OutputDir string
+	// Paths restricts processing to the listed files, nil processes every file.
+	Paths map[string]bool
+	// Filter selects the files to process, nil uses the filter of the processor.
+	Filter *FileFilter
+	// Ref is recorded in the manifest as what the processed ref resolved to.
+	Ref *ResolvedRef
+}
 
-	// Create a FileProcessor struct
-	process := FileProcessor{
-		FileInfo: &fileInfo,
-		Wg:       &wg,
-		Logger:   logger,
-		Err:      nil,
+// ProcessRef fetches and parses the Go files of ref selected by opts into opts.OutputDir.
+// Unlike ProcessRepo it allows the output directory to differ from the ref
+// and several repositories to be processed at once with their own filters.
+// Once ctx is cancelled no new file is started, the files in progress are
+// finished and the others are recorded as interrupted in the manifest.
+// @param ctx context.Context
+// @param src Source
+// @param ref string
+// @param opts ProcessOptions
+func (p *Processor) ProcessRef(ctx context.Context, src Source, ref string, opts ProcessOptions) ([]SkippedFile, error) {
+	if opts.Filter == nil {
+		opts.Filter = p.filter
 	}
+	paths := opts.Paths
 
-	// Invoke the file processor in a goroutine
-	go func(wg *sync.WaitGroup) {
-		wg.Add(1)
-		err := fileProcessor.Invoke(process)
-		if err != nil {
-			logger.Error("Unable to invoke file processor", zap.Error(err))
-			wg.Done()
-			return
-		}
-		wg.Wait()
-		logger.Debug("Finished processing file:", zap.String("path", *process.FileInfo.Path), zap.String("filename", *process.FileInfo.FileName))
-	}(process.Wg)
-}
+	start := time.Now()
+	refName := ref
+	if opts.Ref != nil {
+		refName = opts.Ref.DirName()
+	}
 
-// ProcessRepo fetches and parses Go files from a GitHub repository
-func ProcessRepo(ctx context.Context, client *github.Client, owner, repo, dir string, tag string) {
-	// Create a stack to store directory paths
-	var stack []string
-	stack = append(stack, dir)
+	// List every file of the repository at ref
+	files, err := src.ListFiles(ctx, opts.Dir, ref)
+	if err != nil {
+		p.logger.Error("Error fetching repository content", zap.Error(err))
+		return nil, err
+	}
 
-	// Set options for fetching repository content
-	opts := &github.RepositoryContentGetOptions{}
-	if tag != "" {
-		opts.Ref = tag
+	manifest, err := p.openManifest(opts.OutputDir)
+	if err != nil {
+		return nil, err
+	}
+	if opts.Ref != nil {
+		manifest.SetRef(*opts.Ref)
 	}
 
-	// Iterate through the stack until it is empty
-	for len(stack) > 0 {
-		// Pop an item from the stack
-		currentDir := stack[len(stack)-1]
-		stack = stack[:len(stack)-1]
+	// Record every selected file before fetching any of them
+	var selected []SourceFile
+	selectedPaths := map[string]bool{}
+	for _, file := range files {
+		if paths != nil && !paths[file.Path] {
+			continue
+		}
+		if opts.Filter.MatchPath(file.Path) {
+			selected = append(selected, file)
+			selectedPaths[file.Path] = true
+		}
+	}
+	manifest.Retain(selectedPaths)
+	var pending []SourceFile
+	for _, file := range selected {
+		if p.resume && manifest.Done(file, outputName(file.Path, p.marshal.Compression)) {
+			continue
+		}
+		manifest.Set(file, outputName(file.Path, p.marshal.Compression), StatePending, nil)
+		pending = append(pending, file)
+	}
+	err = manifest.Save()
+	if err != nil {
+		return nil, err
+	}
+	p.emit(ProgressEvent{Event: EventDiscovered, Ref: refName, Dir: opts.OutputDir, Files: len(pending)})
+	if len(pending) < len(selected) {
+		p.logger.Info("Resuming ref", zap.String("ref", ref), zap.Int("done", len(selected)-len(pending)), zap.Int("pending", len(pending)))
+	}
 
-		// Fetch contents of the current directory
-		_, directoryContent, _, err := client.Repositories.GetContents(ctx, owner, repo, currentDir, opts)
-		if err != nil {
-			logger.Error("Error fetching repository content", zap.Error(err))
-			return
+	var wg sync.WaitGroup
+	var jobs []*fileJob
+	interrupted := 0
+	for i, file := range pending {
+		// Stop dispatching once the run is cancelled, the files in progress still finish
+		if ctx.Err() != nil {
+			for _, rest := range pending[i:] {
+				manifest.Set(rest, outputName(rest.Path, p.marshal.Compression), StateInterrupted, ctx.Err())
+				p.emit(ProgressEvent{Event: EventInterrupted, Ref: refName, Dir: opts.OutputDir, Path: rest.Path})
+			}
+			interrupted += len(pending) - i
+			break
 		}
 
-		// Iterate through the directory content
-		for _, content := range directoryContent {
-			if *content.Type == "file" && strings.HasSuffix(*content.Path, ".go") {
-				// Fetch and parse the Go file
-				fetchAndParseFile(ctx, client, owner, repo, *content.Path, tag)
-			} else if *content.Type == "dir" {
-				// Push new directory into the stack
-				stack = append(stack, *content.Path)
+		// Hand the file to the fetch stage, which blocks while its queue is full
+		job := &fileJob{proc: p, ctx: ctx, src: src, file: file, ref: ref, opts: opts, manifest: manifest, wg: &wg}
+		jobs = append(jobs, job)
+		wg.Add(1)
+		p.stages.fetch.submit(job)
+	}
+
+	// Every JSON file is written once the stages are done with the jobs
+	wg.Wait()
+	var skipped []SkippedFile
+	written := map[string]IndexEntry{}
+	described := map[string]*FileInfo{}
+	for _, job := range jobs {
+		switch {
+		case job.err == errExcluded:
+			p.logger.Debug("Skipping generated file", zap.String("path", job.file.Path))
+		case job.interrupted():
+			interrupted++
+		case job.err != nil:
+			skipped = append(skipped, SkippedFile{Path: job.file.Path, Err: job.err})
+		default:
+			p.logger.Debug("Finished processing file:", zap.String("path", job.file.Path), zap.String("filename", job.jsonFile))
+			written[job.file.Path] = IndexEntry{Package: job.pkg, SHA: job.sha}
+			if job.info != nil {
+				described[job.file.Path] = job.info
 			}
 		}
 	}
+	sort.Slice(skipped, func(i, j int) bool {
+		return skipped[i].Path < skipped[j].Path
+	})
+	index, indexErr := p.writeIndex(opts.OutputDir, manifest, written)
+	if indexErr == nil {
+		indexErr = p.writeFileInfos(opts.OutputDir, refName, index, described)
+	}
+	err = errors.Join(manifest.Save(), indexErr)
+	p.emit(ProgressEvent{Event: EventRefDone, Ref: refName, Dir: opts.OutputDir, Files: len(written), Missing: len(skipped) + interrupted, Seconds: time.Since(start).Seconds()})
+	if interrupted > 0 {
+		return skipped, errors.Join(fmt.Errorf("interrupted with %d files unprocessed: %w", interrupted, ctx.Err()), err)
+	}
+	return skipped, err
 }
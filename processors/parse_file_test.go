@@ -0,0 +1,155 @@
+package processors
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	astjson "GoOperatorAST/ast_json"
+)
+
+func TestProcessRepoWaitsForFiles(t *testing.T) {
+	p := newTestProcessor(t, Options{})
+	outputDir := p.OutputDir()
+
+	dir := t.TempDir()
+	writeFile(t, dir, "a.go", "package a\n\nfunc A() {}\n")
+	writeFile(t, dir, "sub/b.go", "package sub\n\nfunc B() {}\n")
+	writeFile(t, dir, "sub/c.go", "package sub\n\nfunc C() {}\n")
+	writeFile(t, dir, "broken.go", "package a\n\nfunc {\n")
+
+	skipped, err := p.ProcessRepo(context.Background(), NewDirSource(dir), "", "old")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(skipped) != 1 || skipped[0].Path != "broken.go" || skipped[0].Err == nil {
+		t.Errorf("unexpected skipped files %v", skipped)
+	}
+
+	// Every file is written when ProcessRepo returns
+	for _, name := range []string{"a.go.json", "sub/b.go.json", "sub/c.go.json"} {
+		if _, err := os.Stat(filepath.Join(outputDir, "old", filepath.FromSlash(name))); err != nil {
+			t.Error(err)
+		}
+	}
+	m, err := LoadManifest(filepath.Join(outputDir, "old"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, entry := range m.Entries() {
+		want := StateMarshalled
+		if entry.Path == "broken.go" {
+			want = StateFailed
+		}
+		if entry.State != want {
+			t.Errorf("%s is %s, want %s", entry.Path, entry.State, want)
+		}
+	}
+}
+
+// cancellingSource cancels the run once it has read a file.
+type cancellingSource struct {
+	Source
+	cancel context.CancelFunc
+}
+
+func (s cancellingSource) ReadFile(ctx context.Context, file SourceFile, ref string) (string, error) {
+	defer s.cancel()
+	return s.Source.ReadFile(ctx, file, ref)
+}
+
+func TestProcessRepoInterrupted(t *testing.T) {
+	p := newTestProcessor(t, Options{})
+	outputDir := p.OutputDir()
+
+	dir := t.TempDir()
+	writeFile(t, dir, "a.go", "package a\n")
+	writeFile(t, dir, "b.go", "package a\n")
+	writeFile(t, dir, "c.go", "package a\n")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	skipped, err := p.ProcessRepo(ctx, cancellingSource{Source: NewDirSource(dir), cancel: cancel}, "", "old")
+	if !errors.Is(err, context.Canceled) || len(skipped) != 0 {
+		t.Fatalf("ProcessRepo = %v, %v", skipped, err)
+	}
+
+	// Nothing was started after the cancellation and no partial file is left behind
+	m, err := LoadManifest(filepath.Join(outputDir, "old"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	entries := m.Entries()
+	if len(entries) != 3 {
+		t.Fatalf("unexpected entries %+v", entries)
+	}
+	for _, entry := range entries {
+		if entry.State != StateInterrupted {
+			t.Errorf("%s is %s, want %s", entry.Path, entry.State, StateInterrupted)
+		}
+	}
+	files, err := os.ReadDir(filepath.Join(outputDir, "old"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	// The index and the file summaries list no file, none was marshalled
+	if len(files) != 3 || files[0].Name() != FileInfoFile || files[1].Name() != IndexFile || files[2].Name() != ManifestFile {
+		t.Errorf("unexpected files %v", files)
+	}
+}
+
+func TestProcessRepoCompressed(t *testing.T) {
+	cache, err := NewBlobCache(t.TempDir(), 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	p := newTestProcessor(t, Options{Cache: cache, Compression: astjson.CompressionZstd})
+	dir := t.TempDir()
+	writeFile(t, dir, "a.go", "package a\n\nfunc A() {}\n")
+	writeFile(t, dir, "sub/b.go", "package sub\n\nfunc B() {}\n")
+
+	// The second tag is served from the cache, compressed as well
+	for _, tag := range []string{"v1", "v2"} {
+		_, err := p.ProcessRepo(context.Background(), NewDirSource(dir), "", tag)
+		if err != nil {
+			t.Fatal(err)
+		}
+		tagDir := filepath.Join(p.OutputDir(), tag)
+		index, err := LoadIndex(tagDir)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if entry := index.Files["sub/b.go"]; entry.Output != "sub/b.go.json.zst" || entry.Package != "sub" {
+			t.Errorf("unexpected index entry %+v", entry)
+		}
+		data, err := os.ReadFile(filepath.Join(tagDir, "sub", "b.go.json.zst"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if data[0] != 0x28 || data[1] != 0xb5 {
+			t.Errorf("%s JSON is not compressed", tag)
+		}
+		err = p.ReadFiles(tagDir)
+		if err != nil {
+			t.Error(err)
+		}
+		files, err := p.readOutputFiles(tagDir)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(files) != 2 || files[1].Name != "sub" {
+			t.Errorf("unexpected output files %+v", files)
+		}
+	}
+	// Each JSON is cached with its summary
+	cached, err := filepath.Glob(filepath.Join(cache.dir, "json", "*-zstd", "*", "*"))
+	if err != nil || len(cached) != 4 {
+		t.Errorf("unexpected cached JSON %v, %v", cached, err)
+	}
+	infos, err := filepath.Glob(filepath.Join(cache.dir, "json", "*-zstd", "*", "*.info.json"))
+	if err != nil || len(infos) != 2 {
+		t.Errorf("unexpected cached summaries %v, %v", infos, err)
+	}
+}
@@ -0,0 +1,423 @@
+package processors
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	astjson "GoOperatorAST/ast_json"
+	"go.uber.org/zap"
+)
+
+// PoolSizes sets the workers of each stage of the pipeline and the length of
+// the queue in front of each stage. Submitting to a full queue blocks, so a
+// slow stage holds back the ones before it instead of piling up files in memory.
+type PoolSizes struct {
+	// Fetch reads files from the sources, mostly waiting on the network.
+	Fetch int
+	// Parse parses files and marshals their AST.
+	Parse int
+	// Write encodes the marshalled AST and writes the JSON files.
+	Write int
+	// Analysis runs the handlers over the JSON files of a tag.
+	Analysis int
+	// Queue is the number of items waiting in front of each stage.
+	Queue int
+}
+
+// DefaultPoolSizes returns pool sizes suited to the machine.
+func DefaultPoolSizes() PoolSizes {
+	cpus := runtime.NumCPU()
+	return PoolSizes{Fetch: 32, Parse: cpus, Write: 8, Analysis: cpus, Queue: 64}
+}
+
+// Validate reports pool sizes that would stall the pipeline.
+func (s PoolSizes) Validate() error {
+	if s.Fetch < 1 || s.Parse < 1 || s.Write < 1 || s.Analysis < 1 || s.Queue < 1 {
+		return fmt.Errorf("every pool size and the queue length must be at least 1, got %+v", s)
+	}
+	return nil
+}
+
+// StageStats is a snapshot of one stage of the pipeline.
+type StageStats struct {
+	Name    string
+	Workers int
+	Busy    int
+	Queued  int
+	Done    int64
+	// Waited is the time the items spent in the queue, Worked the time the workers spent on them.
+	Waited time.Duration
+	Worked time.Duration
+}
+
+// queued is an item waiting in the queue of a stage since at.
+type queued[T any] struct {
+	item T
+	at   time.Time
+}
+
+// stage runs handle on the items of a bounded queue with a fixed number of workers.
+type stage[T any] struct {
+	name    string
+	workers int
+	queue   chan queued[T]
+	logger  *zap.Logger
+	handle  func(T)
+	// fail receives the items whose handler panicked, so that they are not lost.
+	fail   func(T, error)
+	wg     sync.WaitGroup
+	busy   atomic.Int32
+	done   atomic.Int64
+	waited atomic.Int64
+	worked atomic.Int64
+}
+
+func newStage[T any](name string, workers, queue int, logger *zap.Logger, handle func(T), fail func(T, error)) *stage[T] {
+	s := &stage[T]{name: name, workers: workers, queue: make(chan queued[T], queue), logger: logger, handle: handle, fail: fail}
+	for i := 0; i < workers; i++ {
+		s.wg.Add(1)
+		go s.work()
+	}
+	return s
+}
+
+func (s *stage[T]) work() {
+	defer s.wg.Done()
+	for item := range s.queue {
+		s.run(item)
+	}
+}
+
+func (s *stage[T]) run(q queued[T]) {
+	item := q.item
+	start := time.Now()
+	s.waited.Add(int64(start.Sub(q.at)))
+	s.busy.Add(1)
+	defer func() {
+		s.busy.Add(-1)
+		s.done.Add(1)
+		s.worked.Add(int64(time.Since(start)))
+		if r := recover(); r != nil {
+			s.logger.Error("Pipeline stage panicked", zap.String("stage", s.name), zap.Any("panic", r))
+			s.fail(item, fmt.Errorf("%s stage: %v", s.name, r))
+		}
+	}()
+	s.handle(item)
+}
+
+// submit queues an item, blocking while the queue is full.
+func (s *stage[T]) submit(item T) {
+	s.queue <- queued[T]{item: item, at: time.Now()}
+}
+
+func (s *stage[T]) stop() {
+	close(s.queue)
+	s.wg.Wait()
+}
+
+func (s *stage[T]) stats() StageStats {
+	return StageStats{
+		Name:    s.name,
+		Workers: s.workers,
+		Busy:    int(s.busy.Load()),
+		Queued:  len(s.queue),
+		Done:    s.done.Load(),
+		Waited:  time.Duration(s.waited.Load()),
+		Worked:  time.Duration(s.worked.Load()),
+	}
+}
+
+// pipeline moves files through the fetch, parse and write stages, and the
+// JSON files of a tag through the analysis stage.
+type pipeline struct {
+	fetch    *stage[*fileJob]
+	parse    *stage[*fileJob]
+	write    *stage[*fileJob]
+	analysis *stage[*analysisJob]
+}
+
+// newPipeline starts the stages of the pipeline of p.
+func newPipeline(p *Processor, sizes PoolSizes) *pipeline {
+	return &pipeline{
+		fetch:    newStage("fetch", sizes.Fetch, sizes.Queue, p.logger, p.fetchFile, (*fileJob).finish),
+		parse:    newStage("parse", sizes.Parse, sizes.Queue, p.logger, p.parseFile, (*fileJob).finish),
+		write:    newStage("write", sizes.Write, sizes.Queue, p.logger, p.writeFile, (*fileJob).finish),
+		analysis: newStage("analysis", sizes.Analysis, sizes.Queue, p.logger, p.analyseFile, (*analysisJob).finish),
+	}
+}
+
+// stop waits for the queued items and stops the workers.
+func (s *pipeline) stop() {
+	s.fetch.stop()
+	s.parse.stop()
+	s.write.stop()
+	s.analysis.stop()
+}
+
+// stats returns a snapshot of every stage in pipeline order.
+func (s *pipeline) stats() []StageStats {
+	return []StageStats{s.fetch.stats(), s.parse.stats(), s.write.stats(), s.analysis.stats()}
+}
+
+// fileJob carries one file of a ref through the stages.
+type fileJob struct {
+	proc     *Processor
+	ctx      context.Context
+	src      Source
+	file     SourceFile
+	ref      string
+	opts     ProcessOptions
+	manifest *Manifest
+	wg       *sync.WaitGroup
+
+	// stage names the stage handling the job, for its progress events.
+	stage string
+	// sha is the blob SHA of the file, computed from its content when the source has none.
+	sha     string
+	content string
+	meta    *astjson.FileMetaNode
+	node    *astjson.FileNode
+	// pkg is the package name of a file written by the write stage, for the index.
+	pkg string
+	// jsonFile is the fully qualified name of the JSON file, set by the fetch stage.
+	jsonFile string
+	// info summarises the file once it is parsed or served from the cache.
+	info *FileInfo
+	err  error
+}
+
+// output returns the name of the JSON file of the job inside the tag directory.
+func (job *fileJob) output() string {
+	return outputName(job.file.Path, job.proc.marshal.Compression)
+}
+
+// fqfn returns the fully qualified name of the JSON file of the job.
+// Paths that would leave the output directory are rejected.
+func (job *fileJob) fqfn() (string, error) {
+	output := filepath.FromSlash(job.output())
+	if !filepath.IsLocal(output) {
+		return "", fmt.Errorf("%s is outside of the output directory", job.file.Path)
+	}
+	return filepath.Join(job.opts.OutputDir, output), nil
+}
+
+// finish records the outcome of a job and releases the ProcessRef waiting for it.
+// The states of successful and excluded files are recorded by the stages.
+func (job *fileJob) finish(err error) {
+	defer job.wg.Done()
+	job.err = err
+	job.content, job.node = "", nil
+	switch {
+	case err == nil || err == errExcluded:
+	case job.interrupted():
+		job.manifest.Set(job.file, job.output(), StateInterrupted, err)
+		job.proc.emit(job.fileEvent(EventInterrupted))
+	default:
+		job.manifest.Set(job.file, job.output(), StateFailed, err)
+		event := job.fileEvent(EventFailed)
+		event.Error = err.Error()
+		job.proc.emit(event)
+	}
+}
+
+// interrupted reports whether the job failed because its run was cancelled.
+func (job *fileJob) interrupted() bool {
+	return job.err != nil && job.ctx.Err() != nil && errors.Is(job.err, job.ctx.Err())
+}
+
+// fetchFile serves a file from the cache or reads it from its source and hands it to the parse stage.
+func (p *Processor) fetchFile(job *fileJob) {
+	job.stage = "fetch"
+	start := time.Now()
+	if job.ctx.Err() != nil {
+		job.finish(job.ctx.Err())
+		return
+	}
+	path := job.file.Path
+
+	// The JSON files mirror the directories of the repository
+	jsonFile, err := job.fqfn()
+	if err != nil {
+		job.finish(err)
+		return
+	}
+	job.jsonFile = jsonFile
+	err = os.MkdirAll(filepath.Dir(job.jsonFile), 0755)
+	if err != nil {
+		job.finish(err)
+		return
+	}
+
+	// Unchanged files are served from the cache without fetching or parsing them
+	key := jsonCacheKey(job.file.SHA, path)
+	hit, err := p.copyCachedJSON(key, job.jsonFile)
+	if err != nil {
+		job.finish(err)
+		return
+	}
+	if hit {
+		p.finishCached(job, key, start)
+		return
+	}
+
+	// Fetch Golang code from the source
+	content, err := p.readSource(job.ctx, job.src, job.file, job.ref)
+	if err != nil && job.ctx.Err() != nil {
+		job.finish(job.ctx.Err())
+		return
+	}
+	if err != nil {
+		p.logger.Error("Error fetching repository content", zap.String("path", path), zap.Error(err))
+		job.finish(err)
+		return
+	}
+	event := job.fileEvent(EventFetched)
+	event.Bytes, event.Seconds = int64(len(content)), time.Since(start).Seconds()
+	p.emit(event)
+
+	// Generated code is only recognisable by its content
+	meta := p.fileMeta(path, content)
+	if !job.opts.Filter.allows(meta.Category) {
+		job.manifest.Remove(path)
+		p.emit(job.fileEvent(EventExcluded))
+		job.finish(errExcluded)
+		return
+	}
+
+	// Sources without blob SHAs can still skip parsing identical content
+	job.sha = job.file.SHA
+	if job.sha == "" {
+		job.sha = gitBlobSHA([]byte(content))
+	}
+	if job.file.SHA == "" && p.cache != nil {
+		key := jsonCacheKey(job.sha, path)
+		hit, err := p.copyCachedJSON(key, job.jsonFile)
+		if err != nil {
+			job.finish(err)
+			return
+		}
+		if hit {
+			p.finishCached(job, key, start)
+			return
+		}
+	}
+	job.manifest.Set(job.file, job.output(), StateFetched, nil)
+
+	job.content, job.meta = content, meta
+	p.stages.parse.submit(job)
+}
+
+// parseFile parses the file and marshals its AST, then hands it to the write stage.
+func (p *Processor) parseFile(job *fileJob) {
+	job.stage = "parse"
+	start := time.Now()
+	// Files that were still queued when the run was cancelled are left unprocessed
+	if job.ctx.Err() != nil {
+		job.finish(job.ctx.Err())
+		return
+	}
+	node, err := astjson.SourceToNode(&job.content, job.file.Path, p.marshal, job.meta)
+	job.content = ""
+	if err != nil {
+		p.logger.Error("unable to convert file to json", zap.String("path", job.file.Path), zap.Error(err))
+		job.finish(err)
+		return
+	}
+	job.node = node
+	job.info, err = describeFile(node, job.file.Path, job.sha, p.marshal)
+	if err != nil {
+		p.logger.Warn("Unable to summarise file", zap.String("path", job.file.Path), zap.Error(err))
+		job.info = nil
+	}
+	event := job.fileEvent(EventParsed)
+	event.Seconds = time.Since(start).Seconds()
+	p.emit(event)
+	p.stages.write.submit(job)
+}
+
+// writeFile writes the JSON file of a marshalled AST and keeps it in the cache.
+// Marshalled files are written even once the run is cancelled, their work is done.
+func (p *Processor) writeFile(job *fileJob) {
+	job.stage = "write"
+	start := time.Now()
+	err := astjson.WriteJSON(job.jsonFile, strings.Repeat(" ", 2), job.node)
+	if job.node.Name != nil {
+		job.pkg = job.node.Name.Name
+	}
+	job.node = nil
+	if err != nil {
+		p.logger.Error("unable to write json", zap.String("path", job.file.Path), zap.Error(err))
+		job.finish(err)
+		return
+	}
+	job.manifest.Set(job.file, job.output(), StateMarshalled, nil)
+
+	// Keep the result and its summary for other tags and later runs
+	if p.cache != nil && job.sha != "" {
+		key := jsonCacheKey(job.sha, job.file.Path)
+		err = p.cache.PutJSON(key, p.marshal, job.jsonFile)
+		if err == nil && job.info != nil {
+			err = p.cache.PutFileInfo(key, p.marshal, job.info)
+		}
+		if err != nil {
+			p.logger.Warn("unable to cache json", zap.String("path", job.file.Path), zap.Error(err))
+		}
+	}
+	event := job.fileEvent(EventMarshalled)
+	event.Seconds = time.Since(start).Seconds()
+	if info, err := os.Stat(job.jsonFile); err == nil {
+		event.Bytes = info.Size()
+	}
+	p.emit(event)
+	job.finish(nil)
+}
+
+// finishCached finishes a job whose JSON was copied from the cache entry key.
+func (p *Processor) finishCached(job *fileJob, key string, start time.Time) {
+	err := job.finishCachedFile()
+	switch err {
+	case nil:
+		// Files cached without a summary are summarised by writeFileInfos
+		job.info, _ = p.cache.FileInfo(key, p.marshal)
+		event := job.fileEvent(EventCached)
+		event.Seconds = time.Since(start).Seconds()
+		p.emit(event)
+	case errExcluded:
+		p.emit(job.fileEvent(EventExcluded))
+	}
+	job.finish(err)
+}
+
+// analysisJob runs the handlers over one JSON file of a tag directory.
+type analysisJob struct {
+	proc     *Processor
+	dir      string
+	fileName string
+	wg       *sync.WaitGroup
+	err      error
+}
+
+func (job *analysisJob) finish(err error) {
+	defer job.wg.Done()
+	job.err = err
+	if err != nil {
+		job.proc.emit(ProgressEvent{Event: EventFailed, Dir: job.dir, Path: job.fileName, Stage: "analysis", Error: err.Error()})
+	}
+}
+
+func (p *Processor) analyseFile(job *analysisJob) {
+	start := time.Now()
+	err := p.createNodeMap(job.dir, job.fileName)
+	if err == nil {
+		p.emit(ProgressEvent{Event: EventAnalysed, Dir: job.dir, Path: job.fileName, Stage: "analysis", Seconds: time.Since(start).Seconds()})
+	}
+	job.finish(err)
+}
@@ -0,0 +1,81 @@
+package processors
+
+import (
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	astjson "GoOperatorAST/ast_json"
+	"go.uber.org/zap"
+)
+
+// writeASTJSON marshals a Go file the way the pipeline of p does.
+func writeASTJSON(t *testing.T, p *Processor, path, content, output string) {
+	t.Helper()
+	err := os.MkdirAll(filepath.Dir(output), 0755)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = astjson.SourceToJSONWithMeta(&content, path, output, "  ", p.marshal, p.fileMeta(path, content))
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestPoolSizesValidate(t *testing.T) {
+	if err := DefaultPoolSizes().Validate(); err != nil {
+		t.Error(err)
+	}
+	sizes := DefaultPoolSizes()
+	sizes.Queue = 0
+	if err := sizes.Validate(); err == nil {
+		t.Error("an empty queue was accepted")
+	}
+}
+
+func TestStageBackpressure(t *testing.T) {
+	release := make(chan struct{})
+	var handled sync.WaitGroup
+	s := newStage("test", 1, 1, zap.NewNop(), func(int) {
+		<-release
+		handled.Done()
+	}, func(int, error) {})
+
+	// One item is handled and one is queued, the third waits for room
+	handled.Add(3)
+	s.submit(1)
+	s.submit(2)
+	submitted := make(chan struct{})
+	go func() {
+		s.submit(3)
+		close(submitted)
+	}()
+	select {
+	case <-submitted:
+		t.Fatal("submit did not block on a full queue")
+	case <-time.After(50 * time.Millisecond):
+	}
+	if stats := s.stats(); stats.Busy != 1 || stats.Queued != 1 {
+		t.Errorf("unexpected stats %+v", stats)
+	}
+
+	close(release)
+	<-submitted
+	handled.Wait()
+	s.stop()
+	if stats := s.stats(); stats.Done != 3 || stats.Busy != 0 {
+		t.Errorf("unexpected stats %+v", stats)
+	}
+}
+
+func TestStageRecoversPanics(t *testing.T) {
+	var failed error
+	s := newStage("test", 1, 1, zap.NewNop(), func(int) { panic("boom") }, func(_ int, err error) { failed = err })
+	s.submit(1)
+	s.stop()
+	if failed == nil {
+		t.Error("the panicking item was not failed")
+	}
+}
@@ -5,7 +5,7 @@ import (
 	"strings"
 )
 
-func FuncDeclHandler(declMap map[string]interface{}) {
+func (p *Processor) FuncDeclHandler(declMap map[string]interface{}) {
 	var funcName string
 	var params []interface{}
 
@@ -17,14 +17,14 @@ func FuncDeclHandler(declMap map[string]interface{}) {
 	paramTypeList := []string{}
 
 	if declMap["Type"] != nil {
-		p := declMap["Type"].(map[string]interface{})["Params"]
-		paramMap := p.(map[string]interface{})
+		typeParams := declMap["Type"].(map[string]interface{})["Params"]
+		paramMap := typeParams.(map[string]interface{})
 		if paramMap["List"] != nil {
 			params = paramMap["List"].([]interface{})
 			for _, pm := range params {
 				var param map[string]interface{}
 				param = pm.(map[string]interface{})
-				nameList := param["Names"].([]interface{})
+				nameList, _ := param["Names"].([]interface{})
 				if param["Type"] != nil {
 					typeMap := param["Type"].(map[string]interface{})
 					for _, name := range nameList {
@@ -32,34 +32,34 @@ func FuncDeclHandler(declMap map[string]interface{}) {
 					}
 					switch typeMap["NodeType"].(string) {
 					case "Ident":
-						paramNameList, paramTypeList = IdentTypeHandler(typeMap, param)
-						logger.Debug("FuncDeclHandler->Ident")
+						paramNameList, paramTypeList = p.IdentTypeHandler(typeMap, param)
+						p.logger.Debug("FuncDeclHandler->Ident")
 					case "SelectorExpr":
-						SelectorExprHandler(typeMap)
-						logger.Debug("FuncDeclHandler->SelectorExpr")
+						p.SelectorExprHandler(typeMap)
+						p.logger.Debug("FuncDeclHandler->SelectorExpr")
 					case "StarExpr":
-						StarExprHandler(typeMap, param)
-						logger.Debug("FuncDeclHandler->StarExpr")
+						p.StarExprHandler(typeMap, param)
+						p.logger.Debug("FuncDeclHandler->StarExpr")
 					case "ArrayType":
-						ArrayTypeHandler(typeMap, param)
-						logger.Debug("FuncDeclHandler->ArrayType")
+						p.ArrayTypeHandler(typeMap, param)
+						p.logger.Debug("FuncDeclHandler->ArrayType")
 					case "MapType":
-						MapTypeHandler(typeMap, param)
-						logger.Debug("FuncDeclHandler->MapType")
+						p.MapTypeHandler(typeMap, param)
+						p.logger.Debug("FuncDeclHandler->MapType")
 					case "FuncType":
-						logger.Debug("FuncDeclHandler->FuncType", zap.Any("name", param["Names"]))
+						p.logger.Debug("FuncDeclHandler->FuncType", zap.Any("name", param["Names"]))
 					case "InterfaceType":
-						logger.Debug("FuncDeclHandler->InterfaceType", zap.Any("name", param["Names"]))
+						p.logger.Debug("FuncDeclHandler->InterfaceType", zap.Any("name", param["Names"]))
 					case "Ellipsis":
-						EllipsisTypeHandler(typeMap, param)
-						logger.Debug("FuncDeclHandler->Ellipsis")
+						p.EllipsisTypeHandler(typeMap, param)
+						p.logger.Debug("FuncDeclHandler->Ellipsis")
 					case "ChanType":
-						logger.Debug("FuncDeclHandler->ChanType", zap.Any("name", param["Names"]))
+						p.logger.Debug("FuncDeclHandler->ChanType", zap.Any("name", param["Names"]))
 					case "StructType":
-						StructTypeHandler(typeMap)
-						logger.Debug("FuncDeclHandler->StructType")
+						p.StructTypeHandler(typeMap)
+						p.logger.Debug("FuncDeclHandler->StructType")
 					default:
-						logger.Debug("FuncDeclHandler->Unknown", zap.Any("name", param["Names"]))
+						p.logger.Debug("FuncDeclHandler->Unknown", zap.Any("name", param["Names"]))
 					}
 
 				}
@@ -67,8 +67,8 @@ func FuncDeclHandler(declMap map[string]interface{}) {
 		}
 	}
 	if declMap["Results"] != nil {
-		p := declMap["Results"].(map[string]interface{})["List"]
-		paramMap := p.([]interface{})
+		results := declMap["Results"].(map[string]interface{})["List"]
+		paramMap := results.([]interface{})
 		for _, param := range paramMap {
 			typeMap := param.(map[string]interface{})
 			typeName := typeMap["Type"].(map[string]interface{})["Name"].(string)
@@ -76,15 +76,15 @@ func FuncDeclHandler(declMap map[string]interface{}) {
 		}
 	}
 	if declMap["Body"] != nil {
-		logger.Debug("Function body", zap.Any("name", declMap["Body"]))
+		p.logger.Debug("Function body", zap.Any("name", declMap["Body"]))
 	}
 
-	logger.Debug("FuncDecl", zap.String("function name", funcName), zap.Any("params", paramNameList), zap.Any("types", paramTypeList))
+	p.logger.Debug("FuncDecl", zap.String("function name", funcName), zap.Any("params", paramNameList), zap.Any("types", paramTypeList))
 }
 
-func GenDeclHandler(declMap map[string]interface{}) {
+func (p *Processor) GenDeclHandler(declMap map[string]interface{}) {
 
-	logger.Debug("GenDecl", zap.Any("name", declMap["Tok"]))
+	p.logger.Debug("GenDecl", zap.Any("name", declMap["Tok"]))
 	spec := declMap["Specs"].([]interface{})
 	for _, s := range spec {
 		sMap := s.(map[string]interface{})
@@ -94,7 +94,7 @@ func GenDeclHandler(declMap map[string]interface{}) {
 			if sMap["Name"] == nil {
 				path := sMap["Path"].(map[string]interface{})
 				value := strings.ReplaceAll(path["Value"].(string), "\"", "")
-				logger.Debug("ImportSpec", zap.Any("name", value))
+				p.logger.Debug("ImportSpec", zap.Any("name", value))
 			}
 		case "TypeSpec":
 			for _, s := range spec {
@@ -105,41 +105,41 @@ func GenDeclHandler(declMap map[string]interface{}) {
 					if sMap["Name"] == nil {
 						path := sMap["Path"].(map[string]interface{})
 						value := strings.ReplaceAll(path["Value"].(string), "\"", "")
-						logger.Debug("TypeSpec->ImportSpec", zap.Any("name", value))
+						p.logger.Debug("TypeSpec->ImportSpec", zap.Any("name", value))
 					}
 				case "TypeSpec":
 					if sMap["Name"] != nil {
 						name := sMap["Name"].(map[string]interface{})
 						typeMap := sMap["Type"].(map[string]interface{})
 						if typeMap["Name"] != nil {
-							logger.Debug("TypeSpec->TypeSpec", zap.String("type", typeMap["Name"].(string)), zap.Any("name", name["Name"]))
+							p.logger.Debug("TypeSpec->TypeSpec", zap.String("type", typeMap["Name"].(string)), zap.Any("name", name["Name"]))
 						} else {
 							// we are dealing with a struct or an interface
 							switch typeMap["NodeType"].(string) {
 							case "StructType":
-								StructTypeHandler(typeMap)
+								p.StructTypeHandler(typeMap)
 							case "InterfaceType":
-								logger.Debug("TypeSpec->TypeSpec", zap.String("type", "interface"), zap.Any("name", name["Name"]))
+								p.logger.Debug("TypeSpec->TypeSpec", zap.String("type", "interface"), zap.Any("name", name["Name"]))
 							default:
-								logger.Debug("TypeSpec->TypeSpec Unknown", zap.Any("type", typeMap))
+								p.logger.Debug("TypeSpec->TypeSpec Unknown", zap.Any("type", typeMap))
 							}
 						}
 					} else {
-						logger.Debug("Uknown TypeSpec", zap.Any("name", sMap))
+						p.logger.Debug("Uknown TypeSpec", zap.Any("name", sMap))
 					}
 				case "ValueSpec":
-					ValueSpecItemHandler(sMap)
+					p.ValueSpecItemHandler(sMap)
 
 				default:
-					logger.Debug("Unknown", zap.Any("name", sMap["Name"]))
+					p.logger.Debug("Unknown", zap.Any("name", sMap["Name"]))
 				}
 			}
-			logger.Debug("TypeSpec", zap.Any("name", sMap["Name"]))
+			p.logger.Debug("TypeSpec", zap.Any("name", sMap["Name"]))
 		case "ValueSpec":
-			ValueSpecHandler(spec)
+			p.ValueSpecHandler(spec)
 
 		default:
-			logger.Debug("Unknown", zap.Any("name", sMap["Name"]))
+			p.logger.Debug("Unknown", zap.Any("name", sMap["Name"]))
 		}
 	}
 }
@@ -0,0 +1,150 @@
+package processors
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+
+	astjson "GoOperatorAST/ast_json"
+	"go.uber.org/zap"
+)
+
+// DefaultMarshalOptions are the options used to convert files to JSON when Options.Marshal is nil.
+var DefaultMarshalOptions = astjson.Options{
+	WithImports:    false,
+	WithComments:   false,
+	WithPositions:  true,
+	WithReferences: true,
+}
+
+// Options configure a Processor. The zero value of every field but OutputDir
+// selects its default.
+type Options struct {
+	// OutputDir receives one directory of JSON files per processed ref.
+	OutputDir string
+	// Logger receives the progress and failures of the processor, nil discards them.
+	Logger *zap.Logger
+	// Pools sizes the stages of the pipeline, the zero value uses DefaultPoolSizes.
+	Pools PoolSizes
+	// Marshal selects what the JSON files hold, nil uses DefaultMarshalOptions.
+	Marshal *astjson.Options
+	// Compression compresses the JSON files, their names get its extension.
+	// It overrides the compression of Marshal unless it is CompressionNone.
+	Compression astjson.Compression
+	// Cache shares fetched and converted files across refs and runs, nil disables it.
+	Cache *BlobCache
+	// Filter selects the files to process, nil processes the default selection.
+	Filter *FileFilter
+	// Progress receives the events of the processing, nil drops them.
+	Progress Progress
+	// Resume skips the files the manifest of a ref records as marshalled from
+	// the same blob, and retries the others.
+	Resume bool
+}
+
+// Processor converts the Go files of repositories to JSON and analyses the
+// results. Processors share no state, several of them can run in one process.
+type Processor struct {
+	outputDir string
+	logger    *zap.Logger
+	marshal   astjson.Options
+	cache     *BlobCache
+	filter    *FileFilter
+	resume    bool
+	progress  Progress
+	stages    *pipeline
+
+	// manifests holds the manifest of every tag directory processed so far.
+	manifests   map[string]*Manifest
+	manifestsMu sync.Mutex
+}
+
+// NewProcessor validates opts and starts the pipeline of a Processor. Close stops it.
+// @param opts Options
+func NewProcessor(opts Options) (*Processor, error) {
+	if opts.OutputDir == "" {
+		return nil, errors.New("the processor needs an output directory")
+	}
+	if opts.Pools == (PoolSizes{}) {
+		opts.Pools = DefaultPoolSizes()
+	}
+	if err := opts.Pools.Validate(); err != nil {
+		return nil, err
+	}
+	if opts.Filter == nil {
+		opts.Filter = &FileFilter{}
+	}
+	if err := opts.Filter.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid file filter: %w", err)
+	}
+	p := &Processor{
+		outputDir: opts.OutputDir,
+		logger:    opts.Logger,
+		marshal:   DefaultMarshalOptions,
+		cache:     opts.Cache,
+		filter:    opts.Filter,
+		resume:    opts.Resume,
+		progress:  opts.Progress,
+		manifests: map[string]*Manifest{},
+	}
+	if p.logger == nil {
+		p.logger = zap.NewNop()
+	}
+	if opts.Marshal != nil {
+		p.marshal = *opts.Marshal
+	}
+	if opts.Compression != astjson.CompressionNone {
+		p.marshal.Compression = opts.Compression
+	}
+	if err := p.marshal.Compression.Set(p.marshal.Compression.String()); err != nil {
+		return nil, err
+	}
+	p.stages = newPipeline(p, opts.Pools)
+	return p, nil
+}
+
+// OutputDir returns the directory receiving the output of ProcessRepo.
+func (p *Processor) OutputDir() string {
+	return p.outputDir
+}
+
+// Stats returns a snapshot of every stage of the pipeline in pipeline order.
+func (p *Processor) Stats() []StageStats {
+	return p.stages.stats()
+}
+
+// Close waits for the queued files and stops the pipeline. The processor must not be used afterwards.
+func (p *Processor) Close() {
+	p.stages.stop()
+}
+
+// openManifest returns the manifest of a tag directory, the stored one when resuming.
+func (p *Processor) openManifest(dir string) (*Manifest, error) {
+	p.manifestsMu.Lock()
+	defer p.manifestsMu.Unlock()
+	if m, ok := p.manifests[dir]; ok {
+		return m, nil
+	}
+	m := NewManifest(dir)
+	if p.resume {
+		var err error
+		m, err = LoadManifest(dir)
+		if err != nil {
+			return nil, err
+		}
+	}
+	m.logger = p.logger
+	p.manifests[dir] = m
+	return m, nil
+}
+
+// SaveManifests writes the pending changes of every manifest opened by ProcessRepo.
+func (p *Processor) SaveManifests() error {
+	p.manifestsMu.Lock()
+	defer p.manifestsMu.Unlock()
+	var errs []error
+	for _, m := range p.manifests {
+		errs = append(errs, m.Save())
+	}
+	return errors.Join(errs...)
+}
@@ -0,0 +1,77 @@
+package processors
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// newTestProcessor returns a processor with small pools writing to a temporary directory unless opts says otherwise.
+func newTestProcessor(t *testing.T, opts Options) *Processor {
+	t.Helper()
+	if opts.OutputDir == "" {
+		opts.OutputDir = t.TempDir()
+	}
+	if opts.Pools == (PoolSizes{}) {
+		opts.Pools = PoolSizes{Fetch: 2, Parse: 2, Write: 1, Analysis: 1, Queue: 1}
+	}
+	p, err := NewProcessor(opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(p.Close)
+	return p
+}
+
+func TestNewProcessor(t *testing.T) {
+	if _, err := NewProcessor(Options{}); err == nil {
+		t.Error("a processor without output directory was created")
+	}
+	if _, err := NewProcessor(Options{OutputDir: t.TempDir(), Pools: PoolSizes{Fetch: 1}}); err == nil {
+		t.Error("a processor without parse workers was created")
+	}
+	if _, err := NewProcessor(Options{OutputDir: t.TempDir(), Filter: &FileFilter{Include: []string{"["}}}); err == nil {
+		t.Error("a processor with an invalid filter was created")
+	}
+
+	p := newTestProcessor(t, Options{})
+	if p.marshal != DefaultMarshalOptions || p.logger == nil || p.filter == nil {
+		t.Errorf("defaults not applied: %+v", p)
+	}
+}
+
+func TestProcessorsAreIndependent(t *testing.T) {
+	dir := t.TempDir()
+	writeFile(t, dir, "a.go", "package a\n\nfunc A() {}\n")
+	writeFile(t, dir, "a_test.go", "package a\n")
+
+	// Two jobs with their own output and filter run at once
+	plain := newTestProcessor(t, Options{})
+	withTests := newTestProcessor(t, Options{Filter: &FileFilter{Tests: true}})
+	results := make(chan error, 2)
+	for _, p := range []*Processor{plain, withTests} {
+		go func(p *Processor) {
+			_, err := p.ProcessRepo(context.Background(), NewDirSource(dir), "", "v1")
+			results <- err
+		}(p)
+	}
+	for i := 0; i < 2; i++ {
+		if err := <-results; err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	for p, want := range map[*Processor]int{plain: 1, withTests: 2} {
+		files, err := p.readOutputFiles(filepath.Join(p.OutputDir(), "v1"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(files) != want {
+			t.Errorf("%s holds %d files, want %d", p.OutputDir(), len(files), want)
+		}
+	}
+	if _, err := os.Stat(filepath.Join(plain.OutputDir(), "v1", "a_test.go.json")); !os.IsNotExist(err) {
+		t.Errorf("the filter of one processor leaked into the other: %v", err)
+	}
+}
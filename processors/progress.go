@@ -0,0 +1,129 @@
+package processors
+
+import (
+	"encoding/json"
+	"io"
+	"sync"
+	"time"
+)
+
+// Events reported to a Progress.
+const (
+	// EventDiscovered reports the files of a ref selected for processing.
+	EventDiscovered = "discovered"
+	// EventFetched reports a file read from its source.
+	EventFetched = "fetched"
+	// EventCached reports a file whose JSON was served from the blob cache.
+	EventCached = "cached"
+	// EventExcluded reports a file left out as generated code once fetched.
+	EventExcluded = "excluded"
+	// EventParsed reports a file parsed and marshalled.
+	EventParsed = "parsed"
+	// EventMarshalled reports the JSON of a file written.
+	EventMarshalled = "marshalled"
+	// EventFailed reports a file missing from the output.
+	EventFailed = "failed"
+	// EventInterrupted reports a file left unprocessed by a cancelled run.
+	EventInterrupted = "interrupted"
+	// EventAnalysed reports a JSON file analysed by the handlers.
+	EventAnalysed = "analysed"
+	// EventRefDone reports the end of the processing of a ref.
+	EventRefDone = "ref_done"
+)
+
+// ProgressEvent is one step of the processing of a ref.
+type ProgressEvent struct {
+	Time  time.Time `json:"Time"`
+	Event string    `json:"Event"`
+	// Ref is the ref as named in the output, Dir its output directory.
+	Ref  string `json:"Ref,omitempty"`
+	Dir  string `json:"Dir,omitempty"`
+	Path string `json:"Path,omitempty"`
+	// Stage is the pipeline stage that handled the file.
+	Stage string `json:"Stage,omitempty"`
+	// Files counts the files discovered, or the files written once the ref is done.
+	Files int `json:"Files,omitempty"`
+	// Missing counts the files that failed or were interrupted once the ref is done.
+	Missing int `json:"Missing,omitempty"`
+	// Bytes is the size of the source of fetched files and of the JSON of marshalled files.
+	Bytes int64 `json:"Bytes,omitempty"`
+	// Seconds is the time the stage spent on the file, or on the whole ref once it is done.
+	Seconds float64 `json:"Seconds,omitempty"`
+	Error   string  `json:"Error,omitempty"`
+}
+
+// Progress receives the events of a Processor. Events arrive from every
+// stage at once, implementations must be safe for concurrent use.
+type Progress interface {
+	Event(event ProgressEvent)
+}
+
+// NDJSONProgress writes every event as a line of JSON.
+type NDJSONProgress struct {
+	mu  sync.Mutex
+	enc *json.Encoder
+}
+
+// NewNDJSONProgress returns a Progress writing newline delimited JSON to w.
+// @param w io.Writer
+func NewNDJSONProgress(w io.Writer) *NDJSONProgress {
+	return &NDJSONProgress{enc: json.NewEncoder(w)}
+}
+
+// Event writes event, write errors are dropped so that they do not stop processing.
+func (n *NDJSONProgress) Event(event ProgressEvent) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	_ = n.enc.Encode(event)
+}
+
+// multiProgress hands every event to several Progress.
+type multiProgress []Progress
+
+func (m multiProgress) Event(event ProgressEvent) {
+	for _, progress := range m {
+		progress.Event(event)
+	}
+}
+
+// MultiProgress returns a Progress handing every event to each of progress, nil ones are skipped.
+// @param progress ...Progress
+func MultiProgress(progress ...Progress) Progress {
+	var m multiProgress
+	for _, p := range progress {
+		if p != nil {
+			m = append(m, p)
+		}
+	}
+	switch len(m) {
+	case 0:
+		return nil
+	case 1:
+		return m[0]
+	}
+	return m
+}
+
+// emit stamps event and hands it to the Progress of the processor, if any.
+func (p *Processor) emit(event ProgressEvent) {
+	if p.progress == nil {
+		return
+	}
+	if event.Time.IsZero() {
+		event.Time = time.Now()
+	}
+	p.progress.Event(event)
+}
+
+// fileEvent returns an event about the file of a job.
+func (job *fileJob) fileEvent(event string) ProgressEvent {
+	return ProgressEvent{Event: event, Ref: job.refName(), Dir: job.opts.OutputDir, Path: job.file.Path, Stage: job.stage}
+}
+
+// refName returns the name of the ref of a job in the output.
+func (job *fileJob) refName() string {
+	if job.opts.Ref != nil {
+		return job.opts.Ref.DirName()
+	}
+	return job.ref
+}
@@ -0,0 +1,51 @@
+package processors
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"testing"
+)
+
+func TestProgressEvents(t *testing.T) {
+	var out bytes.Buffer
+	p := newTestProcessor(t, Options{Progress: NewNDJSONProgress(&out)})
+	dir := t.TempDir()
+	writeFile(t, dir, "a.go", "package a\n\nfunc A() {}\n")
+	writeFile(t, dir, "broken.go", "package a\n\nfunc {\n")
+
+	_, err := p.ProcessRepo(context.Background(), NewDirSource(dir), "", "v1")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	events := map[string][]ProgressEvent{}
+	scanner := bufio.NewScanner(&out)
+	for scanner.Scan() {
+		var event ProgressEvent
+		if err := json.Unmarshal(scanner.Bytes(), &event); err != nil {
+			t.Fatalf("invalid line %q: %v", scanner.Text(), err)
+		}
+		if event.Ref != "v1" || event.Time.IsZero() {
+			t.Errorf("unexpected event %+v", event)
+		}
+		events[event.Event] = append(events[event.Event], event)
+	}
+
+	if got := events[EventDiscovered]; len(got) != 1 || got[0].Files != 2 {
+		t.Errorf("discovered %+v", got)
+	}
+	if got := events[EventFetched]; len(got) != 2 || got[0].Bytes == 0 || got[0].Stage != "fetch" {
+		t.Errorf("fetched %+v", got)
+	}
+	if got := events[EventMarshalled]; len(got) != 1 || got[0].Path != "a.go" || got[0].Bytes == 0 || got[0].Stage != "write" {
+		t.Errorf("marshalled %+v", got)
+	}
+	if got := events[EventFailed]; len(got) != 1 || got[0].Path != "broken.go" || got[0].Stage != "parse" || got[0].Error == "" {
+		t.Errorf("failed %+v", got)
+	}
+	if got := events[EventRefDone]; len(got) != 1 || got[0].Files != 1 || got[0].Missing != 1 {
+		t.Errorf("ref done %+v", got)
+	}
+}
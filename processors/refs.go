@@ -0,0 +1,105 @@
+package processors
+
+import (
+	"context"
+	"strings"
+)
+
+// Kinds of ResolvedRef.
+const (
+	RefTag     = "tag"
+	RefBranch  = "branch"
+	RefCommit  = "commit"
+	RefVersion = "version"
+	// RefLabel names the refs of sources without commits, such as directories and archives.
+	RefLabel = "label"
+)
+
+// ResolvedRef is a ref pinned to the commit it named when processing started.
+// Reading the commit instead of the ref keeps a run consistent while a branch
+// moves, and processing the commit again reproduces the output later.
+type ResolvedRef struct {
+	// Ref is the ref as requested, empty for the default branch.
+	Ref string `json:"Ref"`
+	// Name is the tag, branch or version the ref resolved to, the full SHA for commits.
+	Name string `json:"Name"`
+	Kind string `json:"Kind"`
+	// Commit is the full SHA of the commit, empty when the source has none.
+	Commit string `json:"Commit,omitempty"`
+}
+
+// RefResolver is implemented by the sources able to pin refs to commits.
+type RefResolver interface {
+	ResolveRef(ctx context.Context, ref string) (ResolvedRef, error)
+}
+
+// ResolveRef pins ref with src, refs of sources that cannot resolve them are kept as labels.
+// @param ctx context.Context
+// @param src Source
+// @param ref string tag, branch, short or full commit SHA, empty for the default branch
+func ResolveRef(ctx context.Context, src Source, ref string) (ResolvedRef, error) {
+	resolver, ok := src.(RefResolver)
+	if !ok {
+		return ResolvedRef{Ref: ref, Name: ref, Kind: RefLabel}, nil
+	}
+	return resolver.ResolveRef(ctx, ref)
+}
+
+// DirName returns the name of the output directory of the ref. Tags, versions
+// and labels keep their name, commits are named by their short SHA and
+// branches by their name and short SHA, so that each commit of a moving
+// branch gets its own directory.
+func (r ResolvedRef) DirName() string {
+	var name string
+	switch {
+	case r.Kind == RefCommit:
+		name = shortSHA(r.Commit)
+	case r.Kind == RefBranch && r.Commit != "":
+		name = r.Name + "@" + shortSHA(r.Commit)
+	default:
+		name = r.Name
+	}
+	return strings.ReplaceAll(name, "/", "_")
+}
+
+// ReadRef returns the ref to read files at, the commit when it is known.
+func (r ResolvedRef) ReadRef() string {
+	if r.Commit != "" && r.Kind != RefVersion {
+		return r.Commit
+	}
+	return r.Name
+}
+
+// shortSHA abbreviates a commit SHA to 12 characters.
+func shortSHA(sha string) string {
+	if len(sha) > 12 {
+		return sha[:12]
+	}
+	return sha
+}
+
+// isCommitPrefix reports whether ref is an abbreviation of the commit SHA.
+func isCommitPrefix(ref, commit string) bool {
+	if len(ref) < 4 || !strings.HasPrefix(commit, strings.ToLower(ref)) {
+		return false
+	}
+	for _, c := range strings.ToLower(ref) {
+		if !strings.ContainsRune("0123456789abcdef", c) {
+			return false
+		}
+	}
+	return true
+}
+
+// commitRef returns the kind and name of a ref resolved to commit, isTag tells whether the ref names a tag.
+func commitRef(ref, name, commit string, isTag bool) ResolvedRef {
+	resolved := ResolvedRef{Ref: ref, Name: name, Kind: RefBranch, Commit: commit}
+	switch {
+	case isTag:
+		resolved.Kind = RefTag
+	case name == "" || isCommitPrefix(name, commit):
+		resolved.Kind = RefCommit
+		resolved.Name = commit
+	}
+	return resolved
+}
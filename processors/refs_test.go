@@ -0,0 +1,89 @@
+package processors
+
+import (
+	"context"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestGitSourceResolveRef(t *testing.T) {
+	dir := createGitRepo(t)
+	runGit(t, dir, "branch", "-M", "main")
+	runGit(t, dir, "branch", "feature/x", "v1")
+	ctx := context.Background()
+	src := NewGitSource(dir)
+	head := gitOutput(t, dir, "rev-parse", "HEAD")
+	v1 := gitOutput(t, dir, "rev-parse", "v1^{commit}")
+
+	tests := []struct {
+		ref  string
+		want ResolvedRef
+		dir  string
+	}{
+		{"", ResolvedRef{Ref: "", Name: "main", Kind: RefBranch, Commit: head}, "main@" + head[:12]},
+		{"v1", ResolvedRef{Ref: "v1", Name: "v1", Kind: RefTag, Commit: v1}, "v1"},
+		{"feature/x", ResolvedRef{Ref: "feature/x", Name: "feature/x", Kind: RefBranch, Commit: v1}, "feature_x@" + v1[:12]},
+		{v1[:7], ResolvedRef{Ref: v1[:7], Name: v1, Kind: RefCommit, Commit: v1}, v1[:12]},
+		{"HEAD~1", ResolvedRef{Ref: "HEAD~1", Name: v1, Kind: RefCommit, Commit: v1}, v1[:12]},
+	}
+	for _, test := range tests {
+		got, err := src.ResolveRef(ctx, test.ref)
+		if err != nil {
+			t.Fatalf("ResolveRef(%q): %v", test.ref, err)
+		}
+		if got != test.want || got.DirName() != test.dir {
+			t.Errorf("ResolveRef(%q) = %+v in %s, want %+v in %s", test.ref, got, got.DirName(), test.want, test.dir)
+		}
+	}
+
+	if _, err := src.ResolveRef(ctx, "missing"); err == nil {
+		t.Error("missing ref was resolved")
+	}
+}
+
+func TestProcessRepoDefaultBranch(t *testing.T) {
+	dir := createGitRepo(t)
+	runGit(t, dir, "branch", "-M", "main")
+	head := gitOutput(t, dir, "rev-parse", "HEAD")
+	p := newTestProcessor(t, Options{})
+	outputDir := p.OutputDir()
+
+	// Reading fails so that only the directory and the manifest are written
+	src := failingResolver{GitSource: NewGitSource(dir)}
+	_, err := p.ProcessRepo(context.Background(), src, "", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	tagDir := filepath.Join(outputDir, "main@"+head[:12])
+	if _, err := os.Stat(filepath.Join(tagDir, ManifestFile)); err != nil {
+		t.Fatal(err)
+	}
+	m, err := LoadManifest(tagDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ref := m.Ref(); ref == nil || ref.Commit != head || ref.Name != "main" {
+		t.Errorf("unexpected manifest ref %+v", ref)
+	}
+}
+
+// failingResolver resolves refs with git but fails to read every file.
+type failingResolver struct {
+	*GitSource
+}
+
+func (s failingResolver) ReadFile(context.Context, SourceFile, string) (string, error) {
+	return "", os.ErrNotExist
+}
+
+func gitOutput(t *testing.T, dir string, args ...string) string {
+	t.Helper()
+	out, err := exec.Command("git", append([]string{"-C", dir}, args...)...).Output()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return strings.TrimSpace(string(out))
+}
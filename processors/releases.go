@@ -0,0 +1,312 @@
+package processors
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"golang.org/x/mod/semver"
+)
+
+// TagLister is implemented by the sources able to list the tags of a repository.
+type TagLister interface {
+	Tags(ctx context.Context) ([]string, error)
+}
+
+// ListTags returns the tags of the repository read by src.
+// @param ctx context.Context
+// @param src Source
+func ListTags(ctx context.Context, src Source) ([]string, error) {
+	lister, ok := src.(TagLister)
+	if !ok {
+		return nil, fmt.Errorf("%T cannot list tags", src)
+	}
+	return lister.Tags(ctx)
+}
+
+// ReleaseQuery selects a series of releases among the tags of a repository.
+type ReleaseQuery struct {
+	// Range is a semver range such as v1.18.0..v1.21.x, either bound may be
+	// left out and an x in a bound matches any value of that component.
+	Range string
+	// Last keeps the last N releases of the range, 0 keeps them all.
+	Last int
+	// Prefix is stripped from tags before reading their version, such as go for go1.21.0.
+	Prefix string
+	// Prereleases keeps the tags with a prerelease suffix such as -rc.1.
+	Prereleases bool
+}
+
+// versionBound is one side of a release range, wildcard bounds such as
+// v1.21.x hold the version without the wildcard components.
+type versionBound struct {
+	version  string
+	wildcard bool
+}
+
+// SelectReleases returns the tags matched by query in semantic version order.
+// Tags that are not semantic versions once the prefix is stripped are
+// ignored, as are tags naming a version already seen.
+// @param tags []string
+// @param query ReleaseQuery
+func SelectReleases(tags []string, query ReleaseQuery) ([]string, error) {
+	if query.Range == "" && query.Last <= 0 {
+		return nil, fmt.Errorf("a release range or a number of releases is required")
+	}
+	lower, upper, err := parseRange(query.Range, query.Prefix)
+	if err != nil {
+		return nil, err
+	}
+
+	type release struct{ tag, version string }
+	var releases []release
+	for _, tag := range tags {
+		version, ok := tagVersion(tag, query.Prefix)
+		if !ok || (!query.Prereleases && semver.Prerelease(version) != "") {
+			continue
+		}
+		if lower != nil && semver.Compare(version, lower.version) < 0 && !lower.contains(version) {
+			continue
+		}
+		if upper != nil && semver.Compare(version, upper.version) > 0 && !upper.contains(version) {
+			continue
+		}
+		releases = append(releases, release{tag, version})
+	}
+	sort.SliceStable(releases, func(i, j int) bool {
+		return semver.Compare(releases[i].version, releases[j].version) < 0
+	})
+
+	var selected []string
+	for i, r := range releases {
+		if i > 0 && semver.Compare(releases[i-1].version, r.version) == 0 {
+			continue
+		}
+		selected = append(selected, r.tag)
+	}
+	if query.Last > 0 && len(selected) > query.Last {
+		selected = selected[len(selected)-query.Last:]
+	}
+	return selected, nil
+}
+
+// tagVersion returns the semantic version named by tag once prefix is stripped.
+func tagVersion(tag, prefix string) (string, bool) {
+	if !strings.HasPrefix(tag, prefix) {
+		return "", false
+	}
+	version := strings.TrimPrefix(tag, prefix)
+	if !strings.HasPrefix(version, "v") {
+		version = "v" + version
+	}
+	return version, semver.IsValid(version)
+}
+
+// parseRange parses a range of the form lower..upper, a range without .. matches a single version.
+func parseRange(spec, prefix string) (*versionBound, *versionBound, error) {
+	if spec == "" {
+		return nil, nil, nil
+	}
+	from, to, found := strings.Cut(spec, "..")
+	if !found {
+		to = from
+	}
+	lower, err := parseBound(from, prefix)
+	if err != nil {
+		return nil, nil, err
+	}
+	upper, err := parseBound(to, prefix)
+	if err != nil {
+		return nil, nil, err
+	}
+	return lower, upper, nil
+}
+
+// parseBound parses a bound of a range, an empty bound is open.
+func parseBound(spec, prefix string) (*versionBound, error) {
+	spec = strings.TrimSpace(spec)
+	if spec == "" {
+		return nil, nil
+	}
+	bound := &versionBound{}
+	for strings.HasSuffix(spec, ".x") || strings.HasSuffix(spec, ".X") || strings.HasSuffix(spec, ".*") {
+		spec = spec[:len(spec)-2]
+		bound.wildcard = true
+	}
+	version, ok := tagVersion(spec, prefix)
+	if !ok && prefix != "" {
+		version, ok = tagVersion(spec, "")
+	}
+	if !ok || (bound.wildcard && version != semver.Major(version) && version != semver.MajorMinor(version)) {
+		return nil, fmt.Errorf("invalid version %q in release range", spec)
+	}
+	bound.version = version
+	return bound, nil
+}
+
+// contains reports whether version matches the wildcard components of a bound,
+// v1.21.x contains v1.21.3 as well as v1.21.0-rc.1.
+func (b *versionBound) contains(version string) bool {
+	switch {
+	case !b.wildcard:
+		return false
+	case strings.Count(b.version, ".") == 0:
+		return semver.Major(version) == b.version
+	}
+	return semver.MajorMinor(version) == b.version
+}
+
+// Kinds of APIChange.
+const (
+	APIAdded   = "added"
+	APIRemoved = "removed"
+	APIChanged = "changed"
+)
+
+// APIChange is a change of one exported declaration between two releases.
+type APIChange struct {
+	// Package is the directory of the package within the repository.
+	Package string `json:"Package"`
+	// Name is the declared name, methods are written Type.Method.
+	Name string `json:"Name"`
+	Kind string `json:"Kind"`
+	Old  string `json:"Old,omitempty"`
+	New  string `json:"New,omitempty"`
+}
+
+// ReleaseChanges holds the API changes introduced by a release.
+type ReleaseChanges struct {
+	Old     string      `json:"Old"`
+	New     string      `json:"New"`
+	Changes []APIChange `json:"Changes"`
+}
+
+// Timeline is the series of releases of a repository and the API changes each one introduced.
+type Timeline struct {
+	Releases []ResolvedRef    `json:"Releases"`
+	Steps    []ReleaseChanges `json:"Steps"`
+}
+
+// ComputeTimeline compares the exported API of each consecutive pair of releases.
+// The API of each release is read from the file summaries of its directory below
+// dir, only the symbols of the files that differ between two releases are compared.
+// @param dir string output directory holding one directory per release
+// @param releases []ResolvedRef in release order
+func (p *Processor) ComputeTimeline(dir string, releases []ResolvedRef) (*Timeline, error) {
+	timeline := &Timeline{Releases: releases, Steps: []ReleaseChanges{}}
+	var previous *FileInfos
+	for i, release := range releases {
+		infos, err := loadReleaseInfos(filepath.Join(dir, release.DirName()))
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", release.Name, err)
+		}
+		if i > 0 {
+			timeline.Steps = append(timeline.Steps, ReleaseChanges{
+				Old:     releases[i-1].Name,
+				New:     release.Name,
+				Changes: compareAPI(previous, infos),
+			})
+		}
+		previous = infos
+	}
+	return timeline, nil
+}
+
+// WriteTimeline writes the timeline of releases to timeline.json in dir.
+// @param dir string output directory holding one directory per release
+// @param releases []ResolvedRef in release order
+func (p *Processor) WriteTimeline(dir string, releases []ResolvedRef) error {
+	timeline, err := p.ComputeTimeline(dir, releases)
+	if err != nil {
+		return err
+	}
+	data, err := json.MarshalIndent(timeline, "", "  ")
+	if err != nil {
+		return err
+	}
+	return writeFileAtomic(filepath.Join(dir, "timeline.json"), bytes.NewReader(data))
+}
+
+// loadReleaseInfos reads the file summaries of the output of a release, which must have some.
+func loadReleaseInfos(dir string) (*FileInfos, error) {
+	if _, err := os.Stat(filepath.Join(dir, FileInfoFile)); err != nil {
+		return nil, fmt.Errorf("no file summaries, process the release again: %w", err)
+	}
+	return LoadFileInfos(dir)
+}
+
+// releaseAPI returns the exported declarations of a release by package directory.
+// Declarations found in several files, such as the variants of a build
+// constraint, list their distinct signatures separated by " | ".
+func releaseAPI(infos *FileInfos) map[string]map[string]string {
+	signatures := map[string]map[string][]string{}
+	for _, filePath := range infos.Paths() {
+		info, _ := infos.File(filePath)
+		if info.Package == "main" || pathCategory(filePath) == CategoryTest {
+			continue
+		}
+		pkg := path.Dir(filePath)
+		if signatures[pkg] == nil {
+			signatures[pkg] = map[string][]string{}
+		}
+		for _, symbol := range info.Exported {
+			signatures[pkg][symbol.Name] = append(signatures[pkg][symbol.Name], symbol.Signature)
+		}
+	}
+
+	api := map[string]map[string]string{}
+	for pkg, decls := range signatures {
+		api[pkg] = map[string]string{}
+		for name, variants := range decls {
+			sort.Strings(variants)
+			var distinct []string
+			for i, variant := range variants {
+				if i == 0 || variant != variants[i-1] {
+					distinct = append(distinct, variant)
+				}
+			}
+			api[pkg][name] = strings.Join(distinct, " | ")
+		}
+	}
+	return api
+}
+
+// compareAPI lists the declarations added, removed or changed between two releases.
+// The symbols of files changed between them are looked up in the API of their
+// package, a symbol moved to another file or with a new body is unchanged.
+func compareAPI(old, new *FileInfos) []APIChange {
+	oldAPI, newAPI := releaseAPI(old), releaseAPI(new)
+	type symbolKey struct{ pkg, name string }
+	seen := map[symbolKey]bool{}
+	changes := []APIChange{}
+	for _, change := range CompareExported(old, new) {
+		key := symbolKey{path.Dir(change.Path), change.Symbol.Name}
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+		oldSignature, wasFound := oldAPI[key.pkg][key.name]
+		signature, found := newAPI[key.pkg][key.name]
+		switch {
+		case found && !wasFound:
+			changes = append(changes, APIChange{Package: key.pkg, Name: key.name, Kind: APIAdded, New: signature})
+		case !found && wasFound:
+			changes = append(changes, APIChange{Package: key.pkg, Name: key.name, Kind: APIRemoved, Old: oldSignature})
+		case found && oldSignature != signature:
+			changes = append(changes, APIChange{Package: key.pkg, Name: key.name, Kind: APIChanged, Old: oldSignature, New: signature})
+		}
+	}
+	sort.Slice(changes, func(i, j int) bool {
+		if changes[i].Package != changes[j].Package {
+			return changes[i].Package < changes[j].Package
+		}
+		return changes[i].Name < changes[j].Name
+	})
+	return changes
+}
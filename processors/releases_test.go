@@ -0,0 +1,118 @@
+package processors
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestSelectReleases(t *testing.T) {
+	tags := []string{"v1.21.3", "v1.18.0", "v1.9.0", "v1.21.0-rc.1", "v1.22.0", "v1.19", "v1.19.0", "latest", "v1.20.1", "v2.0.0"}
+	tests := []struct {
+		query ReleaseQuery
+		want  string
+	}{
+		{ReleaseQuery{Range: "v1.18.0..v1.21.x"}, "v1.18.0,v1.19,v1.20.1,v1.21.3"},
+		{ReleaseQuery{Range: "v1.20.0.."}, "v1.20.1,v1.21.3,v1.22.0,v2.0.0"},
+		{ReleaseQuery{Range: "..v1.x", Last: 2}, "v1.21.3,v1.22.0"},
+		{ReleaseQuery{Range: "v1.21.x", Prereleases: true}, "v1.21.0-rc.1,v1.21.3"},
+		{ReleaseQuery{Last: 3}, "v1.21.3,v1.22.0,v2.0.0"},
+	}
+	for _, test := range tests {
+		got, err := SelectReleases(tags, test.query)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if strings.Join(got, ",") != test.want {
+			t.Errorf("SelectReleases(%+v) = %v, want %s", test.query, got, test.want)
+		}
+	}
+
+	got, err := SelectReleases([]string{"go1.21.0", "go1.20", "weekly.2011-01-01"}, ReleaseQuery{Range: "go1.20..", Prefix: "go"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Join(got, ",") != "go1.20,go1.21.0" {
+		t.Errorf("unexpected prefixed releases %v", got)
+	}
+
+	if _, err := SelectReleases(tags, ReleaseQuery{Range: "v1.x.3.."}); err == nil {
+		t.Error("invalid range was accepted")
+	}
+}
+
+func TestGitSourceTags(t *testing.T) {
+	dir := createGitRepo(t)
+	tags, err := ListTags(context.Background(), NewGitSource(dir))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Join(tags, ",") != "v1,v2" {
+		t.Errorf("unexpected tags %v", tags)
+	}
+	if _, err := ListTags(context.Background(), NewDirSource(dir)); err == nil {
+		t.Error("directory source listed tags")
+	}
+}
+
+func TestWriteTimeline(t *testing.T) {
+	p := newTestProcessor(t, Options{})
+	dir := p.OutputDir()
+	releases := map[string]map[string]string{
+		"v1.0.0": {
+			"a.go":        "package a\n\ntype T struct {\n\tName string\n\tcount int\n}\n\nfunc New() *T { return nil }\n\nfunc (t *T) Close() {}\n\nfunc helper() {}\n",
+			"cmd/main.go": "package main\n\nfunc Run() {}\n",
+		},
+		"v1.1.0": {
+			"a.go": "package a\n\ntype T struct {\n\tName string\n\ttotal int\n}\n\nfunc New(name string) *T { return nil }\n\nfunc (t *T) Close() {}\n\nconst Version = \"1.1\"\n",
+		},
+		// Version moves to another file of the package
+		"v1.2.0": {
+			"a.go": "package a\n\ntype T struct {\n\tName string\n}\n\nfunc New(name string) *T { return nil }\n",
+			"b.go": "package a\n\nconst Version = \"1.2\"\n",
+		},
+	}
+	for release, sources := range releases {
+		src := t.TempDir()
+		for path, content := range sources {
+			writeFile(t, src, path, content)
+		}
+		_, err := p.ProcessRepo(context.Background(), NewDirSource(src), "", release)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	var refs []ResolvedRef
+	for _, release := range []string{"v1.0.0", "v1.1.0", "v1.2.0"} {
+		refs = append(refs, ResolvedRef{Ref: release, Name: release, Kind: RefTag})
+	}
+	err := p.WriteTimeline(dir, refs)
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := os.ReadFile(filepath.Join(dir, "timeline.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var timeline Timeline
+	err = json.Unmarshal(data, &timeline)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(timeline.Steps) != 2 {
+		t.Fatalf("unexpected timeline %+v", timeline)
+	}
+	first := timeline.Steps[0].Changes
+	if len(first) != 2 || first[0] != (APIChange{Package: ".", Name: "New", Kind: APIChanged, Old: "func New() *T", New: "func New(name string) *T"}) || first[1].Name != "Version" || first[1].Kind != APIAdded {
+		t.Errorf("unexpected changes of v1.1.0 %+v", first)
+	}
+	second := timeline.Steps[1].Changes
+	if len(second) != 1 || second[0].Name != "T.Close" || second[0].Kind != APIRemoved || second[0].Old != "func (*T) Close()" {
+		t.Errorf("unexpected changes of v1.2.0 %+v", second)
+	}
+}
@@ -0,0 +1,213 @@
+package processors
+
+import (
+	"context"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// RetryTransport is an http.RoundTripper that waits for rate limits to reset
+// and retries idempotent requests that failed for a transient reason.
+//
+// It understands the X-RateLimit-Remaining and X-RateLimit-Reset headers sent
+// by GitHub and compatible forges as well as Retry-After. A response that
+// exhausts the rate limit is returned at once and the following requests wait
+// for the reset. Its reset header is dropped, so that clients keeping their own
+// rate limit bookkeeping (like go-github) do not refuse these requests instead.
+// 429 and 5xx answers and network errors are retried with exponential backoff
+// and full jitter.
+type RetryTransport struct {
+	// Base is the transport doing the requests, http.DefaultTransport when nil.
+	Base http.RoundTripper
+	// MaxRetries is the number of retries after the first attempt.
+	MaxRetries int
+	// MinBackoff and MaxBackoff bound the exponential backoff between retries.
+	MinBackoff time.Duration
+	MaxBackoff time.Duration
+	// MaxWait is the longest time to wait for a rate limit reset. Longer waits
+	// give up and return the rate limited response.
+	MaxWait time.Duration
+	// Logger receives a message for each wait and retry, it may be nil.
+	Logger *zap.Logger
+
+	mu           sync.Mutex
+	blockedUntil time.Time
+}
+
+// NewRetryTransport creates a RetryTransport with the default retry policy.
+// @param base http.RoundTripper
+// @param l *zap.Logger
+func NewRetryTransport(base http.RoundTripper, l *zap.Logger) *RetryTransport {
+	return &RetryTransport{
+		Base:       base,
+		MaxRetries: 5,
+		MinBackoff: time.Second,
+		MaxBackoff: time.Minute,
+		MaxWait:    time.Hour,
+		Logger:     l,
+	}
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *RetryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	base := t.Base
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	if !isIdempotent(req) {
+		return base.RoundTrip(req)
+	}
+
+	ctx := req.Context()
+	for attempt := 0; ; attempt++ {
+		if err := t.waitForReset(ctx); err != nil {
+			return nil, err
+		}
+
+		resp, err := base.RoundTrip(req)
+		wait, retry := t.classify(ctx, resp, err, attempt)
+		if !retry || attempt >= t.MaxRetries || wait > t.MaxWait {
+			if resp != nil {
+				t.blockExhausted(resp)
+			}
+			return resp, err
+		}
+
+		if resp != nil {
+			resp.Body.Close()
+		}
+		t.log("retrying request", zap.String("url", req.URL.String()), zap.Int("attempt", attempt+1), zap.Duration("wait", wait), zap.Error(err))
+		if err := sleepContext(ctx, wait); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// classify decides if an attempt is retried and how long to wait before doing so.
+func (t *RetryTransport) classify(ctx context.Context, resp *http.Response, err error, attempt int) (time.Duration, bool) {
+	if err != nil {
+		// Transport errors are retried unless the request was cancelled
+		return t.backoff(attempt), ctx.Err() == nil
+	}
+
+	switch {
+	case resp.StatusCode == http.StatusTooManyRequests, resp.StatusCode == http.StatusForbidden:
+		if wait, ok := retryAfter(resp); ok {
+			return wait, true
+		}
+		if reset, ok := rateLimitReset(resp); ok {
+			wait := time.Until(reset)
+			t.block(reset)
+			return max(wait, 0), true
+		}
+		// A plain 403 is a permission problem, not a rate limit
+		return 0, resp.StatusCode == http.StatusTooManyRequests
+	case resp.StatusCode >= 500:
+		if wait, ok := retryAfter(resp); ok {
+			return wait, true
+		}
+		return t.backoff(attempt), true
+	}
+	return 0, false
+}
+
+// blockExhausted makes the requests following a response that used up the rate limit wait for its reset.
+func (t *RetryTransport) blockExhausted(resp *http.Response) {
+	if resp.StatusCode >= 300 {
+		return
+	}
+	if reset, ok := rateLimitReset(resp); ok && time.Until(reset) <= t.MaxWait {
+		t.block(reset)
+		resp.Header.Del("X-RateLimit-Reset")
+		t.log("rate limit exhausted, holding requests until reset", zap.Time("reset", reset))
+	}
+}
+
+// block makes every request wait until the given time.
+func (t *RetryTransport) block(until time.Time) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if until.After(t.blockedUntil) {
+		t.blockedUntil = until
+	}
+}
+
+func (t *RetryTransport) waitForReset(ctx context.Context) error {
+	t.mu.Lock()
+	until := t.blockedUntil
+	t.mu.Unlock()
+	return sleepContext(ctx, time.Until(until))
+}
+
+// backoff returns a random delay up to the exponential backoff for attempt.
+func (t *RetryTransport) backoff(attempt int) time.Duration {
+	limit := t.MinBackoff << attempt
+	if limit <= 0 || limit > t.MaxBackoff {
+		limit = t.MaxBackoff
+	}
+	if limit <= 0 {
+		return 0
+	}
+	return time.Duration(rand.Int63n(int64(limit)) + 1)
+}
+
+func (t *RetryTransport) log(msg string, fields ...zap.Field) {
+	if t.Logger != nil {
+		t.Logger.Warn(msg, fields...)
+	}
+}
+
+// rateLimitReset returns the reset time of an exhausted rate limit.
+func rateLimitReset(resp *http.Response) (time.Time, bool) {
+	if resp.Header.Get("X-RateLimit-Remaining") != "0" {
+		return time.Time{}, false
+	}
+	reset, err := strconv.ParseInt(resp.Header.Get("X-RateLimit-Reset"), 10, 64)
+	if err != nil {
+		return time.Time{}, false
+	}
+	return time.Unix(reset, 0), true
+}
+
+// retryAfter parses the Retry-After header in both its seconds and HTTP date form.
+func retryAfter(resp *http.Response) (time.Duration, bool) {
+	value := resp.Header.Get("Retry-After")
+	if value == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(value); err == nil {
+		return time.Duration(seconds) * time.Second, true
+	}
+	if date, err := http.ParseTime(value); err == nil {
+		return max(time.Until(date), 0), true
+	}
+	return 0, false
+}
+
+func isIdempotent(req *http.Request) bool {
+	switch req.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		return req.Body == nil || req.Body == http.NoBody
+	}
+	return false
+}
+
+// sleepContext sleeps for d or until ctx is done.
+func sleepContext(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return ctx.Err()
+	}
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
@@ -0,0 +1,178 @@
+package processors
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// newTestRetryClient returns a client retrying quickly against handler.
+func newTestRetryClient(t *testing.T, handler http.HandlerFunc) (*http.Client, string) {
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+	transport := NewRetryTransport(server.Client().Transport, nil)
+	transport.MinBackoff = time.Millisecond
+	transport.MaxBackoff = 5 * time.Millisecond
+	return &http.Client{Transport: transport}, server.URL
+}
+
+func TestRetryTransport(t *testing.T) {
+	tests := []struct {
+		name     string
+		failures int
+		fail     func(w http.ResponseWriter)
+		status   int
+		attempts int32
+	}{
+		{
+			name:     "bad gateway",
+			failures: 2,
+			fail:     func(w http.ResponseWriter) { w.WriteHeader(http.StatusBadGateway) },
+			status:   http.StatusOK,
+			attempts: 3,
+		},
+		{
+			name:     "retry after",
+			failures: 1,
+			fail: func(w http.ResponseWriter) {
+				w.Header().Set("Retry-After", "0")
+				w.WriteHeader(http.StatusTooManyRequests)
+			},
+			status:   http.StatusOK,
+			attempts: 2,
+		},
+		{
+			name:     "rate limit reset",
+			failures: 1,
+			fail: func(w http.ResponseWriter) {
+				w.Header().Set("X-RateLimit-Remaining", "0")
+				w.Header().Set("X-RateLimit-Reset", strconv.FormatInt(time.Now().Unix(), 10))
+				w.WriteHeader(http.StatusForbidden)
+			},
+			status:   http.StatusOK,
+			attempts: 2,
+		},
+		{
+			name:     "forbidden",
+			failures: 1,
+			fail:     func(w http.ResponseWriter) { w.WriteHeader(http.StatusForbidden) },
+			status:   http.StatusForbidden,
+			attempts: 1,
+		},
+		{
+			name:     "persistent failure",
+			failures: 100,
+			fail:     func(w http.ResponseWriter) { w.WriteHeader(http.StatusServiceUnavailable) },
+			status:   http.StatusServiceUnavailable,
+			attempts: 6,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var attempts int32
+			client, url := newTestRetryClient(t, func(w http.ResponseWriter, r *http.Request) {
+				if int(atomic.AddInt32(&attempts, 1)) <= test.failures {
+					test.fail(w)
+					return
+				}
+				w.Write([]byte("ok"))
+			})
+
+			resp, err := client.Get(url)
+			if err != nil {
+				t.Fatal(err)
+			}
+			resp.Body.Close()
+			if resp.StatusCode != test.status {
+				t.Errorf("status %d, want %d", resp.StatusCode, test.status)
+			}
+			if attempts != test.attempts {
+				t.Errorf("%d attempts, want %d", attempts, test.attempts)
+			}
+		})
+	}
+}
+
+func TestRetryTransportBlocksAfterExhaustedLimit(t *testing.T) {
+	reset := time.Now().Add(time.Second).Truncate(time.Second).Add(time.Second)
+	var second atomic.Value
+	var attempts int32
+	client, url := newTestRetryClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&attempts, 1) == 2 {
+			second.Store(time.Now())
+		}
+		w.Header().Set("X-RateLimit-Remaining", "0")
+		w.Header().Set("X-RateLimit-Reset", strconv.FormatInt(reset.Unix(), 10))
+		w.Write([]byte("ok"))
+	})
+
+	// The response using up the limit is returned at once, the next request waits
+	start := time.Now()
+	resp, err := client.Get(url)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	if elapsed := time.Since(start); elapsed > 500*time.Millisecond {
+		t.Errorf("response held for %s", elapsed)
+	}
+	if resp.Header.Get("X-RateLimit-Reset") != "" {
+		t.Error("reset header of the exhausted limit was kept")
+	}
+	resp, err = client.Get(url)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	if at := second.Load().(time.Time); at.Before(reset) {
+		t.Errorf("second request sent %s before the reset", reset.Sub(at))
+	}
+}
+
+func TestRetryTransportSkipsPost(t *testing.T) {
+	var attempts int32
+	client, url := newTestRetryClient(t, func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&attempts, 1)
+		w.WriteHeader(http.StatusBadGateway)
+	})
+
+	resp, err := client.Post(url, "text/plain", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	if attempts != 1 {
+		t.Errorf("POST was attempted %d times", attempts)
+	}
+}
+
+// failingSource lists files but cannot read any of them.
+type failingSource struct {
+	files []SourceFile
+}
+
+func (s failingSource) ListFiles(context.Context, string, string) ([]SourceFile, error) {
+	return s.files, nil
+}
+
+func (s failingSource) ReadFile(context.Context, SourceFile, string) (string, error) {
+	return "", errors.New("unavailable")
+}
+
+func TestProcessRepoReportsSkippedFiles(t *testing.T) {
+	p := newTestProcessor(t, Options{})
+	src := failingSource{files: []SourceFile{{Path: "a.go"}, {Path: "README.md"}, {Path: "sub/b.go"}}}
+	skipped, err := p.ProcessRepo(context.Background(), src, "", "v1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(skipped) != 2 || skipped[0].Path != "a.go" || skipped[1].Path != "sub/b.go" {
+		t.Errorf("unexpected skipped files %v", skipped)
+	}
+}
@@ -0,0 +1,36 @@
+package processors
+
+import (
+	"context"
+	"crypto/sha1"
+	"encoding/hex"
+	"strconv"
+)
+
+// SourceFile describes a single file found in a Source.
+type SourceFile struct {
+	// Path is the slash separated path of the file relative to the repository root.
+	Path string
+	// SHA is the git blob SHA of the file, empty when the source does not know it.
+	SHA string
+	// Stamp tells versions of a file without SHA apart, such as its size and
+	// modification time, empty when the source does not know it either.
+	Stamp string
+}
+
+// Source gives read access to the files of a repository at a given ref.
+// An empty ref selects the default branch (or HEAD) of the repository.
+type Source interface {
+	// ListFiles returns every file below dir at ref.
+	ListFiles(ctx context.Context, dir, ref string) ([]SourceFile, error)
+	// ReadFile returns the content of file at ref.
+	ReadFile(ctx context.Context, file SourceFile, ref string) (string, error)
+}
+
+// gitBlobSHA computes the SHA git assigns to a blob holding content.
+func gitBlobSHA(content []byte) string {
+	h := sha1.New()
+	h.Write([]byte("blob " + strconv.Itoa(len(content)) + "\x00"))
+	h.Write(content)
+	return hex.EncodeToString(h.Sum(nil))
+}
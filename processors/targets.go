@@ -0,0 +1,358 @@
+package processors
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"go/ast"
+	"go/build/constraint"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	astjson "GoOperatorAST/ast_json"
+)
+
+// knownOS, unixOS and knownArch mirror the lists of go/build.
+var (
+	knownOS = map[string]bool{
+		"aix": true, "android": true, "darwin": true, "dragonfly": true, "freebsd": true,
+		"hurd": true, "illumos": true, "ios": true, "js": true, "linux": true, "nacl": true,
+		"netbsd": true, "openbsd": true, "plan9": true, "solaris": true, "wasip1": true,
+		"windows": true, "zos": true,
+	}
+	unixOS = map[string]bool{
+		"aix": true, "android": true, "darwin": true, "dragonfly": true, "freebsd": true,
+		"hurd": true, "illumos": true, "ios": true, "linux": true, "netbsd": true,
+		"openbsd": true, "solaris": true,
+	}
+	knownArch = map[string]bool{
+		"386": true, "amd64": true, "amd64p32": true, "arm": true, "armbe": true, "arm64": true,
+		"arm64be": true, "loong64": true, "mips": true, "mipsle": true, "mips64": true,
+		"mips64le": true, "mips64p32": true, "mips64p32le": true, "ppc": true, "ppc64": true,
+		"ppc64le": true, "riscv": true, "riscv64": true, "s390": true, "s390x": true,
+		"sparc": true, "sparc64": true, "wasm": true,
+	}
+)
+
+// Target is a GOOS/GOARCH pair build constraints are evaluated for.
+type Target struct {
+	GOOS   string
+	GOARCH string
+}
+
+func (t Target) String() string {
+	return t.GOOS + "/" + t.GOARCH
+}
+
+// ParseTargets parses a comma separated list of GOOS/GOARCH pairs such as "linux/amd64,darwin/arm64".
+// @param list string
+func ParseTargets(list string) ([]Target, error) {
+	var targets []Target
+	for _, item := range strings.Split(list, ",") {
+		item = strings.TrimSpace(item)
+		if item == "" {
+			continue
+		}
+		goos, goarch, ok := strings.Cut(item, "/")
+		if !ok || !knownOS[goos] || !knownArch[goarch] {
+			return nil, fmt.Errorf("invalid target %q, want GOOS/GOARCH", item)
+		}
+		targets = append(targets, Target{GOOS: goos, GOARCH: goarch})
+	}
+	return targets, nil
+}
+
+// Matches reports whether a file with the build constraint expr is built for the target.
+// An empty expr matches every target. Release tags such as go1.21 and the gc
+// tag are satisfied, cgo and custom tags are not.
+func (t Target) Matches(expr string) (bool, error) {
+	if expr == "" {
+		return true, nil
+	}
+	parsed, err := constraint.Parse("//go:build " + expr)
+	if err != nil {
+		return false, err
+	}
+	return parsed.Eval(t.hasTag), nil
+}
+
+// hasTag follows the tag matching of go/build, android satisfies linux,
+// illumos satisfies solaris and ios satisfies darwin.
+func (t Target) hasTag(tag string) bool {
+	switch {
+	case tag == t.GOOS || tag == t.GOARCH || tag == "gc":
+		return true
+	case tag == "unix":
+		return unixOS[t.GOOS]
+	case tag == "linux":
+		return t.GOOS == "android"
+	case tag == "solaris":
+		return t.GOOS == "illumos"
+	case tag == "darwin":
+		return t.GOOS == "ios"
+	case strings.HasPrefix(tag, "go1."):
+		return true
+	}
+	return false
+}
+
+// fileConstraint combines the file name suffixes and the //go:build line of
+// a file into one expression, empty when the file is built everywhere.
+func fileConstraint(p, content string) (string, error) {
+	var exprs []constraint.Expr
+	if expr := nameConstraint(path.Base(p)); expr != nil {
+		exprs = append(exprs, expr)
+	}
+	expr, err := buildLineConstraint(content)
+	if err != nil {
+		return "", err
+	}
+	if expr != nil {
+		exprs = append(exprs, expr)
+	}
+
+	if len(exprs) == 0 {
+		return "", nil
+	}
+	combined := exprs[0]
+	for _, expr := range exprs[1:] {
+		combined = &constraint.AndExpr{X: combined, Y: expr}
+	}
+	return combined.String(), nil
+}
+
+// nameConstraint returns the constraint implied by the _GOOS, _GOARCH or
+// _GOOS_GOARCH suffix of a file name, applying the rules of go/build.
+func nameConstraint(name string) constraint.Expr {
+	name, _, _ = strings.Cut(name, ".")
+	i := strings.Index(name, "_")
+	if i < 0 {
+		return nil
+	}
+	parts := strings.Split(name[i:], "_")
+	if n := len(parts); n > 0 && parts[n-1] == "test" {
+		parts = parts[:n-1]
+	}
+	n := len(parts)
+	switch {
+	case n >= 2 && knownOS[parts[n-2]] && knownArch[parts[n-1]]:
+		return &constraint.AndExpr{X: &constraint.TagExpr{Tag: parts[n-2]}, Y: &constraint.TagExpr{Tag: parts[n-1]}}
+	case n >= 1 && (knownOS[parts[n-1]] || knownArch[parts[n-1]]):
+		return &constraint.TagExpr{Tag: parts[n-1]}
+	}
+	return nil
+}
+
+// buildLineConstraint parses the //go:build line of content, falling back to
+// the // +build lines of older files.
+func buildLineConstraint(content string) (constraint.Expr, error) {
+	var plusBuild []constraint.Expr
+	scanner := bufio.NewScanner(strings.NewReader(content))
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line != "" && !strings.HasPrefix(line, "//") {
+			// Constraints must appear before the package clause
+			break
+		}
+		switch {
+		case constraint.IsGoBuild(line):
+			return constraint.Parse(line)
+		case constraint.IsPlusBuild(line):
+			expr, err := constraint.Parse(line)
+			if err != nil {
+				return nil, err
+			}
+			plusBuild = append(plusBuild, expr)
+		}
+	}
+
+	if len(plusBuild) == 0 {
+		return nil, nil
+	}
+	combined := plusBuild[0]
+	for _, expr := range plusBuild[1:] {
+		combined = &constraint.AndExpr{X: combined, Y: expr}
+	}
+	return combined, nil
+}
+
+// TargetPackage lists the files and top-level declarations of a package built for a target.
+type TargetPackage struct {
+	Dir   string   `json:"Dir"`
+	Name  string   `json:"Name"`
+	Files []string `json:"Files"`
+	// Decls holds the declared names, methods are written Type.Method.
+	Decls []string `json:"Decls"`
+}
+
+// TargetContents holds the packages built for one target.
+type TargetContents struct {
+	Target   string          `json:"Target"`
+	Packages []TargetPackage `json:"Packages"`
+}
+
+// ComputeTargets evaluates the build constraints recorded in the output JSON
+// of a tag directory and returns the package contents for each target.
+// Files without a recorded path are left out.
+// @param dir string output directory of a tag
+// @param targets []Target
+func (p *Processor) ComputeTargets(dir string, targets []Target) ([]TargetContents, error) {
+	files, err := p.readOutputFiles(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	contents := make([]TargetContents, 0, len(targets))
+	for _, target := range targets {
+		packages := map[string]*TargetPackage{}
+		for _, file := range files {
+			ok, err := target.Matches(file.Meta.Constraint)
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", file.Meta.Path, err)
+			}
+			if !ok {
+				continue
+			}
+			pkgDir := path.Dir(file.Meta.Path)
+			pkg, found := packages[pkgDir]
+			if !found {
+				pkg = &TargetPackage{Dir: pkgDir, Name: file.Name}
+				packages[pkgDir] = pkg
+			}
+			pkg.Files = append(pkg.Files, file.Meta.Path)
+			pkg.Decls = append(pkg.Decls, file.Decls...)
+		}
+
+		result := TargetContents{Target: target.String(), Packages: []TargetPackage{}}
+		for _, pkg := range packages {
+			sort.Strings(pkg.Files)
+			sort.Strings(pkg.Decls)
+			result.Packages = append(result.Packages, *pkg)
+		}
+		sort.Slice(result.Packages, func(i, j int) bool {
+			return result.Packages[i].Dir < result.Packages[j].Dir
+		})
+		contents = append(contents, result)
+	}
+	return contents, nil
+}
+
+// WriteTargets writes the package contents per target of a tag directory to targets.json inside it.
+// @param dir string output directory of a tag
+// @param targets []Target
+func (p *Processor) WriteTargets(dir string, targets []Target) error {
+	contents, err := p.ComputeTargets(dir, targets)
+	if err != nil {
+		return err
+	}
+	data, err := json.MarshalIndent(contents, "", "  ")
+	if err != nil {
+		return err
+	}
+	return writeFileAtomic(filepath.Join(dir, "targets.json"), strings.NewReader(string(data)))
+}
+
+// outputFile is the part of an output JSON used by the analyses of a tag.
+type outputFile struct {
+	Meta  astjson.FileMetaNode
+	Name  string
+	Decls []string
+}
+
+// readOutputFiles decodes the AST JSON files of a tag directory.
+func (p *Processor) readOutputFiles(dir string) ([]outputFile, error) {
+	fileNames, err := listOutputFiles(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	var files []outputFile
+	for _, fileName := range fileNames {
+		data, err := astjson.ReadJSONFile(filepath.Join(dir, filepath.FromSlash(fileName)))
+		if err != nil {
+			return nil, err
+		}
+		var node astjson.FileNode
+		err = json.Unmarshal(data, &node)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", fileName, err)
+		}
+		if node.Meta == nil || node.Meta.Path == "" {
+			continue
+		}
+		file, err := p.decodeOutputFile(fileName, &node)
+		if err != nil {
+			return nil, err
+		}
+		files = append(files, file)
+	}
+	return files, nil
+}
+
+// decodeOutputFile converts the node of the AST JSON file fileName back to go/ast and describes it.
+func (p *Processor) decodeOutputFile(fileName string, node *astjson.FileNode) (file outputFile, err error) {
+	// The Unmarshaller panics on nodes it cannot convert, as do nodes missing a name
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("%s: unable to unmarshal: %v", fileName, r)
+		}
+	}()
+	tree := astjson.NewUnmarshaller(p.marshal).UnmarshalFileNode(node)
+	return outputFile{Meta: *node.Meta, Name: tree.Name.Name, Decls: declNames(tree)}, nil
+}
+
+// isASTFile reports whether a file of an output directory holds the AST of a Go file.
+func isASTFile(name string) bool {
+	for _, compression := range []astjson.Compression{astjson.CompressionNone, astjson.CompressionGzip, astjson.CompressionZstd} {
+		if strings.HasSuffix(name, ".go.json"+compression.Extension()) {
+			return true
+		}
+	}
+	return false
+}
+
+// declNames returns the names declared at the top level of a file.
+func declNames(file *ast.File) []string {
+	var names []string
+	for _, decl := range file.Decls {
+		switch decl := decl.(type) {
+		case *ast.FuncDecl:
+			if decl.Recv != nil && len(decl.Recv.List) > 0 {
+				names = append(names, receiverName(decl.Recv.List[0].Type)+"."+decl.Name.Name)
+			} else {
+				names = append(names, decl.Name.Name)
+			}
+		case *ast.GenDecl:
+			for _, spec := range decl.Specs {
+				switch spec := spec.(type) {
+				case *ast.TypeSpec:
+					names = append(names, spec.Name.Name)
+				case *ast.ValueSpec:
+					for _, name := range spec.Names {
+						if name.Name != "_" {
+							names = append(names, name.Name)
+						}
+					}
+				}
+			}
+		}
+	}
+	return names
+}
+
+// receiverName returns the type name of a method receiver.
+func receiverName(expr ast.Expr) string {
+	switch expr := expr.(type) {
+	case *ast.StarExpr:
+		return receiverName(expr.X)
+	case *ast.IndexExpr:
+		return receiverName(expr.X)
+	case *ast.IndexListExpr:
+		return receiverName(expr.X)
+	case *ast.Ident:
+		return expr.Name
+	}
+	return ""
+}
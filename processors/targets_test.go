@@ -0,0 +1,138 @@
+package processors
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	astjson "GoOperatorAST/ast_json"
+)
+
+func TestFileConstraint(t *testing.T) {
+	tests := []struct {
+		path, content, want string
+	}{
+		{"a.go", "package a\n", ""},
+		{"linux.go", "package a\n", ""},
+		{"a_linux.go", "package a\n", "linux"},
+		{"a_arm64.go", "package a\n", "arm64"},
+		{"a_linux_arm64_test.go", "package a\n", "linux && arm64"},
+		{"a_foo_test.go", "package a\n", ""},
+		{"a.go", "// Copyright\n\n//go:build linux || darwin\n\npackage a\n", "linux || darwin"},
+		{"a_amd64.go", "//go:build !windows\n\npackage a\n", "amd64 && !windows"},
+		{"a.go", "// +build linux darwin\n// +build cgo\n\npackage a\n", "(linux || darwin) && cgo"},
+		{"a.go", "package a\n\n//go:build linux\n", ""},
+	}
+	for _, test := range tests {
+		got, err := fileConstraint(test.path, test.content)
+		if err != nil {
+			t.Errorf("fileConstraint(%s): %v", test.path, err)
+			continue
+		}
+		if got != test.want {
+			t.Errorf("fileConstraint(%s) = %q, want %q", test.path, got, test.want)
+		}
+	}
+}
+
+func TestTargetMatches(t *testing.T) {
+	tests := []struct {
+		target Target
+		expr   string
+		want   bool
+	}{
+		{Target{"linux", "amd64"}, "", true},
+		{Target{"linux", "amd64"}, "linux && amd64", true},
+		{Target{"linux", "amd64"}, "windows", false},
+		{Target{"darwin", "arm64"}, "unix && go1.18", true},
+		{Target{"windows", "amd64"}, "unix", false},
+		{Target{"android", "arm64"}, "linux", true},
+		{Target{"linux", "amd64"}, "cgo", false},
+		{Target{"linux", "amd64"}, "ignore", false},
+	}
+	for _, test := range tests {
+		got, err := test.target.Matches(test.expr)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != test.want {
+			t.Errorf("%s.Matches(%q) = %t, want %t", test.target, test.expr, got, test.want)
+		}
+	}
+
+	if _, err := ParseTargets("linux/amd64,plan10/amd64"); err == nil {
+		t.Error("unknown GOOS was accepted")
+	}
+}
+
+func TestWriteTargets(t *testing.T) {
+	p := newTestProcessor(t, Options{})
+	dir := t.TempDir()
+	sources := map[string]string{
+		"pkg/common.go":       "package pkg\n\ntype T struct{}\n\nfunc (t *T) M() {}\n",
+		"pkg/foo_linux.go":    "package pkg\n\nfunc Foo() {}\n",
+		"pkg/foo_windows.go":  "package pkg\n\nfunc Foo() {}\n",
+		"pkg/unix.go":         "//go:build unix\n\npackage pkg\n\nvar Unix, _ = 1, 2\n",
+		"cmd/main_windows.go": "package main\n\nfunc main() {}\n",
+	}
+	for path, content := range sources {
+		output := filepath.Join(dir, filepath.FromSlash(outputName(path, astjson.CompressionNone)))
+		writeASTJSON(t, p, path, content, output)
+	}
+	// Other files of the directory are not AST JSON
+	err := os.WriteFile(filepath.Join(dir, "notes.json"), []byte("[]"), 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	targets, err := ParseTargets("linux/amd64,windows/amd64")
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = p.WriteTargets(dir, targets)
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := os.ReadFile(filepath.Join(dir, "targets.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var contents []TargetContents
+	err = json.Unmarshal(data, &contents)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(contents) != 2 {
+		t.Fatalf("unexpected contents %+v", contents)
+	}
+	linux, windows := contents[0], contents[1]
+	if len(linux.Packages) != 1 || strings.Join(linux.Packages[0].Decls, ",") != "Foo,T,T.M,Unix" {
+		t.Errorf("unexpected linux contents %+v", linux)
+	}
+	if len(windows.Packages) != 2 || windows.Packages[0].Dir != "cmd" || strings.Join(windows.Packages[1].Files, ",") != "pkg/common.go,pkg/foo_windows.go" {
+		t.Errorf("unexpected windows contents %+v", windows)
+	}
+}
+
+func TestWriteTargetsMalformedJSON(t *testing.T) {
+	p := newTestProcessor(t, Options{})
+	dir := t.TempDir()
+	writeASTJSON(t, p, "pkg/a.go", "package pkg\n", filepath.Join(dir, "pkg", "a.go.json"))
+	// The file decodes but has no package name
+	err := os.WriteFile(filepath.Join(dir, "pkg", "b.go.json"), []byte(`{"NodeType":"File","Decls":[],"Meta":{"Path":"pkg/b.go"}}`), 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	targets, err := ParseTargets("linux/amd64")
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = p.WriteTargets(dir, targets)
+	if err == nil || !strings.HasPrefix(err.Error(), "pkg/b.go.json: ") {
+		t.Errorf("WriteTargets = %v", err)
+	}
+}
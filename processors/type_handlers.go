@@ -5,9 +5,9 @@ import (
 	"strings"
 )
 
-func MapTypeHandler(fieldType map[string]interface{}, fieldMap map[string]interface{}) {
+func (p *Processor) MapTypeHandler(fieldType map[string]interface{}, fieldMap map[string]interface{}) {
 
-	fieldNames := fieldMap["Names"].([]interface{})
+	fieldNames, _ := fieldMap["Names"].([]interface{})
 	nameList := []string{}
 	if fieldNames != nil {
 		for _, fieldName := range fieldNames {
@@ -17,76 +17,74 @@ func MapTypeHandler(fieldType map[string]interface{}, fieldMap map[string]interf
 	var valuesValue string
 	switch fieldType["NodeType"].(string) {
 	case "Ident":
-		logger.Debug("TypeSpec->TypeSpec->StructType->MapType->Ident", zap.String("type", fieldType["Name"].(string)), zap.Any("names", nameList))
+		p.logger.Debug("TypeSpec->TypeSpec->StructType->MapType->Ident", zap.String("type", fieldType["Name"].(string)), zap.Any("names", nameList))
 	case "SelectorExpr":
-		SelectorExprHandler(fieldType)
+		p.SelectorExprHandler(fieldType)
 	case "StarExpr":
-		StarExprHandler(fieldType, fieldMap)
+		p.StarExprHandler(fieldType, fieldMap)
 
 	case "ArrayType":
-		ArrayTypeHandler(fieldType, fieldMap)
+		p.ArrayTypeHandler(fieldType, fieldMap)
 	case "MapType":
 		keys := fieldType["Key"].(map[string]interface{})
 		values := fieldType["Value"].(map[string]interface{})
 		switch values["NodeType"].(string) {
 		case "Ident":
 			valuesValue = values["Name"].(string)
+			p.logger.Debug("TypeSpec->TypeSpec->StructType->MapType", zap.Any("key type", keys["Name"]), zap.String("value type", valuesValue), zap.Any("names", nameList))
 		case "SelectorExpr":
-			SelectorExprHandler(values)
+			p.SelectorExprHandler(values)
 		case "StarExpr":
-			StarExprHandler(values, fieldMap)
+			p.StarExprHandler(values, fieldMap)
 		case "ArrayType":
-			ArrayTypeHandler(values, fieldMap)
+			p.ArrayTypeHandler(values, fieldMap)
 		case "MapType":
-			keysValue := keys["Name"].(string)
-			if values == nil {
-				values = fieldType["Value"].(map[string]interface{})
-			}
-			valuesValue = values["Name"].(string)
-			logger.Debug("TypeSpec->TypeSpec->StructType->MapType", zap.String("key type", keysValue), zap.String("value type", valuesValue), zap.Any("names", nameList))
+			// A map of maps has no value type name, its inner map is handled in turn
+			p.logger.Debug("TypeSpec->TypeSpec->StructType->MapType", zap.Any("key type", keys["Name"]), zap.Any("names", nameList))
+			p.MapTypeHandler(values, fieldMap)
 		}
 	default:
-		logger.Debug("MapType Unknown", zap.Any("type", fieldType["NodeType"]))
+		p.logger.Debug("MapType Unknown", zap.Any("type", fieldType["NodeType"]))
 	}
 
 }
 
-func SelectorExprHandler(fieldType map[string]interface{}) {
+func (p *Processor) SelectorExprHandler(fieldType map[string]interface{}) {
 	starExprType := fieldType["X"].(map[string]interface{})
 	switch starExprType["NodeType"].(string) {
 	case "Ident":
-		logger.Debug("SelectorExpr->Ident", zap.String("type", starExprType["Name"].(string)))
+		p.logger.Debug("SelectorExpr->Ident", zap.String("type", starExprType["Name"].(string)))
 	case "SelectorExpr":
 		xExp := fieldType["X"].(map[string]interface{})
 		selExp := fieldType["Sel"].(map[string]interface{})
 
 		xName := xExp["Name"].(string)
 		selName := selExp["Name"].(string)
-		logger.Debug("SelectorExpr", zap.String("type", xName), zap.String("Selector", selName))
+		p.logger.Debug("SelectorExpr", zap.String("type", xName), zap.String("Selector", selName))
 	default:
-		logger.Debug("SelectorExpr->Unknown", zap.String("type", starExprType["Name"].(string)))
+		p.logger.Debug("SelectorExpr->Unknown", zap.String("type", starExprType["Name"].(string)))
 	}
 
 }
 
-func ArrayTypeHandler(values map[string]interface{}, fieldMap map[string]interface{}) {
+func (p *Processor) ArrayTypeHandler(values map[string]interface{}, fieldMap map[string]interface{}) {
 	arrayType := values["Elt"].(map[string]interface{})
-	fieldNames := fieldMap["Names"].([]interface{})
+	fieldNames, _ := fieldMap["Names"].([]interface{})
 	nameList := []string{}
 	if fieldNames != nil {
 		for _, fieldName := range fieldNames {
 			nameList = append(nameList, fieldName.(map[string]interface{})["Name"].(string))
 		}
 	}
-	logger.Debug("ArrayType", zap.Any("type", arrayType["Name"]), zap.Any("names", nameList))
+	p.logger.Debug("ArrayType", zap.Any("type", arrayType["Name"]), zap.Any("names", nameList))
 
 }
 
-func StarExprHandler(values map[string]interface{}, fieldMap map[string]interface{}) {
+func (p *Processor) StarExprHandler(values map[string]interface{}, fieldMap map[string]interface{}) {
 	starExprType := values["X"].(map[string]interface{})
 	nameList := []string{}
 	if fieldMap["Names"] != nil {
-		fieldNames := fieldMap["Names"].([]interface{})
+		fieldNames, _ := fieldMap["Names"].([]interface{})
 		for _, fieldName := range fieldNames {
 			nameList = append(nameList, fieldName.(map[string]interface{})["Name"].(string))
 
@@ -95,15 +93,15 @@ func StarExprHandler(values map[string]interface{}, fieldMap map[string]interfac
 
 	switch starExprType["NodeType"].(string) {
 	case "Ident":
-		logger.Debug("StarExpr->Ident", zap.String("type", starExprType["Name"].(string)), zap.Any("name", fieldMap["Names"]))
+		p.logger.Debug("StarExpr->Ident", zap.String("type", starExprType["Name"].(string)), zap.Any("name", fieldMap["Names"]))
 	case "SelectorExpr":
-		SelectorExprHandler(starExprType)
+		p.SelectorExprHandler(starExprType)
 	default:
-		logger.Debug("StarExpr->Unknown", zap.String("type", starExprType["NodeType"].(string)), zap.Any("name", fieldMap["Names"]))
+		p.logger.Debug("StarExpr->Unknown", zap.String("type", starExprType["NodeType"].(string)), zap.Any("name", fieldMap["Names"]))
 	}
 }
 
-func ValueSpecHandler(spec []interface{}) {
+func (p *Processor) ValueSpecHandler(spec []interface{}) {
 	for _, s := range spec {
 		sMap := s.(map[string]interface{})
 		sType := sMap["NodeType"].(string)
@@ -112,19 +110,19 @@ func ValueSpecHandler(spec []interface{}) {
 			if sMap["Name"] == nil {
 				path := sMap["Path"].(map[string]interface{})
 				value := strings.ReplaceAll(path["Value"].(string), "\"", "")
-				logger.Debug("ValueSpec->ImportSpec", zap.Any("name", value))
+				p.logger.Debug("ValueSpec->ImportSpec", zap.Any("name", value))
 			}
 		case "TypeSpec":
-			logger.Debug("TypeSpec", zap.Any("name", sMap["Name"]))
+			p.logger.Debug("TypeSpec", zap.Any("name", sMap["Name"]))
 		case "ValueSpec":
-			ValueSpecItemHandler(sMap)
+			p.ValueSpecItemHandler(sMap)
 		default:
-			logger.Debug("Unknown", zap.Any("name", sMap["Name"]))
+			p.logger.Debug("Unknown", zap.Any("name", sMap["Name"]))
 		}
 	}
 }
 
-func ValueSpecItemHandler(sMap map[string]interface{}) {
+func (p *Processor) ValueSpecItemHandler(sMap map[string]interface{}) {
 	values := []interface{}{}
 	typeMap := map[string]interface{}{}
 
@@ -138,64 +136,64 @@ func ValueSpecItemHandler(sMap map[string]interface{}) {
 			typeMap = sMap["Type"].(map[string]interface{})
 			switch typeMap["NodeType"].(string) {
 			case "Ident":
-				paramNameList, paramTypeList := IdentTypeHandler(typeMap, sMap)
+				paramNameList, paramTypeList := p.IdentTypeHandler(typeMap, sMap)
 				//for i, name := range names {
 				//	nameMap := name.(map[string]interface{})
 				//	if values != nil && len(values) > 0 {
 				//		valueMap := values[i].(map[string]interface{})
-				//		logger.Debug("ValueSpecItem->Ident", zap.Any("name", nameMap["Name"]), zap.Any("value", valueMap["Value"]), zap.Any("value type", valueMap["Kind"]), zap.Any("type", typeMap["Name"]))
+				//		p.logger.Debug("ValueSpecItem->Ident", zap.Any("name", nameMap["Name"]), zap.Any("value", valueMap["Value"]), zap.Any("value type", valueMap["Kind"]), zap.Any("type", typeMap["Name"]))
 				//	}
 				//}
-				logger.Debug("ValueSpecItem->Ident", zap.Any("names", paramNameList), zap.Any("type", paramTypeList))
+				p.logger.Debug("ValueSpecItem->Ident", zap.Any("names", paramNameList), zap.Any("type", paramTypeList))
 
 			case "SelectorExpr":
-				SelectorExprHandler(typeMap)
+				p.SelectorExprHandler(typeMap)
 			case "StarExpr":
-				StarExprHandler(typeMap, sMap)
+				p.StarExprHandler(typeMap, sMap)
 			case "ArrayType":
-				ArrayTypeHandler(typeMap, sMap)
+				p.ArrayTypeHandler(typeMap, sMap)
 			case "MapType":
-				MapTypeHandler(typeMap, sMap)
+				p.MapTypeHandler(typeMap, sMap)
 			case "FuncType":
-				logger.Debug("ValueSpecItem->FuncType", zap.Any("name", names), zap.Any("value", values), zap.Any("type", typeMap["Name"]))
+				p.logger.Debug("ValueSpecItem->FuncType", zap.Any("name", names), zap.Any("value", values), zap.Any("type", typeMap["Name"]))
 			case "InterfaceType":
-				logger.Debug("ValueSpecItem->InterfaceType", zap.Any("name", names), zap.Any("value", values), zap.Any("type", typeMap["Name"]))
+				p.logger.Debug("ValueSpecItem->InterfaceType", zap.Any("name", names), zap.Any("value", values), zap.Any("type", typeMap["Name"]))
 			case "Ellipsis":
-				EllipsisTypeHandler(typeMap, sMap)
-				logger.Debug("ValueSpecItem->Ellipsis", zap.Any("name", names), zap.Any("value", values), zap.Any("type", typeMap["Name"]))
+				p.EllipsisTypeHandler(typeMap, sMap)
+				p.logger.Debug("ValueSpecItem->Ellipsis", zap.Any("name", names), zap.Any("value", values), zap.Any("type", typeMap["Name"]))
 			case "ChanType":
-				logger.Debug("ValueSpecItem->Name", zap.Any("name", names), zap.Any("value", values), zap.Any("type", typeMap["Name"]))
+				p.logger.Debug("ValueSpecItem->Name", zap.Any("name", names), zap.Any("value", values), zap.Any("type", typeMap["Name"]))
 			case "StructType":
-				StructTypeHandler(typeMap)
-				logger.Debug("ValueSpecItem->StructType")
+				p.StructTypeHandler(typeMap)
+				p.logger.Debug("ValueSpecItem->StructType")
 			default:
-				logger.Debug("ValueSpecItem->Name", zap.Any("name", names), zap.Any("value", values), zap.Any("type", typeMap["Name"]))
+				p.logger.Debug("ValueSpecItem->Name", zap.Any("name", names), zap.Any("value", values), zap.Any("type", typeMap["Name"]))
 			}
-			//logger.Debug("ValueSpecItem->Name", zap.Any("name", names), zap.Any("value", values), zap.Any("type", typeMap["Name"]))
+			//p.logger.Debug("ValueSpecItem->Name", zap.Any("name", names), zap.Any("value", values), zap.Any("type", typeMap["Name"]))
 			return
 		} else {
 			for i, name := range names {
 				nameMap := name.(map[string]interface{})
 				if values != nil && len(values) > 0 {
 					valueMap := values[i].(map[string]interface{})
-					logger.Debug("ValueSpecItem->Name", zap.Any("name", nameMap["Name"]), zap.Any("value", valueMap["Value"]), zap.Any("type", valueMap["Kind"]))
+					p.logger.Debug("ValueSpecItem->Name", zap.Any("name", nameMap["Name"]), zap.Any("value", valueMap["Value"]), zap.Any("type", valueMap["Kind"]))
 				} else {
-					logger.Debug("ValueSpecItem->Name", zap.Any("name", nameMap["Name"]), zap.Any("value", "nil"), zap.Any("type", "nil"))
+					p.logger.Debug("ValueSpecItem->Name", zap.Any("name", nameMap["Name"]), zap.Any("value", "nil"), zap.Any("type", "nil"))
 				}
 			}
 		}
 
 	} else {
-		logger.Fatal("unexpected ValueSpecItem", zap.Any("name", sMap["Name"]))
+		p.logger.Fatal("unexpected ValueSpecItem", zap.Any("name", sMap["Name"]))
 	}
 }
 
-func StructTypeHandler(fieldsMap map[string]interface{}) {
-	FieldListHandler(fieldsMap)
+func (p *Processor) StructTypeHandler(fieldsMap map[string]interface{}) {
+	p.FieldListHandler(fieldsMap)
 }
 
-func IdentTypeHandler(typeMap, param map[string]interface{}) (paramNameList []string, paramTypeList []string) {
-	nameList := param["Names"].([]interface{})
+func (p *Processor) IdentTypeHandler(typeMap, param map[string]interface{}) (paramNameList []string, paramTypeList []string) {
+	nameList, _ := param["Names"].([]interface{})
 
 	typeName := typeMap["Name"].(string)
 	for _, name := range nameList {
@@ -203,43 +201,43 @@ func IdentTypeHandler(typeMap, param map[string]interface{}) (paramNameList []st
 		paramTypeList = append(paramTypeList, typeName)
 	}
 
-	logger.Debug("FuncDeclHandler->Ident", zap.Any("name", paramNameList), zap.String("type", typeName))
+	p.logger.Debug("FuncDeclHandler->Ident", zap.Any("name", paramNameList), zap.String("type", typeName))
 	return paramNameList, paramTypeList
 }
 
-func EllipsisTypeHandler(typeMap, param map[string]interface{}) {
+func (p *Processor) EllipsisTypeHandler(typeMap, param map[string]interface{}) {
 	if typeMap["Elt"] != nil {
 		ellipsis := typeMap["Elt"].(map[string]interface{})
 		switch ellipsis["NodeType"].(string) {
 		case "Ident":
-			paramNameList, paramTypeList := IdentTypeHandler(ellipsis, param)
-			logger.Debug("EllipsisTypeHandler->Ident", zap.Any("names", paramNameList), zap.Any("types", paramTypeList))
+			paramNameList, paramTypeList := p.IdentTypeHandler(ellipsis, param)
+			p.logger.Debug("EllipsisTypeHandler->Ident", zap.Any("names", paramNameList), zap.Any("types", paramTypeList))
 		case "SelectorExpr":
-			SelectorExprHandler(ellipsis)
+			p.SelectorExprHandler(ellipsis)
 		case "StarExpr":
-			StarExprHandler(ellipsis, param)
+			p.StarExprHandler(ellipsis, param)
 		case "ArrayType":
-			ArrayTypeHandler(ellipsis, param)
+			p.ArrayTypeHandler(ellipsis, param)
 		case "MapType":
-			MapTypeHandler(ellipsis, param)
+			p.MapTypeHandler(ellipsis, param)
 		case "FuncType":
 			if ellipsis["Params"] != nil {
 				params := ellipsis["Params"].(map[string]interface{})
-				FieldListHandler(params)
+				p.FieldListHandler(params)
 			}
-			logger.Debug("EllipsisTypeHandler->FuncType")
+			p.logger.Debug("EllipsisTypeHandler->FuncType")
 		case "InterfaceType":
-			logger.Debug("EllipsisTypeHandler->InterfaceType", zap.Any("name", param["Names"]))
+			p.logger.Debug("EllipsisTypeHandler->InterfaceType", zap.Any("name", param["Names"]))
 		case "Ellipsis":
-			logger.Debug("EllipsisTypeHandler->Ellipsis", zap.Any("name", param["Names"]))
+			p.logger.Debug("EllipsisTypeHandler->Ellipsis", zap.Any("name", param["Names"]))
 		case "ChanType":
 
-			logger.Debug("EllipsisTypeHandler->ChanType", zap.Any("name", param["Names"]))
+			p.logger.Debug("EllipsisTypeHandler->ChanType", zap.Any("name", param["Names"]))
 		case "StructType":
-			StructTypeHandler(ellipsis)
-			logger.Debug("EllipsisTypeHandler->StructType", zap.Any("name", param["Names"]))
+			p.StructTypeHandler(ellipsis)
+			p.logger.Debug("EllipsisTypeHandler->StructType", zap.Any("name", param["Names"]))
 		default:
-			logger.Debug("EllipsisTypeHandler->Unknown", zap.Any("name", param["Names"]))
+			p.logger.Debug("EllipsisTypeHandler->Unknown", zap.Any("name", param["Names"]))
 		}
 	}
 
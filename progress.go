@@ -0,0 +1,80 @@
+package main
+
+import (
+	"GoOperatorAST/processors"
+	"errors"
+	"flag"
+	"go.uber.org/zap"
+	"net"
+	"net/http"
+	"os"
+)
+
+// progressConfig holds the flags selecting where the progress of a run is reported
+type progressConfig struct {
+	file        *string
+	metricsAddr *string
+}
+
+// Register the flags selecting where the progress of a run is reported
+// @param flags: flag set to register the flags with
+// @return the flag values, filled in once the flags are parsed
+func progressFlags(flags *flag.FlagSet) *progressConfig {
+	return &progressConfig{
+		file:        flags.String("progress", "", "Optional: File receiving the progress events as newline delimited JSON, - writes them to stderr"),
+		metricsAddr: flags.String("metricsAddr", "", "Optional: Address such as :9090 serving the progress counters on /metrics for Prometheus"),
+	}
+}
+
+// Open the progress outputs selected by the flags
+// @return the progress to pass to the processor, the metrics to watch the processor with, nil without endpoint, and the function closing the outputs
+func (c *progressConfig) open() (processors.Progress, *processors.Metrics, func(), error) {
+	var progress []processors.Progress
+	var closers []func() error
+
+	switch *c.file {
+	case "":
+	case "-":
+		progress = append(progress, processors.NewNDJSONProgress(os.Stderr))
+	default:
+		f, err := os.Create(*c.file)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		progress = append(progress, processors.NewNDJSONProgress(f))
+		closers = append(closers, f.Close)
+	}
+
+	var metrics *processors.Metrics
+	if *c.metricsAddr != "" {
+		listener, err := net.Listen("tcp", *c.metricsAddr)
+		if err != nil {
+			for _, close := range closers {
+				close()
+			}
+			return nil, nil, nil, err
+		}
+		metrics = processors.NewMetrics()
+		progress = append(progress, metrics)
+		mux := http.NewServeMux()
+		mux.Handle("/metrics", metrics)
+		server := &http.Server{Handler: mux}
+		go func() {
+			err := server.Serve(listener)
+			if err != nil && !errors.Is(err, http.ErrServerClosed) {
+				logger.Error("Metrics endpoint stopped", zap.Error(err))
+			}
+		}()
+		logger.Info("Serving metrics", zap.String("address", listener.Addr().String()))
+		closers = append(closers, server.Close)
+	}
+
+	closeAll := func() {
+		for _, close := range closers {
+			if err := close(); err != nil {
+				logger.Warn("Unable to close progress output", zap.Error(err))
+			}
+		}
+	}
+	return processors.MultiProgress(progress...), metrics, closeAll, nil
+}
@@ -0,0 +1,107 @@
+package main
+
+import (
+	"GoOperatorAST/processors"
+	"context"
+	"fmt"
+	"go.uber.org/zap"
+	"sync"
+)
+
+// seriesParallel is the number of releases of a series processed at once
+const seriesParallel = 4
+
+// tagResult holds the outcome of one release of a series
+type tagResult struct {
+	tag     string
+	skipped []processors.SkippedFile
+	err     error
+}
+
+// Process every release of a series once and write the timeline of the API changes between consecutive releases
+// @param ctx: context of the run
+// @param job: repository to process, its old source and output directory are used
+// @param releases: tags in release order
+// @return the files missing from each release, or the error preventing a release from being processed, and the error writing the timeline
+func runSeries(ctx context.Context, job pairJob, releases []string) ([]tagResult, error) {
+	createDir(job.outputDir)
+
+	var wg sync.WaitGroup
+	results := make([]tagResult, len(releases))
+	refs := make([]processors.ResolvedRef, len(releases))
+	slots := make(chan struct{}, seriesParallel)
+	for i, tag := range releases {
+		wg.Add(1)
+		go func(i int, tag string) {
+			defer wg.Done()
+			slots <- struct{}{}
+			defer func() { <-slots }()
+
+			ref, err := resolveTag(ctx, job, job.oldSource, tag)
+			if err != nil {
+				results[i] = tagResult{tag: tag, err: err}
+				return
+			}
+			refs[i] = ref
+			createDir(job.outputDir + "/" + ref.DirName())
+			logger.Debug(fmt.Sprintf("Processing repository %s with tag %s", job.name, tag))
+			skipped, err := processTag(ctx, job, job.oldSource, ref, nil)
+			results[i] = tagResult{tag: tag, skipped: skipped, err: err}
+		}(i, tag)
+	}
+	wg.Wait()
+
+	// A release that could not be listed would show its whole API as removed
+	for _, result := range results {
+		if result.err != nil {
+			return results, fmt.Errorf("not writing the release timeline, %s failed", result.tag)
+		}
+	}
+	err := job.proc.WriteTimeline(job.outputDir, refs)
+	if err != nil {
+		return results, fmt.Errorf("writing the release timeline: %w", err)
+	}
+	logger.Info("Release timeline written", zap.Int("releases", len(releases)))
+	return results, nil
+}
+
+// Select the releases of a series from the tags of a repository and process them
+// @param ctx: context of the run
+// @param job: repository to process
+// @param tagSource: source listing the tags of the repository
+// @param query: releases to select
+// @param cache: blob cache to trim afterwards, may be nil
+// @return true when every release is complete and the timeline was written
+func processSeries(ctx context.Context, job pairJob, tagSource processors.Source, query processors.ReleaseQuery, cache *processors.BlobCache) bool {
+	tags, err := processors.ListTags(ctx, tagSource)
+	if err != nil {
+		logger.Error("Unable to list tags", zap.Error(err))
+		return false
+	}
+	releases, err := processors.SelectReleases(tags, query)
+	if err != nil {
+		logger.Error("Invalid release selection", zap.Error(err))
+		return false
+	}
+	if len(releases) < 2 {
+		logger.Error("The release selection needs at least two releases", zap.Strings("releases", releases))
+		return false
+	}
+	logger.Info("Processing releases", zap.Strings("releases", releases))
+
+	worker_ch := startWorkerReporter(job.proc)
+	results, err := runSeries(ctx, job, releases)
+	worker_ch <- true
+	finishRun(job.proc, cache)
+
+	complete := err == nil
+	if err != nil {
+		logger.Error("Release series incomplete", zap.Error(err))
+	}
+	for _, result := range results {
+		if !reportMissing(result.tag, result.skipped, result.err) {
+			complete = false
+		}
+	}
+	return complete
+}